@@ -150,10 +150,14 @@ func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fees sdk.
 func deductFeesFromBalanceOrUnclaimedStakingRewards(
 	ctx sdk.Context, dfd DeductFeeDecorator, deductFeesFromAcc authtypes.AccountI, fees sdk.Coins,
 ) error {
-	if err := anteutils.ClaimStakingRewardsIfNecessary(
-		ctx, dfd.bankKeeper, dfd.distributionKeeper, dfd.stakingKeeper, deductFeesFromAcc.GetAddress(), fees,
-	); err != nil {
-		return err
+	// the staking rewards can only cover the fees paid in the staking denomination,
+	// so they are not claimed for the fees paid in a fee token
+	if fees.AmountOf(dfd.stakingKeeper.BondDenom(ctx)).IsPositive() {
+		if err := anteutils.ClaimStakingRewardsIfNecessary(
+			ctx, dfd.bankKeeper, dfd.distributionKeeper, dfd.stakingKeeper, deductFeesFromAcc.GetAddress(), fees,
+		); err != nil {
+			return err
+		}
 	}
 
 	return authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fees)
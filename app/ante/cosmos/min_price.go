@@ -38,17 +38,20 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feesKeeper.GetParams(ctx).MinGasPrice
+	feeMarketParams := mpd.feesKeeper.GetParams(ctx)
+	minGasPrice := feeMarketParams.MinGasPrice
 
 	feeCoins := feeTx.GetFee()
 	evmParams := mpd.evmKeeper.GetParams(ctx)
 	evmDenom := evmParams.GetEvmDenom()
 
-	// only allow user to pass in aevmos and stake native token as transaction fees
+	// only allow user to pass in aevmos, stake native token or a registered fee token
+	// as transaction fees
 	// allow use stake native tokens for fees is just for unit tests to pass
-	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+	feeToken, isFeeToken := evmante.GetFeeToken(feeCoins, evmDenom, feeMarketParams)
+	validFees := len(feeCoins) == 0 || isFeeToken || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
 	if !validFees && !simulate {
-		return ctx, fmt.Errorf("expected only use native token %s for fee, but got %s", evmDenom, feeCoins.String())
+		return ctx, fmt.Errorf("expected only use native token %s for fee or a registered fee token, but got %s", evmDenom, feeCoins.String())
 	}
 
 	// Short-circuit if min gas price is 0 or if simulating
@@ -63,6 +66,16 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		},
 	}
 
+	// the min gas price of the fee tokens is converted from the EVM denomination
+	if isFeeToken {
+		minGasPrices = sdk.DecCoins{
+			{
+				Denom:  feeToken.Denom,
+				Amount: feeToken.FromEvmDenom(minGasPrice),
+			},
+		}
+	}
+
 	gas := feeTx.GetGas()
 
 	requiredFees := make(sdk.Coins, 0)
@@ -11,6 +11,7 @@ import (
 	"github.com/evmos/evmos/v16/testutil"
 	testutiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/utils"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 var execTypes = []struct {
@@ -121,6 +122,54 @@ func (suite *AnteTestSuite) TestMinGasPriceDecorator() {
 			"provided fee < minimum global fee",
 			true,
 		},
+		{
+			"valid cosmos tx with MinGasPrices = 10, fee token gasPrice = 5",
+			func() sdk.Tx {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeTokens = []feemarkettypes.FeeToken{feemarkettypes.NewFeeToken("uatom", math.LegacyNewDecWithPrec(5, 1))}
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(5), "uatom", &testMsg)
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"invalid cosmos tx with MinGasPrices = 10, fee token gasPrice = 4",
+			func() sdk.Tx {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeTokens = []feemarkettypes.FeeToken{feemarkettypes.NewFeeToken("uatom", math.LegacyNewDecWithPrec(5, 1))}
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(4), "uatom", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			"provided fee < minimum global fee",
+			true,
+		},
+		{
+			"invalid cosmos tx with unregistered fee token",
+			func() sdk.Tx {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeTokens = nil
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(10), "uatom", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			fmt.Sprintf("expected only use native token %s for fee", denom),
+			true,
+		},
 		{
 			"valid cosmos tx with MinGasPrices = 0, gasPrice = 0, valid fee",
 			func() sdk.Tx {
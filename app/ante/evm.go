@@ -17,6 +17,7 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.EvmKeeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
+			options.FeegrantKeeper,
 			options.MaxTxGasWanted,
 		),
 	)
@@ -36,7 +37,7 @@ func newEVMAnteHandler(options HandlerOptions) sdk.AnteHandler { //nolint: unuse
 		evmante.NewEthAccountVerificationDecorator(options.AccountKeeper, options.EvmKeeper),
 		evmante.NewCanTransferDecorator(options.EvmKeeper),
 		evmante.NewEthVestingTransactionDecorator(options.AccountKeeper, options.BankKeeper, options.EvmKeeper),
-		evmante.NewEthGasConsumeDecorator(options.BankKeeper, options.DistributionKeeper, options.EvmKeeper, options.StakingKeeper, options.MaxTxGasWanted),
+		evmante.NewEthGasConsumeDecorator(options.BankKeeper, options.DistributionKeeper, options.EvmKeeper, options.StakingKeeper, options.FeegrantKeeper, options.MaxTxGasWanted),
 		evmante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper),
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
 		// emit eth tx hash and index at the very last ante handler.
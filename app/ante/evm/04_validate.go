@@ -111,7 +111,7 @@ func CheckDisabledCreateCallAndUpdateTxFee(
 		return nil, 0, errorsmod.Wrap(evmtypes.ErrCallDisabled, "failed to call contract")
 	}
 
-	if baseFee == nil && txType == ethtypes.DynamicFeeTxType {
+	if baseFee == nil && (txType == ethtypes.DynamicFeeTxType || txType == evmtypes.SetCodeTxType) {
 		return nil, 0, errorsmod.Wrap(ethtypes.ErrTxTypeNotSupported, "dynamic fee tx not supported")
 	}
 
@@ -119,6 +119,17 @@ func CheckDisabledCreateCallAndUpdateTxFee(
 	return txFee, txGasLimit, nil
 }
 
+// CheckDeploymentPolicy checks that the verified sender of the Ethereum transaction is
+// allowed to deploy contracts and that the recipient is not a denied callee under
+// the deployment policy set through governance.
+// NOTE: the sender must have been recovered through the signature verification.
+func CheckDeploymentPolicy(policy evmtypes.DeploymentPolicy, msg *evmtypes.MsgEthereumTx, to *common.Address) error {
+	if err := policy.ValidateTopLevel(common.HexToAddress(msg.From), to); err != nil {
+		return errorsmod.Wrap(err, "rejected by the deployment policy")
+	}
+	return nil
+}
+
 // FIXME: this shouldn't be required if the tx was an Ethereum transaction type
 func ValidateTx(tx sdk.Tx) (*tx.Fee, error) {
 	err := tx.ValidateBasic()
@@ -150,8 +161,31 @@ func ValidateTx(tx sdk.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
+	}
+
+	// the fee granter pays the fees of the senders through its fee grant allowances,
+	// so it must be bound to each signed Ethereum transaction
+	if authInfo.Fee.Granter != "" {
+		feeGranter, err := sdk.AccAddressFromBech32(authInfo.Fee.Granter)
+		if err != nil {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee granter address: %s", err)
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			_, txData, _, err := evmtypes.UnpackEthMsg(msg)
+			if err != nil {
+				return nil, err
+			}
+
+			if !IsFeeGranterBound(feeGranter, txData) {
+				return nil, errorsmod.Wrapf(
+					errortypes.ErrUnauthorized,
+					"fee granter %s is not in the access list of the Ethereum transaction", feeGranter,
+				)
+			}
+		}
 	}
 
 	sigs := protoTx.Signatures
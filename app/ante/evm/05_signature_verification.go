@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
@@ -37,6 +38,7 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 	blockNum := big.NewInt(ctx.BlockHeight())
 	signer := ethtypes.MakeSigner(ethCfg, blockNum)
 	allowUnprotectedTxs := evmParams.GetAllowUnprotectedTxs()
+	deploymentPolicy := evmParams.GetDeploymentPolicy()
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -48,6 +50,14 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 		if err != nil {
 			return ctx, err
 		}
+
+		if err := VerifySetCodeAuthorizations(ctx, esvd.evmKeeper, chainCfg, msgEthTx); err != nil {
+			return ctx, err
+		}
+
+		if err := CheckDeploymentPolicy(deploymentPolicy, msgEthTx, msgEthTx.AsTransaction().To()); err != nil {
+			return ctx, err
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -68,7 +78,14 @@ func SignatureVerification(
 			"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
 	}
 
-	sender, err := signer.Sender(ethTx)
+	var sender common.Address
+	var err error
+	if msg.TxType() == evmtypes.SetCodeTxType {
+		// the go-ethereum signers don't support the set code transactions
+		sender, err = msg.GetSender(signer.ChainID())
+	} else {
+		sender, err = signer.Sender(ethTx)
+	}
 	if err != nil {
 		return errorsmod.Wrapf(
 			errortypes.ErrorInvalidSigner,
@@ -81,3 +98,78 @@ func SignatureVerification(
 	msg.From = sender.Hex()
 	return nil
 }
+
+// VerifySetCodeAuthorizations checks that the EIP-7702 set code transactions are
+// enabled and that their authorizations are signed for the chain. During CheckTx,
+// it also checks that the authorities are EOAs and that the nonces of the
+// authorizations match the ones of the authorities, as the authorizations failing
+// these checks are skipped when the transaction is executed.
+// NOTE: the sender must have been recovered through the signature verification.
+func VerifySetCodeAuthorizations(
+	ctx sdk.Context,
+	evmKeeper EVMKeeper,
+	chainCfg evmtypes.ChainConfig,
+	msg *evmtypes.MsgEthereumTx,
+) error {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	setCodeTx, ok := txData.(*evmtypes.SetCodeTx)
+	if !ok {
+		return nil
+	}
+
+	if !chainCfg.IsPrague(big.NewInt(ctx.BlockHeight())) {
+		return errorsmod.Wrapf(evmtypes.ErrSetCodeTxDisabled, "prague fork not active at height %d", ctx.BlockHeight())
+	}
+
+	chainID := evmKeeper.ChainID()
+	sender := common.HexToAddress(msg.From)
+	nonces := make(map[common.Address]uint64)
+
+	for i, auth := range setCodeTx.Authorizations {
+		if authChainID := auth.ChainID.BigInt(); authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+			return errorsmod.Wrapf(
+				evmtypes.ErrInvalidSetCodeTx,
+				"invalid authorization %d chain ID %s, expected %s or 0", i, authChainID, chainID,
+			)
+		}
+
+		authority, err := auth.Authority()
+		if err != nil {
+			return errorsmod.Wrapf(evmtypes.ErrInvalidSetCodeTx, "couldn't retrieve authority of authorization %d: %s", i, err)
+		}
+
+		if !ctx.IsCheckTx() {
+			continue
+		}
+
+		nonce, ok := nonces[authority]
+		if !ok {
+			account := evmKeeper.GetAccount(ctx, authority)
+			if account != nil {
+				if account.IsContract() && !IsDelegated(ctx, evmKeeper, account) {
+					return errorsmod.Wrapf(evmtypes.ErrInvalidSetCodeTx, "authority of authorization %d is not EOA: address %s", i, authority)
+				}
+				nonce = account.Nonce
+			}
+
+			// the nonce of the sender is incremented before the authorizations are applied
+			if authority == sender {
+				nonce++
+			}
+		}
+
+		if auth.Nonce != nonce {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidSequence,
+				"invalid nonce of authorization %d; got %d, expected %d", i, auth.Nonce, nonce,
+			)
+		}
+		nonces[authority] = nonce + 1
+	}
+
+	return nil
+}
@@ -43,6 +43,7 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
+	feeGranter := GetFeeGranter(tx)
 	for _, msg := range tx.GetMsgs() {
 		_, txData, from, err := evmtypes.UnpackEthMsg(msg)
 		if err != nil {
@@ -51,23 +52,27 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 
 		fromAddr := common.BytesToAddress(from)
 		account := avd.evmKeeper.GetAccount(ctx, fromAddr)
-		if err := VerifyAccountBalance(ctx, avd.ak, account, fromAddr, txData); err != nil {
+		sponsored := IsFeeSponsored(feeGranter, from, txData)
+		if err := VerifyAccountBalance(ctx, avd.ak, avd.evmKeeper, account, fromAddr, txData, sponsored); err != nil {
 			return ctx, err
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
-// VerifyAccountBalance checks that the sender balance is greater than the total transaction cost.
+// VerifyAccountBalance checks that the sender balance is greater than the total transaction cost,
+// or than the transaction value if the fees are sponsored by a fee granter or paid in a fee token.
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper statedb.Keeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	sponsored bool,
 ) error {
-	// check whether the sender address is EOA
-	if account != nil && account.IsContract() {
+	// check whether the sender address is EOA, which can have delegated its code
+	if account != nil && account.IsContract() && !IsDelegated(ctx, evmKeeper, account) {
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
@@ -80,9 +85,28 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	// the fees of the sponsored transactions are paid by the fee granter, and the
+	// fees paid in a fee token are not paid from the EVM balance
+	if sponsored {
+		if value := txData.GetValue(); value != nil && account.Balance.Cmp(value) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"sender balance < tx value (%s < %s)", account.Balance, value,
+			)
+		}
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
 	return nil
 }
+
+// IsDelegated returns true if the code of the account is an EIP-7702 delegation
+// designator.
+func IsDelegated(ctx sdk.Context, evmKeeper statedb.Keeper, account *statedb.Account) bool {
+	_, ok := evmtypes.ParseDelegation(evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash)))
+	return ok
+}
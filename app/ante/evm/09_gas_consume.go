@@ -9,11 +9,13 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	anteutils "github.com/evmos/evmos/v16/app/ante/utils"
 	"github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/x/evm/keeper"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // EthGasConsumeDecorator validates enough intrinsic gas for the transaction and
@@ -23,6 +25,7 @@ type EthGasConsumeDecorator struct {
 	distributionKeeper anteutils.DistributionKeeper
 	evmKeeper          EVMKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	feegrantKeeper     authante.FeegrantKeeper
 	maxGasWanted       uint64
 }
 
@@ -32,6 +35,7 @@ func NewEthGasConsumeDecorator(
 	distributionKeeper anteutils.DistributionKeeper,
 	evmKeeper EVMKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 ) EthGasConsumeDecorator {
 	return EthGasConsumeDecorator{
@@ -39,6 +43,7 @@ func NewEthGasConsumeDecorator(
 		distributionKeeper,
 		evmKeeper,
 		stakingKeeper,
+		feegrantKeeper,
 		maxGasWanted,
 	}
 }
@@ -57,6 +62,7 @@ func NewEthGasConsumeDecorator(
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
 // - user has neither enough balance nor staking rewards to deduct the transaction fees (gas_limit * gas_price)
+// - the fee granter of the transaction doesn't allow to pay the fees of the user
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 // - gas limit is greater than the block gas meter limit
@@ -87,6 +93,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
 	baseFee := egcd.evmKeeper.GetBaseFee(ctx, ethCfg)
+	feeGranter := GetFeeGranter(tx)
 
 	for _, msg := range tx.GetMsgs() {
 		_, txData, from, err := evmtypes.UnpackEthMsg(msg)
@@ -100,12 +107,16 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			egcd.distributionKeeper,
 			egcd.evmKeeper,
 			egcd.stakingKeeper,
+			egcd.feegrantKeeper,
+			msg,
 			from,
+			feeGranter,
 			txData,
 			minPriority,
 			gasWanted,
 			egcd.maxGasWanted,
 			evmDenom,
+			nil,
 			baseFee,
 			homestead,
 			istanbul,
@@ -124,18 +135,23 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(newCtx, tx, simulate)
 }
 
-// ConsumeGas consumes the gas from the user balance and returns the updated gasWanted and minPriority.
+// ConsumeGas consumes the gas from the user balance, or from the fee granter balance if it
+// sponsors the fees of the user, and returns the updated gasWanted and minPriority.
+// If a fee token is provided, the fees are converted and paid in the fee token.
 func ConsumeGas(
 	ctx sdk.Context,
 	bankKeeper anteutils.BankKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	evmKeeper EVMKeeper,
 	stakingKeeper anteutils.StakingKeeper,
-	from sdk.AccAddress,
+	feegrantKeeper authante.FeegrantKeeper,
+	msg sdk.Msg,
+	from, feeGranter sdk.AccAddress,
 	txData evmtypes.TxData,
 	minPriority int64,
 	gasWanted, maxGasWanted uint64,
 	evmDenom string,
+	feeToken *feemarkettypes.FeeToken,
 	baseFee *big.Int,
 	isHomestead, isIstanbul bool,
 ) (uint64, int64, error) {
@@ -157,6 +173,23 @@ func ConsumeGas(
 		return gasWanted, minPriority, errorsmod.Wrapf(err, "failed to verify the fees")
 	}
 
+	if feeToken != nil {
+		// the leftover gas is refunded in the fee token after the execution
+		fees = ConvertFeesToFeeToken(fees, evmDenom, *feeToken)
+		evmKeeper.SetFeeTokenTransient(ctx, common.BytesToAddress(from), txData.GetNonce(), *feeToken)
+	}
+
+	feePayer := from
+	if IsFeeSponsored(feeGranter, from, txData) {
+		if err := UseFeeGrant(ctx, feegrantKeeper, feeGranter, from, fees, msg); err != nil {
+			return gasWanted, minPriority, err
+		}
+
+		// the leftover gas is refunded to the fee granter after the execution
+		evmKeeper.SetFeePayerTransient(ctx, common.BytesToAddress(from), txData.GetNonce(), common.BytesToAddress(feeGranter))
+		feePayer = feeGranter
+	}
+
 	if err = DeductFee(
 		ctx,
 		bankKeeper,
@@ -164,17 +197,16 @@ func ConsumeGas(
 		evmKeeper,
 		stakingKeeper,
 		fees,
-		from,
+		feePayer,
 	); err != nil {
 		return gasWanted, minPriority, err
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeTx,
-			sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
-		),
-	)
+	attrs := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyFee, fees.String())}
+	if !feePayer.Equals(from) {
+		attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx, attrs...))
 
 	priority := evmtypes.GetTxPriority(txData, baseFee)
 
@@ -200,9 +232,12 @@ func DeductFee(
 		return nil
 	}
 
-	// If the account balance is not sufficient, try to withdraw enough staking rewards
-	if err := anteutils.ClaimStakingRewardsIfNecessary(ctx, bankKeeper, distributionKeeper, stakingKeeper, feePayer, fees); err != nil {
-		return err
+	// If the account balance is not sufficient, try to withdraw enough staking rewards.
+	// The staking rewards can only cover the fees paid in the staking denomination.
+	if fees.AmountOf(stakingKeeper.BondDenom(ctx)).IsPositive() {
+		if err := anteutils.ClaimStakingRewardsIfNecessary(ctx, bankKeeper, distributionKeeper, stakingKeeper, feePayer, fees); err != nil {
+			return err
+		}
 	}
 
 	if err := evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(feePayer)); err != nil {
@@ -211,6 +246,68 @@ func DeductFee(
 	return nil
 }
 
+// GetFeeGranter returns the fee granter of the Cosmos transaction wrapping the
+// Ethereum transactions, if any.
+// NOTE: it returns nil if the fee granter address is invalid.
+func GetFeeGranter(tx sdk.Tx) sdk.AccAddress {
+	wrapperTx, ok := tx.(protoTxProvider)
+	if !ok {
+		return nil
+	}
+
+	authInfo := wrapperTx.GetProtoTx().AuthInfo
+	if authInfo == nil || authInfo.Fee == nil || authInfo.Fee.Granter == "" {
+		return nil
+	}
+
+	feeGranter, err := sdk.AccAddressFromBech32(authInfo.Fee.Granter)
+	if err != nil {
+		return nil
+	}
+	return feeGranter
+}
+
+// IsFeeSponsored returns true if the fees of the sender are paid by the fee
+// granter, which must be bound to the Ethereum transaction by the sender.
+func IsFeeSponsored(feeGranter, from sdk.AccAddress, txData evmtypes.TxData) bool {
+	return feeGranter != nil && !feeGranter.Equals(from) && IsFeeGranterBound(feeGranter, txData)
+}
+
+// IsFeeGranterBound returns true if the fee granter is in the access list of the
+// Ethereum transaction. The fee granter is set on the Cosmos transaction
+// wrapping the Ethereum transaction, which is not signed, so the sender opts in
+// to the sponsorship by adding the fee granter address to the signed access
+// list. Otherwise, anyone could wrap the transactions of the sender to spend
+// its allowance.
+func IsFeeGranterBound(feeGranter sdk.AccAddress, txData evmtypes.TxData) bool {
+	granter := common.BytesToAddress(feeGranter)
+	for _, tuple := range txData.GetAccessList() {
+		if tuple.Address == granter {
+			return true
+		}
+	}
+	return false
+}
+
+// UseFeeGrant deducts the fees from the allowance granted by the fee granter to
+// the sender of the Ethereum transaction.
+func UseFeeGrant(
+	ctx sdk.Context,
+	feegrantKeeper authante.FeegrantKeeper,
+	feeGranter, from sdk.AccAddress,
+	fees sdk.Coins,
+	msg sdk.Msg,
+) error {
+	if feegrantKeeper == nil {
+		return errortypes.ErrInvalidRequest.Wrap("fee grants are not enabled")
+	}
+
+	if err := feegrantKeeper.UseGrantedFees(ctx, feeGranter, from, fees, []sdk.Msg{msg}); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, from)
+	}
+	return nil
+}
+
 // TODO: (@fedekunze) Why is this necessary? This seems to be a duplicate from the CheckGasWanted function.
 func CheckBlockGasLimit(ctx sdk.Context, gasWanted uint64, minPriority int64) (sdk.Context, error) {
 	blockGasLimit := types.BlockGasLimit(ctx)
@@ -13,12 +13,16 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/testutil"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *AnteTestSuite) TestAnteHandler() {
@@ -1246,3 +1250,289 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerWithFeeGrant() {
+	addr, privKey := utiltx.NewAddrKey()
+	granter := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	to := utiltx.GenerateAddress()
+
+	// fee = gas limit * gas price
+	fee := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(100000*150)))
+
+	// the sender binds the fee granter to the signed tx through the access list
+	newTx := func(amount int64, bound bool) sdk.Tx {
+		accesses := &types.AccessList{}
+		if bound {
+			accesses = &types.AccessList{{Address: common.BytesToAddress(granter)}}
+		}
+
+		signedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			To:       &to,
+			Nonce:    1,
+			Amount:   big.NewInt(amount),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(150),
+			Accesses: accesses,
+		})
+		signedTx.From = addr.Hex()
+
+		txBuilder := suite.CreateTestTxBuilder(signedTx, privKey, 1, false)
+		txBuilder.SetFeeGranter(granter)
+		return txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		amount    int64
+		unbound   bool
+		expPass   bool
+		expErrMsg string
+	}{
+		{
+			"success - fees paid by the fee granter",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			0,
+			false,
+			true,
+			"",
+		},
+		{
+			"success - allowance limited to Ethereum txs",
+			func() {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: fee}, []string{sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})})
+				suite.Require().NoError(err)
+				err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), allowance)
+				suite.Require().NoError(err)
+			},
+			0,
+			false,
+			true,
+			"",
+		},
+		{
+			"fail - no allowance",
+			func() {},
+			0,
+			false,
+			false,
+			"does not allow to pay fees",
+		},
+		{
+			"fail - spend limit lower than fees",
+			func() {
+				limit := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(1)))
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), &feegrant.BasicAllowance{SpendLimit: limit})
+				suite.Require().NoError(err)
+			},
+			0,
+			false,
+			false,
+			"does not allow to pay fees",
+		},
+		{
+			"fail - allowance limited to other messages",
+			func() {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+				suite.Require().NoError(err)
+				err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), allowance)
+				suite.Require().NoError(err)
+			},
+			0,
+			false,
+			false,
+			"does not allow to pay fees",
+		},
+		{
+			"fail - fee granter not in the access list",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			0,
+			true,
+			false,
+			"is not in the access list",
+		},
+		{
+			"fail - sender balance lower than tx value",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, addr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			10,
+			false,
+			false,
+			"sender balance < tx value",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = false
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter))
+
+			err := suite.app.EvmKeeper.SetBalance(suite.ctx, common.BytesToAddress(granter), big.NewInt(10000000000))
+			suite.Require().NoError(err)
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+
+			tc.malleate()
+
+			suite.ctx = suite.ctx.WithIsCheckTx(false)
+			_, err = suite.anteHandler(suite.ctx, newTx(tc.amount, !tc.unbound), false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the sender balance is untouched and the fees are deducted from the fee granter
+			suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(suite.ctx, addr).Int64())
+			suite.Require().Equal(10000000000-fee.AmountOf(evmtypes.DefaultEVMDenom).Int64(), suite.app.EvmKeeper.GetBalance(suite.ctx, common.BytesToAddress(granter)).Int64())
+
+			payer, found := suite.app.EvmKeeper.GetFeePayerTransient(suite.ctx, addr, 1)
+			suite.Require().True(found)
+			suite.Require().Equal(common.BytesToAddress(granter), payer)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerWithFeeToken() {
+	var feeAmount sdk.Coins
+
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	// one unit of the EVM denomination is worth half a unit of the fee token
+	feeToken := feemarkettypes.NewFeeToken("uatom", sdkmath.LegacyNewDecWithPrec(5, 1))
+	// fee = gas limit * gas price * conversion rate
+	fee := sdk.NewCoins(sdk.NewCoin(feeToken.Denom, sdkmath.NewInt(100000*150/2)))
+	balance := sdk.NewCoins(sdk.NewCoin(feeToken.Denom, sdkmath.NewInt(10000000)))
+
+	// the sender binds the fee token to the signed tx through the access list
+	newTx := func(bound bool) sdk.Tx {
+		accesses := &types.AccessList{}
+		if bound {
+			accesses = &types.AccessList{{Address: feeToken.Address()}}
+		}
+
+		signedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			To:       &to,
+			Nonce:    1,
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(150),
+			Accesses: accesses,
+		})
+		signedTx.From = addr.Hex()
+
+		txBuilder := suite.CreateTestTxBuilder(signedTx, privKey, 1, false)
+		txBuilder.SetFeeAmount(feeAmount)
+		return txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		unbound   bool
+		expPass   bool
+		expErrMsg string
+	}{
+		{
+			"success - fees paid in the fee token",
+			func() {},
+			false,
+			true,
+			"",
+		},
+		{
+			"fail - fee token not in the access list",
+			func() {},
+			true,
+			false,
+			"fee token uatom is not in the access list",
+		},
+		{
+			"fail - fee amount doesn't match the converted fees",
+			func() {
+				feeAmount = sdk.NewCoins(sdk.NewCoin(feeToken.Denom, sdkmath.NewInt(100000*150)))
+			},
+			false,
+			false,
+			"invalid AuthInfo Fee Amount",
+		},
+		{
+			"fail - fee token not registered",
+			func() {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.FeeTokens = nil
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+			"sender balance < tx cost",
+		},
+		{
+			"fail - insufficient fee token balance",
+			func() {
+				err := suite.app.BankKeeper.SendCoins(suite.ctx, addr.Bytes(), sdk.AccAddress(to.Bytes()), balance.Sub(sdk.NewCoin(feeToken.Denom, sdkmath.OneInt())))
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+			"insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = false
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr.Bytes(), balance)
+			suite.Require().NoError(err)
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeTokens = []feemarkettypes.FeeToken{feeToken}
+			err = suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			feeAmount = fee
+			tc.malleate()
+
+			suite.ctx = suite.ctx.WithIsCheckTx(false)
+			_, err = suite.anteHandler(suite.ctx, newTx(!tc.unbound), false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fees are deducted in the fee token and the EVM balance is untouched
+			suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(suite.ctx, addr).Int64())
+			suite.Require().Equal(balance.Sub(fee...), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr.Bytes()))
+
+			paidToken, found := suite.app.EvmKeeper.GetFeeTokenTransient(suite.ctx, addr, 1)
+			suite.Require().True(found)
+			suite.Require().Equal(feeToken, paidToken)
+		})
+	}
+}
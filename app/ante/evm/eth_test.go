@@ -182,7 +182,7 @@ func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	chainID := suite.app.EvmKeeper.ChainID()
-	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, config.DefaultMaxTxGasWanted)
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := testutiltx.GenerateAddress()
 
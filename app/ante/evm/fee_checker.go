@@ -15,6 +15,7 @@ import (
 	anteutils "github.com/evmos/evmos/v16/app/ante/utils"
 	evmostypes "github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // NewDynamicFeeChecker returns a `TxFeeChecker` that applies a dynamic fee to
@@ -25,7 +26,9 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
+// - when the fees are paid in a fee token registered on the fee market params, they
+// are converted to the EVM denomination to compute the effective price.
+func NewDynamicFeeChecker(k DynamicFeeEVMKeeper, fmk DynamicFeeFeeMarketKeeper) anteutils.TxFeeChecker {
 	return func(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coins, int64, error) {
 		// TODO: in the e2e test, if the fee in the genesis transaction meet the baseFee and minGasPrice in the feemarket, we can remove this code
 		if ctx.BlockHeight() == 0 {
@@ -35,8 +38,9 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
 		params := k.GetParams(ctx)
 		denom := params.EvmDenom
 		ethCfg := params.ChainConfig.EthereumConfig(k.ChainID())
+		feeMarketParams := fmk.GetParams(ctx)
 
-		return FeeChecker(ctx, k, denom, ethCfg, feeTx)
+		return FeeChecker(ctx, k, denom, ethCfg, feeMarketParams, feeTx)
 	}
 }
 
@@ -46,6 +50,7 @@ func FeeChecker(
 	k DynamicFeeEVMKeeper,
 	denom string,
 	ethConfig *params.ChainConfig,
+	feeMarketParams feemarkettypes.Params,
 	feeTx sdk.FeeTx,
 ) (sdk.Coins, int64, error) {
 	baseFee := k.GetBaseFee(ctx, ethConfig)
@@ -76,6 +81,11 @@ func FeeChecker(
 	feeCoins := feeTx.GetFee()
 	fee := feeCoins.AmountOfNoDenomValidation(denom)
 
+	feeToken, isFeeToken := GetFeeToken(feeCoins, denom, feeMarketParams)
+	if isFeeToken {
+		fee = feeToken.ToEvmDenom(sdkmath.LegacyNewDecFromInt(feeCoins[0].Amount)).TruncateInt()
+	}
+
 	feeCap := fee.Quo(sdkmath.NewIntFromUint64(gas))
 	baseFeeInt := sdkmath.NewIntFromBigInt(baseFee)
 
@@ -94,6 +104,15 @@ func FeeChecker(
 		},
 	}
 
+	if isFeeToken {
+		// the converted effective fee never exceeds the provided fee, except for
+		// the rounding of the conversions
+		effectiveFee = ConvertFeesToFeeToken(effectiveFee, denom, feeToken)
+		if effectiveFee.IsAnyGT(feeCoins) {
+			effectiveFee = feeCoins
+		}
+	}
+
 	bigPriority := effectivePrice.Sub(baseFeeInt).Quo(types.DefaultPriorityReduction)
 	priority := int64(math.MaxInt64)
 
@@ -17,9 +17,13 @@ import (
 	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
-var _ DynamicFeeEVMKeeper = MockEVMKeeper{}
+var (
+	_ DynamicFeeEVMKeeper       = MockEVMKeeper{}
+	_ DynamicFeeFeeMarketKeeper = MockFeeMarketKeeper{}
+)
 
 type MockEVMKeeper struct {
 	BaseFee        *big.Int
@@ -41,6 +45,16 @@ func (m MockEVMKeeper) ChainID() *big.Int {
 	return big.NewInt(9000)
 }
 
+type MockFeeMarketKeeper struct {
+	FeeTokens []feemarkettypes.FeeToken
+}
+
+func (m MockFeeMarketKeeper) GetParams(_ sdk.Context) feemarkettypes.Params {
+	params := feemarkettypes.DefaultParams()
+	params.FeeTokens = m.FeeTokens
+	return params
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -58,6 +72,11 @@ func TestSDKTxFeeChecker(t *testing.T) {
 	checkTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, log.NewNopLogger()).WithMinGasPrices(minGasPrices)
 	deliverTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, log.NewNopLogger())
 
+	// one unit of the EVM denomination is worth half a unit of the fee token
+	feeMarketKeeper := MockFeeMarketKeeper{
+		FeeTokens: []feemarkettypes.FeeToken{feemarkettypes.NewFeeToken("uatom", math.LegacyNewDecWithPrec(5, 1))},
+	}
+
 	testCases := []struct {
 		name        string
 		ctx         sdk.Context
@@ -203,6 +222,70 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee in fee token",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(2)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(10))))
+				return txBuilder.GetTx()
+			},
+			"10uatom",
+			0,
+			true,
+		},
+		{
+			"success, dynamic fee in fee token priority",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction).Add(math.NewInt(10)).QuoRaw(2))))
+				return txBuilder.GetTx()
+			},
+			"5000005uatom",
+			10,
+			true,
+		},
+		{
+			"fail, dynamic fee in fee token",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(4))))
+				return txBuilder.GetTx()
+			},
+			"",
+			0,
+			false,
+		},
+		{
+			"fail, dynamic fee in unregistered fee token",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uosmo", math.NewInt(100))))
+				return txBuilder.GetTx()
+			},
+			"",
+			0,
+			false,
+		},
 		{
 			"fail, negative dynamic fee tipFeeCap",
 			deliverTxCtx,
@@ -230,7 +313,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			fees, priority, err := NewDynamicFeeChecker(tc.keeper)(tc.ctx, tc.buildTx())
+			fees, priority, err := NewDynamicFeeChecker(tc.keeper, feeMarketKeeper)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
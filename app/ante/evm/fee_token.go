@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// GetFeeToken returns the fee token registered on the fee market params that is
+// used to pay the given fees. The fees must be paid in a single denomination that
+// is not the EVM denomination.
+func GetFeeToken(fees sdk.Coins, evmDenom string, params feemarkettypes.Params) (feemarkettypes.FeeToken, bool) {
+	if len(fees) != 1 || fees[0].Denom == evmDenom {
+		return feemarkettypes.FeeToken{}, false
+	}
+	return params.GetFeeToken(fees[0].Denom)
+}
+
+// IsFeeTokenBound returns true if the fee token is in the access list of the
+// Ethereum transaction. The fee token is taken from the fee amount of the Cosmos
+// transaction wrapping the Ethereum transaction, which is not signed, so the
+// sender opts in to pay with it by adding the fee token address to the signed
+// access list. Otherwise, anyone could wrap the transactions of the sender to
+// spend its fee token balance.
+func IsFeeTokenBound(feeToken feemarkettypes.FeeToken, txData evmtypes.TxData) bool {
+	address := feeToken.Address()
+	for _, tuple := range txData.GetAccessList() {
+		if tuple.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
+// ConvertFeesToFeeToken converts the fees paid in the EVM denomination to the
+// given fee token, rounding up in favor of the fee collector.
+func ConvertFeesToFeeToken(fees sdk.Coins, evmDenom string, feeToken feemarkettypes.FeeToken) sdk.Coins {
+	amount := fees.AmountOfNoDenomValidation(evmDenom)
+	if amount.IsZero() {
+		return sdk.Coins{}
+	}
+
+	return sdk.Coins{{Denom: feeToken.Denom, Amount: feeToken.FeeAmount(amount)}}
+}
+
+// GetFeeAmount returns the fee amount of the Cosmos transaction wrapping the
+// Ethereum transactions.
+func GetFeeAmount(tx sdk.Tx) sdk.Coins {
+	wrapperTx, ok := tx.(protoTxProvider)
+	if !ok {
+		return nil
+	}
+
+	authInfo := wrapperTx.GetProtoTx().AuthInfo
+	if authInfo == nil || authInfo.Fee == nil {
+		return nil
+	}
+	return authInfo.Fee.Amount
+}
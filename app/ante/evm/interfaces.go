@@ -27,11 +27,14 @@ type EVMKeeper interface { //nolint: revive
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetFeePayerTransient(ctx sdk.Context, sender common.Address, nonce uint64, payer common.Address)
+	SetFeeTokenTransient(ctx sdk.Context, sender common.Address, nonce uint64, feeToken feemarkettypes.FeeToken)
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
 type FeeMarketKeeper interface {
-	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
+	DynamicFeeFeeMarketKeeper
+
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 }
@@ -43,6 +46,12 @@ type DynamicFeeEVMKeeper interface {
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
 }
 
+// DynamicFeeFeeMarketKeeper is a subset of FeeMarketKeeper interface that supports the
+// fee tokens of the dynamic fee checker
+type DynamicFeeFeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
+}
+
 type protoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
@@ -12,11 +12,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	anteutils "github.com/evmos/evmos/v16/app/ante/utils"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 var _ sdk.AnteDecorator = &EthSetupContextDecorator{}
@@ -30,6 +32,7 @@ type MonoDecorator struct {
 	evmKeeper          EVMKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	feegrantKeeper     authante.FeegrantKeeper
 	maxGasWanted       uint64
 }
 
@@ -47,6 +50,7 @@ type DecoratorUtils struct {
 	GasWanted          uint64
 	MinPriority        int64
 	TxFee              sdk.Coins
+	FeeToken           *feemarkettypes.FeeToken
 }
 
 // NewMonoDecorator creates a new MonoDecorator
@@ -57,6 +61,7 @@ func NewMonoDecorator(
 	evmKeeper EVMKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
@@ -66,12 +71,13 @@ func NewMonoDecorator(
 		evmKeeper:          evmKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
+		feegrantKeeper:     feegrantKeeper,
 		maxGasWanted:       maxGasWanted,
 	}
 }
 
 // NewUtils returns a new DecoratorUtils instance.
-func (md MonoDecorator) NewUtils(ctx sdk.Context) (*DecoratorUtils, error) {
+func (md MonoDecorator) NewUtils(ctx sdk.Context, tx sdk.Tx) (*DecoratorUtils, error) {
 	evmParams := md.evmKeeper.GetParams(ctx)
 	chainCfg := evmParams.GetChainConfig()
 	ethCfg := chainCfg.EthereumConfig(md.evmKeeper.ChainID())
@@ -87,6 +93,11 @@ func (md MonoDecorator) NewUtils(ctx sdk.Context) (*DecoratorUtils, error) {
 		)
 	}
 
+	var feeToken *feemarkettypes.FeeToken
+	if token, found := GetFeeToken(GetFeeAmount(tx), evmParams.EvmDenom, feeMarketParams); found {
+		feeToken = &token
+	}
+
 	return &DecoratorUtils{
 		EvmParams:          evmParams,
 		EthConfig:          ethCfg,
@@ -101,6 +112,7 @@ func (md MonoDecorator) NewUtils(ctx sdk.Context) (*DecoratorUtils, error) {
 		GasWanted:          0,
 		MinPriority:        int64(math.MaxInt64),
 		TxFee:              sdk.Coins{},
+		FeeToken:           feeToken,
 	}, nil
 }
 
@@ -123,11 +135,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	}
 
 	// 2. get utils
-	decUtils, err := md.NewUtils(ctx)
+	decUtils, err := md.NewUtils(ctx, tx)
 	if err != nil {
 		return ctx, err
 	}
 
+	feeGranter := GetFeeGranter(tx)
+
 	// Use the lowest priority of all the messages as the final one.
 	for i, msg := range tx.GetMsgs() {
 		ethMsg, txData, from, err := evmtypes.UnpackEthMsg(msg)
@@ -135,6 +149,14 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		// the fee token must be bound to the signed Ethereum transaction
+		if decUtils.FeeToken != nil && !IsFeeTokenBound(*decUtils.FeeToken, txData) {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrUnauthorized,
+				"fee token %s is not in the access list of the Ethereum transaction", decUtils.FeeToken.Denom,
+			)
+		}
+
 		feeAmt := txData.Fee()
 		gas := txData.GetGas()
 		fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
@@ -148,7 +170,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 
 		// 3. min gas price (global min fee)
-		if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+		if txType := txData.TxType(); (txType == ethtypes.DynamicFeeTxType || txType == evmtypes.SetCodeTxType) && decUtils.BaseFee != nil {
 			feeAmt = txData.EffectiveFee(decUtils.BaseFee)
 			fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
 		}
@@ -158,6 +180,12 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 
 		// 4. validate basic
+		msgFee, feeDenom := txData.Fee(), decUtils.EvmDenom
+		if decUtils.FeeToken != nil {
+			msgFee = decUtils.FeeToken.FeeAmount(sdkmath.NewIntFromBigInt(msgFee)).BigInt()
+			feeDenom = decUtils.FeeToken.Denom
+		}
+
 		txFee, txGasLimit, err := CheckDisabledCreateCallAndUpdateTxFee(
 			txData.GetTo(),
 			from,
@@ -166,9 +194,9 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			decUtils.EvmParams.EnableCreate,
 			decUtils.EvmParams.EnableCall,
 			decUtils.BaseFee,
-			txData.Fee(),
+			msgFee,
 			txData.TxType(),
-			decUtils.EvmDenom,
+			feeDenom,
 			decUtils.TxFee,
 		)
 		if err != nil {
@@ -185,11 +213,22 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// NOTE: sender address has been verified and cached
 		from = ethMsg.GetFrom()
 
+		if err := VerifySetCodeAuthorizations(ctx, md.evmKeeper, decUtils.EvmParams.ChainConfig, ethMsg); err != nil {
+			return ctx, err
+		}
+
+		// check the deployment policy against the verified sender
+		if err := CheckDeploymentPolicy(decUtils.EvmParams.DeploymentPolicy, ethMsg, txData.GetTo()); err != nil {
+			return ctx, err
+		}
+
 		// 6. account balance verification
 		fromAddr := common.HexToAddress(ethMsg.From)
 		// // TODO: Use account from AccountKeeper instead
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
-		if err := VerifyAccountBalance(ctx, md.accountKeeper, account, fromAddr, txData); err != nil {
+		// the fees paid by a fee granter or in a fee token are not paid from the EVM balance
+		feesPaidSeparately := IsFeeSponsored(feeGranter, from, txData) || decUtils.FeeToken != nil
+		if err := VerifyAccountBalance(ctx, md.accountKeeper, md.evmKeeper, account, fromAddr, txData, feesPaidSeparately); err != nil {
 			return ctx, err
 		}
 
@@ -226,12 +265,16 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			md.distributionKeeper,
 			md.evmKeeper,
 			md.stakingKeeper,
+			md.feegrantKeeper,
+			msg,
 			from,
+			feeGranter,
 			txData,
 			decUtils.MinPriority,
 			decUtils.GasWanted,
 			md.maxGasWanted,
 			decUtils.EvmDenom,
+			decUtils.FeeToken,
 			decUtils.BaseFee,
 			decUtils.Rules.IsHomestead,
 			decUtils.Rules.IsIstanbul,
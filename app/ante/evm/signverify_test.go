@@ -3,8 +3,11 @@ package evm_test
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethante "github.com/evmos/evmos/v16/app/ante/evm"
 	"github.com/evmos/evmos/v16/testutil"
 	testutiltx "github.com/evmos/evmos/v16/testutil/tx"
@@ -82,3 +85,154 @@ func (suite *AnteTestSuite) TestEthSigVerificationDecorator() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestEthSigVerificationDecoratorDeploymentPolicy() {
+	addr, privKey := testutiltx.NewAddrKey()
+	callee := testutiltx.GenerateAddress()
+
+	contractCreationTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		Nonce:    1,
+		Amount:   big.NewInt(10),
+		GasLimit: 1000,
+		GasPrice: big.NewInt(1),
+	})
+	contractCreationTx.From = addr.Hex()
+	err := contractCreationTx.Sign(suite.ethSigner, testutiltx.NewSigner(privKey))
+	suite.Require().NoError(err)
+
+	callTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		To:       &callee,
+		Nonce:    1,
+		Amount:   big.NewInt(10),
+		GasLimit: 1000,
+		GasPrice: big.NewInt(1),
+	})
+	callTx.From = addr.Hex()
+	err = callTx.Sign(suite.ethSigner, testutiltx.NewSigner(privKey))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		tx     sdk.Tx
+		policy evmtypes.DeploymentPolicy
+		expErr error
+	}{
+		{
+			"pass - permissionless",
+			contractCreationTx,
+			evmtypes.DefaultDeploymentPolicy(),
+			nil,
+		},
+		{
+			"pass - allowlisted deployer",
+			contractCreationTx,
+			evmtypes.NewDeploymentPolicy(evmtypes.DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{addr.Hex()}, nil),
+			nil,
+		},
+		{
+			"fail - deployer not allowlisted",
+			contractCreationTx,
+			evmtypes.NewDeploymentPolicy(evmtypes.DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES, []string{callee.Hex()}, nil),
+			evmtypes.ErrDeploymentNotAllowed,
+		},
+		{
+			"pass - call with restricted deployments",
+			callTx,
+			evmtypes.NewDeploymentPolicy(evmtypes.DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{callee.Hex()}, nil),
+			nil,
+		},
+		{
+			"fail - denied callee",
+			callTx,
+			evmtypes.NewDeploymentPolicy(evmtypes.DEPLOYMENT_MODE_PERMISSIONLESS, nil, []string{callee.Hex()}),
+			evmtypes.ErrCalleeDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.evmParamsOption = func(params *evmtypes.Params) {
+				params.DeploymentPolicy = tc.policy
+			}
+			suite.SetupTest()
+			dec := ethante.NewEthSigVerificationDecorator(suite.app.EvmKeeper)
+			_, err := dec.AnteHandle(suite.ctx, tc.tx, false, testutil.NextFn)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+	suite.evmParamsOption = nil
+}
+
+func (suite *AnteTestSuite) TestEthSigVerificationDecoratorSetCodeTx() {
+	addr, privKey := testutiltx.NewAddrKey()
+	_, authorityKey := testutiltx.NewAddrKey()
+	authorityECDSA, err := authorityKey.ToECDSA()
+	suite.Require().NoError(err)
+	delegate := testutiltx.GenerateAddress()
+
+	newSetCodeTx := func(chainID int64, nonce uint64) *evmtypes.MsgEthereumTx {
+		auth := evmtypes.SetCodeAuthorization{
+			ChainID: sdkmath.NewInt(chainID),
+			Address: delegate.Hex(),
+			Nonce:   nonce,
+		}
+		sig, err := crypto.Sign(auth.SigHash().Bytes(), authorityECDSA)
+		suite.Require().NoError(err)
+		auth.SetSignatureValues(new(big.Int).SetBytes(sig[64:]), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+
+		tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:           suite.app.EvmKeeper.ChainID(),
+			To:                &delegate,
+			Nonce:             0,
+			GasLimit:          100000,
+			GasFeeCap:         big.NewInt(1),
+			GasTipCap:         big.NewInt(1),
+			Accesses:          &ethtypes.AccessList{},
+			AuthorizationList: []evmtypes.SetCodeAuthorization{auth},
+		})
+		tx.From = addr.Hex()
+		suite.Require().NoError(tx.Sign(suite.ethSigner, testutiltx.NewSigner(privKey)))
+		return tx
+	}
+
+	testCases := []struct {
+		name   string
+		tx     sdk.Tx
+		prague bool
+		expErr error
+	}{
+		{"pass - prague enabled", newSetCodeTx(9000, 0), true, nil},
+		{"pass - authorization valid on all chains", newSetCodeTx(0, 0), true, nil},
+		{"fail - prague disabled", newSetCodeTx(9000, 0), false, evmtypes.ErrSetCodeTxDisabled},
+		{"fail - invalid authorization chain ID", newSetCodeTx(9001, 0), true, evmtypes.ErrInvalidSetCodeTx},
+		{"fail - invalid authorization nonce", newSetCodeTx(9000, 1), true, errortypes.ErrInvalidSequence},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.evmParamsOption = func(params *evmtypes.Params) {
+				if tc.prague {
+					pragueBlock := sdkmath.ZeroInt()
+					params.ChainConfig.PragueBlock = &pragueBlock
+				}
+			}
+			suite.SetupTest()
+			dec := ethante.NewEthSigVerificationDecorator(suite.app.EvmKeeper)
+			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(true), tc.tx, false, testutil.NextFn)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+	suite.evmParamsOption = nil
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
@@ -41,6 +43,7 @@ import (
 	"cosmossdk.io/simapp"
 	simappparams "cosmossdk.io/simapp/params"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -125,6 +128,7 @@ import (
 	ethante "github.com/evmos/evmos/v16/app/ante/evm"
 	"github.com/evmos/evmos/v16/app/post"
 	v16 "github.com/evmos/evmos/v16/app/upgrades/v16"
+	v17 "github.com/evmos/evmos/v16/app/upgrades/v17"
 	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/ethereum/eip712"
 	"github.com/evmos/evmos/v16/precompiles/common"
@@ -137,6 +141,9 @@ import (
 	erc20client "github.com/evmos/evmos/v16/x/erc20/client"
 	erc20keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
 	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+	"github.com/evmos/evmos/v16/x/erc721"
+	erc721keeper "github.com/evmos/evmos/v16/x/erc721/keeper"
+	erc721types "github.com/evmos/evmos/v16/x/erc721/types"
 	"github.com/evmos/evmos/v16/x/evm"
 	evmkeeper "github.com/evmos/evmos/v16/x/evm/keeper"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
@@ -224,6 +231,7 @@ var (
 		feemarket.AppModuleBasic{},
 		inflation.AppModuleBasic{},
 		erc20.AppModuleBasic{},
+		erc721.AppModuleBasic{},
 		epochs.AppModuleBasic{},
 		revenue.AppModuleBasic{},
 		consensus.AppModuleBasic{},
@@ -290,6 +298,7 @@ type Evmos struct {
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedERC721Keeper   capabilitykeeper.ScopedKeeper
 
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
@@ -298,6 +307,7 @@ type Evmos struct {
 	// Evmos keepers
 	InflationKeeper inflationkeeper.Keeper
 	Erc20Keeper     erc20keeper.Keeper
+	Erc721Keeper    erc721keeper.Keeper
 	EpochsKeeper    epochskeeper.Keeper
 	VestingKeeper   vestingkeeper.Keeper
 	RevenueKeeper   revenuekeeper.Keeper
@@ -342,12 +352,14 @@ func NewEvmos(
 	baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, appOpts, false, false, baseAppOptions)
 
 	// Setup Mempool and Proposal Handlers
+	var processProposal sdk.ProcessProposalHandler
 	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
 		mempool := mempool.NoOpMempool{}
 		app.SetMempool(mempool)
 		handler := baseapp.NewDefaultProposalHandler(mempool, app)
+		processProposal = handler.ProcessProposalHandler()
 		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
+		app.SetProcessProposal(processProposal)
 	})
 
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
@@ -391,6 +403,7 @@ func NewEvmos(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedERC721Keeper := app.CapabilityKeeper.ScopeToModule(erc721types.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -493,6 +506,12 @@ func NewEvmos(
 		app.AuthzKeeper, &app.TransferKeeper,
 	)
 
+	app.Erc721Keeper = erc721keeper.NewKeeper(
+		keys[erc721types.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.EvmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper, scopedERC721Keeper,
+	)
+
 	app.RevenueKeeper = revenuekeeper.NewKeeper(
 		keys[revenuetypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.BankKeeper, app.DistrKeeper, app.AccountKeeper, app.EvmKeeper,
@@ -511,8 +530,10 @@ func NewEvmos(
 	evmKeeper.WithPrecompiles(
 		evmkeeper.AvailablePrecompiles(
 			chainID,
+			appCodec,
 			*stakingKeeper,
 			app.DistrKeeper,
+			app.SlashingKeeper,
 			app.BankKeeper,
 			app.Erc20Keeper,
 			app.VestingKeeper,
@@ -521,6 +542,9 @@ func NewEvmos(
 			app.IBCKeeper.ChannelKeeper,
 		),
 	)
+	// the ERC20 precompiles of the token pairs and the ERC721 precompiles of the
+	// IBC classes are instantiated from the state
+	evmKeeper.WithDynamicPrecompiles(app.Erc20Keeper, app.Erc721Keeper)
 
 	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
@@ -584,11 +608,15 @@ func NewEvmos(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
 
+	// create the ICS-721 non-fungible token transfer module of the ERC721 contracts
+	erc721IBCModule := erc721.NewIBCModule(app.Erc721Keeper)
+
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
-		AddRoute(ibctransfertypes.ModuleName, transferStack)
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(erc721types.ModuleName, erc721IBCModule)
 
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -635,6 +663,7 @@ func NewEvmos(
 			app.GetSubspace(inflationtypes.ModuleName)),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper,
 			app.GetSubspace(erc20types.ModuleName)),
+		erc721.NewAppModule(app.Erc721Keeper),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		revenue.NewAppModule(app.RevenueKeeper, app.AccountKeeper,
@@ -672,6 +701,7 @@ func NewEvmos(
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		erc721types.ModuleName,
 		revenuetypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
@@ -703,6 +733,7 @@ func NewEvmos(
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		erc721types.ModuleName,
 		revenuetypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
@@ -740,6 +771,7 @@ func NewEvmos(
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
+		erc721types.ModuleName,
 		epochstypes.ModuleName,
 		revenuetypes.ModuleName,
 		consensusparamtypes.ModuleName,
@@ -792,6 +824,32 @@ func NewEvmos(
 		}
 	}
 
+	// the EVM state diff queries read the state committed at previous heights,
+	// from versiondb if it's enabled
+	if queryMultiStore != nil {
+		app.EvmKeeper.SetHistoricalStore(queryMultiStore)
+	} else {
+		app.EvmKeeper.SetHistoricalStore(app.CommitMultiStore())
+	}
+
+	// index the accounts changed in each block for the TouchedAccounts query
+	if cast.ToBool(appOpts.Get(srvflags.EVMStateDiffIndex)) {
+		changesetsDB, err := dbm.NewDB("evm_changesets", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(errorsmod.Wrap(err, "error on EVM changeset index setup"))
+		}
+
+		changesets := evmkeeper.NewChangesetIndex(
+			changesetsDB, keys[authtypes.StoreKey], keys[banktypes.StoreKey], keys[evmtypes.StoreKey],
+		)
+		app.SetStreamingService(changesets)
+		app.EvmKeeper.SetChangesetIndex(changesets)
+	}
+
+	if cast.ToBool(appOpts.Get(srvflags.EVMContractStats)) {
+		app.EvmKeeper.EnableContractStats(cast.ToInt(appOpts.Get(srvflags.EVMContractStatsWindow)))
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -801,6 +859,11 @@ func NewEvmos(
 	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
+
+	if cast.ToBool(appOpts.Get(srvflags.EVMParallelExecution)) {
+		workers := cast.ToInt(appOpts.Get(srvflags.EVMParallelWorkers))
+		app.setParallelProcessProposal(encodingConfig.TxConfig.TxDecoder(), processProposal, workers)
+	}
 	app.setupUpgradeHandlers()
 
 	if loadLatest {
@@ -822,6 +885,7 @@ func NewEvmos(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedERC721Keeper = scopedERC721Keeper
 
 	// Finally start the tpsCounter.
 	app.tpsCounter = newTPSCounter(logger)
@@ -852,7 +916,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
-		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
+		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper, app.FeeMarketKeeper),
 	}
 
 	if err := options.Validate(); err != nil {
@@ -875,6 +939,60 @@ func (app *Evmos) setPostHandler() {
 	app.SetPostHandler(post.NewPostHandler(options))
 }
 
+// setParallelProcessProposal wraps the ProcessProposal handler to speculatively
+// execute in parallel, in the background, the Ethereum transactions of the
+// accepted proposals. The results are used when the block is delivered, as long
+// as they are available and still valid.
+func (app *Evmos) setParallelProcessProposal(txDecoder sdk.TxDecoder, handler sdk.ProcessProposalHandler, workers int) {
+	app.SetProcessProposal(func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		res := handler(ctx, req)
+		if res.Status != abci.ResponseProcessProposal_ACCEPT {
+			return res
+		}
+
+		evmDenom := app.EvmKeeper.GetParams(ctx).EvmDenom
+		feeMarketParams := app.FeeMarketKeeper.GetParams(ctx)
+
+		txs := make([]*ethtypes.Transaction, 0, len(req.Txs))
+		for _, bz := range req.Txs {
+			tx, err := txDecoder(bz)
+			if err != nil || len(tx.GetMsgs()) != 1 {
+				continue
+			}
+
+			// the fees of the sponsored transactions are not paid by their senders
+			if ethante.GetFeeGranter(tx) != nil {
+				continue
+			}
+
+			// the fees paid in a fee token are not paid from the EVM balance of the senders
+			if _, found := ethante.GetFeeToken(ethante.GetFeeAmount(tx), evmDenom, feeMarketParams); found {
+				continue
+			}
+
+			// the set code transactions are not supported by go-ethereum, so they
+			// are only executed when the block is delivered
+			if msg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx); ok && msg.TxType() != evmtypes.SetCodeTxType {
+				txs = append(txs, msg.AsTransaction())
+			}
+		}
+
+		if len(txs) == 0 {
+			return res
+		}
+
+		// the speculative execution runs in the background on a branch of the
+		// last committed state, so the proposal is answered without waiting
+		ms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(app.LastBlockHeight())
+		if err != nil {
+			return res
+		}
+
+		app.EvmKeeper.PreExecuteBlock(ctx.WithMultiStore(ms), txs, workers)
+		return res
+	})
+}
+
 // BeginBlocker runs the Tendermint ABCI BeginBlock logic. It executes state changes at the beginning
 // of the new block for every registered module. If there is a registered fork at the current height,
 // BeginBlocker will schedule the upgrade plan and perform the state migration (if any).
@@ -1142,6 +1260,12 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v17 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v17.UpgradeName,
+		v17.CreateUpgradeHandler(app.mm, app.configurator, app.EvmKeeper),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1162,6 +1286,11 @@ func (app *Evmos) setupUpgradeHandlers() {
 		storeUpgrades = &storetypes.StoreUpgrades{
 			Deleted: []string{"recoveryv1", "incentives", "claims"},
 		}
+	case v17.UpgradeName:
+		// erc721 module is added in v17
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{erc721types.StoreKey},
+		}
 	default:
 		// no-op
 	}
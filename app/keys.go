@@ -24,6 +24,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	epochstypes "github.com/evmos/evmos/v16/x/epochs/types"
 	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+	erc721types "github.com/evmos/evmos/v16/x/erc721/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 	inflationtypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
@@ -52,7 +53,7 @@ func StoreKeys() (
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 		// evmos keys
-		inflationtypes.StoreKey, erc20types.StoreKey,
+		inflationtypes.StoreKey, erc20types.StoreKey, erc721types.StoreKey,
 		epochstypes.StoreKey, vestingtypes.StoreKey,
 		revenuetypes.StoreKey,
 	}
@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v17
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/authz"
+	"github.com/evmos/evmos/v16/precompiles/erc20module"
+	"github.com/evmos/evmos/v16/precompiles/slashing"
+	evmkeeper "github.com/evmos/evmos/v16/x/evm/keeper"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v17.0.0
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	ek *evmkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// enable the authz, slashing and erc20 module precompiles
+		if err := EnableNewPrecompiles(ctx, ek); err != nil {
+			logger.Error("failed to enable precompiles", "error", err.Error())
+		}
+
+		// The erc721 module isn't in the version map, so its InitGenesis is run
+		// with the default genesis. The erc20 module migrates the ERC20 balances
+		// of the module-owned token pairs to the bank module.
+		logger.Debug("running module migrations ...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// EnableNewPrecompiles adds the precompiles introduced in v17 to the active
+// precompiles of the EVM parameters. The ones that are already active are
+// skipped.
+func EnableNewPrecompiles(ctx sdk.Context, ek *evmkeeper.Keeper) error {
+	params := ek.GetParams(ctx)
+
+	addresses := make([]common.Address, 0, 3)
+	for _, address := range []string{
+		authz.PrecompileAddress,
+		slashing.PrecompileAddress,
+		erc20module.PrecompileAddress,
+	} {
+		if !params.IsActivePrecompile(address) {
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+	}
+
+	return ek.EnablePrecompiles(ctx, addresses...)
+}
@@ -0,0 +1,62 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v17_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/exp/slices"
+
+	v17 "github.com/evmos/evmos/v16/app/upgrades/v17"
+	"github.com/evmos/evmos/v16/precompiles/authz"
+	"github.com/evmos/evmos/v16/precompiles/erc20module"
+	"github.com/evmos/evmos/v16/precompiles/slashing"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	s.network = network.NewUnitTestNetwork()
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestEnableNewPrecompiles() {
+	newPrecompiles := []string{authz.PrecompileAddress, slashing.PrecompileAddress, erc20module.PrecompileAddress}
+
+	ctx := s.network.GetContext()
+	ek := s.network.App.EvmKeeper
+
+	// the precompiles are not active before the upgrade
+	params := ek.GetParams(ctx)
+	activePrecompiles := make([]string, 0, len(params.ActivePrecompiles))
+	for _, address := range params.ActivePrecompiles {
+		if !slices.Contains(newPrecompiles, address) {
+			activePrecompiles = append(activePrecompiles, address)
+		}
+	}
+	params.ActivePrecompiles = activePrecompiles
+	s.Require().NoError(ek.SetParams(ctx, params))
+	active := len(params.ActivePrecompiles)
+
+	s.Require().NoError(v17.EnableNewPrecompiles(ctx, ek))
+
+	params = ek.GetParams(ctx)
+	for _, address := range newPrecompiles {
+		s.Require().True(params.IsActivePrecompile(address), "expected precompile %s to be active", address)
+	}
+	s.Require().Len(params.ActivePrecompiles, active+len(newPrecompiles))
+
+	// the active precompiles are not added twice
+	s.Require().NoError(v17.EnableNewPrecompiles(ctx, ek))
+	s.Require().Equal(params.ActivePrecompiles, ek.GetParams(ctx).ActivePrecompiles)
+}
@@ -29,12 +29,12 @@ func Cmd() *cobra.Command {
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 
-			store, err := newStore(home, server.GetAppDBBackend(serverCtx.Viper))
+			store, err := NewStore(home, server.GetAppDBBackend(serverCtx.Viper))
 			if err != nil {
 				return fmt.Errorf("error while openning db: %w", err)
 			}
 
-			state, err := store.state()
+			state, err := store.State()
 			if err != nil {
 				return fmt.Errorf("error while getting blockstore state: %w", err)
 			}
@@ -52,7 +52,7 @@ func Cmd() *cobra.Command {
 				reqHeight = state.Height
 			}
 
-			block, err := store.block(reqHeight)
+			block, err := store.Block(reqHeight)
 			if err != nil {
 				return fmt.Errorf("error while getting block with height %d: %w", reqHeight, err)
 			}
@@ -16,25 +16,25 @@ import (
 
 var storeKey = []byte("blockStore")
 
-// store is the block store struct
-type store struct {
+// Store is the block store struct
+type Store struct {
 	dbm.DB
 }
 
-// newStore opens the 'blockstore' db
+// NewStore opens the 'blockstore' db
 // and returns it.
-func newStore(rootDir string, backendType dbm.BackendType) (*store, error) {
+func NewStore(rootDir string, backendType dbm.BackendType) (*Store, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	db, err := dbm.NewDB("blockstore", backendType, dataDir)
 	if err != nil {
 		return nil, err
 	}
 
-	return &store{db}, nil
+	return &Store{db}, nil
 }
 
-// state returns the BlockStoreState as loaded from disk.
-func (s *store) state() (*tmstore.BlockStoreState, error) {
+// State returns the BlockStoreState as loaded from disk.
+func (s *Store) State() (*tmstore.BlockStoreState, error) {
 	bytes, err := s.Get(storeKey)
 	if err != nil {
 		return nil, err
@@ -57,8 +57,8 @@ func (s *store) state() (*tmstore.BlockStoreState, error) {
 	return &bss, nil
 }
 
-// block returns the Block for the given height.
-func (s *store) block(height int64) (*types.Block, error) {
+// Block returns the Block for the given height.
+func (s *Store) Block(height int64) (*types.Block, error) {
 	bm, err := s.meta(height)
 	if err != nil {
 		return nil, fmt.Errorf("error getting block metadata: %v", err)
@@ -86,7 +86,7 @@ func (s *store) block(height int64) (*types.Block, error) {
 
 // meta returns the BlockMeta for the given height.
 // If no block is found for the given height, it returns nil.
-func (s *store) meta(height int64) (*types.BlockMeta, error) {
+func (s *Store) meta(height int64) (*types.BlockMeta, error) {
 	bz, err := s.Get(metaKey(height))
 	if err != nil {
 		return nil, err
@@ -106,7 +106,7 @@ func (s *store) meta(height int64) (*types.BlockMeta, error) {
 
 // part returns the part of the block for the given height and part index.
 // If no block part is found for the given height and index, it returns nil.
-func (s *store) part(height int64, index uint32) (*types.Part, error) {
+func (s *Store) part(height int64, index uint32) (*types.Part, error) {
 	bz, err := s.Get(partKey(height, index))
 	if err != nil {
 		return nil, err
@@ -1,16 +1,26 @@
 package main_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 
 	"github.com/evmos/evmos/v16/app"
 	evmosd "github.com/evmos/evmos/v16/cmd/evmosd"
+	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/utils"
 )
 
@@ -39,3 +49,87 @@ func TestAddKeyLedgerCmd(t *testing.T) {
 	err := svrcmd.Execute(rootCmd, "EVMOSD", app.DefaultNodeHome)
 	require.Error(t, err)
 }
+
+func TestImportExportGethGenesisCmd(t *testing.T) {
+	home := t.TempDir()
+	chainID := utils.TestnetChainID + "-1"
+
+	rootCmd, _ := evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"init",
+		"evmos-test",
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, chainID),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "evmosd", home))
+
+	eoa := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	gethGenesis := core.Genesis{
+		Config: params.AllEthashProtocolChanges,
+		Alloc: core.GenesisAlloc{
+			eoa: {Balance: big.NewInt(1_000_000), Nonce: 5},
+			contract: {
+				Balance: big.NewInt(7),
+				Nonce:   1,
+				Code:    common.Hex2Bytes("6080604052"),
+				Storage: map[common.Hash]common.Hash{
+					common.HexToHash("0x01"): common.HexToHash("0x02"),
+				},
+			},
+		},
+	}
+	bz, err := json.Marshal(gethGenesis)
+	require.NoError(t, err)
+
+	gethFile := filepath.Join(t.TempDir(), "geth.json")
+	require.NoError(t, os.WriteFile(gethFile, bz, 0o600))
+
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"import-geth-genesis",
+		gethFile,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "evmosd", home))
+
+	// the imported genesis is valid and the supply includes the imported balances
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(encodingConfig.Codec, encodingConfig.TxConfig, appState))
+	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Codec, appState)
+	require.Equal(t, int64(1_000_007), bankGenState.Supply.AmountOf(utils.BaseDenom).Int64())
+
+	outFile := filepath.Join(t.TempDir(), "exported.json")
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"export-geth-genesis",
+		filepath.Join(home, "config", "genesis.json"),
+		fmt.Sprintf("--output=%s", outFile),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "evmosd", home))
+
+	bz, err = os.ReadFile(outFile)
+	require.NoError(t, err)
+
+	var exported core.Genesis
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Equal(t, int64(9000), exported.Config.ChainID.Int64())
+	require.Len(t, exported.Alloc, 2)
+	require.Equal(t, gethGenesis.Alloc[eoa].Balance, exported.Alloc[eoa].Balance)
+	require.Equal(t, gethGenesis.Alloc[eoa].Nonce, exported.Alloc[eoa].Nonce)
+	require.Equal(t, gethGenesis.Alloc[contract].Balance, exported.Alloc[contract].Balance)
+	require.Equal(t, gethGenesis.Alloc[contract].Code, exported.Alloc[contract].Code)
+	require.Equal(t, gethGenesis.Alloc[contract].Storage, exported.Alloc[contract].Storage)
+
+	// importing an existing account fails
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"import-geth-genesis",
+		gethFile,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.Error(t, svrcmd.Execute(rootCmd, "evmosd", home))
+}
@@ -0,0 +1,842 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/spf13/cobra"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/evmos/v16/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+const flagOutput = "output"
+
+// ImportGethGenesisCmd returns a command that imports the alloc of a geth genesis
+// file into the auth, bank and evm sections of genesis.json.
+func ImportGethGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-geth-genesis GETH_GENESIS_FILE",
+		Short: "Import the alloc of a geth genesis file to genesis.json",
+		Long: `Import the accounts of a geth genesis file "alloc" to genesis.json. For each
+account, an Ethereum account with the nonce and the code hash is added to the auth
+genesis, the balance is added to the bank genesis in the EVM denomination and the
+code and the storage are added to the evm genesis. Both genesis files are decoded
+as a stream and the accounts are written to the updated genesis as they are decoded,
+so only the imported addresses are kept in memory.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			// write the updated genesis next to the genesis file and replace it on success
+			genFile := config.GenesisFile()
+			tmp, err := os.CreateTemp(filepath.Dir(genFile), "genesis-*.json")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(tmp.Name())
+			defer tmp.Close()
+
+			bw := bufio.NewWriter(tmp)
+			if err := importGethGenesis(clientCtx.Codec, genFile, args[0], bw); err != nil {
+				return err
+			}
+
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+
+			if err := tmp.Close(); err != nil {
+				return err
+			}
+
+			return os.Rename(tmp.Name(), genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// ExportGethGenesisCmd returns a command that converts an exported genesis file into
+// a geth genesis file.
+func ExportGethGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-geth-genesis GENESIS_FILE",
+		Short: "Convert an exported genesis file into a geth genesis file",
+		Long: `Convert the EVM state of a genesis file, as exported by the export command, into a
+geth genesis file. The alloc contains the nonce, the EVM denomination balance, the code
+and the storage of every account. The genesis file is decoded as a stream and the alloc
+is written to the output as the EVM accounts are decoded, so the contract storage is
+never fully loaded in memory.
+`,
+		Example: fmt.Sprintf("%s export > exported.json && %s export-geth-genesis exported.json --output geth.json", "evmosd", "evmosd"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			bw := bufio.NewWriter(w)
+			if err := exportGethGenesis(clientCtx.Codec, args[0], bw); err != nil {
+				return err
+			}
+			return bw.Flush()
+		},
+	}
+
+	cmd.Flags().String(flagOutput, "", "The geth genesis output file (defaults to stdout)")
+
+	return cmd
+}
+
+// importGethGenesis writes the genesis file to w with the accounts of the geth genesis
+// alloc added to the auth, bank and evm genesis states. The alloc is checked for
+// conflicting addresses before anything is written, and then streamed into each of
+// the genesis sections.
+func importGethGenesis(cdc codec.Codec, genesisFile, gethGenesisFile string, w io.Writer) error {
+	existing, denom, err := readGenesisAddresses(cdc, genesisFile)
+	if err != nil {
+		return err
+	}
+
+	// check the alloc and compute the supply of the imported balances
+	imported := new(big.Int)
+	err = walkGethAlloc(gethGenesisFile, func(address common.Address, account core.GenesisAccount) error {
+		if _, ok := existing[address]; ok {
+			return fmt.Errorf("cannot import account at existing address %s", address)
+		}
+		existing[address] = struct{}{}
+
+		if account.Balance != nil && account.Balance.Sign() > 0 {
+			imported.Add(imported, account.Balance)
+		}
+
+		return evmtypes.GenesisAccount{
+			Address: address.Hex(),
+			Code:    common.Bytes2Hex(account.Code),
+			Storage: gethStorage(account.Storage),
+		}.Validate()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import geth genesis: %w", err)
+	}
+
+	f, err := os.Open(genesisFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var authFound, balancesFound, supplyFound, evmFound bool
+	dec := json.NewDecoder(bufio.NewReader(f))
+	err = copyJSONObject(dec, w, func(key string) (bool, error) {
+		if key != "app_state" {
+			return false, nil
+		}
+
+		return true, copyJSONObject(dec, w, func(module string) (bool, error) {
+			switch module {
+			case authtypes.ModuleName:
+				authFound = true
+				return true, appendJSONArrayField(dec, w, "accounts", func(write func(any) error) error {
+					return walkGethAlloc(gethGenesisFile, func(address common.Address, account core.GenesisAccount) error {
+						acc := &types.EthAccount{
+							BaseAccount: authtypes.NewBaseAccount(address.Bytes(), nil, 0, account.Nonce),
+							CodeHash:    crypto.Keccak256Hash(account.Code).Hex(),
+						}
+
+						bz, err := cdc.MarshalInterfaceJSON(acc)
+						if err != nil {
+							return err
+						}
+						return write(json.RawMessage(bz))
+					})
+				})
+			case banktypes.ModuleName:
+				return true, copyJSONObject(dec, w, func(field string) (bool, error) {
+					switch field {
+					case "balances":
+						balancesFound = true
+						return true, appendJSONArray(dec, w, func(write func(any) error) error {
+							return walkGethAlloc(gethGenesisFile, func(address common.Address, account core.GenesisAccount) error {
+								if account.Balance == nil || account.Balance.Sign() <= 0 {
+									return nil
+								}
+
+								bz, err := cdc.MarshalJSON(&banktypes.Balance{
+									Address: sdk.AccAddress(address.Bytes()).String(),
+									Coins:   sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(account.Balance))},
+								})
+								if err != nil {
+									return err
+								}
+								return write(json.RawMessage(bz))
+							})
+						})
+					case "supply":
+						supplyFound = true
+						var supply sdk.Coins
+						if err := dec.Decode(&supply); err != nil {
+							return true, err
+						}
+
+						if imported.Sign() > 0 {
+							supply = supply.Add(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(imported)))
+						}
+						return true, writeJSON(w, supply)
+					default:
+						return false, nil
+					}
+				})
+			case evmtypes.ModuleName:
+				evmFound = true
+				return true, appendJSONArrayField(dec, w, "accounts", func(write func(any) error) error {
+					return walkGethAlloc(gethGenesisFile, func(address common.Address, account core.GenesisAccount) error {
+						bz, err := cdc.MarshalJSON(&evmtypes.GenesisAccount{
+							Address: address.Hex(),
+							Code:    common.Bytes2Hex(account.Code),
+							Storage: gethStorage(account.Storage),
+						})
+						if err != nil {
+							return err
+						}
+						return write(json.RawMessage(bz))
+					})
+				})
+			default:
+				return false, nil
+			}
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import geth genesis: %w", err)
+	}
+
+	if !authFound || !balancesFound || !supplyFound || !evmFound {
+		return fmt.Errorf("genesis file %s is missing the auth accounts, bank balances and supply or evm accounts", genesisFile)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// readGenesisAddresses reads the addresses of the auth and evm genesis accounts and the
+// EVM denomination of the genesis file.
+func readGenesisAddresses(cdc codec.Codec, genesisFile string) (map[common.Address]struct{}, string, error) {
+	f, err := os.Open(genesisFile)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	addresses := make(map[common.Address]struct{})
+	denom := evmtypes.DefaultEVMDenom
+
+	dec := json.NewDecoder(bufio.NewReader(f))
+	err = walkJSONObject(dec, func(key string) error {
+		if key != "app_state" {
+			return skipJSONValue(dec)
+		}
+
+		return walkJSONObject(dec, func(module string) error {
+			switch module {
+			case authtypes.ModuleName:
+				return walkJSONArrayField(dec, "accounts", func() error {
+					var raw json.RawMessage
+					if err := dec.Decode(&raw); err != nil {
+						return err
+					}
+
+					var acc authtypes.GenesisAccount
+					if err := cdc.UnmarshalInterfaceJSON(raw, &acc); err != nil {
+						return err
+					}
+
+					addresses[common.BytesToAddress(acc.GetAddress())] = struct{}{}
+					return nil
+				})
+			case evmtypes.ModuleName:
+				return walkJSONObject(dec, func(field string) error {
+					switch field {
+					case "params":
+						var params evmtypes.Params
+						if err := decodeProtoJSON(cdc, dec, &params); err != nil {
+							return err
+						}
+						denom = params.EvmDenom
+						return nil
+					case "accounts":
+						if err := expectJSONDelim(dec, '['); err != nil {
+							return err
+						}
+
+						for dec.More() {
+							var account struct {
+								Address string `json:"address"`
+							}
+							if err := dec.Decode(&account); err != nil {
+								return err
+							}
+							addresses[common.HexToAddress(account.Address)] = struct{}{}
+						}
+
+						return expectJSONDelim(dec, ']')
+					default:
+						return skipJSONValue(dec)
+					}
+				})
+			default:
+				return skipJSONValue(dec)
+			}
+		})
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read genesis accounts: %w", err)
+	}
+
+	return addresses, denom, nil
+}
+
+// walkGethAlloc decodes the accounts of the geth genesis alloc one at a time.
+func walkGethAlloc(gethGenesisFile string, cb func(address common.Address, account core.GenesisAccount) error) error {
+	f, err := os.Open(gethGenesisFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(f))
+	return walkJSONObject(dec, func(key string) error {
+		if key != "alloc" {
+			return skipJSONValue(dec)
+		}
+
+		return walkJSONObject(dec, func(hexAddr string) error {
+			if !common.IsHexAddress(hexAddr) {
+				return fmt.Errorf("invalid alloc address %s", hexAddr)
+			}
+
+			var account core.GenesisAccount
+			if err := dec.Decode(&account); err != nil {
+				return fmt.Errorf("failed to decode alloc account %s: %w", hexAddr, err)
+			}
+
+			return cb(common.HexToAddress(hexAddr), account)
+		})
+	})
+}
+
+// gethStorage converts a geth alloc storage into the evm genesis storage sorted by key.
+func gethStorage(storage map[common.Hash]common.Hash) evmtypes.Storage {
+	state := make(evmtypes.Storage, 0, len(storage))
+	for key, value := range storage {
+		state = append(state, evmtypes.NewState(key, value))
+	}
+
+	sort.Slice(state, func(i, j int) bool {
+		return state[i].Key < state[j].Key
+	})
+
+	return state
+}
+
+// gethGenesisHeader defines the geth genesis fields written before the alloc.
+type gethGenesisHeader struct {
+	Config     *params.ChainConfig `json:"config"`
+	Nonce      hexutil.Uint64      `json:"nonce"`
+	Timestamp  hexutil.Uint64      `json:"timestamp"`
+	ExtraData  hexutil.Bytes       `json:"extraData"`
+	GasLimit   hexutil.Uint64      `json:"gasLimit"`
+	Difficulty *hexutil.Big        `json:"difficulty"`
+}
+
+// exportGethGenesis converts the exported genesis file into a geth genesis and
+// writes it to w. The file is decoded twice: the first pass reads the chain and
+// EVM configuration and the second one streams the accounts.
+//
+// NOTE: the auth and bank sections are sorted before the evm section on the
+// exported genesis, so the nonces and balances are known when the EVM accounts
+// are decoded.
+func exportGethGenesis(cdc codec.Codec, genesisFile string, w io.Writer) error {
+	header, denom, err := readGethGenesisHeader(cdc, genesisFile)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(genesisFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	headerBz, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	// open the alloc object after the header fields
+	if _, err := w.Write(headerBz[:len(headerBz)-1]); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, `,"alloc":{`); err != nil {
+		return err
+	}
+
+	var (
+		nonces   = make(map[common.Address]uint64)
+		balances = make(map[common.Address]*big.Int)
+		written  = make(map[common.Address]bool)
+	)
+
+	writeAccount := func(address common.Address, account core.GenesisAccount) error {
+		if account.Balance == nil {
+			account.Balance = new(big.Int)
+		}
+
+		bz, err := json.Marshal(account)
+		if err != nil {
+			return err
+		}
+
+		prefix := ","
+		if len(written) == 0 {
+			prefix = ""
+		}
+		written[address] = true
+
+		_, err = fmt.Fprintf(w, "%s%q:%s", prefix, address.Hex(), bz)
+		return err
+	}
+
+	dec := json.NewDecoder(bufio.NewReader(f))
+	err = walkJSONObject(dec, func(key string) error {
+		if key != "app_state" {
+			return skipJSONValue(dec)
+		}
+
+		return walkJSONObject(dec, func(module string) error {
+			switch module {
+			case authtypes.ModuleName:
+				return walkJSONArrayField(dec, "accounts", func() error {
+					var raw json.RawMessage
+					if err := dec.Decode(&raw); err != nil {
+						return err
+					}
+
+					var acc authtypes.GenesisAccount
+					if err := cdc.UnmarshalInterfaceJSON(raw, &acc); err != nil {
+						return err
+					}
+
+					nonces[common.BytesToAddress(acc.GetAddress())] = acc.GetSequence()
+					return nil
+				})
+			case banktypes.ModuleName:
+				return walkJSONArrayField(dec, "balances", func() error {
+					var balance banktypes.Balance
+					if err := decodeProtoJSON(cdc, dec, &balance); err != nil {
+						return err
+					}
+
+					addr, err := sdk.AccAddressFromBech32(balance.Address)
+					if err != nil {
+						return err
+					}
+
+					if amount := balance.Coins.AmountOf(denom); amount.IsPositive() {
+						balances[common.BytesToAddress(addr)] = amount.BigInt()
+					}
+					return nil
+				})
+			case evmtypes.ModuleName:
+				return walkJSONArrayField(dec, "accounts", func() error {
+					var account evmtypes.GenesisAccount
+					if err := decodeProtoJSON(cdc, dec, &account); err != nil {
+						return err
+					}
+
+					address := common.HexToAddress(account.Address)
+					storage := make(map[common.Hash]common.Hash, len(account.Storage))
+					for _, state := range account.Storage {
+						storage[common.HexToHash(state.Key)] = common.HexToHash(state.Value)
+					}
+
+					return writeAccount(address, core.GenesisAccount{
+						Code:    common.Hex2Bytes(account.Code),
+						Storage: storage,
+						Balance: balances[address],
+						Nonce:   nonces[address],
+					})
+				})
+			default:
+				return skipJSONValue(dec)
+			}
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export geth genesis: %w", err)
+	}
+
+	// write the accounts that hold a balance but are not Ethereum accounts (e.g. module accounts)
+	remaining := make([]common.Address, 0)
+	for address := range balances {
+		if !written[address] {
+			remaining = append(remaining, address)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return bytes.Compare(remaining[i].Bytes(), remaining[j].Bytes()) < 0
+	})
+
+	for _, address := range remaining {
+		if err := writeAccount(address, core.GenesisAccount{
+			Balance: balances[address],
+			Nonce:   nonces[address],
+		}); err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "}}\n")
+	return err
+}
+
+// readGethGenesisHeader reads the chain ID, the genesis time, the block gas limit and
+// the EVM parameters of the exported genesis file.
+func readGethGenesisHeader(cdc codec.Codec, genesisFile string) (gethGenesisHeader, string, error) {
+	f, err := os.Open(genesisFile)
+	if err != nil {
+		return gethGenesisHeader{}, "", err
+	}
+	defer f.Close()
+
+	var (
+		chainID     string
+		genesisTime time.Time
+		maxGas      int64
+		evmParams   = evmtypes.DefaultParams()
+	)
+
+	dec := json.NewDecoder(bufio.NewReader(f))
+	err = walkJSONObject(dec, func(key string) error {
+		switch key {
+		case "chain_id":
+			return dec.Decode(&chainID)
+		case "genesis_time":
+			return dec.Decode(&genesisTime)
+		case "consensus_params":
+			var consensusParams struct {
+				Block struct {
+					MaxGas int64 `json:"max_gas,string"`
+				} `json:"block"`
+			}
+			if err := dec.Decode(&consensusParams); err != nil {
+				return err
+			}
+			maxGas = consensusParams.Block.MaxGas
+			return nil
+		case "app_state":
+			return walkJSONObject(dec, func(module string) error {
+				if module != evmtypes.ModuleName {
+					return skipJSONValue(dec)
+				}
+
+				return walkJSONObject(dec, func(field string) error {
+					if field != "params" {
+						return skipJSONValue(dec)
+					}
+					return decodeProtoJSON(cdc, dec, &evmParams)
+				})
+			})
+		default:
+			return skipJSONValue(dec)
+		}
+	})
+	if err != nil {
+		return gethGenesisHeader{}, "", fmt.Errorf("failed to read genesis header: %w", err)
+	}
+
+	eip155ChainID, err := types.ParseChainID(chainID)
+	if err != nil {
+		return gethGenesisHeader{}, "", err
+	}
+
+	gasLimit := params.GenesisGasLimit
+	if maxGas > 0 {
+		gasLimit = uint64(maxGas)
+	}
+
+	return gethGenesisHeader{
+		Config:     evmParams.ChainConfig.EthereumConfig(eip155ChainID),
+		Timestamp:  hexutil.Uint64(genesisTime.Unix()),
+		ExtraData:  hexutil.Bytes{},
+		GasLimit:   hexutil.Uint64(gasLimit),
+		Difficulty: (*hexutil.Big)(new(big.Int)),
+	}, evmParams.EvmDenom, nil
+}
+
+// walkJSONObject iterates over the keys of the JSON object at the decoder position.
+// The callback must consume the value of each key.
+func walkJSONObject(dec *json.Decoder, cb func(key string) error) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid object key %v", tok)
+		}
+
+		if err := cb(key); err != nil {
+			return err
+		}
+	}
+
+	return expectJSONDelim(dec, '}')
+}
+
+// walkJSONArrayField iterates over the elements of the array under the given key of the
+// JSON object at the decoder position. The other fields of the object are skipped.
+func walkJSONArrayField(dec *json.Decoder, field string, cb func() error) error {
+	return walkJSONObject(dec, func(key string) error {
+		if key != field {
+			return skipJSONValue(dec)
+		}
+
+		if err := expectJSONDelim(dec, '['); err != nil {
+			return err
+		}
+
+		for dec.More() {
+			if err := cb(); err != nil {
+				return err
+			}
+		}
+
+		return expectJSONDelim(dec, ']')
+	})
+}
+
+// copyJSONObject copies the JSON object at the decoder position to w. The callback is
+// called with the key of each field after the key is written and returns true if it
+// wrote the value itself, otherwise the value is copied as is.
+func copyJSONObject(dec *json.Decoder, w io.Writer, cb func(key string) (bool, error)) error {
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+
+	for i := 0; dec.More(); i++ {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid object key %v", tok)
+		}
+
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+
+		if err := writeJSON(w, key); err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(w, ":"); err != nil {
+			return err
+		}
+
+		handled, err := cb(key)
+		if err != nil {
+			return err
+		}
+
+		if !handled {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+
+			if _, err := w.Write(raw); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := expectJSONDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "}")
+	return err
+}
+
+// appendJSONArrayField copies the JSON object at the decoder position to w, appending
+// the elements written by the callback to the array under the given key.
+func appendJSONArrayField(dec *json.Decoder, w io.Writer, field string, cb func(write func(any) error) error) error {
+	return copyJSONObject(dec, w, func(key string) (bool, error) {
+		if key != field {
+			return false, nil
+		}
+		return true, appendJSONArray(dec, w, cb)
+	})
+}
+
+// appendJSONArray copies the JSON array at the decoder position to w one element at a
+// time and appends the elements written by the callback.
+func appendJSONArray(dec *json.Decoder, w io.Writer, cb func(write func(any) error) error) error {
+	if err := expectJSONDelim(dec, '['); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	count := 0
+	write := func(v any) error {
+		if count > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		count++
+		return writeJSON(w, v)
+	}
+
+	for dec.More() {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+
+		if err := write(raw); err != nil {
+			return err
+		}
+	}
+
+	if err := expectJSONDelim(dec, ']'); err != nil {
+		return err
+	}
+
+	if err := cb(write); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// writeJSON writes the JSON encoding of v to w.
+func writeJSON(w io.Writer, v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
+// skipJSONValue consumes the next JSON value of the decoder without decoding it.
+func skipJSONValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if delim, ok := tok.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// expectJSONDelim consumes the next token of the decoder and checks it is the given delimiter.
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %s, got %v", delim, tok)
+	}
+
+	return nil
+}
+
+// decodeProtoJSON decodes the next JSON value of the decoder into a proto message.
+func decodeProtoJSON(cdc codec.Codec, dec *json.Decoder, msg codec.ProtoMarshaler) error {
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(raw, msg)
+}
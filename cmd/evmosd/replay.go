@@ -0,0 +1,467 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/client/block"
+	"github.com/evmos/evmos/v16/cmd/evmosd/opendb"
+	"github.com/evmos/evmos/v16/encoding"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+const (
+	flagReplayFrom     = "from"
+	flagReplayTo       = "to"
+	flagReplayTraceTxs = "trace-txs"
+)
+
+// replayedBlock is the report of a replayed block.
+type replayedBlock struct {
+	Height     int64        `json:"height"`
+	BeginBlock replayedStep `json:"begin_block"`
+	Txs        []replayedTx `json:"txs"`
+	EndBlock   replayedStep `json:"end_block"`
+}
+
+// replayedStep is the report of the BeginBlock and EndBlock executions.
+type replayedStep struct {
+	Events       []abci.Event  `json:"events"`
+	EVMStoreDiff []storeChange `json:"evm_store_diff"`
+}
+
+// replayedTx is the report of a replayed transaction.
+type replayedTx struct {
+	Hash         string            `json:"hash"`
+	EthTxHashes  []string          `json:"eth_tx_hashes,omitempty"`
+	Code         uint32            `json:"code"`
+	Log          string            `json:"log,omitempty"`
+	GasWanted    int64             `json:"gas_wanted"`
+	GasUsed      int64             `json:"gas_used"`
+	Events       []abci.Event      `json:"events"`
+	EVMStoreDiff []storeChange     `json:"evm_store_diff"`
+	Mismatch     string            `json:"mismatch,omitempty"`
+	Traces       []json.RawMessage `json:"traces,omitempty"`
+}
+
+// ReplayCmd returns a command to re-execute a range of blocks offline.
+func ReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-execute a range of blocks persisted in the db and report the state transitions",
+		Long: `Re-execute the blocks between the --from and --to heights (inclusive) on top of the application state at height from-1,
+and print a JSON report per block with the gas used, the events and the EVM store changes of each transaction.
+The results are compared against the ABCI responses stored by the node, when available.
+The application and block stores are opened without persisting any change, so the historical state at height from-1 must not be pruned.
+Pass the hashes of the transactions to trace with --trace-txs, either as CometBFT or Ethereum transaction hashes, to include struct logger traces of their EVM messages.
+This command works only if no other process is using the db. Before using it, make sure to stop your node.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			return serverCtx.Viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			from, err := cmd.Flags().GetInt64(flagReplayFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagReplayTo)
+			if err != nil {
+				return err
+			}
+			if to < from {
+				return fmt.Errorf("invalid --%s height %d, it must not be lower than --%s height %d", flagReplayTo, to, flagReplayFrom, from)
+			}
+
+			traceTxs, err := cmd.Flags().GetStringSlice(flagReplayTraceTxs)
+			if err != nil {
+				return err
+			}
+
+			r, err := newReplayer(serverCtx, traceTxs)
+			if err != nil {
+				return err
+			}
+			defer r.close()
+
+			return r.replay(from, to, func(res replayedBlock) error {
+				bz, err := json.Marshal(res)
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().Int64(flagReplayFrom, 0, "First block height to replay")
+	cmd.Flags().Int64(flagReplayTo, 0, "Last block height to replay")
+	cmd.Flags().StringSlice(flagReplayTraceTxs, nil, "Comma-separated hashes of the transactions to trace")
+	_ = cmd.MarkFlagRequired(flagReplayFrom)
+	_ = cmd.MarkFlagRequired(flagReplayTo)
+	return cmd
+}
+
+// replayer re-executes the blocks of the block store on an application backed
+// by a replayStore.
+type replayer struct {
+	blockStore *block.Store
+	stateStore sm.Store
+	db         dbm.DB
+
+	app           *app.Evmos
+	store         *replayStore
+	initialHeight int64
+	latestHeight  int64
+	baseHeight    int64
+	traceTxs      map[string]bool
+	txDecoder     sdk.TxDecoder
+}
+
+// newReplayer opens the block, state and application stores of the node home.
+func newReplayer(serverCtx *server.Context, traceTxs []string) (_ *replayer, err error) {
+	home := serverCtx.Config.RootDir
+	backend := server.GetAppDBBackend(serverCtx.Viper)
+
+	r := &replayer{traceTxs: make(map[string]bool, len(traceTxs))}
+	for _, hash := range traceTxs {
+		r.traceTxs[normalizeTxHash(hash)] = true
+	}
+
+	defer func() {
+		if err != nil {
+			r.close()
+		}
+	}()
+
+	r.blockStore, err = block.NewStore(home, backend)
+	if err != nil {
+		return nil, fmt.Errorf("error while opening block store: %w", err)
+	}
+
+	bss, err := r.blockStore.State()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting blockstore state: %w", err)
+	}
+	r.baseHeight, r.latestHeight = bss.Base, bss.Height
+
+	stateDB, err := dbm.NewDB("state", backend, filepath.Join(home, "data"))
+	if err != nil {
+		return nil, fmt.Errorf("error while opening state store: %w", err)
+	}
+	r.stateStore = sm.NewStore(stateDB, sm.StoreOptions{})
+
+	state, err := r.stateStore.Load()
+	if err != nil {
+		return nil, fmt.Errorf("error while loading state: %w", err)
+	}
+	r.initialHeight = state.InitialHeight
+
+	r.db, err = opendb.OpenReadOnlyDB(home, backend)
+	if err != nil {
+		return nil, fmt.Errorf("error while opening application db: %w", err)
+	}
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	r.txDecoder = encodingConfig.TxConfig.TxDecoder()
+
+	r.app = app.NewEvmos(
+		log.NewNopLogger(), r.db, nil, false, map[int64]bool{}, home, 0, encodingConfig,
+		replayAppOptions{serverCtx.Viper},
+		baseapp.SetChainID(state.ChainID),
+		func(bapp *baseapp.BaseApp) {
+			r.store = newReplayStore(bapp.CommitMultiStore())
+			bapp.SetCMS(r.store)
+		},
+	)
+
+	kvKeys, memKeys, tKeys := app.StoreKeys()
+	keys := make([]storetypes.StoreKey, 0, len(kvKeys)+len(memKeys)+len(tKeys))
+	for name := range kvKeys {
+		keys = append(keys, r.app.GetKey(name))
+	}
+	for name := range memKeys {
+		keys = append(keys, r.app.GetMemKey(name))
+	}
+	for name := range tKeys {
+		keys = append(keys, r.app.GetTKey(name))
+	}
+	r.store.setKeys(keys, r.app.GetKey(evmtypes.StoreKey))
+
+	return r, nil
+}
+
+// close closes the opened stores.
+func (r *replayer) close() {
+	if r.blockStore != nil {
+		_ = r.blockStore.Close()
+	}
+	if r.stateStore != nil {
+		_ = r.stateStore.Close()
+	}
+	if r.db != nil {
+		_ = r.db.Close()
+	}
+}
+
+// replay loads the application state at height from-1 and re-executes the blocks
+// up to the given height, calling the callback with the report of each block.
+func (r *replayer) replay(from, to int64, cb func(replayedBlock) error) error {
+	if from <= r.initialHeight {
+		return fmt.Errorf("cannot replay the initial height %d, the first height to replay must be greater", r.initialHeight)
+	}
+	if from < r.baseHeight || to > r.latestHeight {
+		return fmt.Errorf("invalid height range, the blocks found in the db are between heights %d and %d", r.baseHeight, r.latestHeight)
+	}
+
+	if err := r.app.LoadHeight(from - 1); err != nil {
+		return fmt.Errorf("error while loading application state at height %d: %w", from-1, err)
+	}
+
+	for height := from; height <= to; height++ {
+		res, err := r.replayBlock(height)
+		if err != nil {
+			return fmt.Errorf("error while replaying block %d: %w", height, err)
+		}
+		if err := cb(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayBlock re-executes the block at the given height and commits it to the
+// in-memory state.
+func (r *replayer) replayBlock(height int64) (replayedBlock, error) {
+	blk, err := r.blockStore.Block(height)
+	if err != nil {
+		return replayedBlock{}, err
+	}
+
+	req, err := r.beginBlockRequest(blk)
+	if err != nil {
+		return replayedBlock{}, err
+	}
+
+	// the stored responses are only used to report mismatches, so they are
+	// ignored if the node discarded them
+	expected, err := r.stateStore.LoadABCIResponses(height)
+	if err != nil {
+		expected = nil
+	}
+
+	res := replayedBlock{Height: height, Txs: make([]replayedTx, 0, len(blk.Txs))}
+
+	beginBlock := r.app.BeginBlock(req)
+	branch := r.store.branch
+	res.BeginBlock = replayedStep{Events: beginBlock.Events, EVMStoreDiff: branch.popChanges()}
+
+	for i, tx := range blk.Txs {
+		rtx := replayedTx{Hash: fmt.Sprintf("%X", tmhash.Sum(tx))}
+		ethMsgs := r.ethMsgs(tx)
+		for _, msg := range ethMsgs {
+			rtx.EthTxHashes = append(rtx.EthTxHashes, msg.Hash)
+		}
+
+		if r.shouldTrace(rtx) {
+			rtx.Traces, err = r.traceEthMsgs(req.Header, blk, branch, ethMsgs)
+			if err != nil {
+				return replayedBlock{}, fmt.Errorf("error while tracing tx %s: %w", rtx.Hash, err)
+			}
+		}
+
+		deliverTx := r.app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		rtx.Code = deliverTx.Code
+		rtx.Log = deliverTx.Log
+		rtx.GasWanted = deliverTx.GasWanted
+		rtx.GasUsed = deliverTx.GasUsed
+		rtx.Events = deliverTx.Events
+		rtx.EVMStoreDiff = branch.popChanges()
+
+		if expected != nil && i < len(expected.DeliverTxs) {
+			rtx.Mismatch = compareDeliverTx(expected.DeliverTxs[i], deliverTx)
+		}
+
+		res.Txs = append(res.Txs, rtx)
+	}
+
+	endBlock := r.app.EndBlock(abci.RequestEndBlock{Height: height})
+	res.EndBlock = replayedStep{Events: endBlock.Events, EVMStoreDiff: branch.popChanges()}
+
+	r.app.Commit()
+	return res, nil
+}
+
+// beginBlockRequest builds the BeginBlock request of the block the same way
+// CometBFT does, using the validator set of the previous height stored in the
+// state store.
+func (r *replayer) beginBlockRequest(blk *tmtypes.Block) (abci.RequestBeginBlock, error) {
+	var commitInfo abci.CommitInfo
+	if blk.Height != r.initialHeight {
+		valSet, err := r.stateStore.LoadValidators(blk.Height - 1)
+		if err != nil {
+			return abci.RequestBeginBlock{}, fmt.Errorf("error while loading validators at height %d: %w", blk.Height-1, err)
+		}
+
+		if blk.LastCommit.Size() != len(valSet.Validators) {
+			return abci.RequestBeginBlock{}, fmt.Errorf(
+				"commit size (%d) doesn't match validator set length (%d) at height %d",
+				blk.LastCommit.Size(), len(valSet.Validators), blk.Height,
+			)
+		}
+
+		votes := make([]abci.VoteInfo, len(valSet.Validators))
+		for i, val := range valSet.Validators {
+			votes[i] = abci.VoteInfo{
+				Validator:       tmtypes.TM2PB.Validator(val),
+				SignedLastBlock: blk.LastCommit.Signatures[i].BlockIDFlag != tmtypes.BlockIDFlagAbsent,
+			}
+		}
+		commitInfo = abci.CommitInfo{Round: blk.LastCommit.Round, Votes: votes}
+	}
+
+	header := blk.Header.ToProto()
+	if header == nil {
+		return abci.RequestBeginBlock{}, errors.New("nil header")
+	}
+
+	return abci.RequestBeginBlock{
+		Hash:                blk.Hash(),
+		Header:              *header,
+		LastCommitInfo:      commitInfo,
+		ByzantineValidators: blk.Evidence.Evidence.ToABCI(),
+	}, nil
+}
+
+// ethMsgs returns the Ethereum messages of the transaction. The transactions
+// that cannot be decoded are skipped, as they fail on execution.
+func (r *replayer) ethMsgs(tx tmtypes.Tx) []*evmtypes.MsgEthereumTx {
+	sdkTx, err := r.txDecoder(tx)
+	if err != nil {
+		return nil
+	}
+
+	var msgs []*evmtypes.MsgEthereumTx
+	for _, msg := range sdkTx.GetMsgs() {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			msgs = append(msgs, ethMsg)
+		}
+	}
+	return msgs
+}
+
+// shouldTrace returns true if the transaction is selected for tracing, either
+// by its CometBFT hash or by the hash of one of its Ethereum messages.
+func (r *replayer) shouldTrace(tx replayedTx) bool {
+	if r.traceTxs[normalizeTxHash(tx.Hash)] {
+		return true
+	}
+	for _, hash := range tx.EthTxHashes {
+		if r.traceTxs[normalizeTxHash(hash)] {
+			return true
+		}
+	}
+	return false
+}
+
+// traceEthMsgs traces the Ethereum messages of a transaction with the struct
+// logger on a branch of the state before the transaction execution. Each
+// message is traced after applying the previous ones.
+func (r *replayer) traceEthMsgs(header tmproto.Header, blk *tmtypes.Block, branch *replayBranch, msgs []*evmtypes.MsgEthereumTx) ([]json.RawMessage, error) {
+	ctx := sdk.NewContext(branch.CacheMultiStore(), header, false, log.NewNopLogger())
+
+	blockMaxGas := int64(-1)
+	if cp := r.app.GetConsensusParams(ctx); cp != nil && cp.Block != nil {
+		blockMaxGas = cp.Block.MaxGas
+	}
+
+	traces := make([]json.RawMessage, 0, len(msgs))
+	for i, msg := range msgs {
+		res, err := r.app.EvmKeeper.TraceTx(sdk.WrapSDKContext(ctx), &evmtypes.QueryTraceTxRequest{
+			Msg:             msg,
+			Predecessors:    msgs[:i],
+			TraceConfig:     &evmtypes.TraceConfig{},
+			BlockNumber:     blk.Height,
+			BlockHash:       hex.EncodeToString(blk.Hash()),
+			BlockTime:       blk.Time,
+			ProposerAddress: sdk.ConsAddress(blk.ProposerAddress),
+			BlockMaxGas:     blockMaxGas,
+		})
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, res.Data)
+	}
+
+	return traces, nil
+}
+
+// compareDeliverTx describes the differences between the stored and the
+// replayed DeliverTx responses. It returns an empty string if they match.
+func compareDeliverTx(expected *abci.ResponseDeliverTx, actual abci.ResponseDeliverTx) string {
+	if expected == nil {
+		return ""
+	}
+
+	var diffs []string
+	if expected.Code != actual.Code {
+		diffs = append(diffs, fmt.Sprintf("code: expected %d, got %d", expected.Code, actual.Code))
+	}
+	if expected.GasUsed != actual.GasUsed {
+		diffs = append(diffs, fmt.Sprintf("gas used: expected %d, got %d", expected.GasUsed, actual.GasUsed))
+	}
+	if !bytes.Equal(expected.Data, actual.Data) {
+		diffs = append(diffs, fmt.Sprintf("data: expected %X, got %X", expected.Data, actual.Data))
+	}
+	return strings.Join(diffs, "; ")
+}
+
+// normalizeTxHash returns the upper case hex representation of a transaction
+// hash without the 0x prefix.
+func normalizeTxHash(hash string) string {
+	return strings.ToUpper(strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X"))
+}
+
+var _ servertypes.AppOptions = replayAppOptions{}
+
+// replayAppOptions overrides the application options that would persist data
+// outside of the application store during the replay.
+type replayAppOptions struct {
+	servertypes.AppOptions
+}
+
+// Get implements AppOptions. The state streaming services, including versiondb,
+// are disabled.
+func (o replayAppOptions) Get(key string) interface{} {
+	if key == streaming.OptStoreStreamers {
+		return []string{}
+	}
+	return o.AppOptions.Get(key)
+}
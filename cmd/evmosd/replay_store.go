@@ -0,0 +1,295 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var _ storetypes.CommitMultiStore = &replayStore{}
+
+// replayStore is a CommitMultiStore that executes the blocks on top of a
+// historical version of the application store without persisting anything.
+// The state transitions of each block are kept in memory and the commits only
+// bump the version, so the application and its database are left untouched.
+type replayStore struct {
+	storetypes.CommitMultiStore
+
+	version int64
+	base    storetypes.CacheMultiStore
+
+	// keys are all the store keys mounted by the application
+	keys map[string]storetypes.StoreKey
+	// transientKeys are reset on every commit
+	transientKeys []storetypes.StoreKey
+	// recordKey is the key of the store whose changes are recorded
+	recordKey storetypes.StoreKey
+
+	// branch is the last branch handed over to the application. During the
+	// block execution, it corresponds to the DeliverTx state.
+	branch *replayBranch
+}
+
+// newReplayStore wraps the application CommitMultiStore.
+func newReplayStore(cms storetypes.CommitMultiStore) *replayStore {
+	return &replayStore{CommitMultiStore: cms}
+}
+
+// setKeys sets the store keys of the application and the key of the store whose
+// changes are recorded. It must be called before loading the version.
+func (rs *replayStore) setKeys(keys []storetypes.StoreKey, recordKey storetypes.StoreKey) {
+	rs.keys = make(map[string]storetypes.StoreKey, len(keys))
+	rs.transientKeys = nil
+	for _, key := range keys {
+		rs.keys[key.Name()] = key
+		if _, ok := key.(*storetypes.TransientStoreKey); ok {
+			rs.transientKeys = append(rs.transientKeys, key)
+		}
+	}
+	rs.recordKey = recordKey
+}
+
+// LoadLatestVersion implements CommitMultiStore. The replay store is always loaded
+// at a given version.
+func (rs *replayStore) LoadLatestVersion() error {
+	return errors.New("replay store must be loaded at a given version")
+}
+
+// LoadVersion implements CommitMultiStore. It branches the given historical
+// version of the underlying store, which is loaded at its latest version.
+func (rs *replayStore) LoadVersion(ver int64) error {
+	if err := rs.CommitMultiStore.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	base, err := rs.CommitMultiStore.CacheMultiStoreWithVersion(ver)
+	if err != nil {
+		return err
+	}
+
+	rs.base = base
+	rs.version = ver
+	return nil
+}
+
+// LastCommitID implements Committer. The replayed versions have no hash.
+func (rs *replayStore) LastCommitID() storetypes.CommitID {
+	return storetypes.CommitID{Version: rs.version}
+}
+
+// LatestVersion implements MultiStore.
+func (rs *replayStore) LatestVersion() int64 {
+	return rs.version
+}
+
+// Commit implements Committer. The DeliverTx state is already written to the
+// in-memory base store, so it only resets the transient stores and bumps the
+// version.
+func (rs *replayStore) Commit() storetypes.CommitID {
+	for _, key := range rs.transientKeys {
+		store := rs.base.GetKVStore(key)
+
+		var keys [][]byte
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	rs.version++
+	return rs.LastCommitID()
+}
+
+// CacheMultiStore implements MultiStore. It branches the in-memory base store and
+// records the changes of the branch.
+func (rs *replayStore) CacheMultiStore() storetypes.CacheMultiStore {
+	rs.branch = newReplayBranch(rs.base, rs.keys, rs.recordKey)
+	return rs.branch
+}
+
+// CacheMultiStoreWithVersion implements MultiStore. Only the current version is
+// available.
+func (rs *replayStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	if version != rs.version {
+		return nil, errors.New("replay store only supports the current version")
+	}
+	return rs.CacheMultiStore(), nil
+}
+
+// GetStore implements MultiStore.
+func (rs *replayStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return rs.base.GetStore(key)
+}
+
+// GetKVStore implements MultiStore.
+func (rs *replayStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return rs.base.GetKVStore(key)
+}
+
+var _ storetypes.CacheMultiStore = &replayBranch{}
+
+// replayBranch is a branch of the replay store whose recorded store is wrapped
+// with a storeRecorder. The branches created from it write to the recorder, so
+// the changes committed by each transaction are visible as soon as the
+// transaction state is written.
+type replayBranch struct {
+	cachemulti.Store
+
+	keys     map[string]storetypes.StoreKey
+	stores   map[storetypes.StoreKey]storetypes.CacheWrapper
+	recorder *storeRecorder
+}
+
+// newReplayBranch creates a new branch of the given multistore.
+func newReplayBranch(parent storetypes.MultiStore, keys map[string]storetypes.StoreKey, recordKey storetypes.StoreKey) *replayBranch {
+	parents := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for _, key := range keys {
+		parents[key] = parent.GetKVStore(key)
+	}
+	cms := cachemulti.NewStore(dbm.NewMemDB(), parents, keys, nil, nil)
+
+	b := &replayBranch{
+		Store:  cms,
+		keys:   keys,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys)),
+	}
+	for _, key := range keys {
+		b.stores[key] = cms.GetKVStore(key)
+	}
+	if recordKey != nil {
+		b.recorder = newStoreRecorder(cms.GetKVStore(recordKey))
+		b.stores[recordKey] = b.recorder
+	}
+	return b
+}
+
+// GetStore implements MultiStore.
+func (b *replayBranch) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return b.GetKVStore(key)
+}
+
+// GetKVStore implements MultiStore.
+func (b *replayBranch) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := b.stores[key]
+	if !ok {
+		panic("kv store with key " + key.String() + " has not been registered in stores")
+	}
+	return store.(storetypes.KVStore)
+}
+
+// CacheMultiStore implements MultiStore.
+func (b *replayBranch) CacheMultiStore() storetypes.CacheMultiStore {
+	return cachemulti.NewStore(dbm.NewMemDB(), b.stores, b.keys, nil, nil)
+}
+
+// CacheWrap implements CacheWrapper.
+func (b *replayBranch) CacheWrap() storetypes.CacheWrap {
+	return b.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (b *replayBranch) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return b.CacheWrap()
+}
+
+// popChanges returns the changes recorded since the last call.
+func (b *replayBranch) popChanges() []storeChange {
+	if b.recorder == nil {
+		return nil
+	}
+	return b.recorder.pop()
+}
+
+// storeChange is the change of a single key of the recorded store. A nil value
+// corresponds to a missing key.
+type storeChange struct {
+	Key    hexutil.Bytes `json:"key"`
+	Before hexutil.Bytes `json:"before"`
+	After  hexutil.Bytes `json:"after"`
+}
+
+var _ storetypes.KVStore = &storeRecorder{}
+
+// storeRecorder is a KVStore that records the original value of the keys that
+// are written, in order to report the net changes of the store.
+type storeRecorder struct {
+	storetypes.KVStore
+
+	before map[string][]byte
+	keys   []string
+}
+
+// newStoreRecorder wraps the given store.
+func newStoreRecorder(store storetypes.KVStore) *storeRecorder {
+	return &storeRecorder{
+		KVStore: store,
+		before:  make(map[string][]byte),
+	}
+}
+
+// Set implements KVStore.
+func (r *storeRecorder) Set(key, value []byte) {
+	r.record(key)
+	r.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (r *storeRecorder) Delete(key []byte) {
+	r.record(key)
+	r.KVStore.Delete(key)
+}
+
+// CacheWrap implements CacheWrapper.
+func (r *storeRecorder) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(r)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (r *storeRecorder) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return r.CacheWrap()
+}
+
+// record keeps the value of the key before its first change.
+func (r *storeRecorder) record(key []byte) {
+	if _, ok := r.before[string(key)]; ok {
+		return
+	}
+	r.before[string(key)] = r.KVStore.Get(key)
+	r.keys = append(r.keys, string(key))
+}
+
+// pop returns the changes recorded since the last call, in the order of the
+// first write, and resets the recorder. The keys set back to their original
+// value are omitted.
+func (r *storeRecorder) pop() []storeChange {
+	changes := make([]storeChange, 0, len(r.keys))
+	for _, key := range r.keys {
+		before := r.before[key]
+		after := r.KVStore.Get([]byte(key))
+		if bytes.Equal(before, after) && (before == nil) == (after == nil) {
+			continue
+		}
+		changes = append(changes, storeChange{
+			Key:    []byte(key),
+			Before: before,
+			After:  after,
+		})
+	}
+
+	r.before = make(map[string][]byte)
+	r.keys = nil
+	return changes
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayStore(t *testing.T) {
+	key := storetypes.NewKVStoreKey("evm")
+	otherKey := storetypes.NewKVStoreKey("bank")
+	tKey := storetypes.NewTransientStoreKey("transient_evm")
+
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(otherKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(tKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	cms.GetKVStore(key).Set([]byte("a"), []byte("1"))
+	cms.GetKVStore(otherKey).Set([]byte("a"), []byte("1"))
+	cms.Commit()
+	cms.GetKVStore(key).Set([]byte("a"), []byte("2"))
+	lastCommitID := cms.Commit()
+
+	rs := newReplayStore(cms)
+	rs.setKeys([]storetypes.StoreKey{key, otherKey, tKey}, key)
+	require.Error(t, rs.LoadLatestVersion())
+	require.NoError(t, rs.LoadVersion(1))
+	require.Equal(t, int64(1), rs.LastCommitID().Version)
+
+	// the branch is loaded at the historical version
+	branch := rs.CacheMultiStore()
+	require.Equal(t, []byte("1"), branch.GetKVStore(key).Get([]byte("a")))
+
+	// the changes written by the nested branches are recorded
+	txBranch := branch.CacheMultiStore()
+	txBranch.GetKVStore(key).Set([]byte("a"), []byte("3"))
+	txBranch.GetKVStore(key).Set([]byte("b"), []byte("1"))
+	txBranch.GetKVStore(otherKey).Set([]byte("b"), []byte("1"))
+	txBranch.GetKVStore(tKey).Set([]byte("b"), []byte("1"))
+	require.Empty(t, rs.branch.popChanges())
+	txBranch.Write()
+
+	require.ElementsMatch(t, []storeChange{
+		{Key: []byte("a"), Before: []byte("1"), After: []byte("3")},
+		{Key: []byte("b"), Before: nil, After: []byte("1")},
+	}, rs.branch.popChanges())
+	require.Empty(t, rs.branch.popChanges())
+
+	// a key restored to its original value is not reported
+	txBranch = branch.CacheMultiStore()
+	txBranch.GetKVStore(key).Set([]byte("a"), []byte("4"))
+	txBranch.Write()
+	txBranch = branch.CacheMultiStore()
+	txBranch.GetKVStore(key).Set([]byte("a"), []byte("3"))
+	txBranch.Write()
+	require.Empty(t, rs.branch.popChanges())
+
+	branch.Write()
+	require.Equal(t, int64(2), rs.Commit().Version)
+
+	// the state is committed in memory and the transient stores are reset
+	branch = rs.CacheMultiStore()
+	require.Equal(t, []byte("3"), branch.GetKVStore(key).Get([]byte("a")))
+	require.Equal(t, []byte("1"), branch.GetKVStore(otherKey).Get([]byte("b")))
+	require.Nil(t, branch.GetKVStore(tKey).Get([]byte("b")))
+
+	// the underlying store is left untouched
+	require.Equal(t, lastCommitID, cms.LastCommitID())
+	require.Equal(t, []byte("2"), cms.GetKVStore(key).Get([]byte("a")))
+	require.Nil(t, cms.GetKVStore(key).Get([]byte("b")))
+}
@@ -43,6 +43,47 @@ type EventRevocation struct {
 	TypeUrls []string
 }
 
+// EmitApprovalEvent creates a new approval event emitted on an Approve or Grant transaction.
+func EmitApprovalEvent(args cmn.EmitEventArgs) error {
+	approvalEvent, ok := args.EventData.(EventApproval)
+	if !ok {
+		return fmt.Errorf("invalid Event type, expecting EventApproval but received %T", args.EventData)
+	}
+	// Prepare the event topics
+	event := args.ContractEvents[EventTypeApproval]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(approvalEvent.Grantee)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(approvalEvent.Granter)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(approvalEvent.Methods, approvalEvent.Value)
+	if err != nil {
+		return err
+	}
+
+	args.StateDB.AddLog(&ethtypes.Log{
+		Address:     args.ContractAddr,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(args.Ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
 // EmitRevocationEvent creates a new approval event emitted on a Revoke transaction.
 func EmitRevocationEvent(args cmn.EmitEventArgs) error {
 	// Prepare the event topics
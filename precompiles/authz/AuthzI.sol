@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The AuthzI contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The AuthzI contract's instance.
+AuthzI constant AUTHZ_CONTRACT = AuthzI(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available authorization types.
+string constant GENERIC_AUTHORIZATION = "/cosmos.authz.v1beta1.GenericAuthorization";
+string constant SEND_AUTHORIZATION = "/cosmos.bank.v1beta1.SendAuthorization";
+string constant STAKE_AUTHORIZATION = "/cosmos.staking.v1beta1.StakeAuthorization";
+
+/// @dev AuthorizationType defines the type of staking module authorization type.
+/// It mirrors the Cosmos SDK staking AuthorizationType enum.
+enum AuthorizationType {
+    AUTHORIZATION_TYPE_UNSPECIFIED,
+    AUTHORIZATION_TYPE_DELEGATE,
+    AUTHORIZATION_TYPE_UNDELEGATE,
+    AUTHORIZATION_TYPE_REDELEGATE,
+    AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION
+}
+
+/// @dev Grant represents an x/authz grant between a granter and a grantee.
+/// The spendLimit field is only populated for authorizations that limit
+/// the amount of tokens that can be spent (e.g. send and stake authorizations).
+/// An expiration of zero means that the grant does not expire.
+struct Grant {
+    address granter;
+    address grantee;
+    string authorizationType;
+    string msgType;
+    Coin[] spendLimit;
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/authz module.
+/// The granter of all grants and revocations is the caller of the precompile.
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface AuthzI {
+    /// @dev Grants a generic authorization to the grantee to execute the given
+    /// message type on behalf of the caller.
+    /// @param grantee The address that receives the authorization.
+    /// @param msgType The message type URL that the grantee is allowed to execute.
+    /// @param expiration The unix timestamp (in seconds) at which the grant expires. Zero means no expiration.
+    /// @return success Boolean value to indicate if the grant was successful.
+    function grant(
+        address grantee,
+        string calldata msgType,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants a send authorization to the grantee to spend up to the given
+    /// spend limit on behalf of the caller.
+    /// @param grantee The address that receives the authorization.
+    /// @param spendLimit The coins that the grantee is allowed to spend.
+    /// @param allowList The addresses that are allowed to receive the coins. An empty list allows any recipient.
+    /// @param expiration The unix timestamp (in seconds) at which the grant expires. Zero means no expiration.
+    /// @return success Boolean value to indicate if the grant was successful.
+    function grantSend(
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants a stake authorization to the grantee to execute staking
+    /// messages on behalf of the caller.
+    /// @param grantee The address that receives the authorization.
+    /// @param authorizationType The staking message type that is authorized.
+    /// @param maxTokens The maximum amount of tokens that can be staked. MaxUint256 means no limit.
+    /// @param allowedValidators The validator operator addresses that are allowed.
+    /// @param deniedValidators The validator operator addresses that are denied.
+    /// @param expiration The unix timestamp (in seconds) at which the grant expires. Zero means no expiration.
+    /// @return success Boolean value to indicate if the grant was successful.
+    function grantStake(
+        address grantee,
+        AuthorizationType authorizationType,
+        uint256 maxTokens,
+        string[] calldata allowedValidators,
+        string[] calldata deniedValidators,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the authorizations given by the caller to the grantee for the given message types.
+    /// @param grantee The address that has its authorizations revoked.
+    /// @param msgTypes The message type URLs of the authorizations to revoke.
+    /// @return revoked Boolean value to indicate if the revocation was successful.
+    function revoke(
+        address grantee,
+        string[] calldata msgTypes
+    ) external returns (bool revoked);
+
+    /// @dev Executes the given Cosmos messages on behalf of their signers, using the
+    /// authorizations granted to the caller.
+    /// @param msgs The JSON encoded Cosmos messages, including their "@type" field.
+    /// @return results The encoded responses of the executed messages.
+    function exec(
+        string[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev Returns the grants between a granter and a grantee.
+    /// @param granter The address that granted the authorizations.
+    /// @param grantee The address that received the authorizations.
+    /// @param msgType The message type URL to filter for. An empty string returns all grants.
+    /// @param pageRequest The pagination request.
+    /// @return grants The list of grants.
+    /// @return pageResponse The pagination response.
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgType,
+        PageRequest calldata pageRequest
+    ) external view returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns all the grants given by a granter.
+    /// @param granter The address that granted the authorizations.
+    /// @param pageRequest The pagination request.
+    /// @return grants The list of grants.
+    /// @return pageResponse The pagination response.
+    function granterGrants(
+        address granter,
+        PageRequest calldata pageRequest
+    ) external view returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns all the grants received by a grantee.
+    /// @param grantee The address that received the authorizations.
+    /// @param pageRequest The pagination request.
+    /// @return grants The list of grants.
+    /// @return pageResponse The pagination response.
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pageRequest
+    ) external view returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev This event is emitted when an authorization is granted. It uses the same
+    /// definition as the Approval event of the AuthorizationI interface.
+    /// @param grantee The address that received an authorization from the granter.
+    /// @param granter The address that granted the authorization.
+    /// @param methods The message type URLs for which the authorization is granted.
+    /// @param value The amount of tokens approved to be spent. MaxUint256 means no limit.
+    event Approval(
+        address indexed grantee,
+        address indexed granter,
+        string[] methods,
+        uint256 value
+    );
+
+    /// @dev This event is emitted when an authorization is revoked. It uses the same
+    /// definition as the Revocation event of the AuthorizationI interface.
+    /// @param grantee The address that has its authorization revoked.
+    /// @param granter The address of the granter.
+    /// @param methods The message type URLs for which the authorization is revoked.
+    event Revocation(
+        address indexed grantee,
+        address indexed granter,
+        string[] methods
+    );
+
+    /// @dev This event is emitted when a grantee executes messages on behalf of granters.
+    /// @param grantee The address that executed the messages.
+    /// @param methods The message type URLs of the executed messages.
+    event Exec(
+        address indexed grantee,
+        string[] methods
+    );
+}
@@ -0,0 +1,172 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"embed"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// PrecompileAddress defines the contract address of the authz precompile.
+const PrecompileAddress = "0x0000000000000000000000000000000000000805"
+
+// Precompile defines the precompiled contract for authz.
+type Precompile struct {
+	cmn.Precompile
+	cdc           codec.Codec
+	stakingKeeper stakingkeeper.Keeper
+}
+
+// LoadABI loads the authz ABI from the embedded abi.json file
+// for the authz precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new authz Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	cdc codec.Codec,
+	authzKeeper authzkeeper.Keeper,
+	stakingKeeper stakingkeeper.Keeper,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+		},
+		cdc:           cdc,
+		stakingKeeper: stakingKeeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// WithGasSchedule returns a copy of the precompile that charges gas according to the given schedule.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) vm.PrecompiledContract {
+	p.Precompile = p.Precompile.WithGasSchedule(schedule)
+	return &p
+}
+
+// Address defines the address of the authz compile contract.
+// address: 0x0000000000000000000000000000000000000805
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// Run executes the precompiled contract authz methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	// NOTE: the executed messages can run EVM calls, e.g. the ERC20 conversions, so
+	// the EVM state up to this point needs to be committed, and the cached state objects
+	// discarded so that the changes of these calls are not overwritten with stale values.
+	if err := stateDB.Flush(); err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// Authz transactions
+	case GrantMethod:
+		bz, err = p.Grant(ctx, contract, stateDB, method, args)
+	case GrantSendMethod:
+		bz, err = p.GrantSend(ctx, contract, stateDB, method, args)
+	case GrantStakeMethod:
+		bz, err = p.GrantStake(ctx, contract, stateDB, method, args)
+	case authorization.RevokeMethod:
+		bz, err = p.Revoke(ctx, contract, stateDB, method, args)
+	case ExecMethod:
+		bz, err = p.Exec(ctx, contract, stateDB, method, args)
+	// Authz queries
+	case GrantsMethod:
+		bz, err = p.Grants(ctx, contract, method, args)
+	case GranterGrantsMethod:
+		bz, err = p.GranterGrants(ctx, contract, method, args)
+	case GranteeGrantsMethod:
+		bz, err = p.GranteeGrants(ctx, contract, method, args)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available authz transactions are:
+//   - Grant
+//   - GrantSend
+//   - GrantStake
+//   - Revoke
+//   - Exec
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case GrantMethod,
+		GrantSendMethod,
+		GrantStakeMethod,
+		authorization.RevokeMethod,
+		ExecMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "authz")
+}
@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package authz
+
+const (
+	// ErrInvalidExpiration is raised when the given expiration timestamp is negative.
+	ErrInvalidExpiration = "invalid expiration: expected a non-negative unix timestamp; got: %d"
+	// ErrInvalidMsgs is raised when the given messages cannot be unpacked.
+	ErrInvalidMsgs = "invalid messages defined; expected an array of strings; got: %v"
+	// ErrEmptyMsgs is raised when no messages are provided to execute.
+	ErrEmptyMsgs = "no messages defined; expected at least one message to execute"
+	// ErrInvalidMsg is raised when a given message cannot be decoded.
+	ErrInvalidMsg = "invalid message at index %d: %s"
+	// ErrDisabledMsgType is raised when a message type cannot be granted or executed through the precompile.
+	ErrDisabledMsgType = "message type %s cannot be granted or executed through the authz precompile"
+	// ErrInvalidSpendLimit is raised when the given spend limit is invalid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAllowList is raised when the given allow list cannot be unpacked.
+	ErrInvalidAllowList = "invalid allow list; expected an array of addresses; got: %v"
+	// ErrInvalidAuthorizationType is raised when the given staking authorization type is not valid.
+	ErrInvalidAuthorizationType = "invalid staking authorization type: %v"
+	// ErrInvalidValidators is raised when the given validators cannot be unpacked.
+	ErrInvalidValidators = "invalid validators defined; expected an array of strings; got: %v"
+)
@@ -0,0 +1,81 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// EventTypeExec defines the event type for the authz Exec transaction.
+	EventTypeExec = "Exec"
+)
+
+// EventExec is the event emitted on a successful Exec transaction.
+type EventExec struct {
+	Grantee common.Address
+	Methods []string
+}
+
+// EmitApprovalEvent creates a new approval event emitted on a Grant transaction.
+func (p Precompile) EmitApprovalEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	grantee, granter common.Address,
+	value *big.Int,
+	msgType string,
+) error {
+	return authorization.EmitApprovalEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventApproval{
+			Grantee: grantee,
+			Granter: granter,
+			Value:   value,
+			Methods: []string{msgType},
+		},
+	})
+}
+
+// EmitExecEvent creates a new exec event emitted on an Exec transaction.
+func (p Precompile) EmitExecEvent(ctx sdk.Context, stateDB vm.StateDB, grantee common.Address, typeURLs []string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeExec]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(typeURLs)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the grants between a granter and a grantee, optionally filtered
+// by message type.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	granter := common.BytesToAddress(sdk.MustAccAddressFromBech32(req.Granter))
+	grantee := common.BytesToAddress(sdk.MustAccAddressFromBech32(req.Grantee))
+
+	out, err := new(GrantsOutput).FromGrants(granter, grantee, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GranterGrants returns all the grants given by a granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GranteeGrants returns all the grants received by a grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranteeGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
@@ -0,0 +1,121 @@
+package authz_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/authz"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+	var granter, grantee common.Address
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expGrants   int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{granter}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 1),
+			0,
+		},
+		{
+			"fail - empty granter",
+			func() []interface{} {
+				return []interface{}{common.Address{}, grantee, "", query.PageRequest{}}
+			},
+			false,
+			"invalid granter address",
+			0,
+		},
+		{
+			"pass - no grants",
+			func() []interface{} {
+				return []interface{}{granter, grantee, "", query.PageRequest{}}
+			},
+			true,
+			"",
+			0,
+		},
+		{
+			"pass - grant filtered by message type",
+			func() []interface{} {
+				s.grantGeneric(granter, grantee, sendMsgTypeURL)
+				return []interface{}{granter, grantee, sendMsgTypeURL, query.PageRequest{}}
+			},
+			true,
+			"",
+			1,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			args := tc.malleate()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+			bz, err := s.precompile.Grants(ctx, contract, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			err = s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Len(out.Grants, tc.expGrants)
+			for _, grant := range out.Grants {
+				s.Require().Equal(granter, grant.Granter)
+				s.Require().Equal(grantee, grant.Grantee)
+				s.Require().Equal(sendMsgTypeURL, grant.MsgType)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterAndGranteeGrants() {
+	granter := s.keyring.GetAddr(0)
+	grantee := s.keyring.GetAddr(1)
+
+	s.grantGeneric(granter, grantee, sendMsgTypeURL)
+	s.grantGeneric(granter, s.keyring.GetAddr(2), sendMsgTypeURL)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+	bz, err := s.precompile.GranterGrants(ctx, contract, &method, []interface{}{granter, query.PageRequest{}})
+	s.Require().NoError(err)
+
+	var out authz.GrantsOutput
+	err = s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().Len(out.Grants, 2)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+
+	method = s.precompile.Methods[authz.GranteeGrantsMethod]
+	bz, err = s.precompile.GranteeGrants(ctx, contract, &method, []interface{}{grantee, query.PageRequest{}})
+	s.Require().NoError(err)
+
+	out = authz.GrantsOutput{}
+	err = s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().Len(out.Grants, 1)
+	s.Require().Equal(granter, out.Grants[0].Granter)
+}
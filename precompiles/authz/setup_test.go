@@ -0,0 +1,60 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v16/precompiles/authz"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for authz precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	bondDenom   string
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *authz.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(integrationNetwork)
+	txFactory := factory.New(integrationNetwork, grpcHandler)
+
+	ctx := integrationNetwork.GetContext()
+	bondDenom := integrationNetwork.App.StakingKeeper.BondDenom(ctx)
+	s.Require().NotEmpty(bondDenom, "bond denom cannot be empty")
+
+	s.bondDenom = bondDenom
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	precompile, err := authz.NewPrecompile(
+		s.network.App.AppCodec(),
+		s.network.App.AuthzKeeper,
+		s.network.App.StakingKeeper,
+	)
+	s.Require().NoError(err, "failed to create authz precompile")
+	s.precompile = precompile
+}
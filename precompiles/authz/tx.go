@@ -0,0 +1,219 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction
+	// with a generic authorization.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz Grant transaction
+	// with a send authorization.
+	GrantSendMethod = "grantSend"
+	// GrantStakeMethod defines the ABI method name for the authz Grant transaction
+	// with a stake authorization.
+	GrantStakeMethod = "grantStake"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization for the given message type from the caller to the grantee.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, err := NewMsgGrant(args, contract.CallerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.grant(ctx, contract, stateDB, msg, grantee, nil); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// GrantSend grants a send authorization with the given spend limit from the caller to the grantee.
+func (p Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, err := NewMsgGrantSend(method, args, contract.CallerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+	if !ok {
+		return nil, errorsmod.Wrapf(authz.ErrUnknownAuthorizationType, "expected: *types.SendAuthorization, received: %T", authorization)
+	}
+
+	if err := p.grant(ctx, contract, stateDB, msg, grantee, sendAuthz.SpendLimit); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// GrantStake grants a stake authorization for the given staking message type from the caller to the grantee.
+func (p Precompile) GrantStake(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, maxTokens, err := NewMsgGrantStake(args, contract.CallerAddress, p.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var spendLimit sdk.Coins
+	if maxTokens != nil {
+		spendLimit = sdk.Coins{*maxTokens}
+	}
+
+	if err := p.grant(ctx, contract, stateDB, msg, grantee, spendLimit); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the authorizations given by the caller to the grantee for the given message types.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURLs, err := authorization.CheckRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	granter := contract.CallerAddress
+	for _, typeURL := range typeURLs {
+		if err := p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), typeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventRevocation{
+			Granter:  granter,
+			Grantee:  grantee,
+			TypeUrls: typeURLs,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given Cosmos messages on behalf of their signers, using the
+// authorizations granted to the caller. The caller is the grantee of the messages.
+func (p Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msgs, typeURLs, err := NewExecMsgs(p.cdc, args)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee := contract.CallerAddress
+
+	// NOTE: the executed messages can change the balances of arbitrary accounts.
+	// The accounts are collected from the bank events emitted during the execution to
+	// mirror the balance changes in the EVM stateDB, which would otherwise overwrite the
+	// bank balances when committing the EVM state.
+	eventsBefore := len(ctx.EventManager().Events())
+
+	results, err := p.AuthzKeeper.DispatchActions(ctx, grantee.Bytes(), msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	touched := cmn.BalanceChangedAddresses(ctx.EventManager().Events()[eventsBefore:])
+	cmn.SyncBalances(ctx, stateDB.(*statedb.StateDB), touched)
+
+	if err := p.EmitExecEvent(ctx, stateDB, grantee, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(results)
+}
+
+// grant saves the authorization of the given MsgGrant and emits the corresponding approval event.
+// A nil spend limit is emitted as an approval with an unlimited value.
+func (p Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	msg *authz.MsgGrant,
+	grantee common.Address,
+	spendLimit sdk.Coins,
+) error {
+	if _, err := p.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	values := []*big.Int{abi.MaxUint256}
+	if len(spendLimit) > 0 {
+		values = make([]*big.Int, len(spendLimit))
+		for i, coin := range spendLimit {
+			values[i] = coin.Amount.BigInt()
+		}
+	}
+
+	// NOTE: one approval event is emitted for each coin of the spend limit
+	for _, value := range values {
+		if err := p.EmitApprovalEvent(ctx, stateDB, grantee, contract.CallerAddress, value, authorization.MsgTypeURL()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
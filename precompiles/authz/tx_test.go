@@ -0,0 +1,482 @@
+package authz_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
+	"github.com/evmos/evmos/v16/precompiles/authz"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	inflationtypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	var granter, grantee common.Address
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{grantee, sendMsgTypeURL}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			"fail - empty grantee",
+			func() []interface{} {
+				return []interface{}{common.Address{}, sendMsgTypeURL, int64(0)}
+			},
+			false,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{grantee, sendMsgTypeURL, int64(-1)}
+			},
+			false,
+			fmt.Sprintf(authz.ErrInvalidExpiration, -1),
+		},
+		{
+			"fail - disabled message type",
+			func() []interface{} {
+				return []interface{}{grantee, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			false,
+			"cannot be granted or executed",
+		},
+		{
+			"pass - generic authorization without expiration",
+			func() []interface{} {
+				return []interface{}{grantee, sendMsgTypeURL, int64(0)}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+			bz, err := s.precompile.Grant(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			auth, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+			s.Require().NotNil(auth, "expected authorization to be stored")
+			s.Require().IsType(&authztypes.GenericAuthorization{}, auth)
+			s.Require().Nil(expiration)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1, "expected one approval event")
+			s.Require().Equal(s.precompile.ABI.Events[authorization.EventTypeApproval].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSend() {
+	method := s.precompile.Methods[authz.GrantSendMethod]
+	var granter, grantee common.Address
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{grantee}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 1),
+		},
+		{
+			"fail - invalid spend limit",
+			func() []interface{} {
+				return []interface{}{grantee, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(0)}}, []common.Address{}, int64(0)}
+			},
+			false,
+			"amount is not positive",
+		},
+		{
+			"pass - send authorization with allow list",
+			func() []interface{} {
+				return []interface{}{grantee, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1e18)}}, []common.Address{s.keyring.GetAddr(2)}, int64(0)}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+			bz, err := s.precompile.GrantSend(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			auth, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+			sendAuthz, ok := auth.(*banktypes.SendAuthorization)
+			s.Require().True(ok, "expected send authorization")
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(1e18))), sendAuthz.SpendLimit)
+			s.Require().Equal([]string{s.keyring.GetAccAddr(2).String()}, sendAuthz.AllowList)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantStake() {
+	method := s.precompile.Methods[authz.GrantStakeMethod]
+	var granter, grantee common.Address
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - unspecified authorization type",
+			func() []interface{} {
+				return []interface{}{grantee, uint8(0), abi.MaxUint256, []string{}, []string{}, int64(0)}
+			},
+			false,
+			"invalid staking authorization type",
+		},
+		{
+			"fail - no allowed nor denied validators",
+			func() []interface{} {
+				return []interface{}{grantee, uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE), abi.MaxUint256, []string{}, []string{}, int64(0)}
+			},
+			false,
+			"both allowed & deny list cannot be empty",
+		},
+		{
+			"pass - delegate authorization without limit",
+			func() []interface{} {
+				validators := s.network.GetValidators()
+				return []interface{}{
+					grantee,
+					uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE),
+					abi.MaxUint256,
+					[]string{validators[0].OperatorAddress},
+					[]string{},
+					int64(0),
+				}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+			bz, err := s.precompile.GrantStake(ctx, contract, stateDB, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			auth, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}))
+			stakeAuthz, ok := auth.(*stakingtypes.StakeAuthorization)
+			s.Require().True(ok, "expected stake authorization")
+			s.Require().Nil(stakeAuthz.MaxTokens)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authorization.RevokeMethod]
+	var granter, grantee common.Address
+
+	testcases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - authorization does not exist",
+			func() {},
+			false,
+			"authorization not found",
+		},
+		{
+			"pass - revoke existing authorization",
+			func() {
+				s.grantGeneric(granter, grantee, sendMsgTypeURL)
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee = s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			tc.malleate()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+
+			bz, err := s.precompile.Revoke(ctx, contract, stateDB, &method, []interface{}{grantee, []string{sendMsgTypeURL}})
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			auth, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), sendMsgTypeURL)
+			s.Require().Nil(auth, "expected authorization to be revoked")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+	var granter, grantee, receiver common.Address
+	amount := sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(1e18)))
+
+	sendMsg := func(from common.Address) string {
+		bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(banktypes.NewMsgSend(from.Bytes(), receiver.Bytes(), amount))
+		s.Require().NoError(err)
+		return string(bz)
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - empty messages",
+			func() []interface{} {
+				return []interface{}{[]string{}}
+			},
+			false,
+			authz.ErrEmptyMsgs,
+		},
+		{
+			"fail - invalid message",
+			func() []interface{} {
+				return []interface{}{[]string{"invalid"}}
+			},
+			false,
+			"invalid message at index 0",
+		},
+		{
+			"fail - disabled message type",
+			func() []interface{} {
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(&authztypes.MsgExec{Grantee: s.keyring.GetAccAddr(1).String()})
+				s.Require().NoError(err)
+				return []interface{}{[]string{string(bz)}}
+			},
+			false,
+			"cannot be granted or executed",
+		},
+		{
+			"fail - no authorization",
+			func() []interface{} {
+				return []interface{}{[]string{sendMsg(granter)}}
+			},
+			false,
+			"authorization not found",
+		},
+		{
+			"pass - execute send on behalf of the granter",
+			func() []interface{} {
+				s.grantGeneric(granter, grantee, sendMsgTypeURL)
+				return []interface{}{[]string{sendMsg(granter)}}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			granter, grantee, receiver = s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.keyring.GetAddr(2)
+			args := tc.malleate()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), grantee, s.precompile, 200_000)
+
+			granterBalance := stateDB.GetBalance(granter)
+			receiverBalance := stateDB.GetBalance(receiver)
+
+			bz, err := s.precompile.Exec(ctx, contract, stateDB, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(bz)
+
+			// the balance changes must be reflected in the stateDB
+			expGranterBalance := new(big.Int).Sub(granterBalance, amount[0].Amount.BigInt())
+			expReceiverBalance := new(big.Int).Add(receiverBalance, amount[0].Amount.BigInt())
+			s.Require().Equal(expGranterBalance, stateDB.GetBalance(granter))
+			s.Require().Equal(expReceiverBalance, stateDB.GetBalance(receiver))
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1, "expected one exec event")
+			s.Require().Equal(s.precompile.ABI.Events[authz.EventTypeExec].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExecConvertERC20AfterTransfer() {
+	granter, grantee, receiver := s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.keyring.GetAddr(2)
+	amount := big.NewInt(1e17)
+	transferred := big.NewInt(4e16)
+	converted := big.NewInt(6e16)
+
+	tokenDenom, tokenAddr := s.registerERC20Coin(granter, amount)
+	s.grantGeneric(granter, grantee, sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}))
+
+	ctx := s.network.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter())
+	stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	// the granter transfers ERC20 tokens and the grantee converts the remaining
+	// ones on behalf of the granter within the same EVM transaction
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, s.network.App.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg := ethtypes.NewMessage(granter, &tokenAddr, 0, big.NewInt(0), 10_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+	evm.WithPrecompiles(
+		map[common.Address]vm.PrecompiledContract{s.precompile.Address(): s.precompile},
+		[]common.Address{s.precompile.Address()},
+	)
+
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	input, err := erc20ABI.Pack("transfer", receiver, transferred)
+	s.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(granter), tokenAddr, input, 10_000_000, big.NewInt(0))
+	s.Require().NoError(err, "failed to transfer ERC20 tokens")
+
+	convertMsg := erc20types.NewMsgConvertERC20(math.NewIntFromBigInt(converted), granter.Bytes(), tokenAddr, granter)
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(convertMsg)
+	s.Require().NoError(err)
+	input, err = s.precompile.Pack(authz.ExecMethod, []string{string(bz)})
+	s.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(grantee), s.precompile.Address(), input, 10_000_000, big.NewInt(0))
+	s.Require().NoError(err, "failed to execute the ERC20 conversion")
+
+	s.Require().NoError(stateDB.Commit())
+
+	balance := s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, tokenAddr, granter)
+	s.Require().Zero(balance.Sign(), "expected the converted ERC20 tokens to stay burned")
+	balance = s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, tokenAddr, receiver)
+	s.Require().Equal(transferred, balance, "expected the transferred ERC20 tokens")
+
+	coins := s.network.App.BankKeeper.GetBalance(ctx, granter.Bytes(), tokenDenom)
+	s.Require().Equal(converted, coins.Amount.BigInt(), "expected the converted coins")
+}
+
+// registerERC20Coin registers a coin as a token pair and converts the given amount
+// of it into ERC20 tokens owned by the holder.
+func (s *PrecompileTestSuite) registerERC20Coin(holder common.Address, amount *big.Int) (string, common.Address) {
+	ctx := s.network.GetContext()
+	denom := "xmpl"
+	coins := sdk.Coins{{Denom: denom, Amount: math.NewIntFromBigInt(amount)}}
+	s.Require().NoError(s.network.App.BankKeeper.MintCoins(ctx, inflationtypes.ModuleName, coins))
+	s.Require().NoError(s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, inflationtypes.ModuleName, holder.Bytes(), coins))
+
+	pair, err := s.network.App.Erc20Keeper.RegisterCoin(ctx, banktypes.Metadata{
+		Description: "An exemplary token",
+		Base:        denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0, Aliases: []string{denom}},
+			{Denom: denom, Exponent: 18},
+		},
+		Name:    "Exemplary",
+		Symbol:  "XMPL",
+		Display: denom,
+	})
+	s.Require().NoError(err, "failed to register coin")
+
+	_, err = s.network.App.Erc20Keeper.ConvertCoin(
+		sdk.WrapSDKContext(ctx),
+		erc20types.NewMsgConvertCoin(coins[0], holder, holder.Bytes()),
+	)
+	s.Require().NoError(err, "failed to convert coin")
+
+	return denom, pair.GetERC20Contract()
+}
+
+// grantGeneric is a helper function to store a generic authorization from the granter
+// to the grantee for the given message type.
+func (s *PrecompileTestSuite) grantGeneric(granter, grantee common.Address, msgType string) {
+	err := s.network.App.AuthzKeeper.SaveGrant(
+		s.network.GetContext(),
+		grantee.Bytes(),
+		granter.Bytes(),
+		authztypes.NewGenericAuthorization(msgType),
+		nil,
+	)
+	s.Require().NoError(err, "failed to save grant")
+}
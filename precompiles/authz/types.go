@@ -0,0 +1,492 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"golang.org/x/exp/slices"
+)
+
+// DisabledMsgTypes defines the message types that cannot be granted nor executed
+// through the authz precompile.
+//
+// NOTE: This mirrors the message types blocked by the AuthzLimiterDecorator for Cosmos
+// transactions and additionally blocks nested authz executions.
+var DisabledMsgTypes = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+	sdk.MsgTypeURL(&authz.MsgExec{}),
+}
+
+// Grant is the struct representation of an authz grant as defined in the ABI.
+type Grant struct {
+	Granter           common.Address `abi:"granter"`
+	Grantee           common.Address `abi:"grantee"`
+	AuthorizationType string         `abi:"authorizationType"`
+	MsgType           string         `abi:"msgType"`
+	SpendLimit        []cmn.Coin     `abi:"spendLimit"`
+	Expiration        int64          `abi:"expiration"`
+}
+
+// GrantsOutput is the output of the grants queries.
+type GrantsOutput struct {
+	Grants       []Grant
+	PageResponse query.PageResponse
+}
+
+// GrantSendInput is a struct to unpack the arguments of the GrantSend transaction.
+type GrantSendInput struct {
+	Grantee    common.Address
+	SpendLimit []cmn.Coin
+	AllowList  []common.Address
+	Expiration int64
+}
+
+// GrantsInput is a struct to unpack the arguments of the Grants query.
+type GrantsInput struct {
+	Granter     common.Address
+	Grantee     common.Address
+	MsgType     string
+	PageRequest query.PageRequest
+}
+
+// GranterGrantsInput is a struct to unpack the arguments of the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// GranteeGrantsInput is a struct to unpack the arguments of the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// NewMsgGrant creates a new MsgGrant with a GenericAuthorization from the given arguments.
+func NewMsgGrant(args []interface{}, granter common.Address) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	msgType, ok := args[1].(string)
+	if !ok || msgType == "" {
+		return nil, common.Address{}, fmt.Errorf(authorization.ErrInvalidMethod, args[1])
+	}
+
+	expiration, err := parseExpiration(args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgType), expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, grantee, nil
+}
+
+// NewMsgGrantSend creates a new MsgGrant with a SendAuthorization from the given arguments.
+func NewMsgGrantSend(method *abi.Method, args []interface{}, granter common.Address) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	if grantee, ok := args[0].(common.Address); !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	if _, ok := args[2].([]common.Address); !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAllowList, args[2])
+	}
+
+	var input GrantSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantSendInput struct: %s", err)
+	}
+
+	spendLimit := make(sdk.Coins, len(input.SpendLimit))
+	for i, coin := range input.SpendLimit {
+		if coin.Amount == nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, input.SpendLimit)
+		}
+		spendLimit[i] = coin.ToSDKType()
+	}
+
+	// NOTE: the SDK requires the spend limit to be sorted
+	spendLimit = spendLimit.Sort()
+	if err := spendLimit.Validate(); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	allowList := make([]sdk.AccAddress, len(input.AllowList))
+	for i, addr := range input.AllowList {
+		allowList[i] = addr.Bytes()
+	}
+
+	expiration, err := parseExpiration(input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, input.Grantee, banktypes.NewSendAuthorization(spendLimit, allowList), expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgGrantStake creates a new MsgGrant with a StakeAuthorization from the given arguments.
+func NewMsgGrantStake(args []interface{}, granter common.Address, bondDenom string) (*authz.MsgGrant, common.Address, *sdk.Coin, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, nil, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	authzTypeArg, ok := args[1].(uint8)
+	if !ok {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidAuthorizationType, args[1])
+	}
+
+	authzType := stakingtypes.AuthorizationType(authzTypeArg)
+	if _, found := stakingtypes.AuthorizationType_name[int32(authzType)]; !found || authzType == stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidAuthorizationType, args[1])
+	}
+
+	maxTokens, ok := args[2].(*big.Int)
+	if !ok || maxTokens == nil || maxTokens.Sign() == -1 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	// A MaxUint256 amount defines a stake authorization without limit
+	var coin *sdk.Coin
+	if maxTokens.Cmp(abi.MaxUint256) != 0 {
+		coin = &sdk.Coin{Denom: bondDenom, Amount: math.NewIntFromBigInt(maxTokens)}
+	}
+
+	allowed, ok := args[3].([]string)
+	if !ok {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidValidators, args[3])
+	}
+
+	denied, ok := args[4].([]string)
+	if !ok {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidValidators, args[4])
+	}
+
+	allowedValidators, err := parseValidators(allowed)
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	deniedValidators, err := parseValidators(denied)
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	stakeAuthz, err := stakingtypes.NewStakeAuthorization(allowedValidators, deniedValidators, authzType, coin)
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	expiration, err := parseExpiration(args[5])
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, stakeAuthz, expiration)
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	return msg, grantee, coin, nil
+}
+
+// NewExecMsgs decodes the JSON encoded Cosmos messages passed to the Exec transaction
+// and performs basic validation on them.
+func NewExecMsgs(cdc codec.Codec, args []interface{}) ([]sdk.Msg, []string, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	jsonMsgs, ok := args[0].([]string)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgs, args[0])
+	}
+
+	if len(jsonMsgs) == 0 {
+		return nil, nil, fmt.Errorf(ErrEmptyMsgs)
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	typeURLs := make([]string, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON([]byte(jsonMsg), &msg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsg, i, err)
+		}
+
+		typeURL := sdk.MsgTypeURL(msg)
+		if slices.Contains(DisabledMsgTypes, typeURL) {
+			return nil, nil, fmt.Errorf(ErrDisabledMsgType, typeURL)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsg, i, err)
+		}
+
+		msgs[i] = msg
+		typeURLs[i] = typeURL
+	}
+
+	return msgs, typeURLs, nil
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest instance from the given arguments.
+func NewGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGranter, args[0])
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGrantee, args[1])
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgType,
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest instance from the given arguments.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGranter, args[0])
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewGranteeGrantsRequest creates a new QueryGranteeGrantsRequest instance from the given arguments.
+func NewGranteeGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: parsePageRequest(input.PageRequest),
+	}, nil
+}
+
+// FromGrants populates the GrantsOutput from the grants between the given granter and grantee.
+func (o *GrantsOutput) FromGrants(granter, grantee common.Address, grants []*authz.Grant, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]Grant, len(grants))
+	for i, g := range grants {
+		grant, err := newGrant(granter, grantee, g.Authorization.GetCachedValue(), g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the given grant authorizations.
+func (o *GrantsOutput) FromGrantAuthorizations(grants []*authz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]Grant, len(grants))
+	for i, g := range grants {
+		granter, err := sdk.AccAddressFromBech32(g.Granter)
+		if err != nil {
+			return nil, err
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(g.Grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		grant, err := newGrant(common.BytesToAddress(granter), common.BytesToAddress(grantee), g.Authorization.GetCachedValue(), g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grant
+	}
+
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (o *GrantsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(o.Grants, o.PageResponse)
+}
+
+// setPageResponse sets the page response of the output if present.
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse.Total = pageRes.Total
+		o.PageResponse.NextKey = pageRes.NextKey
+	}
+}
+
+// newGrant creates a new Grant from the given authorization and expiration.
+func newGrant(granter, grantee common.Address, cachedAuthz interface{}, expiration *time.Time) (Grant, error) {
+	authorization, ok := cachedAuthz.(authz.Authorization)
+	if !ok {
+		return Grant{}, fmt.Errorf("%w: %T", authz.ErrUnknownAuthorizationType, cachedAuthz)
+	}
+
+	var spendLimit sdk.Coins
+	switch a := authorization.(type) {
+	case *banktypes.SendAuthorization:
+		spendLimit = a.SpendLimit
+	case *stakingtypes.StakeAuthorization:
+		if a.MaxTokens != nil {
+			spendLimit = sdk.Coins{*a.MaxTokens}
+		}
+	}
+
+	var expirationUnix int64
+	if expiration != nil {
+		expirationUnix = expiration.Unix()
+	}
+
+	return Grant{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: "/" + proto.MessageName(authorization),
+		MsgType:           authorization.MsgTypeURL(),
+		SpendLimit:        cmn.NewCoinsResponse(spendLimit),
+		Expiration:        expirationUnix,
+	}, nil
+}
+
+// newMsgGrant creates a new MsgGrant and performs basic validation on it.
+func newMsgGrant(granter, grantee common.Address, a authz.Authorization, expiration *time.Time) (*authz.MsgGrant, error) {
+	if slices.Contains(DisabledMsgTypes, a.MsgTypeURL()) {
+		return nil, fmt.Errorf(ErrDisabledMsgType, a.MsgTypeURL())
+	}
+
+	msg, err := authz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), a, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// parseExpiration parses the given unix timestamp argument into an expiration time.
+// A zero timestamp defines a grant without expiration.
+func parseExpiration(arg interface{}) (*time.Time, error) {
+	expiration, ok := arg.(int64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "expiration", int64(0), arg)
+	}
+
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	if expiration == 0 {
+		return nil, nil
+	}
+
+	t := time.Unix(expiration, 0).UTC()
+	return &t, nil
+}
+
+// parseValidators converts the given validator operator addresses into
+// a list of bech32 encoded addresses. Hex addresses are supported as well.
+func parseValidators(validators []string) ([]sdk.ValAddress, error) {
+	valAddrs := make([]sdk.ValAddress, len(validators))
+	for i, validator := range validators {
+		if common.IsHexAddress(validator) {
+			valAddrs[i] = common.HexToAddress(validator).Bytes()
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidValidator, validator)
+		}
+		valAddrs[i] = valAddr
+	}
+
+	return valAddrs, nil
+}
+
+// parsePageRequest returns the pagination request to be used in the queries.
+func parsePageRequest(pageRequest query.PageRequest) *query.PageRequest {
+	if bytes.Equal(pageRequest.Key, []byte{0}) {
+		pageRequest.Key = nil
+	}
+	return &pageRequest
+}
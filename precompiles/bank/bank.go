@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	erc20keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 const (
@@ -75,6 +76,10 @@ func (Precompile) Address() common.Address {
 
 // RequiredGas calculates the precompiled contract's base gas rate.
 func (p Precompile) RequiredGas(input []byte) uint64 {
+	if gas, ok := p.ScheduledRequiredGas(input); ok {
+		return gas
+	}
+
 	methodID := input[:4]
 
 	method, err := p.MethodById(methodID)
@@ -97,6 +102,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	return 0
 }
 
+// WithGasSchedule returns a copy of the precompile that charges gas according to the given schedule.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) vm.PrecompiledContract {
+	p.Precompile = p.Precompile.WithGasSchedule(schedule)
+	return &p
+}
+
 // Run executes the precompiled contract bank query methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
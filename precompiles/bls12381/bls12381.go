@@ -0,0 +1,149 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package bls12381 implements the BLS12-381 curve operation precompiled contracts
+// as per EIP-2537. See https://eips.ethereum.org/EIPS/eip-2537 for details.
+package bls12381
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// G1AddAddress defines the hex address of the G1 point addition precompiled contract.
+	G1AddAddress = "0x000000000000000000000000000000000000000b"
+	// G1MSMAddress defines the hex address of the G1 multi-scalar multiplication precompiled contract.
+	G1MSMAddress = "0x000000000000000000000000000000000000000c"
+	// G2AddAddress defines the hex address of the G2 point addition precompiled contract.
+	G2AddAddress = "0x000000000000000000000000000000000000000d"
+	// G2MSMAddress defines the hex address of the G2 multi-scalar multiplication precompiled contract.
+	G2MSMAddress = "0x000000000000000000000000000000000000000e"
+	// PairingAddress defines the hex address of the pairing check precompiled contract.
+	PairingAddress = "0x000000000000000000000000000000000000000f"
+	// MapFpToG1Address defines the hex address of the field element to G1 point mapping precompiled contract.
+	MapFpToG1Address = "0x0000000000000000000000000000000000000010"
+	// MapFp2ToG2Address defines the hex address of the field extension element to G2 point mapping
+	// precompiled contract.
+	MapFp2ToG2Address = "0x0000000000000000000000000000000000000011"
+)
+
+const (
+	// G1AddGas is the gas price of the G1 point addition.
+	G1AddGas uint64 = 375
+	// G1MulGas is the gas price of a single G1 point multiplication within a multi-scalar multiplication.
+	G1MulGas uint64 = 12000
+	// G2AddGas is the gas price of the G2 point addition.
+	G2AddGas uint64 = 600
+	// G2MulGas is the gas price of a single G2 point multiplication within a multi-scalar multiplication.
+	G2MulGas uint64 = 22500
+	// PairingBaseGas is the base gas price of the pairing check.
+	PairingBaseGas uint64 = 37700
+	// PairingPerPairGas is the gas price of each pair of points in the pairing check.
+	PairingPerPairGas uint64 = 32600
+	// MapFpToG1Gas is the gas price of mapping a field element to a G1 point.
+	MapFpToG1Gas uint64 = 5500
+	// MapFp2ToG2Gas is the gas price of mapping a field extension element to a G2 point.
+	MapFp2ToG2Gas uint64 = 23800
+
+	// msmDiscountMultiplier is the divisor applied to the discounted multi-scalar multiplication costs.
+	msmDiscountMultiplier uint64 = 1000
+)
+
+// Input and output lengths in bytes of the encoded values.
+const (
+	fieldElementLength = 64
+	scalarLength       = 32
+	g1PointLength      = 2 * fieldElementLength
+	g2PointLength      = 4 * fieldElementLength
+	g1MSMPairLength    = g1PointLength + scalarLength
+	g2MSMPairLength    = g2PointLength + scalarLength
+	pairingPairLength  = g1PointLength + g2PointLength
+)
+
+var (
+	// G1MSMDiscountTable defines the discounts applied to the G1 multi-scalar multiplication
+	// depending on the number of point and scalar pairs.
+	G1MSMDiscountTable = [128]uint64{1000, 949, 848, 797, 764, 750, 738, 728, 719, 712, 705, 698, 692, 687, 682, 677, 673, 669, 665, 661, 658, 654, 651, 648, 645, 642, 640, 637, 635, 632, 630, 627, 625, 623, 621, 619, 617, 615, 613, 611, 609, 608, 606, 604, 603, 601, 599, 598, 596, 595, 593, 592, 591, 589, 588, 586, 585, 584, 582, 581, 580, 579, 577, 576, 575, 574, 573, 572, 570, 569, 568, 567, 566, 565, 564, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 551, 550, 549, 548, 547, 547, 546, 545, 544, 543, 542, 541, 540, 540, 539, 538, 537, 536, 536, 535, 534, 533, 532, 532, 531, 530, 529, 528, 528, 527, 526, 525, 525, 524, 523, 522, 522, 521, 520, 520, 519}
+	// G2MSMDiscountTable defines the discounts applied to the G2 multi-scalar multiplication
+	// depending on the number of point and scalar pairs.
+	G2MSMDiscountTable = [128]uint64{1000, 1000, 923, 884, 855, 832, 812, 796, 782, 770, 759, 749, 740, 732, 724, 717, 711, 704, 699, 693, 688, 683, 679, 674, 670, 666, 663, 659, 655, 652, 649, 646, 643, 640, 637, 634, 632, 629, 627, 624, 622, 620, 618, 615, 613, 611, 609, 607, 606, 604, 602, 600, 598, 597, 595, 593, 592, 590, 589, 587, 586, 584, 583, 582, 580, 579, 578, 576, 575, 574, 573, 571, 570, 569, 568, 567, 566, 565, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 552, 551, 550, 549, 548, 547, 546, 545, 545, 544, 543, 542, 541, 541, 540, 539, 538, 537, 537, 536, 535, 535, 534, 533, 532, 532, 531, 530, 530, 529, 528, 528, 527, 526, 526, 525, 524, 524}
+)
+
+var (
+	errInvalidInputLength          = errors.New("invalid input length")
+	errInvalidFieldElementTopBytes = errors.New("invalid field element top bytes")
+	errG1PointSubgroup             = errors.New("g1 point is not on correct subgroup")
+	errG2PointSubgroup             = errors.New("g2 point is not on correct subgroup")
+)
+
+// Precompiles returns the BLS12-381 precompiled contracts.
+func Precompiles() []vm.PrecompiledContract {
+	return []vm.PrecompiledContract{
+		&G1Add{},
+		&G1MSM{},
+		&G2Add{},
+		&G2MSM{},
+		&Pairing{},
+		&MapFpToG1{},
+		&MapFp2ToG2{},
+	}
+}
+
+// PrecompileAddresses returns the addresses of the BLS12-381 precompiled contracts.
+func PrecompileAddresses() []common.Address {
+	precompiles := Precompiles()
+	addresses := make([]common.Address, len(precompiles))
+	for i, precompile := range precompiles {
+		addresses[i] = precompile.Address()
+	}
+	return addresses
+}
+
+// msmRequiredGas returns the gas required for a multi-scalar multiplication of k pairs
+// given the cost of a single multiplication and the discount table.
+func msmRequiredGas(k int, mulGas uint64, discountTable []uint64) uint64 {
+	if k == 0 {
+		return 0
+	}
+
+	discount := discountTable[len(discountTable)-1]
+	if k <= len(discountTable) {
+		discount = discountTable[k-1]
+	}
+
+	return (uint64(k) * mulGas * discount) / msmDiscountMultiplier
+}
+
+// decodeFieldElement decodes a 64 byte encoded field element into its 48 byte
+// representation, removing the top 16 bytes that must be zero.
+func decodeFieldElement(in []byte) ([]byte, error) {
+	if len(in) != fieldElementLength {
+		return nil, errors.New("invalid field element length")
+	}
+
+	for _, b := range in[:16] {
+		if b != 0 {
+			return nil, errInvalidFieldElementTopBytes
+		}
+	}
+
+	out := make([]byte, 48)
+	copy(out, in[16:])
+	return out, nil
+}
@@ -0,0 +1,234 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bls12381_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	bls "github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/evmos/evmos/v16/precompiles/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	trueValue  = common.LeftPadBytes(common.Big1.Bytes(), 32)
+	falseValue = make([]byte, 32)
+)
+
+// run executes the given precompile with the given input.
+func run(precompile vm.PrecompiledContract, input []byte) ([]byte, error) {
+	contract := vm.NewContract(vm.AccountRef(common.Address{}), vm.AccountRef(precompile.Address()), common.Big0, precompile.RequiredGas(input))
+	contract.Input = input
+	return precompile.Run(nil, contract, false)
+}
+
+// scalar encodes the given value as a 32 byte scalar.
+func scalar(v int64) []byte {
+	return common.LeftPadBytes(big.NewInt(v).Bytes(), 32)
+}
+
+// concat concatenates the given byte slices.
+func concat(slices ...[]byte) []byte {
+	var out []byte
+	for _, s := range slices {
+		out = append(out, s...)
+	}
+	return out
+}
+
+// g1PointNotInSubgroup returns the encoding of a G1 point that is on the curve
+// but not on the correct subgroup.
+func g1PointNotInSubgroup(t *testing.T) []byte {
+	p, _ := new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	exp := new(big.Int).Div(new(big.Int).Add(p, common.Big1), big.NewInt(4))
+
+	g := bls.NewG1()
+	for x := int64(1); ; x++ {
+		// y^2 = x^3 + 4
+		rhs := new(big.Int).Exp(big.NewInt(x), big.NewInt(3), p)
+		rhs.Add(rhs, big.NewInt(4)).Mod(rhs, p)
+		y := new(big.Int).Exp(rhs, exp, p)
+		if new(big.Int).Exp(y, common.Big2, p).Cmp(rhs) != 0 {
+			continue
+		}
+
+		encoded := concat(common.LeftPadBytes(big.NewInt(x).Bytes(), 64), common.LeftPadBytes(y.Bytes(), 64))
+		point, err := g.DecodePoint(encoded)
+		require.NoError(t, err)
+		if !g.InCorrectSubgroup(point) {
+			return encoded
+		}
+	}
+}
+
+func TestAddresses(t *testing.T) {
+	expAddresses := []string{
+		bls12381.G1AddAddress,
+		bls12381.G1MSMAddress,
+		bls12381.G2AddAddress,
+		bls12381.G2MSMAddress,
+		bls12381.PairingAddress,
+		bls12381.MapFpToG1Address,
+		bls12381.MapFp2ToG2Address,
+	}
+
+	addresses := bls12381.PrecompileAddresses()
+	require.Len(t, addresses, len(expAddresses))
+	for i, address := range addresses {
+		require.Equal(t, common.HexToAddress(expAddresses[i]), address)
+		require.Equal(t, common.BytesToAddress([]byte{byte(0x0b + i)}), address)
+	}
+}
+
+func TestRequiredGas(t *testing.T) {
+	testCases := []struct {
+		name       string
+		precompile vm.PrecompiledContract
+		input      []byte
+		expGas     uint64
+	}{
+		{"G1ADD", &bls12381.G1Add{}, nil, 375},
+		{"G1MSM - empty input", &bls12381.G1MSM{}, nil, 0},
+		{"G1MSM - single pair", &bls12381.G1MSM{}, make([]byte, 160), 12000},
+		{"G1MSM - two pairs", &bls12381.G1MSM{}, make([]byte, 2*160), 22776},
+		{"G1MSM - pairs above discount table", &bls12381.G1MSM{}, make([]byte, 200*160), 1245600},
+		{"G2ADD", &bls12381.G2Add{}, nil, 600},
+		{"G2MSM - single pair", &bls12381.G2MSM{}, make([]byte, 288), 22500},
+		{"G2MSM - two pairs", &bls12381.G2MSM{}, make([]byte, 2*288), 45000},
+		{"PAIRING_CHECK - two pairs", &bls12381.Pairing{}, make([]byte, 2*384), 102900},
+		{"MAP_FP_TO_G1", &bls12381.MapFpToG1{}, nil, 5500},
+		{"MAP_FP2_TO_G2", &bls12381.MapFp2ToG2{}, nil, 23800},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expGas, tc.precompile.RequiredGas(tc.input), tc.name)
+	}
+}
+
+func TestG1(t *testing.T) {
+	g := bls.NewG1()
+	one := g.EncodePoint(g.One())
+	two := g.EncodePoint(g.Double(g.New(), g.One()))
+	three := g.EncodePoint(g.MulScalar(g.New(), g.One(), big.NewInt(3)))
+	infinity := make([]byte, 128)
+
+	testCases := []struct {
+		name        string
+		precompile  vm.PrecompiledContract
+		input       []byte
+		expOutput   []byte
+		errContains string
+	}{
+		{"G1ADD - add points", &bls12381.G1Add{}, concat(one, two), three, ""},
+		{"G1ADD - add point at infinity", &bls12381.G1Add{}, concat(one, infinity), one, ""},
+		{"G1ADD - point not in subgroup", &bls12381.G1Add{}, concat(g1PointNotInSubgroup(t), infinity), g1PointNotInSubgroup(t), ""},
+		{"G1ADD - invalid input length", &bls12381.G1Add{}, one, nil, "invalid input length"},
+		{"G1ADD - point not on curve", &bls12381.G1Add{}, concat(one[:127], []byte{0}, one), nil, "point is not on curve"},
+		{"G1MSM - single pair", &bls12381.G1MSM{}, concat(one, scalar(3)), three, ""},
+		{"G1MSM - multiple pairs", &bls12381.G1MSM{}, concat(one, scalar(1), two, scalar(1)), three, ""},
+		{"G1MSM - zero scalar", &bls12381.G1MSM{}, concat(three, scalar(0)), infinity, ""},
+		{"G1MSM - empty input", &bls12381.G1MSM{}, nil, nil, "invalid input length"},
+		{"G1MSM - point not in subgroup", &bls12381.G1MSM{}, concat(g1PointNotInSubgroup(t), scalar(1)), nil, "g1 point is not on correct subgroup"},
+	}
+
+	for _, tc := range testCases {
+		out, err := run(tc.precompile, tc.input)
+		if tc.errContains != "" {
+			require.ErrorContains(t, err, tc.errContains, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expOutput, out, tc.name)
+	}
+}
+
+func TestG2(t *testing.T) {
+	g := bls.NewG2()
+	one := g.EncodePoint(g.One())
+	two := g.EncodePoint(g.Double(g.New(), g.One()))
+	three := g.EncodePoint(g.MulScalar(g.New(), g.One(), big.NewInt(3)))
+	infinity := make([]byte, 256)
+
+	testCases := []struct {
+		name        string
+		precompile  vm.PrecompiledContract
+		input       []byte
+		expOutput   []byte
+		errContains string
+	}{
+		{"G2ADD - add points", &bls12381.G2Add{}, concat(one, two), three, ""},
+		{"G2ADD - add point at infinity", &bls12381.G2Add{}, concat(infinity, one), one, ""},
+		{"G2ADD - invalid input length", &bls12381.G2Add{}, one, nil, "invalid input length"},
+		{"G2MSM - single pair", &bls12381.G2MSM{}, concat(one, scalar(3)), three, ""},
+		{"G2MSM - multiple pairs", &bls12381.G2MSM{}, concat(one, scalar(1), two, scalar(1)), three, ""},
+		{"G2MSM - invalid input length", &bls12381.G2MSM{}, concat(one, scalar(1), []byte{0}), nil, "invalid input length"},
+	}
+
+	for _, tc := range testCases {
+		out, err := run(tc.precompile, tc.input)
+		if tc.errContains != "" {
+			require.ErrorContains(t, err, tc.errContains, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expOutput, out, tc.name)
+	}
+}
+
+func TestPairing(t *testing.T) {
+	g1, g2 := bls.NewG1(), bls.NewG2()
+	g1One := g1.EncodePoint(g1.One())
+	g1NegOne := g1.EncodePoint(g1.Neg(g1.New(), g1.One()))
+	g2One := g2.EncodePoint(g2.One())
+
+	testCases := []struct {
+		name        string
+		input       []byte
+		expOutput   []byte
+		errContains string
+	}{
+		{"pass - pairing check succeeds", concat(g1One, g2One, g1NegOne, g2One), trueValue, ""},
+		{"pass - pairing check fails", concat(g1One, g2One), falseValue, ""},
+		{"fail - empty input", nil, nil, "invalid input length"},
+		{"fail - G1 point not in subgroup", concat(g1PointNotInSubgroup(t), g2One), nil, "g1 point is not on correct subgroup"},
+	}
+
+	for _, tc := range testCases {
+		out, err := run(&bls12381.Pairing{}, tc.input)
+		if tc.errContains != "" {
+			require.ErrorContains(t, err, tc.errContains, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expOutput, out, tc.name)
+	}
+}
+
+func TestMapToCurve(t *testing.T) {
+	fieldElement := common.LeftPadBytes([]byte{0x01, 0x02, 0x03}, 64)
+
+	out, err := run(&bls12381.MapFpToG1{}, fieldElement)
+	require.NoError(t, err)
+	g1 := bls.NewG1()
+	p1, err := g1.DecodePoint(out)
+	require.NoError(t, err)
+	require.True(t, g1.InCorrectSubgroup(p1), "expected G1 point on correct subgroup")
+
+	out, err = run(&bls12381.MapFp2ToG2{}, concat(fieldElement, fieldElement))
+	require.NoError(t, err)
+	g2 := bls.NewG2()
+	p2, err := g2.DecodePoint(out)
+	require.NoError(t, err)
+	require.True(t, g2.InCorrectSubgroup(p2), "expected G2 point on correct subgroup")
+
+	invalidTopBytes := make([]byte, 64)
+	invalidTopBytes[0] = 1
+	_, err = run(&bls12381.MapFpToG1{}, invalidTopBytes)
+	require.ErrorContains(t, err, "invalid field element top bytes")
+
+	_, err = run(&bls12381.MapFp2ToG2{}, fieldElement)
+	require.ErrorContains(t, err, "invalid input length")
+}
@@ -0,0 +1,129 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bls12381
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	bls "github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+var (
+	_ vm.PrecompiledContract = &G1Add{}
+	_ vm.PrecompiledContract = &G1MSM{}
+)
+
+// G1Add implements the EIP-2537 G1ADD precompiled contract.
+type G1Add struct{}
+
+// Address defines the address of the G1ADD precompiled contract.
+func (G1Add) Address() common.Address {
+	return common.HexToAddress(G1AddAddress)
+}
+
+// RequiredGas returns the static gas required to execute the precompiled contract.
+func (G1Add) RequiredGas(_ []byte) uint64 {
+	return G1AddGas
+}
+
+// Run adds two G1 points.
+//
+// Input data: 256 bytes with the encoding of two G1 points (128 bytes each).
+// The points must be on the curve, but are not checked to be on the correct subgroup.
+//
+// Output data: 128 bytes with the encoding of the resulting G1 point.
+func (G1Add) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if len(contract.Input) != 2*g1PointLength {
+		return nil, errInvalidInputLength
+	}
+
+	g := bls.NewG1()
+
+	p0, err := g.DecodePoint(contract.Input[:g1PointLength])
+	if err != nil {
+		return nil, err
+	}
+
+	p1, err := g.DecodePoint(contract.Input[g1PointLength:])
+	if err != nil {
+		return nil, err
+	}
+
+	r := g.New()
+	g.Add(r, p0, p1)
+
+	return g.EncodePoint(r), nil
+}
+
+// G1MSM implements the EIP-2537 G1MSM precompiled contract.
+type G1MSM struct{}
+
+// Address defines the address of the G1MSM precompiled contract.
+func (G1MSM) Address() common.Address {
+	return common.HexToAddress(G1MSMAddress)
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract, which
+// is discounted depending on the number of point and scalar pairs.
+func (G1MSM) RequiredGas(input []byte) uint64 {
+	return msmRequiredGas(len(input)/g1MSMPairLength, G1MulGas, G1MSMDiscountTable[:])
+}
+
+// Run computes the multi-scalar multiplication of G1 points.
+//
+// Input data: 160*k bytes with k pairs of the encoding of a G1 point (128 bytes)
+// and a scalar (32 bytes). The points must be on the correct subgroup.
+//
+// Output data: 128 bytes with the encoding of the resulting G1 point.
+func (G1MSM) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if len(contract.Input) == 0 || len(contract.Input)%g1MSMPairLength != 0 {
+		return nil, errInvalidInputLength
+	}
+
+	k := len(contract.Input) / g1MSMPairLength
+	points := make([]*bls.PointG1, k)
+	scalars := make([]*big.Int, k)
+
+	g := bls.NewG1()
+
+	for i := 0; i < k; i++ {
+		offset := i * g1MSMPairLength
+
+		point, err := g.DecodePoint(contract.Input[offset : offset+g1PointLength])
+		if err != nil {
+			return nil, err
+		}
+
+		if !g.InCorrectSubgroup(point) {
+			return nil, errG1PointSubgroup
+		}
+
+		points[i] = point
+		// NOTE: the points are on the subgroup of order q so the scalars can be reduced
+		scalars[i] = new(big.Int).SetBytes(contract.Input[offset+g1PointLength : offset+g1MSMPairLength])
+		scalars[i].Mod(scalars[i], g.Q())
+	}
+
+	r := g.New()
+	if _, err := g.MultiExp(r, points, scalars); err != nil {
+		return nil, err
+	}
+
+	return g.EncodePoint(r), nil
+}
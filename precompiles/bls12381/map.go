@@ -0,0 +1,115 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bls12381
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	bls "github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+var (
+	_ vm.PrecompiledContract = &MapFpToG1{}
+	_ vm.PrecompiledContract = &MapFp2ToG2{}
+)
+
+// MapFpToG1 implements the EIP-2537 MAP_FP_TO_G1 precompiled contract.
+type MapFpToG1 struct{}
+
+// Address defines the address of the MAP_FP_TO_G1 precompiled contract.
+func (MapFpToG1) Address() common.Address {
+	return common.HexToAddress(MapFpToG1Address)
+}
+
+// RequiredGas returns the static gas required to execute the precompiled contract.
+func (MapFpToG1) RequiredGas(_ []byte) uint64 {
+	return MapFpToG1Gas
+}
+
+// Run maps a base field element to a G1 point.
+//
+// Input data: 64 bytes with the encoding of a base field element.
+//
+// Output data: 128 bytes with the encoding of the resulting G1 point.
+func (MapFpToG1) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if len(contract.Input) != fieldElementLength {
+		return nil, errInvalidInputLength
+	}
+
+	fe, err := decodeFieldElement(contract.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	g := bls.NewG1()
+
+	r, err := g.MapToCurve(fe)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.EncodePoint(r), nil
+}
+
+// MapFp2ToG2 implements the EIP-2537 MAP_FP2_TO_G2 precompiled contract.
+type MapFp2ToG2 struct{}
+
+// Address defines the address of the MAP_FP2_TO_G2 precompiled contract.
+func (MapFp2ToG2) Address() common.Address {
+	return common.HexToAddress(MapFp2ToG2Address)
+}
+
+// RequiredGas returns the static gas required to execute the precompiled contract.
+func (MapFp2ToG2) RequiredGas(_ []byte) uint64 {
+	return MapFp2ToG2Gas
+}
+
+// Run maps a quadratic extension field element to a G2 point.
+//
+// Input data: 128 bytes with the encoding of the c0 and c1 base field
+// elements of the extension field element (64 bytes each).
+//
+// Output data: 256 bytes with the encoding of the resulting G2 point.
+func (MapFp2ToG2) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if len(contract.Input) != 2*fieldElementLength {
+		return nil, errInvalidInputLength
+	}
+
+	c0, err := decodeFieldElement(contract.Input[:fieldElementLength])
+	if err != nil {
+		return nil, err
+	}
+
+	c1, err := decodeFieldElement(contract.Input[fieldElementLength:])
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the extension field element is expected as c1 || c0
+	fe := make([]byte, 96)
+	copy(fe[:48], c1)
+	copy(fe[48:], c0)
+
+	g := bls.NewG2()
+
+	r, err := g.MapToCurve(fe)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.EncodePoint(r), nil
+}
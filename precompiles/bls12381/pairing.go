@@ -0,0 +1,88 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bls12381
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	bls "github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+var _ vm.PrecompiledContract = &Pairing{}
+
+// Pairing implements the EIP-2537 PAIRING_CHECK precompiled contract.
+type Pairing struct{}
+
+// Address defines the address of the PAIRING_CHECK precompiled contract.
+func (Pairing) Address() common.Address {
+	return common.HexToAddress(PairingAddress)
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract, which
+// depends on the number of G1 and G2 point pairs.
+func (Pairing) RequiredGas(input []byte) uint64 {
+	return PairingBaseGas + uint64(len(input)/pairingPairLength)*PairingPerPairGas
+}
+
+// Run checks that the product of the pairings of the given G1 and G2 points
+// is equal to the multiplicative identity.
+//
+// Input data: 384*k bytes with k pairs of the encoding of a G1 point (128 bytes)
+// and a G2 point (256 bytes). The points must be on the correct subgroups.
+//
+// Output data: 32 bytes with 1 if the pairing check succeeds and 0 otherwise.
+func (Pairing) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if len(contract.Input) == 0 || len(contract.Input)%pairingPairLength != 0 {
+		return nil, errInvalidInputLength
+	}
+
+	k := len(contract.Input) / pairingPairLength
+
+	e := bls.NewPairingEngine()
+	g1, g2 := e.G1, e.G2
+
+	for i := 0; i < k; i++ {
+		offset := i * pairingPairLength
+
+		p1, err := g1.DecodePoint(contract.Input[offset : offset+g1PointLength])
+		if err != nil {
+			return nil, err
+		}
+
+		p2, err := g2.DecodePoint(contract.Input[offset+g1PointLength : offset+pairingPairLength])
+		if err != nil {
+			return nil, err
+		}
+
+		if !g1.InCorrectSubgroup(p1) {
+			return nil, errG1PointSubgroup
+		}
+
+		if !g2.InCorrectSubgroup(p2) {
+			return nil, errG2PointSubgroup
+		}
+
+		e.AddPair(p1, p2)
+	}
+
+	out := make([]byte, 32)
+	if e.Check() {
+		out[31] = 1
+	}
+
+	return out, nil
+}
@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+// BalanceChangedAddresses returns the addresses of the accounts whose balances were
+// changed according to the given bank coin spent and coin received events.
+func BalanceChangedAddresses(events sdk.Events) []common.Address {
+	var addresses []common.Address
+	for _, event := range events {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key != key {
+				continue
+			}
+			addr, err := sdk.AccAddressFromBech32(attr.Value)
+			if err != nil {
+				continue
+			}
+			addresses = append(addresses, common.BytesToAddress(addr))
+		}
+	}
+	return addresses
+}
+
+// SyncBalances mirrors the EVM denomination balances of the given addresses from the
+// bank keeper into the EVM stateDB. This is required after executing Cosmos messages
+// from a precompile, because the stateDB is not aware of the balance changes performed
+// by them and would otherwise overwrite the bank balances when committing the EVM state.
+func SyncBalances(ctx sdk.Context, stateDB *statedb.StateDB, addresses []common.Address) {
+	synced := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		if _, ok := synced[addr]; ok {
+			continue
+		}
+		synced[addr] = struct{}{}
+
+		balance := new(big.Int)
+		if account := stateDB.Keeper().GetAccount(ctx, addr); account != nil {
+			balance = account.Balance
+		}
+
+		delta := new(big.Int).Sub(balance, stateDB.GetBalance(addr))
+		switch delta.Sign() {
+		case 1:
+			stateDB.AddBalance(addr, delta)
+		case -1:
+			stateDB.SubBalance(addr, delta.Neg(delta))
+		}
+	}
+}
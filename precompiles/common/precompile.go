@@ -4,14 +4,19 @@ package common
 
 import (
 	"fmt"
+	gomath "math"
+	"math/big"
+	"math/bits"
 	"time"
 
+	"cosmossdk.io/math"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 // Precompile is a common struct for all precompiles that holds the common data each
@@ -22,12 +27,54 @@ type Precompile struct {
 	ApprovalExpiration   time.Duration
 	KvGasConfig          storetypes.GasConfig
 	TransientKVGasConfig storetypes.GasConfig
+	// gasCosts holds the governance defined method gas costs indexed by method ID
+	gasCosts map[string]evmtypes.MethodGasCost
+}
+
+// WithGasSchedule returns a copy of the precompile that charges the method gas costs
+// defined in the given gas schedule. Methods that are not part of the ABI are ignored.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) Precompile {
+	p.gasCosts = make(map[string]evmtypes.MethodGasCost, len(schedule.Methods))
+	for _, cost := range schedule.Methods {
+		method, ok := p.Methods[cost.Method]
+		if !ok {
+			continue
+		}
+		p.gasCosts[string(method.ID)] = cost
+	}
+	return p
+}
+
+// ScheduledRequiredGas returns the required gas defined by the gas schedule for the
+// method of the given input and a boolean indicating if the method has a scheduled cost.
+func (p Precompile) ScheduledRequiredGas(input []byte) (uint64, bool) {
+	if len(input) < 4 {
+		return 0, false
+	}
+
+	cost, ok := p.gasCosts[string(input[:4])]
+	if !ok {
+		return 0, false
+	}
+
+	// saturate on overflow, so that the call runs out of gas
+	hi, argsGas := bits.Mul64(cost.GasPerByte, uint64(len(input[4:])))
+	gas, carry := bits.Add64(cost.BaseGas, argsGas, 0)
+	if hi != 0 || carry != 0 {
+		return gomath.MaxUint64, true
+	}
+	return gas, true
 }
 
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
-// It uses the method ID to determine if the input is a transaction or a query and
+// If the method has a cost defined in the gas schedule, the scheduled cost is used. Otherwise,
+// it uses the method ID to determine if the input is a transaction or a query and
 // uses the Cosmos SDK gas config flat cost and the flat per byte cost * len(argBz) to calculate the gas.
 func (p Precompile) RequiredGas(input []byte, isTransaction bool) uint64 {
+	if gas, ok := p.ScheduledRequiredGas(input); ok {
+		return gas
+	}
+
 	argsBz := input[4:]
 
 	if isTransaction {
@@ -95,11 +142,17 @@ func (p Precompile) RunSetup(
 
 	defer HandleGasError(ctx, contract, initialGas, &err)()
 
+	kvGasConfig, transientKVGasConfig := p.KvGasConfig, p.TransientKVGasConfig
+	if cost, ok := p.gasCosts[string(method.ID)]; ok && !cost.StorageGasMultiplier.IsNil() && cost.StorageGasMultiplier.IsPositive() {
+		kvGasConfig = scaleGasConfig(kvGasConfig, cost.StorageGasMultiplier)
+		transientKVGasConfig = scaleGasConfig(transientKVGasConfig, cost.StorageGasMultiplier)
+	}
+
 	// set the default SDK gas configuration to track gas usage
 	// we are changing the gas meter type, so it panics gracefully when out of gas
 	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
-		WithKVGasConfig(p.KvGasConfig).
-		WithTransientKVGasConfig(p.TransientKVGasConfig)
+		WithKVGasConfig(kvGasConfig).
+		WithTransientKVGasConfig(transientKVGasConfig)
 	// we need to consume the gas that was already used by the EVM
 	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
 
@@ -128,6 +181,29 @@ func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetype
 	}
 }
 
+// scaleGasConfig returns the given gas configuration with all of its costs multiplied by the
+// given multiplier. The costs that overflow are capped to the maximum gas value.
+func scaleGasConfig(config storetypes.GasConfig, multiplier math.LegacyDec) storetypes.GasConfig {
+	scale := func(gas storetypes.Gas) storetypes.Gas {
+		scaled := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas)).Mul(multiplier).TruncateInt()
+		// saturate on overflow, so that the store operations run out of gas
+		if !scaled.IsUint64() {
+			return gomath.MaxUint64
+		}
+		return scaled.Uint64()
+	}
+
+	return storetypes.GasConfig{
+		HasCost:          scale(config.HasCost),
+		DeleteCost:       scale(config.DeleteCost),
+		ReadCostFlat:     scale(config.ReadCostFlat),
+		ReadCostPerByte:  scale(config.ReadCostPerByte),
+		WriteCostFlat:    scale(config.WriteCostFlat),
+		WriteCostPerByte: scale(config.WriteCostPerByte),
+		IterNextCostFlat: scale(config.IterNextCostFlat),
+	}
+}
+
 // emptyCallData is a helper function that returns the method to be called when the calldata is empty.
 func (p Precompile) emptyCallData(contract *vm.Contract) (method *abi.Method, err error) {
 	switch {
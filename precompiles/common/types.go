@@ -30,6 +30,9 @@ var (
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqzxrz44p", // ICS20 transfer precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqrm4kqgn", // Vesting precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqy6vpsfk", // Bank precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzq986495y", // Authz precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqxffqn6m", // Slashing precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzq85l5x8f", // ERC20 module precompile
 	}
 )
 
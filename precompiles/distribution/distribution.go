@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -82,6 +83,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
 }
 
+// WithGasSchedule returns a copy of the precompile that charges gas according to the given schedule.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) vm.PrecompiledContract {
+	p.Precompile = p.Precompile.WithGasSchedule(schedule)
+	return &p
+}
+
 // Run executes the precompiled contract distribution methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
@@ -19,6 +19,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	auth "github.com/evmos/evmos/v16/precompiles/authorization"
 	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 	transferkeeper "github.com/evmos/evmos/v16/x/ibc/transfer/keeper"
 )
 
@@ -92,6 +93,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return 0
 	}
 
+	if gas, ok := p.ScheduledRequiredGas(input); ok {
+		return gas
+	}
+
 	methodID := input[:4]
 	method, err := p.MethodById(methodID)
 	if err != nil {
@@ -131,6 +136,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	}
 }
 
+// WithGasSchedule returns a copy of the precompile that charges gas according to the given schedule.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) vm.PrecompiledContract {
+	p.Precompile = p.Precompile.WithGasSchedule(schedule)
+	return &p
+}
+
 // Run executes the precompiled contract ERC-20 methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
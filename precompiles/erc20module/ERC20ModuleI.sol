@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The ERC20ModuleI contract's address.
+address constant ERC20_MODULE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The ERC20ModuleI contract's instance.
+ERC20ModuleI constant ERC20_MODULE_CONTRACT = ERC20ModuleI(ERC20_MODULE_PRECOMPILE_ADDRESS);
+
+/// @dev Owner enumerates the ownership of an ERC20 contract.
+/// It mirrors the x/erc20 Owner enum.
+enum Owner {
+    OWNER_UNSPECIFIED,
+    OWNER_MODULE,
+    OWNER_EXTERNAL
+}
+
+/// @dev ConversionPreference enumerates how the Cosmos coins received over IBC
+/// are converted to their ERC20 representation.
+/// It mirrors the x/erc20 ConversionPreference enum.
+enum ConversionPreference {
+    CONVERSION_PREFERENCE_BALANCE,
+    CONVERSION_PREFERENCE_RECEIVED,
+    CONVERSION_PREFERENCE_NONE
+}
+
+/// @dev TokenPair defines a pairing of a native Cosmos coin and an ERC20 token.
+struct TokenPair {
+    /// @dev The address of the ERC20 token contract
+    address erc20Address;
+    /// @dev The Cosmos base denomination of the coin
+    string denom;
+    /// @dev Whether or not the conversions are enabled for the token pair
+    bool enabled;
+    /// @dev The owner of the ERC20 token contract
+    Owner contractOwner;
+}
+
+/// @author Evmos Team
+/// @title ERC20 Module Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/erc20 module.
+/// The sender of all conversions is the caller of the precompile.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface ERC20ModuleI {
+    /// @dev Converts the given amount of Cosmos coins of the caller into their
+    /// ERC20 token representation.
+    /// @param denom The Cosmos denomination of the coins to convert
+    /// @param amount The amount of coins to convert
+    /// @param receiver The address that receives the ERC20 tokens
+    /// @return success Whether or not the conversion was successful
+    function convertCoin(
+        string calldata denom,
+        uint256 amount,
+        address receiver
+    ) external returns (bool success);
+
+    /// @dev Converts the given amount of ERC20 tokens of the caller into their
+    /// Cosmos coin representation.
+    /// @param erc20Address The address of the ERC20 token contract
+    /// @param amount The amount of tokens to convert
+    /// @param receiver The address that receives the Cosmos coins
+    /// @return success Whether or not the conversion was successful
+    function convertERC20(
+        address erc20Address,
+        uint256 amount,
+        address receiver
+    ) external returns (bool success);
+
+    /// @dev Sets how the Cosmos coins of the registered token pairs received
+    /// by the caller over IBC are converted to their ERC20 representation.
+    /// @param preference The conversion preference of the caller
+    /// @return success Whether or not the preference was set
+    function setConversionPreference(
+        ConversionPreference preference
+    ) external returns (bool success);
+
+    /// @dev Queries the token pair of the given Cosmos denomination or ERC20 address.
+    /// @param token The Cosmos denomination or hex address of the ERC20 token contract
+    /// @return tokenPair The registered token pair
+    function tokenPair(
+        string calldata token
+    ) external view returns (TokenPair memory tokenPair);
+
+    /// @dev Queries all the registered token pairs.
+    /// @param pagination Pagination configuration for the query
+    /// @return tokenPairs The list of registered token pairs
+    /// @return pageResponse Pagination information for the response
+    function tokenPairs(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            TokenPair[] memory tokenPairs,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Queries how the Cosmos coins received by the given account over IBC
+    /// are converted to their ERC20 representation.
+    /// @param account The address of the account
+    /// @return preference The conversion preference of the account
+    function conversionPreference(
+        address account
+    ) external view returns (ConversionPreference preference);
+
+    /// @dev ConvertCoin defines an event emitted when Cosmos coins are converted
+    /// into ERC20 tokens.
+    /// @param sender The address of the coins owner
+    /// @param receiver The address that received the ERC20 tokens
+    /// @param erc20Address The address of the ERC20 token contract
+    /// @param denom The Cosmos denomination of the converted coins
+    /// @param amount The amount of converted coins
+    event ConvertCoin(
+        address indexed sender,
+        address indexed receiver,
+        address indexed erc20Address,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev ConvertERC20 defines an event emitted when ERC20 tokens are converted
+    /// into Cosmos coins.
+    /// @param sender The address of the tokens owner
+    /// @param receiver The address that received the Cosmos coins
+    /// @param erc20Address The address of the ERC20 token contract
+    /// @param denom The Cosmos denomination of the received coins
+    /// @param amount The amount of converted tokens
+    event ConvertERC20(
+        address indexed sender,
+        address indexed receiver,
+        address indexed erc20Address,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev SetConversionPreference defines an event emitted when an account
+    /// sets how the Cosmos coins it receives over IBC are converted.
+    /// @param account The address of the account
+    /// @param preference The conversion preference of the account
+    event SetConversionPreference(
+        address indexed account,
+        ConversionPreference preference
+    );
+}
@@ -0,0 +1,159 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"embed"
+
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	erc20keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// PrecompileAddress defines the contract address of the erc20 module precompile.
+const PrecompileAddress = "0x0000000000000000000000000000000000000807"
+
+// Precompile defines the precompiled contract for the erc20 module.
+type Precompile struct {
+	cmn.Precompile
+	erc20Keeper erc20keeper.Keeper
+}
+
+// LoadABI loads the erc20 module ABI from the embedded abi.json file
+// for the erc20 module precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new erc20 module Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	erc20Keeper erc20keeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+		},
+		erc20Keeper: erc20Keeper,
+	}, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// WithGasSchedule returns a copy of the precompile that charges gas according to the given schedule.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) vm.PrecompiledContract {
+	p.Precompile = p.Precompile.WithGasSchedule(schedule)
+	return &p
+}
+
+// Address defines the address of the erc20 module compile contract.
+// address: 0x0000000000000000000000000000000000000807
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// Run executes the precompiled contract erc20 module methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	// NOTE: the conversions execute EVM calls on the ERC20 token contracts, so the
+	// EVM state up to this point needs to be committed, and the cached state objects
+	// discarded so that the changes of these calls are not overwritten with stale values.
+	if err := stateDB.Flush(); err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// ERC20 module transactions
+	case ConvertCoinMethod:
+		bz, err = p.ConvertCoin(ctx, contract, stateDB, method, args)
+	case ConvertERC20Method:
+		bz, err = p.ConvertERC20(ctx, contract, stateDB, method, args)
+	case SetConversionPreferenceMethod:
+		bz, err = p.SetConversionPreference(ctx, contract, stateDB, method, args)
+	// ERC20 module queries
+	case TokenPairMethod:
+		bz, err = p.TokenPair(ctx, contract, method, args)
+	case TokenPairsMethod:
+		bz, err = p.TokenPairs(ctx, contract, method, args)
+	case ConversionPreferenceMethod:
+		bz, err = p.ConversionPreference(ctx, contract, method, args)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available erc20 module transactions are:
+//   - ConvertCoin
+//   - ConvertERC20
+//   - SetConversionPreference
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case ConvertCoinMethod,
+		ConvertERC20Method,
+		SetConversionPreferenceMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "erc20module")
+}
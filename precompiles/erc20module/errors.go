@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20module
+
+const (
+	// ErrInvalidDenom is raised when the given denomination is invalid.
+	ErrInvalidDenom = "invalid denom: %v"
+	// ErrInvalidToken is raised when the given token is not a valid denomination nor hex address.
+	ErrInvalidToken = "invalid token: %v"
+	// ErrInvalidERC20Address is raised when the given ERC20 token address is invalid.
+	ErrInvalidERC20Address = "invalid ERC20 address: %v"
+	// ErrInvalidReceiver is raised when the given receiver address is invalid.
+	ErrInvalidReceiver = "invalid receiver address: %v"
+	// ErrInvalidAccount is raised when the given account address is invalid.
+	ErrInvalidAccount = "invalid account address: %v"
+	// ErrInvalidConversionPreference is raised when the given conversion preference is invalid.
+	ErrInvalidConversionPreference = "invalid conversion preference: %v"
+	// ErrConversionNotExecuted is raised when the conversion is not executed because the token pair
+	// contract does not exist anymore.
+	ErrConversionNotExecuted = "conversion not executed: token pair for %s has been removed"
+)
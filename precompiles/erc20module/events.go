@@ -0,0 +1,125 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+const (
+	// EventTypeConvertCoin defines the event type for the erc20 module ConvertCoin transaction.
+	EventTypeConvertCoin = "ConvertCoin"
+	// EventTypeConvertERC20 defines the event type for the erc20 module ConvertERC20 transaction.
+	EventTypeConvertERC20 = "ConvertERC20"
+	// EventTypeSetConversionPreference defines the event type for the erc20 module
+	// SetConversionPreference transaction.
+	EventTypeSetConversionPreference = "SetConversionPreference"
+)
+
+// EventConvert defines the event data for the erc20 module ConvertCoin and ConvertERC20 transactions.
+type EventConvert struct {
+	Sender       common.Address
+	Receiver     common.Address
+	Erc20Address common.Address
+	Denom        string
+	Amount       *big.Int
+}
+
+// EmitConvertCoinEvent creates a new convert coin event emitted on a ConvertCoin transaction.
+func (p Precompile) EmitConvertCoinEvent(ctx sdk.Context, stateDB vm.StateDB, data EventConvert) error {
+	return p.emitConvertEvent(ctx, stateDB, p.ABI.Events[EventTypeConvertCoin], data)
+}
+
+// EmitConvertERC20Event creates a new convert ERC20 event emitted on a ConvertERC20 transaction.
+func (p Precompile) EmitConvertERC20Event(ctx sdk.Context, stateDB vm.StateDB, data EventConvert) error {
+	return p.emitConvertEvent(ctx, stateDB, p.ABI.Events[EventTypeConvertERC20], data)
+}
+
+// emitConvertEvent creates a new conversion event with the given event definition.
+// Both conversion events share the same layout.
+func (p Precompile) emitConvertEvent(ctx sdk.Context, stateDB vm.StateDB, event abi.Event, data EventConvert) error {
+	// Prepare the event topics
+	topics := make([]common.Hash, 4)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	topics[3], err = cmn.MakeTopic(data.Erc20Address)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(data.Denom, data.Amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitSetConversionPreferenceEvent creates a new event emitted on a SetConversionPreference transaction.
+func (p Precompile) EmitSetConversionPreferenceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	account common.Address,
+	preference erc20types.ConversionPreference,
+) error {
+	event := p.ABI.Events[EventTypeSetConversionPreference]
+
+	// Prepare the event topics
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(account)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(uint8(preference))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
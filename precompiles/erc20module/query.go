@@ -0,0 +1,82 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// TokenPairMethod defines the ABI method name for the erc20 module TokenPair query.
+	TokenPairMethod = "tokenPair"
+	// TokenPairsMethod defines the ABI method name for the erc20 module TokenPairs query.
+	TokenPairsMethod = "tokenPairs"
+	// ConversionPreferenceMethod defines the ABI method name for the erc20 module
+	// ConversionPreference query.
+	ConversionPreferenceMethod = "conversionPreference"
+)
+
+// TokenPair returns the token pair registered for the given denomination or ERC20 address.
+func (p Precompile) TokenPair(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewTokenPairRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.erc20Keeper.TokenPair(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewTokenPair(res.TokenPair))
+}
+
+// TokenPairs returns all the registered token pairs.
+func (p Precompile) TokenPairs(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewTokenPairsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.erc20Keeper.TokenPairs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(TokenPairsOutput).FromResponse(res)
+	return out.Pack(method.Outputs)
+}
+
+// ConversionPreference returns how the Cosmos coins received by the given account over IBC
+// are converted to their ERC20 representation.
+func (p Precompile) ConversionPreference(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewConversionPreferenceRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.erc20Keeper.ConversionPreference(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(uint8(res.Preference))
+}
@@ -0,0 +1,224 @@
+package erc20module_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/erc20module"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+func (s *PrecompileTestSuite) TestTokenPair() {
+	method := s.precompile.Methods[erc20module.TokenPairMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty token",
+			func() []interface{} {
+				return []interface{}{""}
+			},
+			false,
+			"invalid token",
+		},
+		{
+			"fail - token pair not found",
+			func() []interface{} {
+				return []interface{}{"unregistered"}
+			},
+			false,
+			"token pair with token 'unregistered'",
+		},
+		{
+			"pass - query by denom",
+			func() []interface{} {
+				return []interface{}{s.tokenDenom}
+			},
+			true,
+			"",
+		},
+		{
+			"pass - query by ERC20 address",
+			func() []interface{} {
+				return []interface{}{s.tokenAddr.Hex()}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.TokenPair(ctx, contract, &method, tc.malleate())
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct {
+				TokenPair erc20module.TokenPair
+			}
+			err = s.precompile.UnpackIntoInterface(&out, erc20module.TokenPairMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(s.tokenAddr, out.TokenPair.Erc20Address)
+			s.Require().Equal(s.tokenDenom, out.TokenPair.Denom)
+			s.Require().True(out.TokenPair.Enabled)
+			s.Require().Equal(uint8(erc20types.OWNER_MODULE), out.TokenPair.ContractOwner)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTokenPairs() {
+	method := s.precompile.Methods[erc20module.TokenPairsMethod]
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			[]interface{}{},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"pass - query all token pairs",
+			[]interface{}{query.PageRequest{Key: []byte{0}, Limit: 10, CountTotal: true}},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.TokenPairs(ctx, contract, &method, tc.args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out erc20module.TokenPairsOutput
+			err = s.precompile.UnpackIntoInterface(&out, erc20module.TokenPairsMethod, bz)
+			s.Require().NoError(err)
+
+			pairs := s.network.App.Erc20Keeper.GetTokenPairs(ctx)
+			s.Require().Len(out.TokenPairs, len(pairs))
+			s.Require().Equal(uint64(len(pairs)), out.PageResponse.Total)
+
+			found := false
+			for _, pair := range out.TokenPairs {
+				if pair.Erc20Address == s.tokenAddr {
+					found = true
+					s.Require().Equal(s.tokenDenom, pair.Denom)
+				}
+			}
+			s.Require().True(found, "expected registered token pair in response")
+			s.Require().NotEqual(common.Address{}, out.TokenPairs[0].Erc20Address)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConversionPreference() {
+	method := s.precompile.Methods[erc20module.ConversionPreferenceMethod]
+
+	testcases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expPass       bool
+		errContains   string
+		expPreference erc20types.ConversionPreference
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+			0,
+		},
+		{
+			"fail - zero address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			false,
+			"invalid account address",
+			0,
+		},
+		{
+			"pass - default preference",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			true,
+			"",
+			erc20types.CONVERSION_PREFERENCE_BALANCE,
+		},
+		{
+			"pass - preference set by the account",
+			func() []interface{} {
+				s.network.App.Erc20Keeper.SetAccountConversionPreference(
+					s.network.GetContext(), s.keyring.GetAccAddr(0), erc20types.CONVERSION_PREFERENCE_NONE,
+				)
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			true,
+			"",
+			erc20types.CONVERSION_PREFERENCE_NONE,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.ConversionPreference(ctx, contract, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(uint8(tc.expPreference), out[0])
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package erc20module_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/erc20module"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	inflationtypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the erc20 module
+// precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	// tokenDenom is the denomination of the coin registered as a token pair for testing.
+	tokenDenom string
+	// tokenAddr is the address of the ERC20 contract of the registered token pair.
+	tokenAddr common.Address
+
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *erc20module.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(integrationNetwork)
+	txFactory := factory.New(integrationNetwork, grpcHandler)
+
+	s.tokenDenom = "xmpl"
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	// Mint coins to the first account and register them as a token pair
+	ctx := s.network.GetContext()
+	coins := sdk.Coins{{Denom: s.tokenDenom, Amount: math.NewInt(1e18)}}
+	err := s.network.App.BankKeeper.MintCoins(ctx, inflationtypes.ModuleName, coins)
+	s.Require().NoError(err)
+	err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, inflationtypes.ModuleName, s.keyring.GetAccAddr(0), coins)
+	s.Require().NoError(err)
+
+	xmplMetadata := banktypes.Metadata{
+		Description: "An exemplary token",
+		Base:        s.tokenDenom,
+		// NOTE: Denom units MUST be increasing
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    s.tokenDenom,
+				Exponent: 0,
+				Aliases:  []string{s.tokenDenom},
+			},
+			{
+				Denom:    s.tokenDenom,
+				Exponent: 18,
+			},
+		},
+		Name:    "Exemplary",
+		Symbol:  "XMPL",
+		Display: s.tokenDenom,
+	}
+
+	tokenPair, err := s.network.App.Erc20Keeper.RegisterCoin(ctx, xmplMetadata)
+	s.Require().NoError(err, "failed to register coin")
+	s.tokenAddr = tokenPair.GetERC20Contract()
+
+	precompile, err := erc20module.NewPrecompile(
+		s.network.App.Erc20Keeper,
+		s.network.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create erc20 module precompile")
+	s.precompile = precompile
+}
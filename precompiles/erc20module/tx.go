@@ -0,0 +1,182 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+const (
+	// ConvertCoinMethod defines the ABI method name for the erc20 module ConvertCoin
+	// transaction.
+	ConvertCoinMethod = "convertCoin"
+	// ConvertERC20Method defines the ABI method name for the erc20 module ConvertERC20
+	// transaction.
+	ConvertERC20Method = "convertERC20"
+	// SetConversionPreferenceMethod defines the ABI method name for the erc20 module
+	// SetConversionPreference transaction.
+	SetConversionPreferenceMethod = "setConversionPreference"
+)
+
+// ConvertCoin converts the Cosmos coins of the caller into their ERC20 token representation.
+//
+// NOTE: the conversion executes a separate EVM call on the ERC20 token contract. Changes to the
+// EVM state performed earlier in the same transaction must be flushed beforehand, which is done
+// in Run, so that the balance and storage changes of the conversion are loaded afterwards.
+func (p Precompile) ConvertCoin(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.CallerAddress
+
+	msg, err := NewMsgConvertCoin(args, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ sender: %s, receiver: %s, coin: %s }",
+			sender, msg.Receiver, msg.Coin,
+		),
+	)
+
+	res, err := p.erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: a nil response without error is returned when the token pair
+	// is removed because the ERC20 contract was self-destructed
+	if res == nil {
+		return nil, fmt.Errorf(ErrConversionNotExecuted, msg.Coin.Denom)
+	}
+
+	pair, err := p.tokenPair(ctx, msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitConvertCoinEvent(ctx, stateDB, EventConvert{
+		Sender:       sender,
+		Receiver:     common.HexToAddress(msg.Receiver),
+		Erc20Address: pair.GetERC20Contract(),
+		Denom:        pair.Denom,
+		Amount:       msg.Coin.Amount.BigInt(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ConvertERC20 converts the ERC20 tokens of the caller into their Cosmos coin representation.
+//
+// NOTE: the conversion executes a separate EVM call on the ERC20 token contract. Changes to the
+// EVM state performed earlier in the same transaction must be flushed beforehand, which is done
+// in Run, so that the balance and storage changes of the conversion are loaded afterwards.
+func (p Precompile) ConvertERC20(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.CallerAddress
+
+	msg, receiver, err := NewMsgConvertERC20(args, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ sender: %s, receiver: %s, contract: %s, amount: %s }",
+			sender, receiver, msg.ContractAddress, msg.Amount,
+		),
+	)
+
+	res, err := p.erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: a nil response without error is returned when the token pair
+	// is removed because the ERC20 contract was self-destructed
+	if res == nil {
+		return nil, fmt.Errorf(ErrConversionNotExecuted, msg.ContractAddress)
+	}
+
+	pair, err := p.tokenPair(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitConvertERC20Event(ctx, stateDB, EventConvert{
+		Sender:       sender,
+		Receiver:     receiver,
+		Erc20Address: pair.GetERC20Contract(),
+		Denom:        pair.Denom,
+		Amount:       msg.Amount.BigInt(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetConversionPreference sets how the Cosmos coins received by the caller over IBC are
+// converted to their ERC20 representation.
+func (p Precompile) SetConversionPreference(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.CallerAddress
+
+	msg, err := NewMsgSetConversionPreference(args, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, preference: %s }", sender, msg.Preference),
+	)
+
+	if _, err := p.erc20Keeper.SetConversionPreference(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSetConversionPreferenceEvent(ctx, stateDB, sender, msg.Preference); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// tokenPair returns the token pair registered for the given denomination or ERC20 address.
+func (p Precompile) tokenPair(ctx sdk.Context, token string) (erc20types.TokenPair, error) {
+	res, err := p.erc20Keeper.TokenPair(ctx, &erc20types.QueryTokenPairRequest{Token: token})
+	if err != nil {
+		return erc20types.TokenPair{}, err
+	}
+	return res.TokenPair, nil
+}
@@ -0,0 +1,323 @@
+package erc20module_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/contracts"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/erc20module"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+func (s *PrecompileTestSuite) TestConvertCoin() {
+	method := s.precompile.Methods[erc20module.ConvertCoinMethod]
+	amount := big.NewInt(1e17)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{s.tokenDenom, amount}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			"fail - empty denom",
+			func() []interface{} {
+				return []interface{}{"", amount, s.keyring.GetAddr(1)}
+			},
+			false,
+			"invalid denom",
+		},
+		{
+			"fail - invalid receiver",
+			func() []interface{} {
+				return []interface{}{s.tokenDenom, amount, common.Address{}}
+			},
+			false,
+			"invalid receiver address",
+		},
+		{
+			"fail - token pair not registered",
+			func() []interface{} {
+				return []interface{}{"unregistered", amount, s.keyring.GetAddr(1)}
+			},
+			false,
+			"not registered",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{s.tokenDenom, big.NewInt(2e18), s.keyring.GetAddr(1)}
+			},
+			false,
+			"insufficient funds",
+		},
+		{
+			"pass - convert coin to the receiver",
+			func() []interface{} {
+				return []interface{}{s.tokenDenom, amount, s.keyring.GetAddr(1)}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sender := s.keyring.GetAddr(0)
+			args := tc.malleate()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), sender, s.precompile, 200_000)
+
+			bz, err := s.precompile.ConvertCoin(ctx, contract, stateDB, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.tokenDenom)
+			s.Require().Equal(big.NewInt(9e17), balance.Amount.BigInt(), "expected coins to be escrowed")
+
+			erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			tokenBalance := s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, s.tokenAddr, s.keyring.GetAddr(1))
+			s.Require().Equal(amount, tokenBalance, "expected receiver to get the ERC20 tokens")
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1, "expected one convert coin event")
+			s.Require().Equal(s.precompile.ABI.Events[erc20module.EventTypeConvertCoin].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(s.tokenAddr.Bytes()), logs[0].Topics[3])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConvertERC20() {
+	method := s.precompile.Methods[erc20module.ConvertERC20Method]
+	amount := big.NewInt(1e17)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{s.tokenAddr, amount}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			"fail - invalid ERC20 address",
+			func() []interface{} {
+				return []interface{}{"invalid", amount, s.keyring.GetAddr(0)}
+			},
+			false,
+			"invalid ERC20 address",
+		},
+		{
+			"fail - invalid receiver",
+			func() []interface{} {
+				return []interface{}{s.tokenAddr, amount, common.Address{}}
+			},
+			false,
+			"invalid receiver address",
+		},
+		{
+			"fail - insufficient token balance",
+			func() []interface{} {
+				return []interface{}{s.tokenAddr, big.NewInt(2e17), s.keyring.GetAddr(0)}
+			},
+			false,
+			"",
+		},
+		{
+			"pass - convert ERC20 back to coins",
+			func() []interface{} {
+				return []interface{}{s.tokenAddr, amount, s.keyring.GetAddr(0)}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// convert coins into ERC20 tokens held by the second account beforehand
+			s.convertCoin(s.keyring.GetAddr(1), amount)
+
+			sender := s.keyring.GetAddr(1)
+			args := tc.malleate()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), sender, s.precompile, 200_000)
+
+			bz, err := s.precompile.ConvertERC20(ctx, contract, stateDB, &method, args)
+			if !tc.expPass {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.tokenDenom)
+			s.Require().Equal(big.NewInt(1e18), balance.Amount.BigInt(), "expected coins to be unescrowed")
+
+			erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			tokenBalance := s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, s.tokenAddr, sender)
+			s.Require().Zero(tokenBalance.Sign(), "expected ERC20 tokens to be burned")
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1, "expected one convert ERC20 event")
+			s.Require().Equal(s.precompile.ABI.Events[erc20module.EventTypeConvertERC20].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSetConversionPreference() {
+	method := s.precompile.Methods[erc20module.SetConversionPreferenceMethod]
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			[]interface{}{},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid preference type",
+			[]interface{}{"none"},
+			false,
+			"invalid conversion preference",
+		},
+		{
+			"fail - undefined preference",
+			[]interface{}{uint8(3)},
+			false,
+			"invalid conversion preference",
+		},
+		{
+			"pass - convert the received coins only",
+			[]interface{}{uint8(erc20types.CONVERSION_PREFERENCE_RECEIVED)},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sender := s.keyring.GetAddr(0)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), sender, s.precompile, 200_000)
+
+			bz, err := s.precompile.SetConversionPreference(ctx, contract, stateDB, &method, tc.args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			preference := s.network.App.Erc20Keeper.GetAccountConversionPreference(ctx, s.keyring.GetAccAddr(0))
+			s.Require().Equal(erc20types.CONVERSION_PREFERENCE_RECEIVED, preference)
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1, "expected one set conversion preference event")
+			s.Require().Equal(s.precompile.ABI.Events[erc20module.EventTypeSetConversionPreference].ID, logs[0].Topics[0])
+			s.Require().Equal(common.BytesToHash(sender.Bytes()), logs[0].Topics[1])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConvertERC20AfterTransfer() {
+	amount := big.NewInt(1e17)
+	transferred := big.NewInt(4e16)
+	converted := big.NewInt(6e16)
+
+	// convert coins into ERC20 tokens held by the second account beforehand
+	s.convertCoin(s.keyring.GetAddr(1), amount)
+
+	ctx := s.network.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter())
+	sender := s.keyring.GetAddr(1)
+	stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	// transfer and convert the ERC20 tokens within the same EVM transaction
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, s.network.App.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg := ethtypes.NewMessage(sender, &s.tokenAddr, 0, big.NewInt(0), 10_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+	evm.WithPrecompiles(
+		map[common.Address]vm.PrecompiledContract{s.precompile.Address(): s.precompile},
+		[]common.Address{s.precompile.Address()},
+	)
+
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	input, err := erc20ABI.Pack("transfer", s.keyring.GetAddr(0), transferred)
+	s.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(sender), s.tokenAddr, input, 10_000_000, big.NewInt(0))
+	s.Require().NoError(err, "failed to transfer ERC20 tokens")
+
+	input, err = s.precompile.Pack(erc20module.ConvertERC20Method, s.tokenAddr, converted, sender)
+	s.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(sender), s.precompile.Address(), input, 10_000_000, big.NewInt(0))
+	s.Require().NoError(err, "failed to convert ERC20 tokens")
+
+	s.Require().NoError(stateDB.Commit())
+
+	balance := s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, s.tokenAddr, sender)
+	s.Require().Zero(balance.Sign(), "expected the converted ERC20 tokens to stay burned")
+	balance = s.network.App.Erc20Keeper.BalanceOf(ctx, erc20ABI, s.tokenAddr, s.keyring.GetAddr(0))
+	s.Require().Equal(transferred, balance, "expected the transferred ERC20 tokens")
+
+	coins := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(1), s.tokenDenom)
+	s.Require().Equal(converted, coins.Amount.BigInt(), "expected the converted coins")
+
+	supply, err := s.network.App.Erc20Keeper.CallEVM(ctx, erc20ABI, erc20types.ModuleAddress, s.tokenAddr, false, "totalSupply")
+	s.Require().NoError(err)
+	s.Require().Equal(transferred, new(big.Int).SetBytes(supply.Ret), "expected the ERC20 supply to be backed by the escrowed coins")
+}
+
+// convertCoin converts coins from the first account into ERC20 tokens owned by the given receiver.
+func (s *PrecompileTestSuite) convertCoin(receiver common.Address, amount *big.Int) {
+	method := s.precompile.Methods[erc20module.ConvertCoinMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+	_, err := s.precompile.ConvertCoin(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.tokenDenom, amount, receiver})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,211 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20module
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// TokenPair is the struct representation of a token pair as defined in the ABI.
+type TokenPair struct {
+	Erc20Address  common.Address `abi:"erc20Address"`
+	Denom         string         `abi:"denom"`
+	Enabled       bool           `abi:"enabled"`
+	ContractOwner uint8          `abi:"contractOwner"`
+}
+
+// TokenPairsOutput is the output of the TokenPairs query.
+type TokenPairsOutput struct {
+	TokenPairs   []TokenPair
+	PageResponse query.PageResponse
+}
+
+// TokenPairsInput is a struct to unpack the arguments of the TokenPairs query.
+type TokenPairsInput struct {
+	Pagination query.PageRequest
+}
+
+// NewMsgConvertCoin creates a new MsgConvertCoin instance from the given arguments
+// with the given sender as the owner of the coins.
+func NewMsgConvertCoin(args []interface{}, sender common.Address) (*erc20types.MsgConvertCoin, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return nil, fmt.Errorf(ErrInvalidDenom, args[0])
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	receiver, ok := args[2].(common.Address)
+	if !ok || receiver == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidReceiver, args[2])
+	}
+
+	msg := erc20types.NewMsgConvertCoin(
+		sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)},
+		receiver,
+		sender.Bytes(),
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgConvertERC20 creates a new MsgConvertERC20 instance from the given arguments
+// with the given sender as the owner of the tokens.
+func NewMsgConvertERC20(args []interface{}, sender common.Address) (*erc20types.MsgConvertERC20, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	erc20Address, ok := args[0].(common.Address)
+	if !ok || erc20Address == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidERC20Address, args[0])
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	receiver, ok := args[2].(common.Address)
+	if !ok || receiver == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[2])
+	}
+
+	msg := erc20types.NewMsgConvertERC20(
+		math.NewIntFromBigInt(amount),
+		receiver.Bytes(),
+		erc20Address,
+		sender,
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, receiver, nil
+}
+
+// NewMsgSetConversionPreference creates a new MsgSetConversionPreference instance from the
+// given arguments with the given sender as the account whose preference is set.
+func NewMsgSetConversionPreference(args []interface{}, sender common.Address) (*erc20types.MsgSetConversionPreference, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	preference, ok := args[0].(uint8)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidConversionPreference, args[0])
+	}
+
+	msg := erc20types.NewMsgSetConversionPreference(
+		sender.Bytes(),
+		erc20types.ConversionPreference(preference),
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewTokenPairRequest creates a new QueryTokenPairRequest instance from the given arguments.
+func NewTokenPairRequest(args []interface{}) (*erc20types.QueryTokenPairRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	token, ok := args[0].(string)
+	if !ok || token == "" {
+		return nil, fmt.Errorf(ErrInvalidToken, args[0])
+	}
+
+	return &erc20types.QueryTokenPairRequest{Token: token}, nil
+}
+
+// NewTokenPairsRequest creates a new QueryTokenPairsRequest instance from the given arguments.
+func NewTokenPairsRequest(method *abi.Method, args []interface{}) (*erc20types.QueryTokenPairsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input TokenPairsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to TokenPairsInput struct: %s", err)
+	}
+
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &erc20types.QueryTokenPairsRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewConversionPreferenceRequest creates a new QueryConversionPreferenceRequest instance from
+// the given arguments.
+func NewConversionPreferenceRequest(args []interface{}) (*erc20types.QueryConversionPreferenceRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok || account == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidAccount, args[0])
+	}
+
+	return &erc20types.QueryConversionPreferenceRequest{Address: account.Hex()}, nil
+}
+
+// NewTokenPair converts a token pair to its ABI representation.
+func NewTokenPair(pair erc20types.TokenPair) TokenPair {
+	return TokenPair{
+		Erc20Address:  pair.GetERC20Contract(),
+		Denom:         pair.Denom,
+		Enabled:       pair.Enabled,
+		ContractOwner: uint8(pair.ContractOwner),
+	}
+}
+
+// FromResponse populates the TokenPairsOutput from a QueryTokenPairsResponse.
+func (to *TokenPairsOutput) FromResponse(res *erc20types.QueryTokenPairsResponse) *TokenPairsOutput {
+	to.TokenPairs = make([]TokenPair, len(res.TokenPairs))
+	for i, pair := range res.TokenPairs {
+		to.TokenPairs[i] = NewTokenPair(pair)
+	}
+
+	if res.Pagination != nil {
+		to.PageResponse.Total = res.Pagination.Total
+		to.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return to
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (to *TokenPairsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(to.TokenPairs, to.PageResponse)
+}
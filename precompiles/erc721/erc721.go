@@ -0,0 +1,216 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import (
+	"embed"
+	"fmt"
+
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc721types "github.com/evmos/evmos/v16/x/erc721/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+const (
+	// abiPath defines the path to the ERC-721 precompile ABI JSON file.
+	abiPath = "abi.json"
+
+	GasTransferFrom      = 62_000
+	GasApprove           = 49_000
+	GasSetApprovalForAll = 46_000
+	GasName              = 3_421
+	GasSymbol            = 3_464
+	GasTokenURI          = 5_025
+	GasBalanceOf         = 2_851
+	GasOwnerOf           = 2_879
+	GasGetApproved       = 2_962
+	GasIsApprovedForAll  = 3_089
+	GasSupportsInterface = 421
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Precompile defines the precompiled contract for the ERC-721 representation of
+// the ICS-721 classes received over IBC.
+type Precompile struct {
+	cmn.Precompile
+	classPair erc721types.ClassPair
+	keeper    ClassKeeper
+}
+
+// LoadABI loads the ERC-721 ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, abiPath)
+}
+
+// NewPrecompile creates a new ERC-721 Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	classPair erc721types.ClassPair,
+	keeper ClassKeeper,
+) (*Precompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		classPair: classPair,
+		keeper:    keeper,
+	}, nil
+}
+
+// Address defines the address of the ERC-721 precompile contract.
+func (p Precompile) Address() common.Address {
+	return p.classPair.GetERC721Contract()
+}
+
+// RequiredGas calculates the contract gas used for the
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// Validate input length
+	if len(input) < 4 {
+		return 0
+	}
+
+	if gas, ok := p.ScheduledRequiredGas(input); ok {
+		return gas
+	}
+
+	methodID := input[:4]
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	switch method.Name {
+	// ERC-721 transactions
+	case TransferFromMethod, SafeTransferFromMethod, SafeTransferFromWithDataMethod:
+		return GasTransferFrom
+	case ApproveMethod:
+		return GasApprove
+	case SetApprovalForAllMethod:
+		return GasSetApprovalForAll
+	// ERC-721 queries
+	case NameMethod:
+		return GasName
+	case SymbolMethod:
+		return GasSymbol
+	case TokenURIMethod:
+		return GasTokenURI
+	case BalanceOfMethod:
+		return GasBalanceOf
+	case OwnerOfMethod:
+		return GasOwnerOf
+	case GetApprovedMethod:
+		return GasGetApproved
+	case IsApprovedForAllMethod:
+		return GasIsApprovedForAll
+	case SupportsInterfaceMethod:
+		return GasSupportsInterface
+	default:
+		return 0
+	}
+}
+
+// WithGasSchedule returns a copy of the precompile that charges gas according to the given schedule.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) vm.PrecompiledContract {
+	p.Precompile = p.Precompile.WithGasSchedule(schedule)
+	return &p
+}
+
+// Run executes the precompiled contract ERC-721 methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	bz, err = p.HandleMethod(ctx, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(methodName string) bool {
+	switch methodName {
+	case TransferFromMethod,
+		SafeTransferFromMethod,
+		SafeTransferFromWithDataMethod,
+		ApproveMethod,
+		SetApprovalForAllMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// HandleMethod handles the execution of each of the ERC-721 methods.
+func (p Precompile) HandleMethod(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, err error) {
+	switch method.Name {
+	// ERC-721 transactions
+	case TransferFromMethod, SafeTransferFromMethod, SafeTransferFromWithDataMethod:
+		bz, err = p.TransferFrom(ctx, contract, stateDB, method, args)
+	case ApproveMethod:
+		bz, err = p.Approve(ctx, contract, stateDB, method, args)
+	case SetApprovalForAllMethod:
+		bz, err = p.SetApprovalForAll(ctx, contract, stateDB, method, args)
+	// ERC-721 queries
+	case NameMethod:
+		bz, err = p.Name(ctx, contract, stateDB, method, args)
+	case SymbolMethod:
+		bz, err = p.Symbol(ctx, contract, stateDB, method, args)
+	case TokenURIMethod:
+		bz, err = p.TokenURI(ctx, contract, stateDB, method, args)
+	case BalanceOfMethod:
+		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
+	case OwnerOfMethod:
+		bz, err = p.OwnerOf(ctx, contract, stateDB, method, args)
+	case GetApprovedMethod:
+		bz, err = p.GetApproved(ctx, contract, stateDB, method, args)
+	case IsApprovedForAllMethod:
+		bz, err = p.IsApprovedForAll(ctx, contract, stateDB, method, args)
+	case SupportsInterfaceMethod:
+		bz, err = p.SupportsInterface(ctx, contract, stateDB, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import "errors"
+
+var (
+	// ERC721 errors
+	ErrInvalidOwner               = errors.New("ERC721: address zero is not a valid owner")
+	ErrInvalidTokenID             = errors.New("ERC721: invalid token ID")
+	ErrApprovalToCurrentOwner     = errors.New("ERC721: approval to current owner")
+	ErrApproveCallerNotOwner      = errors.New("ERC721: approve caller is not token owner or approved for all")
+	ErrApproveToCaller            = errors.New("ERC721: approve to caller")
+	ErrCallerNotOwnerOrApproved   = errors.New("ERC721: caller is not token owner or approved")
+	ErrTransferFromIncorrectOwner = errors.New("ERC721: transfer from incorrect owner")
+	ErrTransferToZeroAddress      = errors.New("ERC721: transfer to the zero address")
+	// ErrTransferToNonReceiver is returned by the safe transfers to contracts, as
+	// the precompile can't call the onERC721Received hook of the recipient.
+	ErrTransferToNonReceiver = errors.New("ERC721: transfer to non ERC721Receiver implementer")
+)
@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the ERC-721 transfers.
+	EventTypeTransfer = "Transfer"
+	// EventTypeApproval defines the event type for the ERC-721 Approve transactions.
+	EventTypeApproval = "Approval"
+	// EventTypeApprovalForAll defines the event type for the ERC-721
+	// SetApprovalForAll transactions.
+	EventTypeApprovalForAll = "ApprovalForAll"
+)
+
+// EmitTransferEvent creates a new Transfer event emitted on transferFrom and
+// safeTransferFrom transactions.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, tokenID *big.Int) error {
+	return p.emitIndexedEvent(ctx, stateDB, EventTypeTransfer, from, to, tokenID)
+}
+
+// EmitApprovalEvent creates a new Approval event emitted on Approve transactions.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner, approved common.Address, tokenID *big.Int) error {
+	return p.emitIndexedEvent(ctx, stateDB, EventTypeApproval, owner, approved, tokenID)
+}
+
+// EmitApprovalForAllEvent creates a new ApprovalForAll event emitted on
+// SetApprovalForAll transactions.
+func (p Precompile) EmitApprovalForAllEvent(ctx sdk.Context, stateDB vm.StateDB, owner, operator common.Address, approved bool) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeApprovalForAll]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(operator)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(approved)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// emitIndexedEvent emits an event whose arguments are the two addresses and the
+// token ID indexed as topics.
+func (p Precompile) emitIndexedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	first, second common.Address,
+	tokenID *big.Int,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 4)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(first)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(second)
+	if err != nil {
+		return err
+	}
+
+	topics[3], err = cmn.MakeTopic(tokenID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// NameMethod defines the ABI method name for the ERC-721 Name
+	// query.
+	NameMethod = "name"
+	// SymbolMethod defines the ABI method name for the ERC-721 Symbol
+	// query.
+	SymbolMethod = "symbol"
+	// TokenURIMethod defines the ABI method name for the ERC-721 TokenURI
+	// query.
+	TokenURIMethod = "tokenURI"
+	// BalanceOfMethod defines the ABI method name for the ERC-721 BalanceOf
+	// query.
+	BalanceOfMethod = "balanceOf"
+	// OwnerOfMethod defines the ABI method name for the ERC-721 OwnerOf
+	// query.
+	OwnerOfMethod = "ownerOf"
+	// GetApprovedMethod defines the ABI method name for the ERC-721 GetApproved
+	// query.
+	GetApprovedMethod = "getApproved"
+	// IsApprovedForAllMethod defines the ABI method name for the ERC-721
+	// IsApprovedForAll query.
+	IsApprovedForAllMethod = "isApprovedForAll"
+	// SupportsInterfaceMethod defines the ABI method name for the ERC-165
+	// SupportsInterface query.
+	SupportsInterfaceMethod = "supportsInterface"
+)
+
+// supportedInterfaces are the ERC-165 identifiers of the interfaces implemented
+// by the precompile: ERC-165, ERC-721 and ERC-721 Metadata.
+var supportedInterfaces = map[[4]byte]bool{
+	{0x01, 0xff, 0xc9, 0xa7}: true,
+	{0x80, 0xac, 0x58, 0xcd}: true,
+	{0x5b, 0x5e, 0x13, 0x9f}: true,
+}
+
+// Name returns the name of the class.
+func (p Precompile) Name(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	class, found := p.keeper.GetClass(ctx, p.Address())
+	if !found {
+		return nil, fmt.Errorf("class not found for %s", p.Address())
+	}
+
+	return method.Outputs.Pack(class.Name)
+}
+
+// Symbol returns the symbol of the class.
+func (p Precompile) Symbol(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	class, found := p.keeper.GetClass(ctx, p.Address())
+	if !found {
+		return nil, fmt.Errorf("class not found for %s", p.Address())
+	}
+
+	return method.Outputs.Pack(class.Symbol)
+}
+
+// TokenURI returns the URI of the token received from the source chain.
+func (p Precompile) TokenURI(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	tokenID, err := ParseTokenIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nft, found := p.keeper.GetNFT(ctx, p.Address(), tokenID)
+	if !found {
+		return nil, ErrInvalidTokenID
+	}
+
+	return method.Outputs.Pack(nft.Uri)
+}
+
+// BalanceOf returns the number of tokens owned by the given address.
+func (p Precompile) BalanceOf(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseBalanceOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner == (common.Address{}) {
+		return nil, ErrInvalidOwner
+	}
+
+	balance := p.keeper.GetNFTBalance(ctx, p.Address(), owner)
+	return method.Outputs.Pack(new(big.Int).SetUint64(balance))
+}
+
+// OwnerOf returns the owner of the given token.
+func (p Precompile) OwnerOf(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	tokenID, err := ParseTokenIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nft, found := p.keeper.GetNFT(ctx, p.Address(), tokenID)
+	if !found {
+		return nil, ErrInvalidTokenID
+	}
+
+	return method.Outputs.Pack(nft.GetOwnerAddress())
+}
+
+// GetApproved returns the address approved to transfer the given token.
+func (p Precompile) GetApproved(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	tokenID, err := ParseTokenIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := p.keeper.GetNFT(ctx, p.Address(), tokenID); !found {
+		return nil, ErrInvalidTokenID
+	}
+
+	return method.Outputs.Pack(p.keeper.GetApproved(ctx, p.Address(), tokenID))
+}
+
+// IsApprovedForAll returns true if the operator is allowed to manage all the
+// tokens of the owner.
+func (p Precompile) IsApprovedForAll(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, operator, err := ParseIsApprovedForAllArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.keeper.IsApprovedForAll(ctx, p.Address(), owner, operator))
+}
+
+// SupportsInterface returns true if the precompile implements the interface
+// with the given ERC-165 identifier.
+func (p Precompile) SupportsInterface(
+	_ sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	interfaceID, ok := args[0].([4]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid interface ID: %v", args[0])
+	}
+
+	return method.Outputs.Pack(supportedInterfaces[interfaceID])
+}
@@ -0,0 +1,149 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// TransferFromMethod defines the ABI method name for the ERC-721
+	// transferFrom transaction.
+	TransferFromMethod = "transferFrom"
+	// SafeTransferFromMethod defines the ABI method name for the ERC-721
+	// safeTransferFrom transaction.
+	SafeTransferFromMethod = "safeTransferFrom"
+	// SafeTransferFromWithDataMethod defines the ABI method name for the ERC-721
+	// safeTransferFrom transaction with additional data.
+	//
+	// NOTE: the overloaded methods are suffixed with an index by the ABI parser.
+	SafeTransferFromWithDataMethod = "safeTransferFrom0"
+	// ApproveMethod defines the ABI method name for the ERC-721 approve
+	// transaction.
+	ApproveMethod = "approve"
+	// SetApprovalForAllMethod defines the ABI method name for the ERC-721
+	// setApprovalForAll transaction.
+	SetApprovalForAllMethod = "setApprovalForAll"
+)
+
+// TransferFrom transfers a token from its owner to the destination address. The
+// caller must be the owner, the approved address of the token or an operator of
+// the owner. The safe transfers to contracts are rejected, as the precompile
+// can't call the onERC721Received hook of the recipient.
+func (p Precompile) TransferFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, tokenID, err := ParseTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nft, found := p.keeper.GetNFT(ctx, p.Address(), tokenID)
+	if !found {
+		return nil, ErrInvalidTokenID
+	}
+
+	owner := nft.GetOwnerAddress()
+	if owner != from {
+		return nil, ErrTransferFromIncorrectOwner
+	}
+
+	if to == (common.Address{}) {
+		return nil, ErrTransferToZeroAddress
+	}
+
+	spender := contract.CallerAddress
+	if spender != owner &&
+		p.keeper.GetApproved(ctx, p.Address(), tokenID) != spender &&
+		!p.keeper.IsApprovedForAll(ctx, p.Address(), owner, spender) {
+		return nil, ErrCallerNotOwnerOrApproved
+	}
+
+	if method.Name != TransferFromMethod && stateDB.GetCodeSize(to) > 0 {
+		return nil, ErrTransferToNonReceiver
+	}
+
+	if err := p.keeper.TransferNFT(ctx, p.Address(), tokenID, to); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitTransferEvent(ctx, stateDB, from, to, tokenID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Approve gives permission to the given address to transfer a token. The caller
+// must be the owner of the token or an operator of the owner.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	approved, tokenID, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nft, found := p.keeper.GetNFT(ctx, p.Address(), tokenID)
+	if !found {
+		return nil, ErrInvalidTokenID
+	}
+
+	owner := nft.GetOwnerAddress()
+	if approved == owner {
+		return nil, ErrApprovalToCurrentOwner
+	}
+
+	caller := contract.CallerAddress
+	if caller != owner && !p.keeper.IsApprovedForAll(ctx, p.Address(), owner, caller) {
+		return nil, ErrApproveCallerNotOwner
+	}
+
+	p.keeper.SetApproved(ctx, p.Address(), tokenID, approved)
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, approved, tokenID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// SetApprovalForAll approves or removes the given operator to manage all the
+// tokens of the caller.
+func (p Precompile) SetApprovalForAll(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	operator, approved, err := ParseSetApprovalForAllArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+	if operator == owner {
+		return nil, ErrApproveToCaller
+	}
+
+	p.keeper.SetApprovalForAll(ctx, p.Address(), owner, operator, approved)
+
+	if err := p.EmitApprovalForAllEvent(ctx, stateDB, owner, operator, approved); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
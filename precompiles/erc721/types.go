@@ -0,0 +1,170 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc721types "github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+// ClassKeeper defines the expected erc721 keeper interface that manages the
+// tokens of the classes received over IBC.
+type ClassKeeper interface {
+	GetClass(ctx sdk.Context, contract common.Address) (erc721types.Class, bool)
+	GetNFT(ctx sdk.Context, contract common.Address, tokenID *big.Int) (erc721types.NFT, bool)
+	GetNFTBalance(ctx sdk.Context, contract, owner common.Address) uint64
+	TransferNFT(ctx sdk.Context, contract common.Address, tokenID *big.Int, to common.Address) error
+	GetApproved(ctx sdk.Context, contract common.Address, tokenID *big.Int) common.Address
+	SetApproved(ctx sdk.Context, contract common.Address, tokenID *big.Int, approved common.Address)
+	IsApprovedForAll(ctx sdk.Context, contract, owner, operator common.Address) bool
+	SetApprovalForAll(ctx sdk.Context, contract, owner, operator common.Address, approved bool)
+}
+
+// EventTransfer defines the event data for the ERC721 Transfer events.
+type EventTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int //nolint:revive,stylecheck // follows the ABI argument naming
+}
+
+// EventApproval defines the event data for the ERC721 Approval events.
+type EventApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int //nolint:revive,stylecheck // follows the ABI argument naming
+}
+
+// EventApprovalForAll defines the event data for the ERC721 ApprovalForAll events.
+type EventApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+}
+
+// ParseTransferFromArgs parses the arguments from the transferFrom and
+// safeTransferFrom methods and returns the sender address (from), destination
+// address (to) and token ID.
+func ParseTransferFromArgs(args []interface{}) (
+	from, to common.Address, tokenID *big.Int, err error,
+) {
+	// NOTE: the safeTransferFrom overload has an additional data argument
+	if len(args) != 3 && len(args) != 4 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf("invalid number of arguments; expected 3 or 4; got: %d", len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	to, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf("invalid to address: %v", args[1])
+	}
+
+	tokenID, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf("invalid token ID: %v", args[2])
+	}
+
+	return from, to, tokenID, nil
+}
+
+// ParseApproveArgs parses the arguments from the approve method and returns the
+// approved address and token ID.
+func ParseApproveArgs(args []interface{}) (
+	approved common.Address, tokenID *big.Int, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	approved, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("invalid approved address: %v", args[0])
+	}
+
+	tokenID, ok = args[1].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("invalid token ID: %v", args[1])
+	}
+
+	return approved, tokenID, nil
+}
+
+// ParseSetApprovalForAllArgs parses the arguments from the setApprovalForAll
+// method and returns the operator address and approval status.
+func ParseSetApprovalForAllArgs(args []interface{}) (
+	operator common.Address, approved bool, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, false, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	operator, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, false, fmt.Errorf("invalid operator address: %v", args[0])
+	}
+
+	approved, ok = args[1].(bool)
+	if !ok {
+		return common.Address{}, false, fmt.Errorf("invalid approved value: %v", args[1])
+	}
+
+	return operator, approved, nil
+}
+
+// ParseTokenIDArgs parses the token ID argument of the ownerOf, tokenURI and
+// getApproved methods.
+func ParseTokenIDArgs(args []interface{}) (*big.Int, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	tokenID, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid token ID: %v", args[0])
+	}
+
+	return tokenID, nil
+}
+
+// ParseBalanceOfArgs parses the owner address argument of the balanceOf method.
+func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseIsApprovedForAllArgs parses the owner and operator address arguments of
+// the isApprovedForAll method.
+func ParseIsApprovedForAllArgs(args []interface{}) (owner, operator common.Address, err error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	operator, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid operator address: %v", args[1])
+	}
+
+	return owner, operator, nil
+}
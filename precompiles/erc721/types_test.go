@@ -0,0 +1,202 @@
+package erc721_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/precompiles/erc721"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	erc721types "github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func TestParseTransferFromArgs(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+	tokenID := big.NewInt(1)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - transferFrom arguments",
+			args:    []interface{}{from, to, tokenID},
+			expPass: true,
+		},
+		{
+			name:    "pass - safeTransferFrom arguments with data",
+			args:    []interface{}{from, to, tokenID, []byte("data")},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid from address",
+			args:        []interface{}{"invalid address", to, tokenID},
+			errContains: "invalid from address",
+		},
+		{
+			name:        "fail - invalid to address",
+			args:        []interface{}{from, "invalid address", tokenID},
+			errContains: "invalid to address",
+		},
+		{
+			name:        "fail - invalid token ID",
+			args:        []interface{}{from, to, "invalid token ID"},
+			errContains: "invalid token ID",
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{1, 2},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			parsedFrom, parsedTo, parsedTokenID, err := erc721.ParseTransferFromArgs(tc.args)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, from, parsedFrom)
+				require.Equal(t, to, parsedTo)
+				require.Equal(t, tokenID, parsedTokenID)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestParseApproveArgs(t *testing.T) {
+	approved := utiltx.GenerateAddress()
+	tokenID := big.NewInt(1)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{approved, tokenID},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid approved address",
+			args:        []interface{}{"invalid address", tokenID},
+			errContains: "invalid approved address",
+		},
+		{
+			name:        "fail - invalid token ID",
+			args:        []interface{}{approved, "invalid token ID"},
+			errContains: "invalid token ID",
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{1, 2, 3},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			parsedApproved, parsedTokenID, err := erc721.ParseApproveArgs(tc.args)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, approved, parsedApproved)
+				require.Equal(t, tokenID, parsedTokenID)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestParseSetApprovalForAllArgs(t *testing.T) {
+	operator := utiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{operator, true},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid operator address",
+			args:        []interface{}{"invalid address", true},
+			errContains: "invalid operator address",
+		},
+		{
+			name:        "fail - invalid approved value",
+			args:        []interface{}{operator, "true"},
+			errContains: "invalid approved value",
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{operator},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			parsedOperator, approved, err := erc721.ParseSetApprovalForAllArgs(tc.args)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, operator, parsedOperator)
+				require.True(t, approved)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+func TestRequiredGas(t *testing.T) {
+	precompile, err := erc721.NewPrecompile(erc721types.ClassPair{}, nil)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		method string
+		args   []interface{}
+		expGas uint64
+	}{
+		{
+			erc721.TransferFromMethod,
+			[]interface{}{utiltx.GenerateAddress(), utiltx.GenerateAddress(), big.NewInt(1)},
+			erc721.GasTransferFrom,
+		},
+		{
+			erc721.SafeTransferFromWithDataMethod,
+			[]interface{}{utiltx.GenerateAddress(), utiltx.GenerateAddress(), big.NewInt(1), []byte("data")},
+			erc721.GasTransferFrom,
+		},
+		{
+			erc721.OwnerOfMethod,
+			[]interface{}{big.NewInt(1)},
+			erc721.GasOwnerOf,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.method, func(t *testing.T) {
+			input, err := precompile.Pack(tc.method, tc.args...)
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, precompile.RequiredGas(input))
+		})
+	}
+
+	require.Zero(t, precompile.RequiredGas([]byte{1, 2}))
+}
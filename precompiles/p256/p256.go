@@ -32,8 +32,14 @@ const (
 	VerifyInputLength = 160
 )
 
-// PrecompileAddress defines the hex address of the p256 precompiled contract.
-const PrecompileAddress = "0x000000000000000000000000000000000000000b"
+const (
+	// PrecompileAddress defines the hex address of the p256 precompiled contract.
+	PrecompileAddress = "0x000000000000000000000000000000000000000b"
+	// RIP7212PrecompileAddress defines the hex address of the p256 precompiled contract as
+	// per RIP-7212. The precompile must be activated at this address instead of PrecompileAddress
+	// to enable the EIP-2537 BLS12-381 precompiles, which use the 0x0b address.
+	RIP7212PrecompileAddress = "0x0000000000000000000000000000000000000100"
+)
 
 // Precompile secp256r1 (P256) signature verification
 // implemented as a native contract as per EIP-7212.
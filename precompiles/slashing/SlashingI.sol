@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The SlashingI contract's address.
+address constant SLASHING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The SlashingI contract's instance.
+SlashingI constant SLASHING_CONTRACT = SlashingI(SLASHING_PRECOMPILE_ADDRESS);
+
+/// @dev SigningInfo defines a validator's signing info for monitoring their
+/// liveness activity.
+struct SigningInfo {
+    /// @dev The consensus address of the validator
+    address validatorAddress;
+    /// @dev The block height at which the validator started signing blocks
+    int64 startHeight;
+    /// @dev The index offset into the signed block bit array
+    int64 indexOffset;
+    /// @dev The unix timestamp (in seconds) until which the validator is jailed
+    int64 jailedUntil;
+    /// @dev Whether or not the validator has been tombstoned
+    bool tombstoned;
+    /// @dev The number of blocks missed in the current window
+    int64 missedBlocksCounter;
+}
+
+/// @dev Params defines the parameters of the slashing module.
+struct Params {
+    /// @dev The number of blocks used to compute the validator liveness
+    int64 signedBlocksWindow;
+    /// @dev The minimum fraction of blocks that must be signed within the window
+    Dec minSignedPerWindow;
+    /// @dev The duration (in seconds) a validator is jailed for downtime
+    int64 downtimeJailDuration;
+    /// @dev The fraction of the stake slashed for double signing
+    Dec slashFractionDoubleSign;
+    /// @dev The fraction of the stake slashed for downtime
+    Dec slashFractionDowntime;
+}
+
+/// @author Evmos Team
+/// @title Slashing Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/slashing module.
+/// @custom:address 0x0000000000000000000000000000000000000806
+interface SlashingI {
+    /// @dev Unjails a validator after the downtime jail period has passed.
+    /// Only the validator operator, i.e. the caller of the precompile, can unjail their validator.
+    /// @param validatorAddress The address of the validator operator
+    /// @return success Whether or not the unjail was successful
+    function unjail(address validatorAddress) external returns (bool success);
+
+    /// @dev Queries the signing info of a validator.
+    /// @param consAddress The consensus address of the validator
+    /// @return signingInfo The signing info of the validator
+    function getSigningInfo(
+        address consAddress
+    ) external view returns (SigningInfo memory signingInfo);
+
+    /// @dev Queries the signing info of all validators.
+    /// @param pagination Pagination configuration for the query
+    /// @return signingInfos The list of validator signing infos
+    /// @return pageResponse Pagination information for the response
+    function getSigningInfos(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            SigningInfo[] memory signingInfos,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Queries the parameters of the slashing module.
+    /// @return slashingParams The parameters of the slashing module
+    function params() external view returns (Params memory slashingParams);
+
+    /// @dev ValidatorUnjailed defines an event emitted when a validator is unjailed.
+    /// @param validator The address of the validator operator
+    event ValidatorUnjailed(address indexed validator);
+}
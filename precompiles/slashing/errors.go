@@ -0,0 +1,12 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+const (
+	// ErrDifferentCallerFromValidator is raised when the contract caller address is not the same as the validator address.
+	ErrDifferentCallerFromValidator = "caller address %s is not the same as validator address %s"
+	// ErrInvalidConsAddress is raised when the given consensus address is invalid.
+	ErrInvalidConsAddress = "invalid consensus address: %v"
+	// ErrSigningInfoNotFound is raised when no signing info is found for the given consensus address.
+	ErrSigningInfoNotFound = "signing info not found for validator %s"
+)
@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// EventTypeValidatorUnjailed defines the event type for the slashing Unjail transaction.
+	EventTypeValidatorUnjailed = "ValidatorUnjailed"
+)
+
+// EventValidatorUnjailed defines the event data for the slashing Unjail transaction.
+type EventValidatorUnjailed struct {
+	Validator common.Address
+}
+
+// EmitValidatorUnjailedEvent creates a new validator unjailed event emitted on an Unjail transaction.
+func (p Precompile) EmitValidatorUnjailedEvent(ctx sdk.Context, stateDB vm.StateDB, validatorAddr common.Address) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeValidatorUnjailed]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
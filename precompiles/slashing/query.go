@@ -0,0 +1,82 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// GetSigningInfoMethod defines the ABI method name for the slashing SigningInfo query.
+	GetSigningInfoMethod = "getSigningInfo"
+	// GetSigningInfosMethod defines the ABI method name for the slashing SigningInfos query.
+	GetSigningInfosMethod = "getSigningInfos"
+	// ParamsMethod defines the ABI method name for the slashing Params query.
+	ParamsMethod = "params"
+)
+
+// GetSigningInfo returns the signing info of the validator with the given consensus address.
+func (p Precompile) GetSigningInfo(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewSigningInfoRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.slashingKeeper.SigningInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(SigningInfoOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.SigningInfo)
+}
+
+// GetSigningInfos returns the signing info of all validators.
+func (p Precompile) GetSigningInfos(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewSigningInfosRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.slashingKeeper.SigningInfos(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(SigningInfosOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// Params returns the parameters of the slashing module.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	params := p.slashingKeeper.GetParams(ctx)
+
+	out := new(ParamsOutput).FromParams(params)
+	return method.Outputs.Pack(out.Params)
+}
@@ -0,0 +1,125 @@
+package slashing_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/slashing"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestGetSigningInfo() {
+	method := s.precompile.Methods[slashing.GetSigningInfoMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid consensus address",
+			func() []interface{} {
+				return []interface{}{"invalid"}
+			},
+			false,
+			"invalid consensus address",
+		},
+		{
+			"fail - signing info not found",
+			func() []interface{} {
+				return []interface{}{evmosutiltx.GenerateAddress()}
+			},
+			false,
+			"SigningInfo not found",
+		},
+		{
+			"pass - signing info of a genesis validator",
+			func() []interface{} {
+				return []interface{}{s.consAddress(0)}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			args := tc.malleate()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.GetSigningInfo(ctx, contract, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out slashing.SigningInfoOutput
+			err = s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfoMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Equal(s.consAddress(0), out.SigningInfo.ValidatorAddress)
+			s.Require().False(out.SigningInfo.Tombstoned)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetSigningInfos() {
+	method := s.precompile.Methods[slashing.GetSigningInfosMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+	_, err := s.precompile.GetSigningInfos(ctx, contract, &method, []interface{}{})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+
+	bz, err := s.precompile.GetSigningInfos(ctx, contract, &method, []interface{}{query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out slashing.SigningInfosOutput
+	err = s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfosMethod, bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().Len(out.SigningInfos, 1)
+	s.Require().Equal(uint64(len(s.network.GetValidators())), out.PageResponse.Total)
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	method := s.precompile.Methods[slashing.ParamsMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+	bz, err := s.precompile.Params(ctx, contract, &method, []interface{}{})
+	s.Require().NoError(err)
+
+	var out slashing.ParamsOutput
+	err = s.precompile.UnpackIntoInterface(&out, slashing.ParamsMethod, bz)
+	s.Require().NoError(err, "failed to unpack output")
+
+	params := s.network.App.SlashingKeeper.GetParams(ctx)
+	s.Require().Equal(params.SignedBlocksWindow, out.Params.SignedBlocksWindow)
+	s.Require().Equal(int64(params.DowntimeJailDuration.Seconds()), out.Params.DowntimeJailDuration)
+	s.Require().Equal(params.MinSignedPerWindow.BigInt(), out.Params.MinSignedPerWindow.Value)
+	s.Require().Equal(uint8(math.LegacyPrecision), out.Params.SlashFractionDowntime.Precision)
+}
+
+// consAddress returns the consensus address of the genesis validator at the given index.
+func (s *PrecompileTestSuite) consAddress(index int) common.Address {
+	consAddr, err := s.network.GetValidators()[index].GetConsAddr()
+	s.Require().NoError(err)
+	return common.BytesToAddress(consAddr)
+}
@@ -0,0 +1,65 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/evmos/evmos/v16/precompiles/slashing"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for slashing precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *slashing.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(integrationNetwork)
+	txFactory := factory.New(integrationNetwork, grpcHandler)
+
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	precompile, err := slashing.NewPrecompile(
+		s.network.App.SlashingKeeper,
+		s.network.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create slashing precompile")
+	s.precompile = precompile
+
+	// NOTE: the signing infos are created on the first signed block,
+	// so they are set manually for the genesis validators
+	ctx := s.network.GetContext()
+	for _, val := range s.network.GetValidators() {
+		consAddr, err := val.GetConsAddr()
+		s.Require().NoError(err)
+		signingInfo := slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0)
+		s.network.App.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
+	}
+}
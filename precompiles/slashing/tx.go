@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// UnjailMethod defines the ABI method name for the slashing Unjail
+	// transaction.
+	UnjailMethod = "unjail"
+)
+
+// Unjail unjails the validator operated by the tx signer.
+func (p Precompile) Unjail(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	validatorAddress, ok := args[0].(common.Address)
+	if !ok || validatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidValidator, args[0])
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"validator_address", validatorAddress.String(),
+	)
+
+	// we only allow the contract caller to unjail their own validator, so that
+	// contracts can operate validators as well.
+	if contract.CallerAddress != validatorAddress {
+		return nil, fmt.Errorf(ErrDifferentCallerFromValidator, contract.CallerAddress.String(), validatorAddress.String())
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := slashingkeeper.NewMsgServerImpl(p.slashingKeeper)
+	if _, err := msgSrv.Unjail(sdk.WrapSDKContext(ctx), NewMsgUnjail(validatorAddress)); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitValidatorUnjailedEvent(ctx, stateDB, validatorAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,177 @@
+package slashing_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/slashing"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+func (s *PrecompileTestSuite) TestUnjail() {
+	method := s.precompile.Methods[slashing.UnjailMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func(validator common.Address) (common.Address, []interface{})
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(validator common.Address) (common.Address, []interface{}) {
+				return validator, []interface{}{}
+			},
+			false,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid validator address",
+			func(validator common.Address) (common.Address, []interface{}) {
+				return validator, []interface{}{"invalid"}
+			},
+			false,
+			"invalid validator address",
+		},
+		{
+			"fail - different caller than validator",
+			func(validator common.Address) (common.Address, []interface{}) {
+				s.jail(validator, time.Time{})
+				return s.keyring.GetAddr(0), []interface{}{validator}
+			},
+			false,
+			"is not the same as validator address",
+		},
+		{
+			"fail - validator not jailed",
+			func(validator common.Address) (common.Address, []interface{}) {
+				return validator, []interface{}{validator}
+			},
+			false,
+			"validator not jailed",
+		},
+		{
+			"fail - jail period not passed",
+			func(validator common.Address) (common.Address, []interface{}) {
+				s.jail(validator, s.network.GetContext().BlockTime().Add(time.Hour))
+				return validator, []interface{}{validator}
+			},
+			false,
+			"validator still jailed",
+		},
+		{
+			"pass - unjail validator",
+			func(validator common.Address) (common.Address, []interface{}) {
+				s.jail(validator, time.Time{})
+				return validator, []interface{}{validator}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+			s.Require().NoError(err)
+			validator := common.BytesToAddress(valAddr.Bytes())
+
+			// NOTE: the genesis validators are not self-delegated, which is required to unjail
+			val := s.network.GetValidators()[0]
+			selfDelegation := stakingtypes.NewDelegation(valAddr.Bytes(), valAddr, val.DelegatorShares)
+			s.network.App.StakingKeeper.SetDelegation(s.network.GetContext(), selfDelegation)
+
+			origin, args := tc.malleate(validator)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), origin, s.precompile, 200_000)
+
+			bz, err := s.precompile.Unjail(ctx, origin, contract, stateDB, &method, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, bz)
+
+			val, found := s.network.App.StakingKeeper.GetValidator(ctx, valAddr)
+			s.Require().True(found)
+			s.Require().False(val.IsJailed(), "expected validator to be unjailed")
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1, "expected one unjail event")
+			s.Require().Equal(s.precompile.ABI.Events[slashing.EventTypeValidatorUnjailed].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUnjailFromContract() {
+	origin := s.keyring.GetAddr(0)
+
+	valAddr, err := sdk.ValAddressFromBech32(s.network.GetValidators()[0].OperatorAddress)
+	s.Require().NoError(err)
+	validator := common.BytesToAddress(valAddr.Bytes())
+
+	// NOTE: the genesis validators are not self-delegated, which is required to unjail
+	val := s.network.GetValidators()[0]
+	selfDelegation := stakingtypes.NewDelegation(valAddr.Bytes(), valAddr, val.DelegatorShares)
+	s.network.App.StakingKeeper.SetDelegation(s.network.GetContext(), selfDelegation)
+	s.jail(validator, time.Time{})
+
+	ctx := s.network.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter())
+	stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	// the validator is operated by a contract that forwards the calls to the precompile
+	stateDB.SetCode(validator, testutil.NewForwarderCode(s.precompile.Address()))
+
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, s.network.App.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg := ethtypes.NewMessage(origin, &validator, 0, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+	evm.WithPrecompiles(
+		map[common.Address]vm.PrecompiledContract{s.precompile.Address(): s.precompile},
+		[]common.Address{s.precompile.Address()},
+	)
+
+	input, err := s.precompile.Pack(slashing.UnjailMethod, validator)
+	s.Require().NoError(err)
+
+	// the origin cannot unjail the validator operated by the contract
+	_, _, err = evm.Call(vm.AccountRef(origin), s.precompile.Address(), input, 1_000_000, big.NewInt(0))
+	s.Require().ErrorContains(err, "is not the same as validator address")
+
+	bz, _, err := evm.Call(vm.AccountRef(origin), validator, input, 1_000_000, big.NewInt(0))
+	s.Require().NoError(err, "expected the contract to unjail its validator")
+	s.Require().Equal(cmn.TrueValue, bz)
+	s.Require().NoError(stateDB.Commit())
+
+	val, found := s.network.App.StakingKeeper.GetValidator(ctx, valAddr)
+	s.Require().True(found)
+	s.Require().False(val.IsJailed(), "expected validator to be unjailed")
+}
+
+// jail jails the given validator until the given time.
+func (s *PrecompileTestSuite) jail(validator common.Address, until time.Time) {
+	ctx := s.network.GetContext()
+
+	val, found := s.network.App.StakingKeeper.GetValidator(ctx, validator.Bytes())
+	s.Require().True(found)
+
+	consAddr, err := val.GetConsAddr()
+	s.Require().NoError(err)
+
+	s.network.App.StakingKeeper.Jail(ctx, consAddr)
+	s.network.App.SlashingKeeper.JailUntil(ctx, consAddr, until)
+}
@@ -0,0 +1,172 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+// SigningInfo is the struct representation of a validator signing info as defined in the ABI.
+type SigningInfo struct {
+	ValidatorAddress    common.Address `abi:"validatorAddress"`
+	StartHeight         int64          `abi:"startHeight"`
+	IndexOffset         int64          `abi:"indexOffset"`
+	JailedUntil         int64          `abi:"jailedUntil"`
+	Tombstoned          bool           `abi:"tombstoned"`
+	MissedBlocksCounter int64          `abi:"missedBlocksCounter"`
+}
+
+// Params is the struct representation of the slashing module parameters as defined in the ABI.
+type Params struct {
+	SignedBlocksWindow      int64   `abi:"signedBlocksWindow"`
+	MinSignedPerWindow      cmn.Dec `abi:"minSignedPerWindow"`
+	DowntimeJailDuration    int64   `abi:"downtimeJailDuration"`
+	SlashFractionDoubleSign cmn.Dec `abi:"slashFractionDoubleSign"`
+	SlashFractionDowntime   cmn.Dec `abi:"slashFractionDowntime"`
+}
+
+// SigningInfoOutput is the output of the GetSigningInfo query.
+type SigningInfoOutput struct {
+	SigningInfo SigningInfo
+}
+
+// SigningInfosOutput is the output of the GetSigningInfos query.
+type SigningInfosOutput struct {
+	SigningInfos []SigningInfo
+	PageResponse query.PageResponse
+}
+
+// ParamsOutput is the output of the Params query.
+type ParamsOutput struct {
+	Params Params
+}
+
+// SigningInfosInput is a struct to unpack the arguments of the GetSigningInfos query.
+type SigningInfosInput struct {
+	Pagination query.PageRequest
+}
+
+// NewMsgUnjail creates a new MsgUnjail instance for the validator operated by the given address.
+func NewMsgUnjail(validatorAddress common.Address) *slashingtypes.MsgUnjail {
+	return slashingtypes.NewMsgUnjail(sdk.ValAddress(validatorAddress.Bytes()))
+}
+
+// NewSigningInfoRequest creates a new QuerySigningInfoRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewSigningInfoRequest(args []interface{}) (*slashingtypes.QuerySigningInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	consAddress, ok := args[0].(common.Address)
+	if !ok || consAddress == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidConsAddress, args[0])
+	}
+
+	return &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdk.ConsAddress(consAddress.Bytes()).String(),
+	}, nil
+}
+
+// NewSigningInfosRequest creates a new QuerySigningInfosRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewSigningInfosRequest(method *abi.Method, args []interface{}) (*slashingtypes.QuerySigningInfosRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input SigningInfosInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SigningInfosInput struct: %s", err)
+	}
+
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &slashingtypes.QuerySigningInfosRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the SigningInfoOutput from a QuerySigningInfoResponse.
+func (so *SigningInfoOutput) FromResponse(res *slashingtypes.QuerySigningInfoResponse) (*SigningInfoOutput, error) {
+	signingInfo, err := newSigningInfo(res.ValSigningInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	so.SigningInfo = signingInfo
+	return so, nil
+}
+
+// FromResponse populates the SigningInfosOutput from a QuerySigningInfosResponse.
+func (so *SigningInfosOutput) FromResponse(res *slashingtypes.QuerySigningInfosResponse) (*SigningInfosOutput, error) {
+	so.SigningInfos = make([]SigningInfo, len(res.Info))
+	for i, info := range res.Info {
+		signingInfo, err := newSigningInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		so.SigningInfos[i] = signingInfo
+	}
+
+	if res.Pagination != nil {
+		so.PageResponse.Total = res.Pagination.Total
+		so.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return so, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (so *SigningInfosOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(so.SigningInfos, so.PageResponse)
+}
+
+// FromParams populates the ParamsOutput from the slashing module parameters.
+func (po *ParamsOutput) FromParams(params slashingtypes.Params) *ParamsOutput {
+	po.Params = Params{
+		SignedBlocksWindow:      params.SignedBlocksWindow,
+		MinSignedPerWindow:      newDec(params.MinSignedPerWindow),
+		DowntimeJailDuration:    int64(params.DowntimeJailDuration.Seconds()),
+		SlashFractionDoubleSign: newDec(params.SlashFractionDoubleSign),
+		SlashFractionDowntime:   newDec(params.SlashFractionDowntime),
+	}
+	return po
+}
+
+// newSigningInfo converts a validator signing info to its ABI representation.
+func newSigningInfo(info slashingtypes.ValidatorSigningInfo) (SigningInfo, error) {
+	consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return SigningInfo{}, fmt.Errorf(ErrInvalidConsAddress, info.Address)
+	}
+
+	return SigningInfo{
+		ValidatorAddress:    common.BytesToAddress(consAddr.Bytes()),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         info.JailedUntil.Unix(),
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}, nil
+}
+
+// newDec converts a legacy decimal to its ABI representation.
+func newDec(dec math.LegacyDec) cmn.Dec {
+	return cmn.Dec{
+		Value:     dec.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
@@ -142,6 +142,19 @@ interface StakingI is authorization.AuthorizationI {
         uint256 value
     ) external returns (bool success);
 
+    /// @dev Defines a method for editing a validator.
+    /// @param description The updated description. Fields set to "[do-not-modify]" are not updated
+    /// @param validatorAddress The validator address
+    /// @param commissionRate The new commission rate. Set to -1 to not modify it
+    /// @param minSelfDelegation The new minimum self delegation. Set to -1 to not modify it
+    /// @return success Whether or not the edit validator was successful
+    function editValidator(
+        Description calldata description,
+        address validatorAddress,
+        int256 commissionRate,
+        int256 minSelfDelegation
+    ) external returns (bool success);
+
     /// @dev Defines a method for performing a delegation of coins from a delegator to a validator.
     /// @param delegatorAddress The address of the delegator
     /// @param validatorAddress The address of the validator
@@ -274,6 +287,16 @@ interface StakingI is authorization.AuthorizationI {
         uint256 value
     );
 
+    /// @dev EditValidator defines an Event emitted when a validator is edited.
+    /// @param validatorAddress The address of the validator
+    /// @param commissionRate The commission rate after the edit
+    /// @param minSelfDelegation The minimum self delegation after the edit
+    event EditValidator(
+        address indexed validatorAddress,
+        int256 commissionRate,
+        int256 minSelfDelegation
+    );
+
     /// @dev Delegate defines an Event emitted when a given amount of tokens are delegated from the
     /// delegator address to the validator address.
     /// @param delegatorAddress The address of the delegator
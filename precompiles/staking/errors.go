@@ -8,6 +8,10 @@ const (
 	ErrDecreaseAmountTooBig = "amount by which the allowance should be decreased is greater than the authorization limit: %s > %s"
 	// ErrDifferentOriginFromDelegator is raised when the origin address is not the same as the delegator address.
 	ErrDifferentOriginFromDelegator = "origin address %s is not the same as delegator address %s"
+	// ErrDifferentCallerFromDelegator is raised when the contract caller address is not the same as the delegator address.
+	ErrDifferentCallerFromDelegator = "caller address %s is not the same as delegator address %s"
+	// ErrDifferentCallerFromValidator is raised when the contract caller address is not the same as the validator address.
+	ErrDifferentCallerFromValidator = "caller address %s is not the same as validator address %s"
 	// ErrNoDelegationFound is raised when no delegation is found for the given delegator and validator addresses.
 	ErrNoDelegationFound = "delegation with delegator %s not found for validator %s"
 )
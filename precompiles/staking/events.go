@@ -22,6 +22,8 @@ import (
 const (
 	// EventTypeCreateValidator defines the event type for the staking CreateValidator transaction.
 	EventTypeCreateValidator = "CreateValidator"
+	// EventTypeEditValidator defines the event type for the staking EditValidator transaction.
+	EventTypeEditValidator = "EditValidator"
 	// EventTypeDelegate defines the event type for the staking Delegate transaction.
 	EventTypeDelegate = "Delegate"
 	// EventTypeUnbond defines the event type for the staking Undelegate transaction.
@@ -148,6 +150,37 @@ func (p Precompile) EmitCreateValidatorEvent(ctx sdk.Context, stateDB vm.StateDB
 	return nil
 }
 
+// EmitEditValidatorEvent creates a new edit validator event emitted on an EditValidator transaction.
+// The event data contains the validator commission rate and minimum self delegation after the edit.
+func (p Precompile) EmitEditValidatorEvent(ctx sdk.Context, stateDB vm.StateDB, validatorAddr common.Address) error {
+	// NOTE: At this point the validator has already been checked, so no need to check again
+	validator, _ := p.stakingKeeper.GetValidator(ctx, validatorAddr.Bytes())
+
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeEditValidator]
+
+	topics, err := p.createValidatorTxTopics(2, event, validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(validator.Commission.Rate.BigInt(), validator.MinSelfDelegation.BigInt())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
 // EmitDelegateEvent creates a new delegate event emitted on a Delegate transaction.
 func (p Precompile) EmitDelegateEvent(ctx sdk.Context, stateDB vm.StateDB, msg *stakingtypes.MsgDelegate, delegatorAddr common.Address) error {
 	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	gomath "math"
 	"math/big"
 	"time"
 
@@ -45,6 +46,11 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 			s.precompile.Methods[staking.CreateValidatorMethod].Name,
 			true,
 		},
+		{
+			staking.EditValidatorMethod,
+			s.precompile.Methods[staking.EditValidatorMethod].Name,
+			true,
+		},
 		{
 			staking.DelegateMethod,
 			s.precompile.Methods[staking.DelegateMethod].Name,
@@ -133,6 +139,42 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestRequiredGasWithGasSchedule() {
+	schedule := evmtypes.PrecompileGasSchedule{
+		Address: s.precompile.Address().String(),
+		Methods: []evmtypes.MethodGasCost{
+			{Method: staking.DelegateMethod, BaseGas: 10_000, GasPerByte: 2},
+			{Method: "unknownMethod", BaseGas: 1},
+		},
+	}
+	precompile := s.precompile.WithGasSchedule(schedule)
+
+	delegateInput, err := s.precompile.Pack(
+		staking.DelegateMethod,
+		s.address,
+		s.validators[0].GetOperator().String(),
+		big.NewInt(10000000000),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(10_000+2*uint64(len(delegateInput)-4), precompile.RequiredGas(delegateInput), "expected scheduled gas cost")
+
+	undelegateInput, err := s.precompile.Pack(
+		staking.UndelegateMethod,
+		s.address,
+		s.validators[0].GetOperator().String(),
+		big.NewInt(1),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(7760), precompile.RequiredGas(undelegateInput), "expected default gas cost for unscheduled method")
+
+	// the original precompile is not modified
+	s.Require().Equal(uint64(7760), s.precompile.RequiredGas(delegateInput))
+
+	// the scheduled cost saturates instead of overflowing
+	schedule.Methods[0].BaseGas = gomath.MaxUint64
+	s.Require().Equal(uint64(gomath.MaxUint64), s.precompile.WithGasSchedule(schedule).RequiredGas(delegateInput))
+}
+
 // TestRun tests the precompile's Run method.
 func (s *PrecompileTestSuite) TestRun() {
 	testcases := []struct {
@@ -20,6 +20,8 @@ import (
 const (
 	// CreateValidatorMethod defines the ABI method name for the staking create validator transaction
 	CreateValidatorMethod = "createValidator"
+	// EditValidatorMethod defines the ABI method name for the staking edit validator transaction
+	EditValidatorMethod = "editValidator"
 	// DelegateMethod defines the ABI method name for the staking Delegate
 	// transaction.
 	DelegateMethod = "delegate"
@@ -48,8 +50,8 @@ const (
 // CreateValidator performs create validator.
 func (p Precompile) CreateValidator(
 	ctx sdk.Context,
-	origin common.Address,
-	_ *vm.Contract,
+	_ common.Address,
+	contract *vm.Contract,
 	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
@@ -69,9 +71,10 @@ func (p Precompile) CreateValidator(
 		"value", msg.Value.Amount.String(),
 	)
 
-	// we only allow the tx signer "origin" to create their own validator.
-	if origin != validatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), validatorHexAddr.String())
+	// we only allow the contract caller to create their own validator, so that
+	// contracts can operate validators as well.
+	if contract.CallerAddress != validatorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromDelegator, contract.CallerAddress.String(), validatorHexAddr.String())
 	}
 
 	// Execute the transaction using the message server
@@ -85,6 +88,54 @@ func (p Precompile) CreateValidator(
 		return nil, err
 	}
 
+	// NOTE: This ensures that the self delegation in the bank keeper is correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	stateDB.(*statedb.StateDB).SubBalance(contract.CallerAddress, msg.Value.Amount.BigInt())
+
+	return method.Outputs.Pack(true)
+}
+
+// EditValidator performs the edit of a validator's description, commission rate
+// and minimum self delegation.
+func (p Precompile) EditValidator(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, validatorHexAddr, err := NewMsgEditValidator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"validator_address", validatorHexAddr.String(),
+		"description", msg.Description.String(),
+		"commission_rate", msg.CommissionRate,
+		"min_self_delegation", msg.MinSelfDelegation,
+	)
+
+	// we only allow the contract caller to edit their own validator, so that
+	// contracts can operate validators as well.
+	if contract.CallerAddress != validatorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromValidator, contract.CallerAddress.String(), validatorHexAddr.String())
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	if _, err = msgSrv.EditValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the edit validator transaction
+	if err = p.EmitEditValidatorEvent(ctx, stateDB, validatorHexAddr); err != nil {
+		return nil, err
+	}
+
 	return method.Outputs.Pack(true)
 }
 
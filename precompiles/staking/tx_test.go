@@ -7,13 +7,16 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	geth "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	"github.com/evmos/evmos/v16/precompiles/staking"
 	"github.com/evmos/evmos/v16/precompiles/testutil"
 	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
 )
 
 func (s *PrecompileTestSuite) TestCreateValidator() {
@@ -56,7 +59,7 @@ func (s *PrecompileTestSuite) TestCreateValidator() {
 			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
 		},
 		{
-			"fail - different origin than delegator",
+			"fail - different caller than delegator",
 			func() []interface{} {
 				differentAddr := evmosutiltx.GenerateAddress()
 				return []interface{}{
@@ -297,6 +300,230 @@ func (s *PrecompileTestSuite) TestCreateValidator() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestEditValidator() {
+	var (
+		method      = s.precompile.Methods[staking.EditValidatorMethod]
+		description = staking.Description{
+			Moniker:         "node0-edited",
+			Identity:        stakingtypes.DoNotModifyDesc,
+			Website:         "https://evmos.org",
+			SecurityContact: stakingtypes.DoNotModifyDesc,
+			Details:         stakingtypes.DoNotModifyDesc,
+		}
+		minSelfDelegation = big.NewInt(2)
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		gas         uint64
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			func(data []byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid description",
+			func() []interface{} {
+				return []interface{}{"", s.address, staking.DoNotModifyCommissionRate, minSelfDelegation}
+			},
+			200000,
+			func(data []byte) {},
+			true,
+			"invalid description",
+		},
+		{
+			"fail - invalid validator address",
+			func() []interface{} {
+				return []interface{}{description, 1205, staking.DoNotModifyCommissionRate, minSelfDelegation}
+			},
+			200000,
+			func(data []byte) {},
+			true,
+			"invalid validator address",
+		},
+		{
+			"fail - invalid commission rate",
+			func() []interface{} {
+				return []interface{}{description, s.address, "", minSelfDelegation}
+			},
+			200000,
+			func(data []byte) {},
+			true,
+			"invalid type for commissionRate",
+		},
+		{
+			"fail - invalid min self delegation",
+			func() []interface{} {
+				return []interface{}{description, s.address, staking.DoNotModifyCommissionRate, ""}
+			},
+			200000,
+			func(data []byte) {},
+			true,
+			"invalid amount",
+		},
+		{
+			"fail - different caller than validator",
+			func() []interface{} {
+				return []interface{}{description, evmosutiltx.GenerateAddress(), staking.DoNotModifyCommissionRate, minSelfDelegation}
+			},
+			200000,
+			func(data []byte) {},
+			true,
+			"is not the same as validator address",
+		},
+		{
+			"fail - commission rate changed within 24h",
+			func() []interface{} {
+				return []interface{}{description, s.address, math.LegacyNewDecWithPrec(5, 1).BigInt(), staking.DoNotModifyMinSelfDelegation}
+			},
+			200000,
+			func(data []byte) {},
+			true,
+			stakingtypes.ErrCommissionUpdateTime.Error(),
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{description, s.address, staking.DoNotModifyCommissionRate, minSelfDelegation}
+			},
+			200000,
+			func(data []byte) {
+				success, err := s.precompile.Unpack(staking.EditValidatorMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(success[0], true)
+
+				log := s.stateDB.Logs()[1]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.ABI.Events[staking.EventTypeEditValidator]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), geth.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(s.ctx.BlockHeight()))
+
+				// Check the fully unpacked event matches the one emitted
+				var editValidatorEvent staking.EventEditValidator
+				err = cmn.UnpackLog(s.precompile.ABI, &editValidatorEvent, staking.EventTypeEditValidator, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.address, editValidatorEvent.ValidatorAddress)
+				s.Require().Equal(math.LegacyOneDec().BigInt(), editValidatorEvent.CommissionRate)
+				s.Require().Equal(minSelfDelegation, editValidatorEvent.MinSelfDelegation)
+
+				validator := s.app.StakingKeeper.Validator(s.ctx, s.address.Bytes())
+				s.Require().NotNil(validator, "expected validator not to be nil")
+				s.Require().Equal(description.Moniker, validator.GetMoniker())
+				s.Require().Equal(minSelfDelegation.String(), validator.GetMinSelfDelegation().String())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, tc.gas)
+
+			// create the validator operated by the origin to be edited
+			createMethod := s.precompile.Methods[staking.CreateValidatorMethod]
+			_, err := s.precompile.CreateValidator(s.ctx, s.address, contract, s.stateDB, &createMethod, []interface{}{
+				staking.Description{Moniker: "node0"},
+				staking.Commission{
+					Rate:          math.LegacyOneDec().BigInt(),
+					MaxRate:       math.LegacyOneDec().BigInt(),
+					MaxChangeRate: math.LegacyOneDec().BigInt(),
+				},
+				big.NewInt(1),
+				s.address,
+				"nfJ0axJC9dhta1MAE1EBFaVdxxkYzxYrBaHuJVjG//M=",
+				big.NewInt(1205000000000000000),
+			})
+			s.Require().NoError(err)
+
+			bz, err := s.precompile.EditValidator(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestEditValidatorFromContract() {
+	var (
+		operator = evmosutiltx.GenerateAddress()
+		value    = big.NewInt(1205000000000000000)
+		funds    = big.NewInt(2e18)
+	)
+
+	ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	stateDB := statedb.New(ctx, s.app.EvmKeeper, statedb.NewEmptyTxConfig(geth.BytesToHash(ctx.HeaderHash())))
+
+	// the validator is operated by a contract that forwards the calls to the precompile
+	stateDB.SetCode(operator, testutil.NewForwarderCode(s.precompile.Address()))
+	stateDB.AddBalance(operator, funds)
+
+	cfg, err := s.app.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, s.app.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg := ethtypes.NewMessage(s.address, &operator, 0, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+	evm := s.app.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+	evm.WithPrecompiles(
+		map[geth.Address]vm.PrecompiledContract{s.precompile.Address(): s.precompile},
+		[]geth.Address{s.precompile.Address()},
+	)
+
+	input, err := s.precompile.Pack(
+		staking.CreateValidatorMethod,
+		staking.Description{Moniker: "node0"},
+		staking.Commission{
+			Rate:          math.LegacyOneDec().BigInt(),
+			MaxRate:       math.LegacyOneDec().BigInt(),
+			MaxChangeRate: math.LegacyOneDec().BigInt(),
+		},
+		big.NewInt(1),
+		operator,
+		"nfJ0axJC9dhta1MAE1EBFaVdxxkYzxYrBaHuJVjG//M=",
+		value,
+	)
+	s.Require().NoError(err)
+	_, _, err = evm.Call(vm.AccountRef(s.address), operator, input, 1_000_000, big.NewInt(0))
+	s.Require().NoError(err, "expected the contract to create its validator")
+
+	description := staking.Description{Moniker: "contract node"}
+	input, err = s.precompile.Pack(staking.EditValidatorMethod, description, operator, staking.DoNotModifyCommissionRate, staking.DoNotModifyMinSelfDelegation)
+	s.Require().NoError(err)
+
+	// the origin cannot edit the validator operated by the contract
+	_, _, err = evm.Call(vm.AccountRef(s.address), s.precompile.Address(), input, 1_000_000, big.NewInt(0))
+	s.Require().ErrorContains(err, "is not the same as validator address")
+
+	_, _, err = evm.Call(vm.AccountRef(s.address), operator, input, 1_000_000, big.NewInt(0))
+	s.Require().NoError(err, "expected the contract to edit its validator")
+	s.Require().NoError(stateDB.Commit())
+
+	validator := s.app.StakingKeeper.Validator(ctx, operator.Bytes())
+	s.Require().NotNil(validator, "expected validator not to be nil")
+	s.Require().Equal(description.Moniker, validator.GetMoniker())
+
+	// the self delegation is not overwritten by the contract balance in the state DB
+	balance := s.app.BankKeeper.GetBalance(ctx, operator.Bytes(), s.bondDenom)
+	s.Require().Equal(new(big.Int).Sub(funds, value), balance.Amount.BigInt())
+}
+
 func (s *PrecompileTestSuite) TestDelegate() {
 	method := s.precompile.Methods[staking.DelegateMethod]
 
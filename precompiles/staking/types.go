@@ -22,12 +22,28 @@ import (
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 )
 
+var (
+	// DoNotModifyCommissionRate is the commission rate value used in an EditValidator
+	// transaction to leave the commission rate unchanged.
+	DoNotModifyCommissionRate = big.NewInt(-1)
+	// DoNotModifyMinSelfDelegation is the minimum self delegation value used in an EditValidator
+	// transaction to leave the minimum self delegation unchanged.
+	DoNotModifyMinSelfDelegation = big.NewInt(-1)
+)
+
 // EventCreateValidator defines the event data for the staking CreateValidator transaction.
 type EventCreateValidator struct {
 	ValidatorAddress common.Address
 	Value            *big.Int
 }
 
+// EventEditValidator defines the event data for the staking EditValidator transaction.
+type EventEditValidator struct {
+	ValidatorAddress  common.Address
+	CommissionRate    *big.Int
+	MinSelfDelegation *big.Int
+}
+
 // EventDelegate defines the event data for the staking Delegate transaction.
 type EventDelegate struct {
 	DelegatorAddress common.Address
@@ -158,6 +174,70 @@ func NewMsgCreateValidator(args []interface{}, denom string) (*stakingtypes.MsgC
 	return msg, validatorAddress, nil
 }
 
+// NewMsgEditValidator creates a new MsgEditValidator instance and does sanity checks
+// on the given arguments before populating the message.
+//
+// NOTE: a commission rate or minimum self delegation of -1 leaves the corresponding
+// value unchanged.
+func NewMsgEditValidator(args []interface{}) (*stakingtypes.MsgEditValidator, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	descriptionInput, ok := args[0].(Description)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDescription, args[0])
+	}
+
+	description := stakingtypes.Description{
+		Moniker:         descriptionInput.Moniker,
+		Identity:        descriptionInput.Identity,
+		Website:         descriptionInput.Website,
+		SecurityContact: descriptionInput.SecurityContact,
+		Details:         descriptionInput.Details,
+	}
+
+	validatorAddress, ok := args[1].(common.Address)
+	if !ok || validatorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidValidator, args[1])
+	}
+
+	commissionRateInput, ok := args[2].(*big.Int)
+	if !ok || commissionRateInput == nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "commissionRate", &big.Int{}, args[2])
+	}
+
+	var commissionRate *sdk.Dec
+	if commissionRateInput.Cmp(DoNotModifyCommissionRate) != 0 {
+		rate := math.LegacyNewDecFromBigIntWithPrec(commissionRateInput, math.LegacyPrecision)
+		commissionRate = &rate
+	}
+
+	minSelfDelegationInput, ok := args[3].(*big.Int)
+	if !ok || minSelfDelegationInput == nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, args[3])
+	}
+
+	var minSelfDelegation *math.Int
+	if minSelfDelegationInput.Cmp(DoNotModifyMinSelfDelegation) != 0 {
+		msd := math.NewIntFromBigInt(minSelfDelegationInput)
+		minSelfDelegation = &msd
+	}
+
+	msg := stakingtypes.NewMsgEditValidator(
+		sdk.ValAddress(validatorAddress.Bytes()),
+		description,
+		commissionRate,
+		minSelfDelegation,
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, validatorAddress, nil
+}
+
 // NewMsgDelegate creates a new MsgDelegate instance and does sanity checks
 // on the given arguments before populating the message.
 func NewMsgDelegate(args []interface{}, denom string) (*stakingtypes.MsgDelegate, common.Address, error) {
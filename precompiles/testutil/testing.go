@@ -20,3 +20,21 @@ func NewPrecompileContract(t *testing.T, ctx sdk.Context, caller common.Address,
 	require.Equal(t, uint64(0), initialGas)
 	return contract, ctx
 }
+
+// NewForwarderCode returns the runtime bytecode of a contract that forwards its call data
+// to the target address and returns, or reverts with, the return data of the call.
+func NewForwarderCode(target common.Address) []byte {
+	code := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	code = append(code, target.Bytes()...)
+	return append(code,
+		byte(vm.GAS), byte(vm.CALL),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+		byte(vm.PUSH1), 51, byte(vm.JUMPI),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT),
+		byte(vm.JUMPDEST), byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+}
@@ -15,6 +15,7 @@ import (
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	erc20 "github.com/evmos/evmos/v16/precompiles/erc20"
 	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 	transferkeeper "github.com/evmos/evmos/v16/x/ibc/transfer/keeper"
 )
 
@@ -77,6 +78,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	// We should execute the transactions from Evmos testnet
 	// to ensure parity in the values.
 
+	if gas, ok := p.ScheduledRequiredGas(input); ok {
+		return gas
+	}
+
 	// If there is no method ID, then it's the fallback or receive case
 	if len(input) < 4 {
 		return DepositRequiredGas
@@ -98,6 +103,13 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	return p.Precompile.RequiredGas(input)
 }
 
+// WithGasSchedule returns a copy of the precompile that charges gas according to the given schedule.
+func (p Precompile) WithGasSchedule(schedule evmtypes.PrecompileGasSchedule) vm.PrecompiledContract {
+	erc20Precompile := *p.Precompile
+	erc20Precompile.Precompile = erc20Precompile.Precompile.WithGasSchedule(schedule)
+	return &Precompile{Precompile: &erc20Precompile}
+}
+
 // Run executes the precompiled contract WERC20 methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, stateDB, method, initialGas, args, err := p.Precompile.RunSetup(evm, contract, readOnly, p.IsTransaction)
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/evmos/v16/rpc/backend"
+	"github.com/evmos/evmos/v16/rpc/namespaces/ethereum/bundler"
 	"github.com/evmos/evmos/v16/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/evmos/v16/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/evmos/v16/rpc/namespaces/ethereum/eth/filters"
@@ -40,6 +41,10 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// BundlerNamespace enables the ERC-4337 bundler methods, which are served
+	// under the eth namespace
+	BundlerNamespace = "bundler"
+
 	apiVersion = "1.0"
 )
 
@@ -157,6 +162,22 @@ func init() {
 				},
 			}
 		},
+		BundlerNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   bundler.NewAPI(ctx, clientCtx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -181,6 +202,15 @@ func GetRPCAPIs(ctx *server.Context,
 	return apis
 }
 
+// StopRPCAPIs stops the background routines started by the APIs.
+func StopRPCAPIs(apis []rpc.API) {
+	for _, api := range apis {
+		if bundlerAPI, ok := api.Service.(*bundler.API); ok {
+			bundler.Stop(bundlerAPI)
+		}
+	}
+}
+
 // RegisterAPINamespace registers a new API namespace with the API creator.
 // This function fails if the namespace is already registered.
 func RegisterAPINamespace(ns string, creator APICreator) error {
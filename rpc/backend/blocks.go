@@ -281,7 +281,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 				continue
 			}
 
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsg.Hash = ethMsg.TxHash().Hex()
 			result = append(result, ethMsg)
 		}
 	}
@@ -89,6 +89,7 @@ func (suite *BackendTestSuite) TestResend() {
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterParams(queryClient, &header, 1)
 				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeHistoryError(feeMarketClient, 1)
 				_, err := RegisterBlock(client, 1, nil)
 				suite.Require().NoError(err)
 				_, err = RegisterBlockResults(client, 1)
@@ -477,6 +478,7 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeHistoryError(feeMarketClient, 1)
 				RegisterParams(queryClient, &header, 1)
 				_, err := RegisterBlock(client, 1, nil)
 				suite.Require().NoError(err)
@@ -495,6 +497,7 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParamsError(feeMarketClient, 1)
+				RegisterFeeHistoryError(feeMarketClient, 1)
 				RegisterParams(queryClient, &header, 1)
 				_, err := RegisterBlock(client, 1, nil)
 				suite.Require().NoError(err)
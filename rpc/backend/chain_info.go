@@ -5,6 +5,7 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 
 	"cosmossdk.io/math"
@@ -22,6 +23,14 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// gasTipCapBlocks is the number of recent blocks sampled to suggest a gas tip cap
+	gasTipCapBlocks = 20
+	// gasTipCapPercentile is the percentile of the priority fees sampled to suggest a
+	// gas tip cap
+	gasTipCapPercentile = 60
+)
+
 // ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config.
 func (b *Backend) ChainID() (*hexutil.Big, error) {
 	eip155ChainID, err := types.ParseChainID(b.clientCtx.ChainID)
@@ -178,6 +187,14 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	// use the fee history kept by the fee market module if it has all the blocks,
+	// otherwise recompute it from the blocks and their results
+	feeHistory, err := b.feeHistoryFromStore(blockStart, blockEnd, rewardPercentiles)
+	if err == nil {
+		return feeHistory, nil
+	}
+	b.logger.Debug("fee history not found in the fee market store", "error", err.Error())
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
 	rewardCount := len(rewardPercentiles)
@@ -233,7 +250,7 @@ func (b *Backend) FeeHistory(
 		}
 	}
 
-	feeHistory := rpctypes.FeeHistoryResult{
+	feeHistory = &rpctypes.FeeHistoryResult{
 		OldestBlock:  oldestBlock,
 		BaseFee:      thisBaseFee,
 		GasUsedRatio: thisGasUsedRatio,
@@ -243,18 +260,75 @@ func (b *Backend) FeeHistory(
 		feeHistory.Reward = reward
 	}
 
-	return &feeHistory, nil
+	return feeHistory, nil
+}
+
+// feeHistoryFromStore returns the fee history of the given range of blocks from
+// the entries kept by the fee market module. It returns an error if any of the
+// blocks is missing.
+func (b *Backend) feeHistoryFromStore(
+	blockStart, blockEnd int64,
+	rewardPercentiles []float64,
+) (*rpctypes.FeeHistoryResult, error) {
+	blocks := blockEnd - blockStart + 1
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		BlockCount: uint64(blocks), // #nosec G701 -- checked for int overflow already
+		LastBlock:  blockEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(res.Entries)) != blocks {
+		return nil, fmt.Errorf("fee history has %d of the %d blocks from height %d", len(res.Entries), blocks, blockStart)
+	}
+
+	feeHistory := &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      make([]*hexutil.Big, blocks+1),
+		GasUsedRatio: make([]float64, blocks),
+	}
+
+	calculateRewards := len(rewardPercentiles) != 0
+	if calculateRewards {
+		feeHistory.Reward = make([][]*hexutil.Big, blocks)
+	}
+
+	defaultGasLimit := uint64(^uint32(0))
+	for i, entry := range res.Entries {
+		if entry.Height != blockStart+int64(i) {
+			return nil, fmt.Errorf("fee history of height %d not found", blockStart+int64(i))
+		}
+
+		feeHistory.BaseFee[i] = (*hexutil.Big)(entry.BaseFee.BigInt())
+		feeHistory.BaseFee[i+1] = (*hexutil.Big)(entry.NextBaseFee.BigInt())
+		feeHistory.GasUsedRatio[i] = entry.GasUsedRatio(defaultGasLimit)
+
+		if calculateRewards {
+			feeHistory.Reward[i] = make([]*hexutil.Big, len(rewardPercentiles))
+			for j, p := range rewardPercentiles {
+				feeHistory.Reward[i][j] = (*hexutil.Big)(entry.Reward(p))
+			}
+		}
+	}
+
+	return feeHistory, nil
 }
 
 // SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// The tip cap is the gasTipCapPercentile percentile of the priority fees paid in the last
+// gasTipCapBlocks blocks, as kept in the fee history of the fee market module. If no priority
+// fees are found, we return a positive value to help client to mitigate the base fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
 		return big.NewInt(0), nil
 	}
 
+	if tipCap, err := b.gasTipCapFromFeeHistory(); err == nil {
+		return tipCap, nil
+	}
+
 	params, err := b.queryClient.FeeMarket.Params(b.ctx, &feemarkettypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
@@ -276,3 +350,32 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	}
 	return big.NewInt(maxDelta), nil
 }
+
+// gasTipCapFromFeeHistory returns the gasTipCapPercentile percentile of the priority
+// fees paid in the last gasTipCapBlocks blocks with ethereum transactions.
+func (b *Backend) gasTipCapFromFeeHistory() (*big.Int, error) {
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		BlockCount: gasTipCapBlocks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tips := make([]*big.Int, 0, len(res.Entries))
+	for _, entry := range res.Entries {
+		if len(entry.Rewards) == 0 {
+			continue
+		}
+		tips = append(tips, entry.Reward(gasTipCapPercentile))
+	}
+
+	if len(tips) == 0 {
+		return nil, errors.New("no priority fees found in the fee history")
+	}
+
+	sort.Slice(tips, func(i, j int) bool {
+		return tips[i].Cmp(tips[j]) < 0
+	})
+
+	return tips[(len(tips)-1)*gasTipCapPercentile/100], nil
+}
@@ -269,6 +269,42 @@ func (suite *BackendTestSuite) TestSuggestGasTipCap() {
 			big.NewInt(0),
 			true,
 		},
+		{
+			"pass - Gets the suggest gas tip cap from the fee history",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistory(
+					feeMarketClient,
+					1,
+					&feemarkettypes.QueryFeeHistoryRequest{BlockCount: gasTipCapBlocks},
+					[]feemarkettypes.FeeHistoryEntry{
+						{Height: 1, Rewards: feemarkettypes.NewFeeHistoryRewards([]feemarkettypes.TxPriorityFee{{GasUsed: 1, PriorityFee: big.NewInt(5)}})},
+						{Height: 2},
+						{Height: 3, Rewards: feemarkettypes.NewFeeHistoryRewards([]feemarkettypes.TxPriorityFee{{GasUsed: 1, PriorityFee: big.NewInt(7)}})},
+						{Height: 4, Rewards: feemarkettypes.NewFeeHistoryRewards([]feemarkettypes.TxPriorityFee{{GasUsed: 1, PriorityFee: big.NewInt(2)}})},
+					},
+				)
+			},
+			big.NewInt(1),
+			big.NewInt(5),
+			true,
+		},
+		{
+			"pass - Gets the suggest gas tip cap from the params without priority fees in the fee history",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistory(
+					feeMarketClient,
+					1,
+					&feemarkettypes.QueryFeeHistoryRequest{BlockCount: gasTipCapBlocks},
+					[]feemarkettypes.FeeHistoryEntry{{Height: 1}},
+				)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+			},
+			big.NewInt(8),
+			big.NewInt(1),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -364,6 +400,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			func(validator sdk.AccAddress) {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistoryError(feeMarketClient, 1)
 				RegisterBlockError(client, ethrpc.BlockNumber(1).Int64())
 			},
 			1,
@@ -377,6 +415,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			func(validator sdk.AccAddress) {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistoryError(feeMarketClient, 1)
 				_, err := RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				suite.Require().NoError(err)
 				RegisterBlockResultsError(client, 1)
@@ -394,6 +434,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistoryError(feeMarketClient, 1)
 				_, err := RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				suite.Require().NoError(err)
 				_, err = RegisterBlockResults(client, 1)
@@ -416,6 +458,8 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeHistoryError(feeMarketClient, 1)
 				_, err := RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				suite.Require().NoError(err)
 				_, err = RegisterBlockResults(client, 1)
@@ -437,6 +481,48 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
 			true,
 		},
+		{
+			"pass - Valid FeeHistoryResults object from the fee market store",
+			func(validator sdk.AccAddress) {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeHistory(
+					feeMarketClient,
+					1,
+					&feemarkettypes.QueryFeeHistoryRequest{BlockCount: 2, LastBlock: 2},
+					[]feemarkettypes.FeeHistoryEntry{
+						{
+							Height:      1,
+							BaseFee:     math.NewInt(10),
+							NextBaseFee: math.NewInt(11),
+							GasUsed:     50,
+							GasLimit:    100,
+						},
+						{
+							Height:      2,
+							BaseFee:     math.NewInt(11),
+							NextBaseFee: math.NewInt(12),
+							GasUsed:     25,
+							GasLimit:    100,
+							Rewards:     feemarkettypes.NewFeeHistoryRewards([]feemarkettypes.TxPriorityFee{{GasUsed: 10, PriorityFee: big.NewInt(1)}, {GasUsed: 15, PriorityFee: big.NewInt(3)}}),
+						},
+					},
+				)
+			},
+			2,
+			2,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(10)), (*hexutil.Big)(big.NewInt(11)), (*hexutil.Big)(big.NewInt(12))},
+				GasUsedRatio: []float64{0.5, 0.25},
+				Reward: [][]*hexutil.Big{
+					{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))},
+					{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(3)), (*hexutil.Big)(big.NewInt(3)), (*hexutil.Big)(big.NewInt(3))},
+				},
+			},
+			nil,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -64,6 +64,18 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", matchContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", matchContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -138,16 +150,24 @@ func TestRegisterParamsError(t *testing.T) {
 	require.Error(t, err)
 }
 
+// matchContextWithHeight matches the contexts on the given height, including
+// the ones the backend derives with a cancel function.
+func matchContextWithHeight(height int64) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+		return ok && len(heights) == 1 && heights[0] == strconv.FormatInt(height, 10)
+	})
+}
+
 // ETH Call
 func RegisterEthCall(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
-	queryClient.On("EthCall", ctx, request).
+	queryClient.On("EthCall", matchContextWithHeight(1), request).
 		Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 }
 
 func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
-	queryClient.On("EthCall", ctx, request).
+	queryClient.On("EthCall", matchContextWithHeight(1), request).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
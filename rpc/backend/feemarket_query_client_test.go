@@ -5,6 +5,7 @@ import (
 	"github.com/evmos/evmos/v16/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v16/rpc/types"
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+	"github.com/stretchr/testify/mock"
 )
 
 var _ feemarkettypes.QueryClient = &mocks.FeeMarketQueryClient{}
@@ -19,3 +20,14 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// FeeHistory
+func RegisterFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, height int64, req *feemarkettypes.QueryFeeHistoryRequest, entries []feemarkettypes.FeeHistoryEntry) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), req).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{Entries: entries}, nil)
+}
+
+func RegisterFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), mock.Anything).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
@@ -137,6 +137,36 @@ func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest,
 	return r0, r1
 }
 
+// ContractStats provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) ContractStats(ctx context.Context, in *types.QueryContractStatsRequest, opts ...grpc.CallOption) (*types.QueryContractStatsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryContractStatsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryContractStatsRequest, ...grpc.CallOption) *types.QueryContractStatsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryContractStatsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryContractStatsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CosmosAccount provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosmosAccountRequest, opts ...grpc.CallOption) (*types.QueryCosmosAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -167,6 +197,96 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosm
 	return r0, r1
 }
 
+// DeployerPermission provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) DeployerPermission(ctx context.Context, in *types.QueryDeployerPermissionRequest, opts ...grpc.CallOption) (*types.QueryDeployerPermissionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryDeployerPermissionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDeployerPermissionRequest, ...grpc.CallOption) *types.QueryDeployerPermissionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDeployerPermissionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDeployerPermissionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentFactories provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) DeploymentFactories(ctx context.Context, in *types.QueryDeploymentFactoriesRequest, opts ...grpc.CallOption) (*types.QueryDeploymentFactoriesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryDeploymentFactoriesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDeploymentFactoriesRequest, ...grpc.CallOption) *types.QueryDeploymentFactoriesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDeploymentFactoriesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDeploymentFactoriesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) DeploymentPolicy(ctx context.Context, in *types.QueryDeploymentPolicyRequest, opts ...grpc.CallOption) (*types.QueryDeploymentPolicyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryDeploymentPolicyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDeploymentPolicyRequest, ...grpc.CallOption) *types.QueryDeploymentPolicyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDeploymentPolicyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDeploymentPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequest, opts ...grpc.CallOption) (*types.EstimateGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -257,6 +377,36 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	return r0, r1
 }
 
+// StateDiff provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StateDiff(ctx context.Context, in *types.QueryStateDiffRequest, opts ...grpc.CallOption) (*types.QueryStateDiffResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryStateDiffResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStateDiffRequest, ...grpc.CallOption) *types.QueryStateDiffResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStateDiffResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStateDiffRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -287,6 +437,36 @@ func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageReq
 	return r0, r1
 }
 
+// TouchedAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TouchedAccounts(ctx context.Context, in *types.QueryTouchedAccountsRequest, opts ...grpc.CallOption) (*types.QueryTouchedAccountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryTouchedAccountsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTouchedAccountsRequest, ...grpc.CallOption) *types.QueryTouchedAccountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryTouchedAccountsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTouchedAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceBlock provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryTraceBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -317,6 +497,36 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBl
 	return r0, r1
 }
 
+// TraceCall provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *types.QueryTraceCallRequest, opts ...grpc.CallOption) (*types.QueryTraceCallResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryTraceCallResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) *types.QueryTraceCallResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryTraceCallResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTraceCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceTx provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *types.QueryTraceTxRequest, opts ...grpc.CallOption) (*types.QueryTraceTxResponse, error) {
 	_va := make([]interface{}, len(opts))
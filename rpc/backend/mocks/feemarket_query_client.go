@@ -77,6 +77,66 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	return r0, r1
 }
 
+// FeeHistory provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeHistory(ctx context.Context, in *types.QueryFeeHistoryRequest, opts ...grpc.CallOption) (*types.QueryFeeHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) *types.QueryFeeHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeTokenGasPrice provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeTokenGasPrice(ctx context.Context, in *types.QueryFeeTokenGasPriceRequest, opts ...grpc.CallOption) (*types.QueryFeeTokenGasPriceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeTokenGasPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeTokenGasPriceRequest, ...grpc.CallOption) *types.QueryFeeTokenGasPriceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeTokenGasPriceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeTokenGasPriceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
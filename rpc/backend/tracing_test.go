@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -262,3 +263,69 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := common.BigToAddress(common.Big1)
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	config := &evmtypes.TraceConfig{Tracer: evmtypes.TracerERC7562}
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		expTraceResult interface{}
+		expPass        bool
+	}{
+		{
+			"fail - header not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, TraceConfig: config, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returned trace result",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, TraceConfig: config, ChainId: suite.backend.chainID.Int64()})
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(callArgs, 1, config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraceResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v16/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v16/rpc/types"
+	"github.com/evmos/evmos/v16/server/config"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// estimationVerificationGasLimit is the verification gas limit used to
+// simulate the validation of a user operation during the gas estimation.
+const estimationVerificationGasLimit = 10_000_000
+
+// ErrBundlerNotConfigured is returned when the bundler key is missing.
+var ErrBundlerNotConfigured = errors.New("bundler key is not configured on the node")
+
+// API is the ERC-4337 bundler API. It validates the user operations by
+// simulating them against the EntryPoint, keeps them in a local mempool and
+// submits them in bundles signed by the bundler key.
+type API struct {
+	logger      log.Logger
+	backend     backend.EVMBackend
+	entryPoints []common.Address
+	bundler     common.Address
+	beneficiary common.Address
+	bundleSize  int
+	rules       ValidationRules
+	mempool     *Mempool
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewAPI creates an instance of the ERC-4337 bundler API and starts the
+// bundling loop if the bundler key is configured. The loop runs until the API
+// is stopped on the shutdown of the JSON-RPC server.
+func NewAPI(
+	ctx *server.Context,
+	clientCtx client.Context,
+	evmBackend backend.EVMBackend,
+) *API {
+	appConf, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		panic(err)
+	}
+	cfg := appConf.Bundler
+
+	entryPoints := make([]common.Address, len(cfg.EntryPoints))
+	for i, entryPoint := range cfg.EntryPoints {
+		entryPoints[i] = common.HexToAddress(entryPoint)
+	}
+
+	minStake, ok := new(big.Int).SetString(cfg.MinStake, 10)
+	if !ok {
+		panic(fmt.Errorf("invalid bundler min stake %s", cfg.MinStake))
+	}
+
+	api := &API{
+		logger:      ctx.Logger.With("api", "bundler"),
+		backend:     evmBackend,
+		entryPoints: entryPoints,
+		bundleSize:  cfg.MaxBundleSize,
+		rules: ValidationRules{
+			MinStake:        minStake,
+			MinUnstakeDelay: cfg.MinUnstakeDelay,
+		},
+		mempool: NewMempool(cfg.MempoolSize),
+		stop:    make(chan struct{}),
+	}
+
+	if cfg.Key == "" || clientCtx.Keyring == nil {
+		api.logger.Info("bundler key not configured, user operations will not be accepted")
+		return api
+	}
+
+	record, err := clientCtx.Keyring.Key(cfg.Key)
+	if err != nil {
+		api.logger.Error("failed to find the bundler key in the keyring", "key", cfg.Key, "error", err.Error())
+		return api
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		api.logger.Error("failed to get the bundler key address", "key", cfg.Key, "error", err.Error())
+		return api
+	}
+
+	api.bundler = common.BytesToAddress(address)
+	api.beneficiary = api.bundler
+	if cfg.Beneficiary != "" {
+		api.beneficiary = common.HexToAddress(cfg.Beneficiary)
+	}
+
+	go api.run(cfg.BundleInterval)
+
+	return api
+}
+
+// SendUserOperation validates the user operation and adds it to the mempool
+// of the bundler. It returns the user operation hash.
+func (api *API) SendUserOperation(op UserOperation, entryPoint common.Address) (common.Hash, error) {
+	api.logger.Debug("eth_sendUserOperation", "sender", op.Sender, "entry point", entryPoint)
+
+	if api.bundler == (common.Address{}) {
+		return common.Hash{}, ErrBundlerNotConfigured
+	}
+
+	if !api.isSupported(entryPoint) {
+		return common.Hash{}, NewInvalidFieldsError("unsupported entry point %s", entryPoint)
+	}
+
+	if err := op.ValidateBasic(); err != nil {
+		return common.Hash{}, err
+	}
+
+	header, err := api.backend.CurrentHeader()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if header.BaseFee != nil && op.MaxFeePerGas.ToInt().Cmp(header.BaseFee) < 0 {
+		return common.Hash{}, NewInvalidFieldsError("maxFeePerGas %s is lower than the base fee %s", op.MaxFeePerGas.ToInt(), header.BaseFee)
+	}
+
+	result, err := api.validateUserOp(&op, entryPoint)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := api.userOpHash(&op, entryPoint)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	entry := &MempoolEntry{
+		UserOp:          &op,
+		EntryPoint:      entryPoint,
+		Hash:            hash,
+		FactoryStaked:   api.rules.isStaked(result.FactoryInfo),
+		PaymasterStaked: api.rules.isStaked(result.PaymasterInfo),
+	}
+	if err := api.mempool.Add(entry); err != nil {
+		return common.Hash{}, err
+	}
+
+	return hash, nil
+}
+
+// EstimateUserOperationGas estimates the pre-verification gas, the
+// verification gas limit and the call gas limit of the user operation. The
+// signature can be a dummy one and the gas and fee fields can be omitted.
+func (api *API) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) (*UserOperationGasEstimate, error) {
+	api.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender, "entry point", entryPoint)
+
+	if !api.isSupported(entryPoint) {
+		return nil, NewInvalidFieldsError("unsupported entry point %s", entryPoint)
+	}
+
+	// simulate the validation without fees, so that no prefund is required
+	simulatedOp := op
+	simulatedOp.CallGasLimit = (*hexutil.Big)(new(big.Int))
+	simulatedOp.VerificationGasLimit = (*hexutil.Big)(big.NewInt(estimationVerificationGasLimit))
+	simulatedOp.PreVerificationGas = (*hexutil.Big)(new(big.Int))
+	simulatedOp.MaxFeePerGas = (*hexutil.Big)(new(big.Int))
+	simulatedOp.MaxPriorityFeePerGas = (*hexutil.Big)(new(big.Int))
+
+	result, _, err := api.simulateValidation(&simulatedOp, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	callGasLimit, err := api.backend.EstimateGas(evmtypes.TransactionArgs{
+		From:  &entryPoint,
+		To:    &op.Sender,
+		Input: &op.CallData,
+	}, nil)
+	if err != nil {
+		return nil, NewError(ErrCodeExecutionReverted, "%s", err.Error())
+	}
+
+	return &UserOperationGasEstimate{
+		PreVerificationGas:   hexutil.Uint64(CalcPreVerificationGas(&op)),
+		VerificationGasLimit: hexutil.Uint64(result.ReturnInfo.PreOpGas.Uint64()),
+		CallGasLimit:         callGasLimit,
+	}, nil
+}
+
+// GetUserOperationReceipt returns the receipt of a user operation bundled by
+// the node. It returns nil if the user operation is unknown or the bundle
+// transaction is not included yet.
+func (api *API) GetUserOperationReceipt(hash common.Hash) (*UserOperationReceipt, error) {
+	api.logger.Debug("eth_getUserOperationReceipt", "hash", hash)
+
+	entryPoint, txHash, found := api.mempool.Submitted(hash)
+	if !found {
+		return nil, nil
+	}
+
+	receipt, err := api.backend.GetTransactionReceipt(txHash)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	logs, _ := receipt["logs"].([]*ethtypes.Log)
+	return parseUserOperationReceipt(hash, entryPoint, logs, receipt)
+}
+
+// SupportedEntryPoints returns the EntryPoint addresses supported by the
+// bundler.
+func (api *API) SupportedEntryPoints() []common.Address {
+	api.logger.Debug("eth_supportedEntryPoints")
+	return api.entryPoints
+}
+
+// isSupported returns true if the EntryPoint is supported by the bundler.
+func (api *API) isSupported(entryPoint common.Address) bool {
+	for _, supported := range api.entryPoints {
+		if supported == entryPoint {
+			return true
+		}
+	}
+	return false
+}
+
+// userOpHash returns the hash of the user operation on the node chain.
+func (api *API) userOpHash(op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	chainID, err := api.backend.ChainID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return UserOpHash(op, entryPoint, chainID.ToInt())
+}
+
+// validateUserOp simulates the validation of the user operation and enforces
+// the ERC-7562 rules on the traced execution.
+func (api *API) validateUserOp(op *UserOperation, entryPoint common.Address) (*ValidationResult, error) {
+	result, trace, err := api.simulateValidation(op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := api.rules.Validate(op, result, trace, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// simulateValidation traces the EntryPoint simulateValidation method with the
+// ERC-7562 tracer and decodes its result.
+func (api *API) simulateValidation(op *UserOperation, entryPoint common.Address) (*ValidationResult, *evmtypes.ERC7562TracerResult, error) {
+	data, err := packSimulateValidation(op)
+	if err != nil {
+		return nil, nil, NewInvalidFieldsError("%s", err.Error())
+	}
+
+	args := evmtypes.TransactionArgs{
+		To:    &entryPoint,
+		Input: (*hexutil.Bytes)(&data),
+	}
+	traceConfig := &evmtypes.TraceConfig{Tracer: evmtypes.TracerERC7562}
+
+	res, err := api.backend.TraceCall(args, rpctypes.EthLatestBlockNumber, traceConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, err
+	}
+	var trace evmtypes.ERC7562TracerResult
+	if err := json.Unmarshal(bz, &trace); err != nil {
+		return nil, nil, err
+	}
+
+	result, err := unpackValidationResult(trace.Output)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, &trace, nil
+}
+
+// parseUserOperationReceipt builds the receipt of a user operation from the
+// logs of the bundle transaction.
+func parseUserOperationReceipt(
+	hash common.Hash,
+	entryPoint common.Address,
+	logs []*ethtypes.Log,
+	receipt map[string]interface{},
+) (*UserOperationReceipt, error) {
+	userOpEvent := entryPointABI.Events["UserOperationEvent"]
+	revertReasonEvent := entryPointABI.Events["UserOperationRevertReason"]
+	beforeExecutionEvent := entryPointABI.Events["BeforeExecution"]
+
+	// the logs of a user operation are emitted between the previous
+	// UserOperationEvent (or BeforeExecution) and its own UserOperationEvent
+	start := 0
+	for i, ethLog := range logs {
+		if ethLog.Address != entryPoint || len(ethLog.Topics) == 0 {
+			continue
+		}
+
+		switch ethLog.Topics[0] {
+		case beforeExecutionEvent.ID:
+			start = i + 1
+		case userOpEvent.ID:
+			if len(ethLog.Topics) < 4 || ethLog.Topics[1] != hash {
+				start = i + 1
+				continue
+			}
+
+			values, err := userOpEvent.Inputs.NonIndexed().Unpack(ethLog.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode UserOperationEvent: %w", err)
+			}
+			if len(values) != 4 {
+				return nil, fmt.Errorf("invalid UserOperationEvent data")
+			}
+			nonce, _ := values[0].(*big.Int)
+			success, _ := values[1].(bool)
+			actualGasCost, _ := values[2].(*big.Int)
+			actualGasUsed, _ := values[3].(*big.Int)
+
+			userOpReceipt := &UserOperationReceipt{
+				UserOpHash:    hash,
+				EntryPoint:    entryPoint,
+				Sender:        common.BytesToAddress(ethLog.Topics[2].Bytes()),
+				Paymaster:     common.BytesToAddress(ethLog.Topics[3].Bytes()),
+				Nonce:         (*hexutil.Big)(nonce),
+				ActualGasCost: (*hexutil.Big)(actualGasCost),
+				ActualGasUsed: (*hexutil.Big)(actualGasUsed),
+				Success:       success,
+				Logs:          logs[start:i],
+				Receipt:       receipt,
+			}
+
+			for _, opLog := range logs[start:i] {
+				if opLog.Address != entryPoint || len(opLog.Topics) < 2 ||
+					opLog.Topics[0] != revertReasonEvent.ID || opLog.Topics[1] != hash {
+					continue
+				}
+				values, err := revertReasonEvent.Inputs.NonIndexed().Unpack(opLog.Data)
+				if err == nil && len(values) == 2 {
+					userOpReceipt.Reason, _ = values[1].([]byte)
+				}
+			}
+
+			return userOpReceipt, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,134 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/evmos/evmos/v16/rpc/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// reputationDecayInterval is the interval at which the reputation of the
+// entities decays.
+const reputationDecayInterval = time.Hour
+
+// Stop stops the bundling loop of the API. It is safe to call it multiple
+// times. It is not a method of the API so that it is not served over JSON-RPC.
+func Stop(api *API) {
+	api.stopOnce.Do(func() { close(api.stop) })
+}
+
+// run submits a bundle of the pending user operations of each EntryPoint on
+// every interval until the API is stopped.
+func (api *API) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	decayTicker := time.NewTicker(reputationDecayInterval)
+	defer decayTicker.Stop()
+
+	for {
+		select {
+		case <-api.stop:
+			return
+		case <-decayTicker.C:
+			api.mempool.DecayReputation()
+		case <-ticker.C:
+			for _, entryPoint := range api.entryPoints {
+				if err := api.submitBundle(entryPoint); err != nil {
+					api.logger.Error("failed to submit bundle", "entry point", entryPoint, "error", err.Error())
+				}
+			}
+		}
+	}
+}
+
+// submitBundle re-validates the pending user operations of the EntryPoint and
+// submits the valid ones in a handleOps transaction signed by the bundler key.
+// The user operations rejected by the EntryPoint are dropped from the mempool.
+func (api *API) submitBundle(entryPoint common.Address) error {
+	pending := api.mempool.Pending(entryPoint, api.bundleSize)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	entries := make([]*MempoolEntry, 0, len(pending))
+	for _, entry := range pending {
+		if _, err := api.validateUserOp(entry.UserOp, entryPoint); err != nil {
+			api.logger.Debug("dropping invalid user operation", "hash", entry.Hash, "error", err.Error())
+			api.mempool.Remove(entry.Hash)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	for len(entries) > 0 {
+		ops := make([]*UserOperation, len(entries))
+		for i, entry := range entries {
+			ops[i] = entry.UserOp
+		}
+
+		data, err := packHandleOps(ops, api.beneficiary)
+		if err != nil {
+			return err
+		}
+
+		args := evmtypes.TransactionArgs{
+			From:  &api.bundler,
+			To:    &entryPoint,
+			Input: (*hexutil.Bytes)(&data),
+		}
+
+		// simulate the bundle to drop the user operations that fail with the
+		// current state
+		if _, err := api.backend.DoCall(args, rpctypes.EthLatestBlockNumber); err != nil {
+			failedOp, ok := failedOpFromError(err)
+			if !ok || !failedOp.OpIndex.IsInt64() || failedOp.OpIndex.Int64() >= int64(len(entries)) {
+				return err
+			}
+
+			index := failedOp.OpIndex.Int64()
+			api.logger.Debug("dropping failed user operation", "hash", entries[index].Hash, "reason", failedOp.Reason)
+			api.mempool.Remove(entries[index].Hash)
+			entries = append(entries[:index], entries[index+1:]...)
+			continue
+		}
+
+		txHash, err := api.backend.SendTransaction(args)
+		if err != nil {
+			return err
+		}
+
+		api.mempool.MarkSubmitted(entries, txHash)
+		api.logger.Info("submitted bundle", "entry point", entryPoint, "tx hash", txHash, "user operations", len(entries))
+		return nil
+	}
+
+	return nil
+}
+
+// failedOpFromError decodes the FailedOp error from the revert data of a call
+// error.
+func failedOpFromError(err error) (*FailedOp, bool) {
+	var revertErr *evmtypes.RevertError
+	if !errors.As(err, &revertErr) {
+		return nil, false
+	}
+
+	reason, ok := revertErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+
+	data, err := hexutil.Decode(reason)
+	if err != nil {
+		return nil, false
+	}
+
+	return unpackFailedOp(data)
+}
@@ -0,0 +1,221 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Gas overheads used to compute the pre-verification gas of a user operation.
+// They are the ones used by the reference bundler implementation.
+const (
+	fixedGasOverhead     = 21000
+	perUserOpGasOverhead = 18300
+	perUserOpWordGas     = 4
+	zeroByteGas          = 4
+	nonZeroByteGas       = 16
+	dummySignatureSize   = 65
+)
+
+// depositToSelector is the method selector of the EntryPoint depositTo method,
+// which is the only method that the entities can call during the validation.
+var depositToSelector = hexutil.MustDecode("0xb760faf9")
+
+var (
+	//go:embed abi.json
+	entryPointABIJSON []byte
+
+	// entryPointABI is the ABI of the EntryPoint v0.6 methods, errors and
+	// events used by the bundler
+	entryPointABI abi.ABI
+
+	// userOpHashArgs are the arguments used to compute the hash of the packed
+	// user operation fields
+	userOpHashArgs abi.Arguments
+	// userOpHashDomainArgs are the arguments used to compute the user operation
+	// hash from the packed user operation hash, the EntryPoint and chain ID
+	userOpHashDomainArgs abi.Arguments
+)
+
+func init() {
+	var err error
+	entryPointABI, err = abi.JSON(bytes.NewReader(entryPointABIJSON))
+	if err != nil {
+		panic(err)
+	}
+
+	addressType, _ := abi.NewType("address", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+
+	userOpHashArgs = abi.Arguments{
+		{Type: addressType}, {Type: uint256Type}, {Type: bytes32Type}, {Type: bytes32Type},
+		{Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type},
+		{Type: uint256Type}, {Type: bytes32Type},
+	}
+	userOpHashDomainArgs = abi.Arguments{{Type: bytes32Type}, {Type: addressType}, {Type: uint256Type}}
+}
+
+// StakeInfo is the stake of an entity returned by the EntryPoint.
+type StakeInfo struct {
+	Stake           *big.Int
+	UnstakeDelaySec *big.Int
+}
+
+// ReturnInfo holds the gas and validity data returned by the EntryPoint
+// simulateValidation method.
+type ReturnInfo struct {
+	PreOpGas         *big.Int
+	Prefund          *big.Int
+	SigFailed        bool
+	ValidAfter       *big.Int
+	ValidUntil       *big.Int
+	PaymasterContext []byte
+}
+
+// ValidationResult is the result of the EntryPoint simulateValidation method.
+type ValidationResult struct {
+	ReturnInfo    ReturnInfo
+	SenderInfo    StakeInfo
+	FactoryInfo   StakeInfo
+	PaymasterInfo StakeInfo
+}
+
+// UserOpHash computes the hash of the user operation for the given EntryPoint
+// and chain ID, as done by the EntryPoint getUserOpHash method.
+func UserOpHash(op *UserOperation, entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	packed, err := userOpHashArgs.Pack(
+		op.Sender,
+		bigOrZero(op.Nonce),
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		bigOrZero(op.CallGasLimit),
+		bigOrZero(op.VerificationGasLimit),
+		bigOrZero(op.PreVerificationGas),
+		bigOrZero(op.MaxFeePerGas),
+		bigOrZero(op.MaxPriorityFeePerGas),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	bz, err := userOpHashDomainArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(bz), nil
+}
+
+// CalcPreVerificationGas returns the gas overhead of the user operation that
+// is not metered by the EntryPoint, i.e. the calldata cost and the share of the
+// bundle transaction overhead.
+func CalcPreVerificationGas(op *UserOperation) uint64 {
+	// use placeholders for the values that are unknown during the estimation
+	sigSize := len(op.Signature)
+	if sigSize < dummySignatureSize {
+		sigSize = dummySignatureSize
+	}
+	packedOp := op.toEntryPoint()
+	packedOp.PreVerificationGas = big.NewInt(fixedGasOverhead)
+	packedOp.Signature = bytes.Repeat([]byte{1}, sigSize)
+
+	packed, err := entryPointABI.Methods["simulateValidation"].Inputs.Pack(packedOp)
+	if err != nil {
+		return 0
+	}
+
+	callDataCost := uint64(0)
+	for _, b := range packed {
+		if b == 0 {
+			callDataCost += zeroByteGas
+		} else {
+			callDataCost += nonZeroByteGas
+		}
+	}
+
+	words := uint64(len(packed)+31) / 32
+	return callDataCost + fixedGasOverhead + perUserOpGasOverhead + perUserOpWordGas*words
+}
+
+// packSimulateValidation returns the call data of the EntryPoint
+// simulateValidation method for the given user operation.
+func packSimulateValidation(op *UserOperation) ([]byte, error) {
+	return entryPointABI.Pack("simulateValidation", op.toEntryPoint())
+}
+
+// packHandleOps returns the call data of the EntryPoint handleOps method for
+// the given user operations and beneficiary.
+func packHandleOps(ops []*UserOperation, beneficiary common.Address) ([]byte, error) {
+	packed := make([]entryPointUserOperation, len(ops))
+	for i, op := range ops {
+		packed[i] = op.toEntryPoint()
+	}
+	return entryPointABI.Pack("handleOps", packed, beneficiary)
+}
+
+// FailedOp is the error returned by the EntryPoint when a user operation fails
+// its validation.
+type FailedOp struct {
+	OpIndex *big.Int
+	Reason  string
+}
+
+// unpackFailedOp decodes a FailedOp error from the given revert data.
+func unpackFailedOp(data []byte) (*FailedOp, bool) {
+	failedOpErr := entryPointABI.Errors["FailedOp"]
+	values, err := failedOpErr.Unpack(data)
+	if err != nil {
+		return nil, false
+	}
+
+	var failedOp FailedOp
+	if err := failedOpErr.Inputs.Copy(&failedOp, values.([]interface{})); err != nil {
+		return nil, false
+	}
+	return &failedOp, true
+}
+
+// unpackValidationResult decodes the revert data of the EntryPoint
+// simulateValidation method. Errors are returned with the corresponding
+// ERC-4337 error code.
+func unpackValidationResult(data []byte) (*ValidationResult, error) {
+	if failedOp, ok := unpackFailedOp(data); ok {
+		// AA3x reasons are related to the paymaster
+		if strings.HasPrefix(failedOp.Reason, "AA3") {
+			return nil, NewError(ErrCodeSimulatePaymasterValidation, "%s", failedOp.Reason)
+		}
+		return nil, NewError(ErrCodeSimulateValidation, "%s", failedOp.Reason)
+	}
+
+	aggregationErr := entryPointABI.Errors["ValidationResultWithAggregation"]
+	if _, err := aggregationErr.Unpack(data); err == nil {
+		return nil, NewError(ErrCodeUnsupportedAggregator, "signature aggregators are not supported")
+	}
+
+	validationResultErr := entryPointABI.Errors["ValidationResult"]
+	values, err := validationResultErr.Unpack(data)
+	if err != nil {
+		reason, errUnpack := abi.UnpackRevert(data)
+		if errUnpack != nil {
+			reason = hexutil.Encode(data)
+		}
+		return nil, NewError(ErrCodeSimulateValidation, "unexpected simulateValidation result: %s", reason)
+	}
+
+	var result ValidationResult
+	if err := validationResultErr.Inputs.Copy(&result, values.([]interface{})); err != nil {
+		return nil, fmt.Errorf("failed to decode validation result: %w", err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,170 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newUserOp() *UserOperation {
+	return &UserOperation{
+		Sender:               common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Nonce:                (*hexutil.Big)(big.NewInt(1)),
+		InitCode:             hexutil.Bytes{},
+		CallData:             hexutil.MustDecode("0xb61d27f6"),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(200_000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(60_000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(2_000_000_000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1_000_000_000)),
+		PaymasterAndData:     hexutil.Bytes{},
+		Signature:            hexutil.Bytes(make([]byte, 65)),
+	}
+}
+
+func TestUserOpHash(t *testing.T) {
+	op := newUserOp()
+	entryPoint := common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	chainID := big.NewInt(9000)
+
+	hash, err := UserOpHash(op, entryPoint, chainID)
+	require.NoError(t, err)
+
+	word := func(value *big.Int) []byte {
+		return common.LeftPadBytes(value.Bytes(), 32)
+	}
+	packed := append([]byte{}, common.LeftPadBytes(op.Sender.Bytes(), 32)...)
+	packed = append(packed, word(op.Nonce.ToInt())...)
+	packed = append(packed, crypto.Keccak256(op.InitCode)...)
+	packed = append(packed, crypto.Keccak256(op.CallData)...)
+	packed = append(packed, word(op.CallGasLimit.ToInt())...)
+	packed = append(packed, word(op.VerificationGasLimit.ToInt())...)
+	packed = append(packed, word(op.PreVerificationGas.ToInt())...)
+	packed = append(packed, word(op.MaxFeePerGas.ToInt())...)
+	packed = append(packed, word(op.MaxPriorityFeePerGas.ToInt())...)
+	packed = append(packed, crypto.Keccak256(op.PaymasterAndData)...)
+
+	domain := append([]byte{}, crypto.Keccak256(packed)...)
+	domain = append(domain, common.LeftPadBytes(entryPoint.Bytes(), 32)...)
+	domain = append(domain, word(chainID)...)
+	require.Equal(t, crypto.Keccak256Hash(domain), hash)
+
+	// the signature is not part of the hash
+	op.Signature = hexutil.Bytes{1}
+	sameHash, err := UserOpHash(op, entryPoint, chainID)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash)
+
+	otherChainHash, err := UserOpHash(op, entryPoint, big.NewInt(9001))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherChainHash)
+}
+
+func TestCalcPreVerificationGas(t *testing.T) {
+	op := newUserOp()
+	gas := CalcPreVerificationGas(op)
+	require.Greater(t, gas, uint64(fixedGasOverhead+perUserOpGasOverhead))
+
+	// the estimation does not depend on the signature content or gas values
+	op.Signature = hexutil.Bytes{}
+	op.PreVerificationGas = nil
+	require.Equal(t, gas, CalcPreVerificationGas(op))
+
+	// more call data costs more gas
+	op.CallData = append(op.CallData, 1, 2, 3, 4)
+	require.Greater(t, CalcPreVerificationGas(op), gas)
+}
+
+func TestUnpackValidationResult(t *testing.T) {
+	validationResult := ValidationResult{
+		ReturnInfo: ReturnInfo{
+			PreOpGas:         big.NewInt(50_000),
+			Prefund:          big.NewInt(1000),
+			SigFailed:        true,
+			ValidAfter:       big.NewInt(10),
+			ValidUntil:       big.NewInt(20),
+			PaymasterContext: []byte{1, 2},
+		},
+		SenderInfo:    StakeInfo{Stake: big.NewInt(1), UnstakeDelaySec: big.NewInt(2)},
+		FactoryInfo:   StakeInfo{Stake: big.NewInt(3), UnstakeDelaySec: big.NewInt(4)},
+		PaymasterInfo: StakeInfo{Stake: big.NewInt(5), UnstakeDelaySec: big.NewInt(6)},
+	}
+
+	packError := func(name string, args ...interface{}) []byte {
+		abiErr := entryPointABI.Errors[name]
+		bz, err := abiErr.Inputs.Pack(args...)
+		require.NoError(t, err)
+		return append(abiErr.ID[:4], bz...)
+	}
+
+	testCases := []struct {
+		name    string
+		data    []byte
+		expCode int
+	}{
+		{
+			"pass - validation result",
+			packError("ValidationResult", validationResult.ReturnInfo, validationResult.SenderInfo, validationResult.FactoryInfo, validationResult.PaymasterInfo),
+			0,
+		},
+		{
+			"fail - failed op",
+			packError("FailedOp", big.NewInt(0), "AA23 reverted"),
+			ErrCodeSimulateValidation,
+		},
+		{
+			"fail - failed op of the paymaster",
+			packError("FailedOp", big.NewInt(0), "AA31 paymaster deposit too low"),
+			ErrCodeSimulatePaymasterValidation,
+		},
+		{
+			"fail - aggregator",
+			packError("ValidationResultWithAggregation", validationResult.ReturnInfo, validationResult.SenderInfo, validationResult.FactoryInfo, validationResult.PaymasterInfo,
+				struct {
+					Aggregator common.Address
+					StakeInfo  StakeInfo
+				}{common.Address{1}, validationResult.SenderInfo}),
+			ErrCodeUnsupportedAggregator,
+		},
+		{
+			"fail - unexpected result",
+			[]byte{1, 2, 3, 4},
+			ErrCodeSimulateValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := unpackValidationResult(tc.data)
+			if tc.expCode != 0 {
+				var rpcErr *Error
+				require.ErrorAs(t, err, &rpcErr)
+				require.Equal(t, tc.expCode, rpcErr.ErrorCode())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, validationResult, *result)
+		})
+	}
+}
+
+func TestPackHandleOps(t *testing.T) {
+	op := newUserOp()
+	beneficiary := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	data, err := packHandleOps([]*UserOperation{op, op}, beneficiary)
+	require.NoError(t, err)
+
+	method, err := entryPointABI.MethodById(data[:4])
+	require.NoError(t, err)
+	require.Equal(t, "handleOps", method.Name)
+
+	values, err := method.Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	require.Equal(t, beneficiary, values[1])
+}
@@ -0,0 +1,66 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import "fmt"
+
+// JSON-RPC error codes defined by ERC-4337
+const (
+	// ErrCodeInvalidFields is returned for invalid user operation fields
+	ErrCodeInvalidFields = -32602
+	// ErrCodeSimulateValidation is returned when the EntryPoint or the account
+	// rejects the user operation during the simulated validation
+	ErrCodeSimulateValidation = -32500
+	// ErrCodeSimulatePaymasterValidation is returned when the paymaster rejects
+	// the user operation during the simulated validation
+	ErrCodeSimulatePaymasterValidation = -32501
+	// ErrCodeOpcodeValidation is returned when the validation breaks the
+	// ERC-7562 opcode or storage rules
+	ErrCodeOpcodeValidation = -32502
+	// ErrCodeExpiresShortly is returned when the user operation is out of its
+	// validity time range
+	ErrCodeExpiresShortly = -32503
+	// ErrCodeBannedOrThrottled is returned when the factory or the paymaster
+	// of the user operation is banned or throttled by its reputation
+	ErrCodeBannedOrThrottled = -32504
+	// ErrCodeInsufficientStake is returned when an entity requires a stake to
+	// perform the validation
+	ErrCodeInsufficientStake = -32505
+	// ErrCodeUnsupportedAggregator is returned for signature aggregators
+	ErrCodeUnsupportedAggregator = -32506
+	// ErrCodeInvalidSignature is returned when the account or paymaster
+	// signature is invalid
+	ErrCodeInvalidSignature = -32507
+	// ErrCodeExecutionReverted is returned when the execution of the call data
+	// reverts during the gas estimation
+	ErrCodeExecutionReverted = -32521
+)
+
+// Error is a JSON-RPC error with an ERC-4337 error code.
+type Error struct {
+	code    int
+	message string
+}
+
+// NewError creates a new JSON-RPC error with the given code.
+func NewError(code int, format string, args ...interface{}) *Error {
+	return &Error{
+		code:    code,
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+// NewInvalidFieldsError creates a new error for invalid user operation fields.
+func NewInvalidFieldsError(format string, args ...interface{}) *Error {
+	return NewError(ErrCodeInvalidFields, format, args...)
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.message
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *Error) ErrorCode() int {
+	return e.code
+}
@@ -0,0 +1,263 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// replacementFeeBump is the minimum fee increase (in percent) required to
+	// replace a pending user operation
+	replacementFeeBump = 10
+	// maxSubmittedOps is the maximum number of bundled user operations tracked
+	// by the mempool to serve their receipts
+	maxSubmittedOps = 10000
+)
+
+// ErrMempoolFull is returned when the mempool reached its maximum size.
+var ErrMempoolFull = errors.New("user operation mempool is full")
+
+// MempoolEntry is a user operation waiting to be bundled.
+type MempoolEntry struct {
+	UserOp     *UserOperation
+	EntryPoint common.Address
+	Hash       common.Hash
+	// FactoryStaked and PaymasterStaked are true if the factory and the
+	// paymaster of the user operation meet the minimum stake
+	FactoryStaked   bool
+	PaymasterStaked bool
+}
+
+// entities returns the factory and the paymaster of the user operation, if
+// any.
+func (e *MempoolEntry) entities() []entity {
+	var entities []entity
+	if factory := e.UserOp.Factory(); factory != (common.Address{}) {
+		entities = append(entities, entity{"factory", factory, e.FactoryStaked})
+	}
+	if paymaster := e.UserOp.Paymaster(); paymaster != (common.Address{}) {
+		entities = append(entities, entity{"paymaster", paymaster, e.PaymasterStaked})
+	}
+	return entities
+}
+
+// submittedOp is a user operation included in a bundle transaction.
+type submittedOp struct {
+	entryPoint common.Address
+	txHash     common.Hash
+}
+
+// Mempool is the local mempool of the user operations received by the node.
+// It holds at most one user operation per sender and limits the pending user
+// operations of the factories and paymasters by their stake and reputation.
+type Mempool struct {
+	mu       sync.RWMutex
+	maxSize  int
+	entries  map[common.Hash]*MempoolEntry
+	senders  map[common.Address]common.Hash
+	entities map[common.Address]int
+
+	reputation *reputation
+
+	submitted      map[common.Hash]submittedOp
+	submittedOrder []common.Hash
+}
+
+// NewMempool creates a new user operation mempool with the given maximum size.
+func NewMempool(maxSize int) *Mempool {
+	return &Mempool{
+		maxSize:    maxSize,
+		entries:    make(map[common.Hash]*MempoolEntry),
+		senders:    make(map[common.Address]common.Hash),
+		entities:   make(map[common.Address]int),
+		reputation: newReputation(),
+		submitted:  make(map[common.Hash]submittedOp),
+	}
+}
+
+// Add adds the user operation to the mempool. A pending user operation of the
+// same sender is only replaced if it has the same nonce and the new one pays
+// higher fees.
+func (m *Mempool) Add(entry *MempoolEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var replaced *MempoolEntry
+	if pendingHash, ok := m.senders[entry.UserOp.Sender]; ok {
+		pending := m.entries[pendingHash]
+		if pending.EntryPoint != entry.EntryPoint || pending.UserOp.Nonce.ToInt().Cmp(entry.UserOp.Nonce.ToInt()) != 0 {
+			return NewInvalidFieldsError("sender %s already has a pending user operation", entry.UserOp.Sender)
+		}
+		if !isFeeBumped(pending.UserOp.MaxFeePerGas.ToInt(), entry.UserOp.MaxFeePerGas.ToInt()) ||
+			!isFeeBumped(pending.UserOp.MaxPriorityFeePerGas.ToInt(), entry.UserOp.MaxPriorityFeePerGas.ToInt()) {
+			return NewInvalidFieldsError("replacement user operation must increase the fees by %d%%", replacementFeeBump)
+		}
+		replaced = pending
+	} else if len(m.entries) >= m.maxSize {
+		return ErrMempoolFull
+	}
+
+	if err := m.checkEntities(entry, replaced); err != nil {
+		return err
+	}
+
+	if replaced != nil {
+		m.remove(replaced.Hash)
+	}
+
+	m.entries[entry.Hash] = entry
+	m.senders[entry.UserOp.Sender] = entry.Hash
+	for _, entity := range entry.entities() {
+		m.entities[entity.address]++
+		m.reputation.seen(entity.address)
+	}
+	return nil
+}
+
+// checkEntities enforces the ERC-7562 reputation and mempool limits on the
+// factory and the paymaster of the user operation. The user operation it
+// replaces, if any, is not counted.
+func (m *Mempool) checkEntities(entry, replaced *MempoolEntry) error {
+	for _, entity := range entry.entities() {
+		count := m.entities[entity.address]
+		if replaced != nil {
+			for _, replacedEntity := range replaced.entities() {
+				if replacedEntity.address == entity.address {
+					count--
+				}
+			}
+		}
+
+		switch m.reputation.status(entity.address) {
+		case statusBanned:
+			return NewError(ErrCodeBannedOrThrottled, "%s %s is banned", entity.title, entity.address)
+		case statusThrottled:
+			if count >= throttledEntityMempoolCount {
+				return NewError(ErrCodeBannedOrThrottled, "%s %s is throttled", entity.title, entity.address)
+			}
+		}
+
+		if !entity.staked && count >= sameUnstakedEntityMempoolCount {
+			return NewError(ErrCodeInsufficientStake, "unstaked %s %s reached the limit of %d pending user operations", entity.title, entity.address, sameUnstakedEntityMempoolCount)
+		}
+	}
+	return nil
+}
+
+// Remove removes the user operation with the given hash from the mempool.
+func (m *Mempool) Remove(hash common.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(hash)
+}
+
+// remove removes the user operation without locking the mempool.
+func (m *Mempool) remove(hash common.Hash) {
+	entry, ok := m.entries[hash]
+	if !ok {
+		return
+	}
+	delete(m.entries, hash)
+	delete(m.senders, entry.UserOp.Sender)
+	for _, entity := range entry.entities() {
+		m.entities[entity.address]--
+		if m.entities[entity.address] <= 0 {
+			delete(m.entities, entity.address)
+		}
+	}
+}
+
+// Len returns the number of pending user operations.
+func (m *Mempool) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.entries)
+}
+
+// Pending returns up to max pending user operations of the EntryPoint, sorted
+// by priority fee.
+func (m *Mempool) Pending(entryPoint common.Address, max int) []*MempoolEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := make([]*MempoolEntry, 0, len(m.entries))
+	for _, entry := range m.entries {
+		if entry.EntryPoint == entryPoint {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		cmp := entries[i].UserOp.MaxPriorityFeePerGas.ToInt().Cmp(entries[j].UserOp.MaxPriorityFeePerGas.ToInt())
+		if cmp != 0 {
+			return cmp > 0
+		}
+		return bytes.Compare(entries[i].Hash.Bytes(), entries[j].Hash.Bytes()) < 0
+	})
+
+	if len(entries) > max {
+		entries = entries[:max]
+	}
+	return entries
+}
+
+// MarkSubmitted removes the user operations from the mempool and records the
+// hash of the bundle transaction that includes them.
+func (m *Mempool) MarkSubmitted(entries []*MempoolEntry, txHash common.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, entry := range entries {
+		m.remove(entry.Hash)
+		for _, entity := range entry.entities() {
+			m.reputation.included(entity.address)
+		}
+
+		if _, ok := m.submitted[entry.Hash]; !ok {
+			m.submittedOrder = append(m.submittedOrder, entry.Hash)
+		}
+		m.submitted[entry.Hash] = submittedOp{entryPoint: entry.EntryPoint, txHash: txHash}
+	}
+
+	// forget the oldest bundled user operations
+	for len(m.submittedOrder) > maxSubmittedOps {
+		delete(m.submitted, m.submittedOrder[0])
+		m.submittedOrder = m.submittedOrder[1:]
+	}
+}
+
+// DecayReputation reduces the reputation counters of the entities. It is
+// called hourly so that the throttled and banned entities recover over time.
+func (m *Mempool) DecayReputation() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.reputation.decay()
+}
+
+// Submitted returns the EntryPoint and the bundle transaction hash of a
+// bundled user operation.
+func (m *Mempool) Submitted(hash common.Hash) (entryPoint common.Address, txHash common.Hash, found bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	op, found := m.submitted[hash]
+	return op.entryPoint, op.txHash, found
+}
+
+// isFeeBumped returns true if the new fee is at least replacementFeeBump
+// percent higher than the old fee.
+func isFeeBumped(oldFee, newFee *big.Int) bool {
+	minFee := new(big.Int).Mul(oldFee, big.NewInt(100+replacementFeeBump))
+	minFee.Quo(minFee, big.NewInt(100))
+	return newFee.Cmp(minFee) >= 0
+}
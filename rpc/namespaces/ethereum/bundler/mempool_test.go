@@ -0,0 +1,212 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newMempoolEntry(sender byte, nonce, priorityFee int64) *MempoolEntry {
+	op := newUserOp()
+	op.Sender = common.Address{sender}
+	op.Nonce = (*hexutil.Big)(big.NewInt(nonce))
+	op.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(priorityFee))
+	op.MaxFeePerGas = (*hexutil.Big)(big.NewInt(priorityFee * 2))
+	return &MempoolEntry{
+		UserOp:     op,
+		EntryPoint: common.Address{0xee},
+		Hash:       common.Hash{sender, byte(nonce), byte(priorityFee)},
+	}
+}
+
+func TestMempoolAdd(t *testing.T) {
+	testCases := []struct {
+		name     string
+		maxSize  int
+		pending  *MempoolEntry
+		entry    *MempoolEntry
+		expPass  bool
+		expCount int
+	}{
+		{
+			"pass - new sender",
+			2,
+			newMempoolEntry(1, 0, 100),
+			newMempoolEntry(2, 0, 100),
+			true,
+			2,
+		},
+		{
+			"pass - replacement with bumped fees",
+			2,
+			newMempoolEntry(1, 0, 100),
+			newMempoolEntry(1, 0, 110),
+			true,
+			1,
+		},
+		{
+			"fail - replacement without bumped fees",
+			2,
+			newMempoolEntry(1, 0, 100),
+			newMempoolEntry(1, 0, 109),
+			false,
+			1,
+		},
+		{
+			"fail - sender with a pending user operation of another nonce",
+			2,
+			newMempoolEntry(1, 0, 100),
+			newMempoolEntry(1, 1, 200),
+			false,
+			1,
+		},
+		{
+			"fail - mempool full",
+			1,
+			newMempoolEntry(1, 0, 100),
+			newMempoolEntry(2, 0, 100),
+			false,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mempool := NewMempool(tc.maxSize)
+			require.NoError(t, mempool.Add(tc.pending))
+
+			err := mempool.Add(tc.entry)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+			require.Equal(t, tc.expCount, mempool.Len())
+		})
+	}
+}
+
+func TestMempoolPending(t *testing.T) {
+	mempool := NewMempool(10)
+	low := newMempoolEntry(1, 0, 100)
+	high := newMempoolEntry(2, 0, 300)
+	mid := newMempoolEntry(3, 0, 200)
+	other := newMempoolEntry(4, 0, 400)
+	other.EntryPoint = common.Address{0xef}
+
+	for _, entry := range []*MempoolEntry{low, high, mid, other} {
+		require.NoError(t, mempool.Add(entry))
+	}
+
+	require.Equal(t, []*MempoolEntry{high, mid, low}, mempool.Pending(common.Address{0xee}, 10))
+	require.Equal(t, []*MempoolEntry{high, mid}, mempool.Pending(common.Address{0xee}, 2))
+	require.Equal(t, []*MempoolEntry{other}, mempool.Pending(common.Address{0xef}, 10))
+
+	mempool.Remove(high.Hash)
+	require.Equal(t, []*MempoolEntry{mid, low}, mempool.Pending(common.Address{0xee}, 10))
+
+	// the sender can submit a new user operation once the pending one is removed
+	require.NoError(t, mempool.Add(newMempoolEntry(2, 1, 100)))
+}
+
+func TestMempoolMarkSubmitted(t *testing.T) {
+	mempool := NewMempool(10)
+	entry := newMempoolEntry(1, 0, 100)
+	require.NoError(t, mempool.Add(entry))
+
+	_, _, found := mempool.Submitted(entry.Hash)
+	require.False(t, found)
+
+	txHash := common.Hash{0xaa}
+	mempool.MarkSubmitted([]*MempoolEntry{entry}, txHash)
+	require.Equal(t, 0, mempool.Len())
+
+	entryPoint, bundleHash, found := mempool.Submitted(entry.Hash)
+	require.True(t, found)
+	require.Equal(t, entry.EntryPoint, entryPoint)
+	require.Equal(t, txHash, bundleHash)
+}
+
+func TestMempoolEntityLimits(t *testing.T) {
+	paymaster := common.Address{0xaa}
+	newPaymasterEntry := func(sender byte, staked bool) *MempoolEntry {
+		entry := newMempoolEntry(sender, 0, 100)
+		entry.UserOp.PaymasterAndData = paymaster.Bytes()
+		entry.PaymasterStaked = staked
+		return entry
+	}
+
+	// an unstaked paymaster is limited to sameUnstakedEntityMempoolCount
+	// pending user operations
+	mempool := NewMempool(100)
+	for i := 0; i < sameUnstakedEntityMempoolCount; i++ {
+		require.NoError(t, mempool.Add(newPaymasterEntry(byte(i), false)))
+	}
+	err := mempool.Add(newPaymasterEntry(sameUnstakedEntityMempoolCount, false))
+	require.Error(t, err)
+	require.Equal(t, ErrCodeInsufficientStake, err.(*Error).ErrorCode())
+
+	// a replacement does not count the user operation it replaces
+	replacement := newPaymasterEntry(0, false)
+	replacement.UserOp.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(200))
+	replacement.UserOp.MaxFeePerGas = (*hexutil.Big)(big.NewInt(400))
+	replacement.Hash = common.Hash{0xff}
+	require.NoError(t, mempool.Add(replacement))
+
+	// removing a user operation frees a slot
+	mempool.Remove(replacement.Hash)
+	require.NoError(t, mempool.Add(newPaymasterEntry(0, false)))
+
+	// a staked paymaster is not limited
+	require.NoError(t, mempool.Add(newPaymasterEntry(sameUnstakedEntityMempoolCount, true)))
+}
+
+func TestMempoolReputation(t *testing.T) {
+	paymaster := common.Address{0xaa}
+	mempool := NewMempool(100)
+	newPaymasterEntry := func(sender byte) *MempoolEntry {
+		entry := newMempoolEntry(sender, 0, 100)
+		entry.UserOp.PaymasterAndData = paymaster.Bytes()
+		entry.PaymasterStaked = true
+		return entry
+	}
+
+	// the user operations of the paymaster are seen but never included
+	seen := minInclusionRateDenominator * (throttlingSlack + 1)
+	for i := 0; i < seen; i++ {
+		entry := newPaymasterEntry(byte(i))
+		require.NoError(t, mempool.Add(entry))
+		mempool.Remove(entry.Hash)
+	}
+	require.Equal(t, statusThrottled, mempool.reputation.status(paymaster))
+
+	// a throttled paymaster is limited to throttledEntityMempoolCount pending
+	// user operations
+	for i := 0; i < throttledEntityMempoolCount; i++ {
+		require.NoError(t, mempool.Add(newPaymasterEntry(byte(i))))
+	}
+	err := mempool.Add(newPaymasterEntry(throttledEntityMempoolCount))
+	require.Error(t, err)
+	require.Equal(t, ErrCodeBannedOrThrottled, err.(*Error).ErrorCode())
+
+	// the included user operations restore the reputation
+	mempool.MarkSubmitted(mempool.Pending(common.Address{0xee}, 10), common.Hash{0xbb})
+	require.Equal(t, statusOK, mempool.reputation.status(paymaster))
+
+	// an entity is banned above the ban slack
+	mempool.reputation.entry(paymaster).opsSeen = minInclusionRateDenominator * (throttledEntityMempoolCount + banSlack + 1)
+	require.Equal(t, statusBanned, mempool.reputation.status(paymaster))
+	err = mempool.Add(newPaymasterEntry(0))
+	require.Error(t, err)
+	require.Equal(t, ErrCodeBannedOrThrottled, err.(*Error).ErrorCode())
+
+	// the reputation recovers over time
+	for i := 0; i < 100; i++ {
+		mempool.DecayReputation()
+	}
+	require.Equal(t, statusOK, mempool.reputation.status(paymaster))
+	require.NoError(t, mempool.Add(newPaymasterEntry(0)))
+}
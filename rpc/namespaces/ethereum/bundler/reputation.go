@@ -0,0 +1,101 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import "github.com/ethereum/go-ethereum/common"
+
+// ERC-7562 reputation constants
+const (
+	// minInclusionRateDenominator is the ratio of the seen user operations of
+	// an entity that are expected to be included in a bundle
+	minInclusionRateDenominator = 10
+	// throttlingSlack is the number of seen user operations allowed above the
+	// expected inclusion rate before throttling an entity
+	throttlingSlack = 10
+	// banSlack is the number of seen user operations allowed above the
+	// expected inclusion rate before banning an entity
+	banSlack = 50
+	// throttledEntityMempoolCount is the maximum number of pending user
+	// operations of a throttled entity
+	throttledEntityMempoolCount = 4
+	// sameUnstakedEntityMempoolCount is the maximum number of pending user
+	// operations that use the same unstaked factory or paymaster
+	sameUnstakedEntityMempoolCount = 10
+)
+
+// reputationStatus is the ERC-7562 status of an entity.
+type reputationStatus int
+
+const (
+	statusOK reputationStatus = iota
+	statusThrottled
+	statusBanned
+)
+
+// reputationEntry holds the user operation counters of an entity.
+type reputationEntry struct {
+	opsSeen     uint64
+	opsIncluded uint64
+}
+
+// reputation tracks the ERC-7562 reputation of the factories and paymasters
+// of the user operations received by the bundler. It is not safe for
+// concurrent use and is guarded by the mempool lock.
+type reputation struct {
+	entries map[common.Address]*reputationEntry
+}
+
+// newReputation creates an empty reputation table.
+func newReputation() *reputation {
+	return &reputation{entries: make(map[common.Address]*reputationEntry)}
+}
+
+// entry returns the counters of the entity, creating them if needed.
+func (r *reputation) entry(address common.Address) *reputationEntry {
+	entry, ok := r.entries[address]
+	if !ok {
+		entry = &reputationEntry{}
+		r.entries[address] = entry
+	}
+	return entry
+}
+
+// seen increments the seen user operations of the entity.
+func (r *reputation) seen(address common.Address) {
+	r.entry(address).opsSeen++
+}
+
+// included increments the included user operations of the entity.
+func (r *reputation) included(address common.Address) {
+	r.entry(address).opsIncluded++
+}
+
+// status returns the reputation status of the entity.
+func (r *reputation) status(address common.Address) reputationStatus {
+	entry, ok := r.entries[address]
+	if !ok {
+		return statusOK
+	}
+
+	maxSeen := entry.opsSeen / minInclusionRateDenominator
+	switch {
+	case maxSeen <= entry.opsIncluded+throttlingSlack:
+		return statusOK
+	case maxSeen <= entry.opsIncluded+banSlack:
+		return statusThrottled
+	default:
+		return statusBanned
+	}
+}
+
+// decay reduces the counters of every entity by 1/24th, so that the
+// reputation recovers over time when called hourly.
+func (r *reputation) decay() {
+	for address, entry := range r.entries {
+		entry.opsSeen -= entry.opsSeen / 24
+		entry.opsIncluded -= entry.opsIncluded / 24
+		if entry.opsSeen == 0 && entry.opsIncluded == 0 {
+			delete(r.entries, address)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// UserOperation defines an ERC-4337 user operation as sent over JSON-RPC, using
+// the format of the EntryPoint v0.6.
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+// entryPointUserOperation is the ABI representation of a user operation.
+// The field names match the components of the UserOperation tuple.
+type entryPointUserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// toEntryPoint returns the ABI representation of the user operation. Missing
+// numeric fields are set to zero.
+func (op *UserOperation) toEntryPoint() entryPointUserOperation {
+	return entryPointUserOperation{
+		Sender:               op.Sender,
+		Nonce:                bigOrZero(op.Nonce),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         bigOrZero(op.CallGasLimit),
+		VerificationGasLimit: bigOrZero(op.VerificationGasLimit),
+		PreVerificationGas:   bigOrZero(op.PreVerificationGas),
+		MaxFeePerGas:         bigOrZero(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: bigOrZero(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+// Factory returns the factory address of the user operation, if any.
+func (op *UserOperation) Factory() common.Address {
+	if len(op.InitCode) < common.AddressLength {
+		return common.Address{}
+	}
+	return common.BytesToAddress(op.InitCode[:common.AddressLength])
+}
+
+// Paymaster returns the paymaster address of the user operation, if any.
+func (op *UserOperation) Paymaster() common.Address {
+	if len(op.PaymasterAndData) < common.AddressLength {
+		return common.Address{}
+	}
+	return common.BytesToAddress(op.PaymasterAndData[:common.AddressLength])
+}
+
+// ValidateBasic performs a stateless validation of the user operation fields.
+func (op *UserOperation) ValidateBasic() error {
+	if op.Sender == (common.Address{}) {
+		return NewInvalidFieldsError("missing sender")
+	}
+	for name, value := range map[string]*hexutil.Big{
+		"nonce":                op.Nonce,
+		"callGasLimit":         op.CallGasLimit,
+		"verificationGasLimit": op.VerificationGasLimit,
+		"preVerificationGas":   op.PreVerificationGas,
+		"maxFeePerGas":         op.MaxFeePerGas,
+		"maxPriorityFeePerGas": op.MaxPriorityFeePerGas,
+	} {
+		if value == nil {
+			return NewInvalidFieldsError("missing %s", name)
+		}
+	}
+	if len(op.InitCode) != 0 && len(op.InitCode) < common.AddressLength {
+		return NewInvalidFieldsError("initCode must start with the factory address")
+	}
+	if len(op.PaymasterAndData) != 0 && len(op.PaymasterAndData) < common.AddressLength {
+		return NewInvalidFieldsError("paymasterAndData must start with the paymaster address")
+	}
+	if op.MaxPriorityFeePerGas.ToInt().Cmp(op.MaxFeePerGas.ToInt()) > 0 {
+		return NewInvalidFieldsError("maxPriorityFeePerGas is higher than maxFeePerGas")
+	}
+	if op.PreVerificationGas.ToInt().Cmp(new(big.Int).SetUint64(CalcPreVerificationGas(op))) < 0 {
+		return NewInvalidFieldsError("preVerificationGas too low, expected at least %d", CalcPreVerificationGas(op))
+	}
+	return nil
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOperationGasEstimate struct {
+	PreVerificationGas   hexutil.Uint64 `json:"preVerificationGas"`
+	VerificationGasLimit hexutil.Uint64 `json:"verificationGasLimit"`
+	CallGasLimit         hexutil.Uint64 `json:"callGasLimit"`
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash            `json:"userOpHash"`
+	EntryPoint    common.Address         `json:"entryPoint"`
+	Sender        common.Address         `json:"sender"`
+	Nonce         *hexutil.Big           `json:"nonce"`
+	Paymaster     common.Address         `json:"paymaster"`
+	ActualGasCost *hexutil.Big           `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big           `json:"actualGasUsed"`
+	Success       bool                   `json:"success"`
+	Reason        hexutil.Bytes          `json:"reason,omitempty"`
+	Logs          []*ethtypes.Log        `json:"logs"`
+	Receipt       map[string]interface{} `json:"receipt"`
+}
+
+// bigOrZero returns the value of the given hex big integer or zero if it is nil.
+func bigOrZero(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(value.ToInt())
+}
@@ -0,0 +1,227 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"bytes"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+const (
+	// validUntilMargin is the minimum remaining validity of a user operation
+	// to be accepted, so that it can be included in a bundle
+	validUntilMargin = 30 * time.Second
+	// maxAssociatedSlotOffset is the maximum offset from keccak(address || x)
+	// of a storage slot associated with an address
+	maxAssociatedSlotOffset = 128
+)
+
+// bannedOpcodes are the opcodes that cannot be used by the entities during the
+// validation of a user operation (ERC-7562 OP-011). GAS is only reported by
+// the tracer when it is not followed by a call.
+var bannedOpcodes = []vm.OpCode{
+	vm.GASPRICE, vm.GASLIMIT, vm.DIFFICULTY, vm.TIMESTAMP, vm.BASEFEE, vm.BLOCKHASH,
+	vm.NUMBER, vm.SELFBALANCE, vm.BALANCE, vm.ORIGIN, vm.GAS, vm.CREATE, vm.COINBASE,
+	vm.SELFDESTRUCT,
+}
+
+// entity is a contract involved in the validation of a user operation.
+type entity struct {
+	title   string
+	address common.Address
+	staked  bool
+}
+
+// ValidationRules enforces the ERC-7562 validation rules on the data collected
+// by the ERC-7562 tracer during the simulation of a user operation validation.
+type ValidationRules struct {
+	MinStake        *big.Int
+	MinUnstakeDelay uint64
+}
+
+// isStaked returns true if the stake info meets the minimum stake and unstake
+// delay.
+func (r ValidationRules) isStaked(info StakeInfo) bool {
+	return info.Stake != nil && info.Stake.Cmp(r.MinStake) >= 0 &&
+		info.UnstakeDelaySec != nil && info.UnstakeDelaySec.Cmp(new(big.Int).SetUint64(r.MinUnstakeDelay)) >= 0
+}
+
+// Validate checks the validation result returned by the EntryPoint and the
+// traced opcodes, calls and storage accesses of each validation phase.
+func (r ValidationRules) Validate(
+	op *UserOperation,
+	result *ValidationResult,
+	trace *evmtypes.ERC7562TracerResult,
+	now time.Time,
+) error {
+	if result.ReturnInfo.SigFailed {
+		return NewError(ErrCodeInvalidSignature, "invalid user operation signature")
+	}
+
+	if validAfter := result.ReturnInfo.ValidAfter; validAfter != nil && validAfter.Cmp(big.NewInt(now.Unix())) > 0 {
+		return NewError(ErrCodeExpiresShortly, "user operation is not valid until %s", validAfter)
+	}
+
+	validUntil := result.ReturnInfo.ValidUntil
+	if validUntil != nil && validUntil.Sign() > 0 && validUntil.Cmp(big.NewInt(now.Add(validUntilMargin).Unix())) < 0 {
+		return NewError(ErrCodeExpiresShortly, "user operation expires at %s", validUntil)
+	}
+
+	account := entity{"account", op.Sender, r.isStaked(result.SenderInfo)}
+	factory := entity{"factory", op.Factory(), r.isStaked(result.FactoryInfo)}
+	paymaster := entity{"paymaster", op.Paymaster(), r.isStaked(result.PaymasterInfo)}
+
+	for _, phase := range trace.Phases {
+		var current entity
+		switch {
+		case phase.TopLevelTarget == (common.Address{}):
+			// the EntryPoint did not call any entity in this phase
+			continue
+		case phase.TopLevelTarget == account.address:
+			current = account
+		case paymaster.address != (common.Address{}) && phase.TopLevelTarget == paymaster.address:
+			current = paymaster
+		case factory.address != (common.Address{}):
+			// the factory is called by the EntryPoint through the SenderCreator
+			current = factory
+		default:
+			return NewError(ErrCodeOpcodeValidation, "unexpected call from the EntryPoint to %s", phase.TopLevelTarget)
+		}
+
+		if err := r.validatePhase(op, phase, current, account, factory, trace.Keccak); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePhase checks the opcodes, calls and storage accesses of the
+// validation phase of the given entity.
+func (r ValidationRules) validatePhase(
+	op *UserOperation,
+	phase *evmtypes.ERC7562Phase,
+	current, account, factory entity,
+	keccak []hexutil.Bytes,
+) error {
+	if phase.OOG {
+		return NewError(ErrCodeOpcodeValidation, "%s internally reverts on out of gas", current.title)
+	}
+
+	for _, opcode := range bannedOpcodes {
+		if phase.Opcodes[opcode.String()] > 0 {
+			return NewError(ErrCodeOpcodeValidation, "%s uses banned opcode %s", current.title, opcode)
+		}
+	}
+
+	// CREATE2 is only allowed once by the factory to deploy the sender
+	create2Count := phase.Opcodes[vm.CREATE2.String()]
+	if (current.title != factory.title && create2Count > 0) || create2Count > 1 {
+		return NewError(ErrCodeOpcodeValidation, "%s uses banned opcode %s", current.title, vm.CREATE2)
+	}
+
+	for _, selector := range phase.EntryPointCalls {
+		if len(selector) != 0 && !bytes.Equal(selector, depositToSelector) {
+			return NewError(ErrCodeOpcodeValidation, "%s calls the EntryPoint method %s", current.title, selector)
+		}
+	}
+
+	for address, size := range phase.ContractSize {
+		if size == 0 && address != op.Sender && !isPrecompile(address) {
+			return NewError(ErrCodeOpcodeValidation, "%s accesses un-deployed contract %s", current.title, address)
+		}
+	}
+
+	for address, access := range phase.Access {
+		// the sender storage can always be accessed
+		if address == account.address {
+			continue
+		}
+
+		for slot := range access.Reads {
+			_, written := access.Writes[slot]
+			if err := r.validateStorageAccess(op, address, common.HexToHash(slot), !written, current, factory, keccak); err != nil {
+				return err
+			}
+		}
+		for slot := range access.Writes {
+			if err := r.validateStorageAccess(op, address, common.HexToHash(slot), false, current, factory, keccak); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateStorageAccess checks the access of the entity to the slot of the
+// given contract storage (ERC-7562 STO rules).
+func (r ValidationRules) validateStorageAccess(
+	op *UserOperation,
+	address common.Address,
+	slot common.Hash,
+	readOnly bool,
+	current, factory entity,
+	keccak []hexutil.Bytes,
+) error {
+	// storage associated with the sender is allowed if the sender already
+	// exists or the factory is staked
+	if isAssociated(slot, op.Sender, keccak) {
+		if len(op.InitCode) != 0 && !factory.staked {
+			return NewError(ErrCodeInsufficientStake, "unstaked factory: %s accesses storage of %s associated with the undeployed sender", current.title, address)
+		}
+		return nil
+	}
+
+	// the entity own storage and the storage associated with the entity
+	// require the entity to be staked
+	if address == current.address || isAssociated(slot, current.address, keccak) {
+		if !current.staked {
+			return NewError(ErrCodeInsufficientStake, "unstaked %s accesses its storage %s slot %s", current.title, address, slot)
+		}
+		return nil
+	}
+
+	// staked entities can read any storage
+	if readOnly && current.staked {
+		return nil
+	}
+
+	return NewError(ErrCodeOpcodeValidation, "%s accesses storage of %s slot %s", current.title, address, slot)
+}
+
+// isAssociated returns true if the storage slot is associated with the given
+// address, i.e. the slot is the address itself or it is keccak(address || x) + n
+// with n <= 128.
+func isAssociated(slot common.Hash, address common.Address, keccak []hexutil.Bytes) bool {
+	addressWord := common.LeftPadBytes(address.Bytes(), common.HashLength)
+	if bytes.Equal(slot.Bytes(), addressWord) {
+		return true
+	}
+
+	slotValue := slot.Big()
+	for _, input := range keccak {
+		if len(input) < common.HashLength || !bytes.Equal(input[:common.HashLength], addressWord) {
+			continue
+		}
+		offset := new(big.Int).Sub(slotValue, crypto.Keccak256Hash(input).Big())
+		if offset.Sign() >= 0 && offset.Cmp(big.NewInt(maxAssociatedSlotOffset)) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrecompile returns true if the address is in the range of the Ethereum and
+// EVM extensions precompiled contracts.
+func isPrecompile(address common.Address) bool {
+	return new(big.Int).SetBytes(address.Bytes()).BitLen() <= 16
+}
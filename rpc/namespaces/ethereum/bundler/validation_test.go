@@ -0,0 +1,212 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func TestValidationRulesValidate(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	rules := ValidationRules{MinStake: big.NewInt(100), MinUnstakeDelay: 10}
+	staked := StakeInfo{Stake: big.NewInt(100), UnstakeDelaySec: big.NewInt(10)}
+	unstaked := StakeInfo{Stake: big.NewInt(0), UnstakeDelaySec: big.NewInt(0)}
+
+	factoryAddr := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	paymasterAddr := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	tokenAddr := common.HexToAddress("0x5555555555555555555555555555555555555555")
+
+	var (
+		op     *UserOperation
+		result *ValidationResult
+		trace  *evmtypes.ERC7562TracerResult
+	)
+
+	accountPhase := func() *evmtypes.ERC7562Phase {
+		return &evmtypes.ERC7562Phase{
+			TopLevelTarget: op.Sender,
+			Opcodes:        map[string]uint64{},
+			Access:         map[common.Address]*evmtypes.ERC7562StorageAccess{},
+			ContractSize:   map[common.Address]int{},
+		}
+	}
+
+	// balanceSlot is the slot of the sender in a mapping at slot 0
+	balanceInput := append(common.LeftPadBytes(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes(), 32), make([]byte, 32)...)
+	balanceSlot := crypto.Keccak256Hash(balanceInput)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expCode  int
+	}{
+		{
+			"pass - no banned access",
+			func() {},
+			0,
+		},
+		{
+			"fail - signature failed",
+			func() { result.ReturnInfo.SigFailed = true },
+			ErrCodeInvalidSignature,
+		},
+		{
+			"fail - not valid yet",
+			func() { result.ReturnInfo.ValidAfter = big.NewInt(now.Unix() + 1) },
+			ErrCodeExpiresShortly,
+		},
+		{
+			"fail - expires shortly",
+			func() { result.ReturnInfo.ValidUntil = big.NewInt(now.Unix() + 10) },
+			ErrCodeExpiresShortly,
+		},
+		{
+			"fail - banned opcode",
+			func() { trace.Phases[0].Opcodes["TIMESTAMP"] = 1 },
+			ErrCodeOpcodeValidation,
+		},
+		{
+			"fail - out of gas",
+			func() { trace.Phases[0].OOG = true },
+			ErrCodeOpcodeValidation,
+		},
+		{
+			"fail - CREATE2 by the account",
+			func() { trace.Phases[0].Opcodes["CREATE2"] = 1 },
+			ErrCodeOpcodeValidation,
+		},
+		{
+			"pass - CREATE2 by the factory",
+			func() {
+				op.InitCode = append(factoryAddr.Bytes(), 1, 2, 3, 4)
+				phase := accountPhase()
+				phase.TopLevelTarget = common.HexToAddress("0x7fc98430eaedbb6070b35b39d798725049088348")
+				phase.Opcodes["CREATE2"] = 1
+				trace.Phases = append([]*evmtypes.ERC7562Phase{phase}, trace.Phases...)
+			},
+			0,
+		},
+		{
+			"fail - EntryPoint method call",
+			func() { trace.Phases[0].EntryPointCalls = []hexutil.Bytes{{1, 2, 3, 4}} },
+			ErrCodeOpcodeValidation,
+		},
+		{
+			"pass - EntryPoint depositTo call",
+			func() { trace.Phases[0].EntryPointCalls = []hexutil.Bytes{depositToSelector, {}} },
+			0,
+		},
+		{
+			"fail - call to an undeployed contract",
+			func() { trace.Phases[0].ContractSize[tokenAddr] = 0 },
+			ErrCodeOpcodeValidation,
+		},
+		{
+			"pass - call to a precompile",
+			func() { trace.Phases[0].ContractSize[common.BytesToAddress([]byte{0x08, 0x00})] = 0 },
+			0,
+		},
+		{
+			"pass - storage associated with the sender",
+			func() {
+				trace.Keccak = []hexutil.Bytes{balanceInput}
+				trace.Phases[0].Access[tokenAddr] = &evmtypes.ERC7562StorageAccess{
+					Reads:  map[string]uint64{balanceSlot.Hex(): 1},
+					Writes: map[string]uint64{balanceSlot.Hex(): 1},
+				}
+			},
+			0,
+		},
+		{
+			"fail - storage associated with an undeployed sender and an unstaked factory",
+			func() {
+				op.InitCode = append(factoryAddr.Bytes(), 1, 2, 3, 4)
+				trace.Keccak = []hexutil.Bytes{balanceInput}
+				trace.Phases[0].Access[tokenAddr] = &evmtypes.ERC7562StorageAccess{
+					Reads: map[string]uint64{common.BigToHash(new(big.Int).Add(balanceSlot.Big(), big.NewInt(1))).Hex(): 1},
+				}
+			},
+			ErrCodeInsufficientStake,
+		},
+		{
+			"fail - unrelated storage",
+			func() {
+				trace.Phases[0].Access[tokenAddr] = &evmtypes.ERC7562StorageAccess{
+					Reads: map[string]uint64{common.Hash{1}.Hex(): 1},
+				}
+			},
+			ErrCodeOpcodeValidation,
+		},
+		{
+			"fail - unstaked paymaster own storage",
+			func() {
+				op.PaymasterAndData = paymasterAddr.Bytes()
+				phase := accountPhase()
+				phase.TopLevelTarget = paymasterAddr
+				phase.Access[paymasterAddr] = &evmtypes.ERC7562StorageAccess{
+					Writes: map[string]uint64{common.Hash{1}.Hex(): 1},
+				}
+				trace.Phases = append(trace.Phases, phase)
+			},
+			ErrCodeInsufficientStake,
+		},
+		{
+			"pass - staked paymaster own storage and unrelated reads",
+			func() {
+				op.PaymasterAndData = paymasterAddr.Bytes()
+				result.PaymasterInfo = staked
+				phase := accountPhase()
+				phase.TopLevelTarget = paymasterAddr
+				phase.Access[paymasterAddr] = &evmtypes.ERC7562StorageAccess{
+					Writes: map[string]uint64{common.Hash{1}.Hex(): 1},
+				}
+				phase.Access[tokenAddr] = &evmtypes.ERC7562StorageAccess{
+					Reads: map[string]uint64{common.Hash{1}.Hex(): 1},
+				}
+				trace.Phases = append(trace.Phases, phase)
+			},
+			0,
+		},
+		{
+			"fail - unexpected top level call",
+			func() {
+				phase := accountPhase()
+				phase.TopLevelTarget = tokenAddr
+				trace.Phases = append(trace.Phases, phase)
+			},
+			ErrCodeOpcodeValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			op = newUserOp()
+			result = &ValidationResult{
+				ReturnInfo:    ReturnInfo{ValidAfter: big.NewInt(0), ValidUntil: big.NewInt(0)},
+				SenderInfo:    unstaked,
+				FactoryInfo:   unstaked,
+				PaymasterInfo: unstaked,
+			}
+			trace = &evmtypes.ERC7562TracerResult{}
+			trace.Phases = []*evmtypes.ERC7562Phase{accountPhase()}
+
+			tc.malleate()
+
+			err := rules.Validate(op, result, trace, now)
+			if tc.expCode != 0 {
+				var rpcErr *Error
+				require.ErrorAs(t, err, &rpcErr)
+				require.Equal(t, tc.expCode, rpcErr.ErrorCode())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
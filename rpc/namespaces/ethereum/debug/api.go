@@ -105,6 +105,14 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *evmtypes.TraceConfig) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number", blockNr)
+	return a.backend.TraceCall(args, blockNr, config)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -33,25 +33,37 @@ type StorageResult struct {
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
-	BlockHash        *common.Hash         `json:"blockHash"`
-	BlockNumber      *hexutil.Big         `json:"blockNumber"`
-	From             common.Address       `json:"from"`
-	Gas              hexutil.Uint64       `json:"gas"`
-	GasPrice         *hexutil.Big         `json:"gasPrice"`
-	GasFeeCap        *hexutil.Big         `json:"maxFeePerGas,omitempty"`
-	GasTipCap        *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
-	Hash             common.Hash          `json:"hash"`
-	Input            hexutil.Bytes        `json:"input"`
-	Nonce            hexutil.Uint64       `json:"nonce"`
-	To               *common.Address      `json:"to"`
-	TransactionIndex *hexutil.Uint64      `json:"transactionIndex"`
-	Value            *hexutil.Big         `json:"value"`
-	Type             hexutil.Uint64       `json:"type"`
-	Accesses         *ethtypes.AccessList `json:"accessList,omitempty"`
-	ChainID          *hexutil.Big         `json:"chainId,omitempty"`
-	V                *hexutil.Big         `json:"v"`
-	R                *hexutil.Big         `json:"r"`
-	S                *hexutil.Big         `json:"s"`
+	BlockHash        *common.Hash           `json:"blockHash"`
+	BlockNumber      *hexutil.Big           `json:"blockNumber"`
+	From             common.Address         `json:"from"`
+	Gas              hexutil.Uint64         `json:"gas"`
+	GasPrice         *hexutil.Big           `json:"gasPrice"`
+	GasFeeCap        *hexutil.Big           `json:"maxFeePerGas,omitempty"`
+	GasTipCap        *hexutil.Big           `json:"maxPriorityFeePerGas,omitempty"`
+	Hash             common.Hash            `json:"hash"`
+	Input            hexutil.Bytes          `json:"input"`
+	Nonce            hexutil.Uint64         `json:"nonce"`
+	To               *common.Address        `json:"to"`
+	TransactionIndex *hexutil.Uint64        `json:"transactionIndex"`
+	Value            *hexutil.Big           `json:"value"`
+	Type             hexutil.Uint64         `json:"type"`
+	Accesses         *ethtypes.AccessList   `json:"accessList,omitempty"`
+	ChainID          *hexutil.Big           `json:"chainId,omitempty"`
+	Authorizations   []SetCodeAuthorization `json:"authorizationList,omitempty"`
+	V                *hexutil.Big           `json:"v"`
+	R                *hexutil.Big           `json:"r"`
+	S                *hexutil.Big           `json:"s"`
+}
+
+// SetCodeAuthorization represents an EIP-7702 authorization of a set code
+// transaction that will serialize to the RPC representation.
+type SetCodeAuthorization struct {
+	ChainID *hexutil.Big   `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       *hexutil.Big   `json:"r"`
+	S       *hexutil.Big   `json:"s"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -48,7 +48,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.TxHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -161,7 +161,45 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	rpcTx, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// go-ethereum doesn't support the set code transactions, so the fields
+	// derived from the hash and the signature are set here
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		rpcTx.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+		rpcTx.Hash = setCodeTx.Hash()
+		rpcTx.From, _ = setCodeTx.Sender(setCodeTx.GetChainID()) // #nosec G703
+		rpcTx.Authorizations = NewSetCodeAuthorizations(setCodeTx.Authorizations)
+	}
+	return rpcTx, nil
+}
+
+// NewSetCodeAuthorizations returns the RPC representation of the authorizations
+// of a set code transaction.
+func NewSetCodeAuthorizations(authorizations []evmtypes.SetCodeAuthorization) []SetCodeAuthorization {
+	result := make([]SetCodeAuthorization, len(authorizations))
+	for i, auth := range authorizations {
+		v, r, s := auth.GetRawSignatureValues()
+		result[i] = SetCodeAuthorization{
+			ChainID: (*hexutil.Big)(auth.ChainID.BigInt()),
+			Address: auth.GetAddress(),
+			Nonce:   hexutil.Uint64(auth.Nonce),
+			R:       (*hexutil.Big)(r),
+			S:       (*hexutil.Big)(s),
+		}
+		if v != nil {
+			result[i].YParity = hexutil.Uint64(v.Uint64())
+		}
+	}
+	return result
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
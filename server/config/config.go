@@ -5,11 +5,14 @@ package config
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"path"
 	"time"
 
 	"github.com/spf13/viper"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cometbft/cometbft/libs/strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -59,6 +62,21 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultParallelExecution is the default value of the speculative parallel execution of the eth txs
+	DefaultParallelExecution = false
+
+	// DefaultParallelWorkers is the default number of workers of the parallel execution (0 = number of CPUs)
+	DefaultParallelWorkers = 0
+
+	// DefaultContractStats is the default value of the collection of the contract statistics
+	DefaultContractStats = false
+
+	// DefaultContractStatsWindow is the default number of blocks the contract statistics are aggregated over
+	DefaultContractStatsWindow = 100
+
+	// DefaultStateDiffIndex is the default value of the indexing of the accounts changed in each block
+	DefaultStateDiffIndex = false
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -95,6 +113,28 @@ const (
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
+	// ============================
+	//           Bundler
+	// ============================
+
+	// DefaultEntryPoint is the address of the canonical ERC-4337 EntryPoint (v0.6)
+	DefaultEntryPoint = "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789"
+
+	// DefaultMaxBundleSize is the default maximum number of user operations in a bundle
+	DefaultMaxBundleSize = 10
+
+	// DefaultBundleInterval is the default interval between two bundles
+	DefaultBundleInterval = 5 * time.Second
+
+	// DefaultMempoolSize is the default maximum number of user operations in the bundler mempool
+	DefaultMempoolSize = 1000
+
+	// DefaultMinStake is the default minimum stake (in wei) of the staked entities
+	DefaultMinStake = "1000000000000000000"
+
+	// DefaultMinUnstakeDelay is the default minimum unstake delay (in seconds) of the staked entities
+	DefaultMinUnstakeDelay = 86400
+
 	// ============================
 	//           MemIAVL
 	// ============================
@@ -127,6 +167,7 @@ type Config struct {
 	EVM     EVMConfig     `mapstructure:"evm"`
 	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
 	TLS     TLSConfig     `mapstructure:"tls"`
+	Bundler BundlerConfig `mapstructure:"bundler"`
 
 	MemIAVL MemIAVLConfig `mapstructure:"memiavl"`
 }
@@ -138,6 +179,21 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// ParallelExecution enables the speculative parallel execution of the eth txs
+	// of the block proposals.
+	ParallelExecution bool `mapstructure:"parallel-execution"`
+	// ParallelWorkers defines the number of workers of the parallel execution.
+	// The number of CPUs is used if it is 0.
+	ParallelWorkers int `mapstructure:"parallel-workers"`
+	// ContractStats enables the collection of the gas used, call and revert
+	// counts of the contracts executed in each block.
+	ContractStats bool `mapstructure:"contract-stats"`
+	// ContractStatsWindow defines the number of blocks the contract statistics
+	// are aggregated over.
+	ContractStatsWindow int `mapstructure:"contract-stats-window"`
+	// StateDiffIndex enables the indexing of the accounts changed in each
+	// block, used by the TouchedAccounts query.
+	StateDiffIndex bool `mapstructure:"state-diff-index"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -190,6 +246,28 @@ type TLSConfig struct {
 	KeyPath string `mapstructure:"key-path"`
 }
 
+// BundlerConfig defines the configuration of the ERC-4337 bundler served by
+// the JSON-RPC server when the "bundler" API namespace is enabled.
+type BundlerConfig struct {
+	// EntryPoints defines the EntryPoint contracts supported by the bundler
+	EntryPoints []string `mapstructure:"entry-points"`
+	// Key defines the name of the keyring key used to sign the bundles
+	Key string `mapstructure:"key"`
+	// Beneficiary defines the address that receives the bundle fees.
+	// The bundler key address is used if it is empty.
+	Beneficiary string `mapstructure:"beneficiary"`
+	// MaxBundleSize defines the maximum number of user operations in a bundle
+	MaxBundleSize int `mapstructure:"max-bundle-size"`
+	// BundleInterval defines the interval between two bundles
+	BundleInterval time.Duration `mapstructure:"bundle-interval"`
+	// MempoolSize defines the maximum number of user operations in the mempool
+	MempoolSize int `mapstructure:"mempool-size"`
+	// MinStake defines the minimum stake (in wei) of the staked entities
+	MinStake string `mapstructure:"min-stake"`
+	// MinUnstakeDelay defines the minimum unstake delay (in seconds) of the staked entities
+	MinUnstakeDelay uint64 `mapstructure:"min-unstake-delay"`
+}
+
 // MemIAVLConfig defines the configuration for memIAVL.
 type MemIAVLConfig struct {
 	memiavlcfg.MemIAVLConfig
@@ -237,6 +315,7 @@ func DefaultConfig() *Config {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Bundler: *DefaultBundlerConfig(),
 		MemIAVL: *DefaultMemIAVLConfig(),
 	}
 }
@@ -244,8 +323,13 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:              DefaultEVMTracer,
+		MaxTxGasWanted:      DefaultMaxTxGasWanted,
+		ParallelExecution:   DefaultParallelExecution,
+		ParallelWorkers:     DefaultParallelWorkers,
+		ContractStats:       DefaultContractStats,
+		ContractStatsWindow: DefaultContractStatsWindow,
+		StateDiffIndex:      DefaultStateDiffIndex,
 	}
 }
 
@@ -255,6 +339,14 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.ParallelWorkers < 0 {
+		return fmt.Errorf("parallel workers cannot be negative: %d", c.ParallelWorkers)
+	}
+
+	if c.ContractStatsWindow < 1 {
+		return fmt.Errorf("contract stats window must be positive: %d", c.ContractStatsWindow)
+	}
+
 	return nil
 }
 
@@ -265,7 +357,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "bundler"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -368,6 +460,51 @@ func (c TLSConfig) Validate() error {
 	return nil
 }
 
+// DefaultBundlerConfig returns the default ERC-4337 bundler configuration
+func DefaultBundlerConfig() *BundlerConfig {
+	return &BundlerConfig{
+		EntryPoints:     []string{DefaultEntryPoint},
+		Key:             "",
+		Beneficiary:     "",
+		MaxBundleSize:   DefaultMaxBundleSize,
+		BundleInterval:  DefaultBundleInterval,
+		MempoolSize:     DefaultMempoolSize,
+		MinStake:        DefaultMinStake,
+		MinUnstakeDelay: DefaultMinUnstakeDelay,
+	}
+}
+
+// Validate returns an error if the bundler configuration fields are invalid.
+func (c BundlerConfig) Validate() error {
+	for _, entryPoint := range c.EntryPoints {
+		if !common.IsHexAddress(entryPoint) {
+			return fmt.Errorf("invalid entry point address %s", entryPoint)
+		}
+	}
+
+	if c.Beneficiary != "" && !common.IsHexAddress(c.Beneficiary) {
+		return fmt.Errorf("invalid beneficiary address %s", c.Beneficiary)
+	}
+
+	if c.MaxBundleSize <= 0 {
+		return errors.New("max bundle size must be positive")
+	}
+
+	if c.BundleInterval <= 0 {
+		return errors.New("bundle interval must be positive")
+	}
+
+	if c.MempoolSize <= 0 {
+		return errors.New("mempool size must be positive")
+	}
+
+	if minStake, ok := new(big.Int).SetString(c.MinStake, 10); !ok || minStake.Sign() < 0 {
+		return fmt.Errorf("invalid min stake %s", c.MinStake)
+	}
+
+	return nil
+}
+
 // DefaultMemIAVLConfig returns the default MemIAVL configuration
 func DefaultMemIAVLConfig() *MemIAVLConfig {
 	return &MemIAVLConfig{memiavlcfg.MemIAVLConfig{
@@ -417,6 +554,10 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tls config value: %s", err.Error())
 	}
 
+	if err := c.Bundler.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid bundler config value: %s", err.Error())
+	}
+
 	if err := c.MemIAVL.Validate(); err != nil {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid memIAVL config value: %s", err.Error())
 	}
@@ -22,6 +22,27 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# ParallelExecution enables the speculative parallel execution of the eth txs of the block
+# proposals. The txs are executed in parallel when a proposal is received and the results
+# are used on delivery as long as the state they read is unchanged, so the outcome is the
+# same as the serial execution. Only the validators receive the proposals.
+parallel-execution = {{ .EVM.ParallelExecution }}
+
+# ParallelWorkers defines the number of workers of the parallel execution (0 = number of CPUs).
+parallel-workers = {{ .EVM.ParallelWorkers }}
+
+# ContractStats enables the collection of the gas used, call and revert counts of the contracts
+# executed in each block. The statistics are emitted as events at the end of the block and
+# aggregated over the recent blocks for the ContractStats query and the telemetry.
+contract-stats = {{ .EVM.ContractStats }}
+
+# ContractStatsWindow defines the number of blocks the contract statistics are aggregated over.
+contract-stats-window = {{ .EVM.ContractStatsWindow }}
+
+# StateDiffIndex enables the indexing of the accounts changed in each block, in the
+# 'data/evm_changesets' database. The index is required by the TouchedAccounts query.
+state-diff-index = {{ .EVM.StateDiffIndex }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -97,4 +118,35 @@ certificate-path = "{{ .TLS.CertificatePath }}"
 
 # Key path defines the key.pem file path for the TLS configuration.
 key-path = "{{ .TLS.KeyPath }}"
+
+###############################################################################
+###                          Bundler Configuration                          ###
+###############################################################################
+
+[bundler]
+
+# EntryPoints defines the ERC-4337 EntryPoint contracts supported by the bundler.
+# The bundler is served by the JSON-RPC server when the "bundler" namespace is enabled.
+entry-points = "{{range $index, $elmt := .Bundler.EntryPoints}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# Key defines the name of the keyring key used to sign the bundles.
+key = "{{ .Bundler.Key }}"
+
+# Beneficiary defines the address that receives the bundle fees (empty = bundler key address).
+beneficiary = "{{ .Bundler.Beneficiary }}"
+
+# MaxBundleSize defines the maximum number of user operations in a bundle.
+max-bundle-size = {{ .Bundler.MaxBundleSize }}
+
+# BundleInterval defines the interval between two bundles.
+bundle-interval = "{{ .Bundler.BundleInterval }}"
+
+# MempoolSize defines the maximum number of user operations in the bundler mempool.
+mempool-size = {{ .Bundler.MempoolSize }}
+
+# MinStake defines the minimum stake (in wei) of the staked factories, paymasters and accounts.
+min-stake = "{{ .Bundler.MinStake }}"
+
+# MinUnstakeDelay defines the minimum unstake delay (in seconds) of the staked entities.
+min-unstake-delay = {{ .Bundler.MinUnstakeDelay }}
 ` + memiavlcfg.DefaultConfigTemplate
@@ -61,8 +61,15 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer            = "evm.tracer"
+	EVMMaxTxGasWanted    = "evm.max-tx-gas-wanted"
+	EVMParallelExecution = "evm.parallel-execution"
+	EVMParallelWorkers   = "evm.parallel-workers"
+
+	EVMContractStats       = "evm.contract-stats"
+	EVMContractStatsWindow = "evm.contract-stats-window"
+
+	EVMStateDiffIndex = "evm.state-diff-index"
 )
 
 // TLS flags
@@ -79,6 +79,8 @@ func StartJSONRPC(ctx *server.Context,
 	}
 	httpSrvDone := make(chan struct{}, 1)
 
+	httpSrv.RegisterOnShutdown(func() { rpc.StopRPCAPIs(apis) })
+
 	ln, err := Listen(httpSrv.Addr, config)
 	if err != nil {
 		return nil, nil, err
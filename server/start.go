@@ -202,6 +202,11 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMParallelExecution, config.DefaultParallelExecution, "Enable the speculative parallel execution of the eth txs of the block proposals")
+	cmd.Flags().Int(srvflags.EVMParallelWorkers, config.DefaultParallelWorkers, "the number of workers of the parallel execution (0 = number of CPUs)")
+	cmd.Flags().Bool(srvflags.EVMContractStats, config.DefaultContractStats, "Enable the collection of the gas used, call and revert counts of the contracts executed in each block")
+	cmd.Flags().Int(srvflags.EVMContractStatsWindow, config.DefaultContractStatsWindow, "the number of blocks the contract statistics are aggregated over")
+	cmd.Flags().Bool(srvflags.EVMStateDiffIndex, config.DefaultStateDiffIndex, "Enable the indexing of the accounts changed in each block for the TouchedAccounts query")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
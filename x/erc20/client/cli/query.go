@@ -13,6 +13,10 @@ import (
 	"github.com/evmos/evmos/v16/x/erc20/types"
 )
 
+// flagDiscrepanciesOnly is the flag to only report the token pairs whose supply
+// isn't backed
+const flagDiscrepanciesOnly = "discrepancies-only"
+
 // GetQueryCmd returns the parent command for all erc20 CLI query commands
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetAuditCmd(),
+		GetConversionPreferenceCmd(),
 	)
 	return cmd
 }
@@ -129,3 +135,79 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAuditCmd audits the supply backing of the registered token pairs
+func GetAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit [TOKEN]",
+		Short: "Audit the supply backing of the registered token pairs",
+		Long: `Audit the supply backing of the registered token pairs, optionally restricted to the token pair of the given ERC20 hex address or Cosmos denom.
+For module-owned pairs, the ERC20 total supply must equal the coins escrowed by the module account.
+For externally-owned pairs, the ERC20 balance of the module account must equal the coin supply.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			discrepanciesOnly, err := cmd.Flags().GetBool(flagDiscrepanciesOnly)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAuditRequest{
+				DiscrepanciesOnly: discrepanciesOnly,
+			}
+			if len(args) > 0 {
+				req.Token = args[0]
+			}
+
+			res, err := queryClient.Audit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagDiscrepanciesOnly, false, "Only report the token pairs whose supply isn't backed")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetConversionPreferenceCmd queries how the coins received by an account over
+// IBC are converted
+func GetConversionPreferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-preference ADDRESS",
+		Short: "Get how the coins received by an account over IBC are converted",
+		Long:  "Get how the coins received by an account over IBC are converted. The address can be either a bech32 or a hex address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConversionPreferenceRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.ConversionPreference(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -36,6 +36,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewConvertERC20Cmd(),
+		NewSetConversionPreferenceCmd(),
 	)
 	return txCmd
 }
@@ -87,6 +88,38 @@ func NewConvertERC20Cmd() *cobra.Command {
 	return cmd
 }
 
+// NewSetConversionPreferenceCmd returns a CLI command handler for setting how the
+// coins received over IBC are converted
+func NewSetConversionPreferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-conversion-preference [balance|received|none]",
+		Short: "Set how the coins received over IBC are converted to ERC20 tokens",
+		Long: `Set how the coins of the registered token pairs received over IBC are converted to ERC20 tokens:
+  - balance: the whole balance of the received denomination is converted (default)
+  - received: only the received coins are converted
+  - none: the received coins are not converted`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			preference, err := types.ParseConversionPreference(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetConversionPreference(cliCtx.GetFromAddress(), preference)
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterERC20ProposalCmd implements the command to submit a community-pool-spend proposal
 func NewRegisterERC20ProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
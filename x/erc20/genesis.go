@@ -37,12 +37,17 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	for _, preference := range data.ConversionPreferences {
+		k.SetAccountConversionPreference(ctx, preference.GetAccAddress(), preference.Preference)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:                k.GetParams(ctx),
+		TokenPairs:            k.GetTokenPairs(ctx),
+		ConversionPreferences: k.GetAccountConversionPreferences(ctx),
 	}
 }
@@ -91,7 +91,11 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 						Enabled:       true,
 						ContractOwner: types.OWNER_MODULE,
 					},
-				}),
+				},
+				types.NewAccountConversionPreference(
+					sdk.AccAddress(utiltx.GenerateAddress().Bytes()), types.CONVERSION_PREFERENCE_RECEIVED,
+				),
+			),
 		},
 	}
 
@@ -109,6 +113,13 @@ func (suite *GenesisTestSuite) TestERC20InitGenesis() {
 		} else {
 			suite.Require().Len(tc.genesisState.TokenPairs, 0)
 		}
+
+		for _, preference := range tc.genesisState.ConversionPreferences {
+			suite.Require().Equal(
+				preference.Preference,
+				suite.app.Erc20Keeper.GetAccountConversionPreference(suite.ctx, preference.GetAccAddress()),
+			)
+		}
 	}
 }
 
@@ -136,7 +147,11 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 						Enabled:       true,
 						ContractOwner: types.OWNER_MODULE,
 					},
-				}),
+				},
+				types.NewAccountConversionPreference(
+					sdk.AccAddress(utiltx.GenerateAddress().Bytes()), types.CONVERSION_PREFERENCE_RECEIVED,
+				),
+			),
 		},
 	}
 
@@ -153,6 +168,11 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 			} else {
 				suite.Require().Len(genesisExported.TokenPairs, 0)
 			}
+
+			suite.Require().ElementsMatch(
+				suite.app.Erc20Keeper.GetAccountConversionPreferences(suite.ctx),
+				genesisExported.ConversionPreferences,
+			)
 		})
 		// }
 	}
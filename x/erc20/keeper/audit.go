@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// AuditTokenPairs returns the supply backing audits of the registered token
+// pairs. The token pairs whose ERC20 is a precompile are skipped, as their
+// ERC20 balances are the Cosmos coin balances.
+func (k Keeper) AuditTokenPairs(ctx sdk.Context) []types.TokenPairAudit {
+	var audits []types.TokenPairAudit
+	k.IterateTokenPairs(ctx, func(tokenPair types.TokenPair) bool {
+		if k.isAuditable(ctx, tokenPair) {
+			audits = append(audits, k.AuditTokenPair(ctx, tokenPair))
+		}
+		return false
+	})
+
+	return audits
+}
+
+// isAuditable returns false if the ERC20 of the token pair is a precompile,
+// including the precompiles that are only activated in the EVM parameters.
+func (k Keeper) isAuditable(ctx sdk.Context, tokenPair types.TokenPair) bool {
+	return !k.IsPrecompilePair(ctx, tokenPair)
+}
+
+// AuditTokenPair compares the ERC20 and Cosmos coin amounts that must back
+// each other for the given token pair:
+//   - module-owned pairs: the ERC20 total supply must equal the Cosmos coins
+//     escrowed by the module account
+//   - externally-owned pairs: the ERC20 balance of the module account must
+//     equal the Cosmos coin supply
+func (k Keeper) AuditTokenPair(ctx sdk.Context, tokenPair types.TokenPair) types.TokenPairAudit {
+	audit := types.TokenPairAudit{
+		Erc20Address:  tokenPair.Erc20Address,
+		Denom:         tokenPair.Denom,
+		ContractOwner: tokenPair.ContractOwner,
+		Erc20Amount:   sdkmath.ZeroInt(),
+		CoinAmount:    sdkmath.ZeroInt(),
+	}
+
+	var (
+		method string
+		args   []interface{}
+	)
+
+	switch {
+	case tokenPair.IsNativeCoin():
+		audit.CoinAmount = k.bankKeeper.GetBalance(ctx, types.ModuleAddress.Bytes(), tokenPair.Denom).Amount
+		method = "totalSupply"
+	case tokenPair.IsNativeERC20():
+		audit.CoinAmount = k.bankKeeper.GetSupply(ctx, tokenPair.Denom).Amount
+		method, args = "balanceOf", []interface{}{types.ModuleAddress}
+	default:
+		audit.Error = types.ErrUndefinedOwner.Error()
+		return audit
+	}
+
+	amount, err := k.queryERC20Amount(ctx, tokenPair, method, args...)
+	if err != nil {
+		audit.Error = err.Error()
+		return audit
+	}
+
+	audit.Erc20Amount = sdkmath.NewIntFromBigInt(amount)
+	return audit
+}
+
+// queryERC20Amount calls an ERC20 method that returns an amount.
+func (k Keeper) queryERC20Amount(
+	ctx sdk.Context,
+	tokenPair types.TokenPair,
+	method string,
+	args ...interface{},
+) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, tokenPair.GetERC20Contract(), false, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	unpacked, err := erc20.Unpack(method, res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrABIUnpack, "failed to unpack %s", method)
+	}
+
+	amount, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrABIUnpack, "invalid %s type %T", method, unpacked[0])
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,95 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/evmos/evmos/v16/utils"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// AutoRegisterIBCCoin creates the token pair of an IBC voucher received over an
+// allow-listed channel and activates its ERC20 precompile in the EVM parameters.
+// Only the vouchers of the coins native to the counterparty chain are
+// registered. The precompile address is derived from the hash of the voucher's
+// denom trace and the bank metadata is derived from the denom trace if it isn't
+// set.
+func (k Keeper) AutoRegisterIBCCoin(ctx sdk.Context, denom, channel string) (*types.TokenPair, error) {
+	if k.IsDenomRegistered(ctx, denom) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "coin denomination already registered: %s", denom,
+		)
+	}
+
+	address, err := utils.GetIBCDenomAddress(denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.IsERC20Registered(ctx, address) || k.evmKeeper.IsAvailablePrecompile(address) ||
+		k.evmKeeper.GetParams(ctx).IsActivePrecompile(address.String()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "precompile address already in use: %s", address,
+		)
+	}
+
+	hash, err := transfertypes.ParseHexHash(denom[len("ibc/"):])
+	if err != nil {
+		return nil, err
+	}
+
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			transfertypes.ErrTraceNotFound, "denom trace not found for %s", denom,
+		)
+	}
+
+	// only the coins native to the counterparty chain are registered, so that the
+	// registrations are bounded by the denoms of the allow-listed counterparties
+	if trace.Path != fmt.Sprintf("%s/%s", transfertypes.PortID, channel) {
+		return nil, errorsmod.Wrapf(
+			transfertypes.ErrInvalidDenomForTransfer,
+			"only the single-hop denom traces received through %s can be registered: %s", channel, trace.GetFullDenomPath(),
+		)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		metadata, err := types.NewIBCCoinMetadata(trace)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrInternalTokenPair, "failed to derive coin metadata for %s: %s", denom, err,
+			)
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	pair := types.NewTokenPair(address, denom, types.OWNER_MODULE)
+
+	// NOTE: only the address is activated in the EVM parameters, the precompile
+	// is instantiated from the token pair when it's called
+	if err := k.evmKeeper.EnablePrecompiles(ctx, address); err != nil {
+		return nil, err
+	}
+
+	k.SetTokenPair(ctx, pair)
+	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
+	k.SetERC20Map(ctx, address, pair.GetID())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRegisterCoin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyChannel, channel),
+		),
+	)
+
+	return &pair, nil
+}
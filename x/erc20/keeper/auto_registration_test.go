@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+
+	"github.com/evmos/evmos/v16/testutil"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/utils"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestAutoRegisterIBCCoin() {
+	trace := transfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", transfertypes.PortID, "channel-3"),
+		BaseDenom: "uatom",
+	}
+	denom := trace.IBCDenom()
+	multiHopTrace := transfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s/%s/%s", transfertypes.PortID, "channel-3", transfertypes.PortID, "channel-9"),
+		BaseDenom: "uatom",
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		denom       string
+		expPass     bool
+		expMetadata banktypes.Metadata
+	}{
+		{
+			"fail - not an IBC voucher",
+			func() {},
+			"acoin",
+			false,
+			banktypes.Metadata{},
+		},
+		{
+			"fail - denom trace not found",
+			func() {},
+			denom,
+			false,
+			banktypes.Metadata{},
+		},
+		{
+			"fail - multi-hop denom trace",
+			func() {
+				suite.app.TransferKeeper.SetDenomTrace(suite.ctx, multiHopTrace)
+			},
+			multiHopTrace.IBCDenom(),
+			false,
+			banktypes.Metadata{},
+		},
+		{
+			"fail - denom already registered",
+			func() {
+				suite.app.TransferKeeper.SetDenomTrace(suite.ctx, trace)
+				_, err := suite.app.Erc20Keeper.AutoRegisterIBCCoin(suite.ctx, denom, "channel-3")
+				suite.Require().NoError(err)
+			},
+			denom,
+			false,
+			banktypes.Metadata{},
+		},
+		{
+			"pass - metadata derived from the denom trace",
+			func() {
+				suite.app.TransferKeeper.SetDenomTrace(suite.ctx, trace)
+			},
+			denom,
+			true,
+			banktypes.Metadata{
+				Description: "IBC voucher of uatom received through transfer/channel-3",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: denom, Exponent: 0, Aliases: []string{"uatom"}},
+					{Denom: "atom", Exponent: 6},
+				},
+				Base:    denom,
+				Display: "atom",
+				Name:    "Atom",
+				Symbol:  "ATOM",
+			},
+		},
+		{
+			"pass - existing metadata",
+			func() {
+				suite.app.TransferKeeper.SetDenomTrace(suite.ctx, trace)
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+					Base:       denom,
+					Display:    denom,
+					Name:       "Cosmos Hub Atom",
+					Symbol:     "ATOM",
+				})
+			},
+			denom,
+			true,
+			banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+				Base:       denom,
+				Display:    denom,
+				Name:       "Cosmos Hub Atom",
+				Symbol:     "ATOM",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.AutoRegisterIBCCoin(suite.ctx, tc.denom, "channel-3")
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			address, err := utils.GetIBCDenomAddress(denom)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.NewTokenPair(address, denom, types.OWNER_MODULE), *pair)
+			suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, denom))
+			suite.Require().True(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, address))
+			suite.Require().Contains(suite.app.EvmKeeper.GetParams(suite.ctx).ActivePrecompiles, address.String())
+			// the precompile is instantiated from the state instead of being kept in memory
+			suite.Require().False(suite.app.EvmKeeper.IsAvailablePrecompile(address))
+			suite.Require().True(suite.app.Erc20Keeper.IsPrecompilePair(suite.ctx, *pair))
+			precompile, found := suite.app.Erc20Keeper.GetDynamicPrecompile(suite.ctx, address)
+			suite.Require().True(found)
+			suite.Require().Equal(address, precompile.Address())
+
+			metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expMetadata, metadata)
+
+			found = false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeAutoRegisterCoin {
+					found = true
+				}
+			}
+			suite.Require().True(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketAutoRegistration() {
+	sourceChannel := "channel-292"
+	evmosChannel := "channel-3"
+	trace := transfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", transfertypes.PortID, evmosChannel),
+		BaseDenom: "uatom",
+	}
+	denom := trace.IBCDenom()
+
+	testCases := []struct {
+		name        string
+		channels    []string
+		expRegister bool
+	}{
+		{
+			"no-op - auto-registration disabled",
+			nil,
+			false,
+		},
+		{
+			"no-op - channel not allow-listed",
+			[]string{"channel-0"},
+			false,
+		},
+		{
+			"pass - channel allow-listed",
+			[]string{evmosChannel},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.AutoRegistrationChannels = tc.channels
+			suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, params))
+
+			// the transfer module sets the denom trace and mints the voucher
+			// before the middleware callback
+			receiver := utiltx.GenerateAddress()
+			coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100)))
+			suite.app.TransferKeeper.SetDenomTrace(suite.ctx, trace)
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, receiver.Bytes(), coins)
+			suite.Require().NoError(err)
+
+			sender := sdk.MustBech32ifyAddressBytes(sdk.Bech32MainPrefix, utiltx.GenerateAddress().Bytes())
+			transfer := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, sdk.AccAddress(receiver.Bytes()).String(), "")
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, clienttypes.NewHeight(0, 100), 0)
+
+			ack := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, ibcmock.MockAcknowledgement)
+			suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+			suite.Require().Equal(tc.expRegister, suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, denom))
+
+			// the vouchers aren't converted nor escrowed
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, receiver.Bytes(), denom)
+			suite.Require().Equal(coins[0], balance)
+
+			if !tc.expRegister {
+				return
+			}
+
+			// the following transfers keep the token pair
+			ack = suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, ibcmock.MockAcknowledgement)
+			suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+			suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, denom))
+
+			address, err := utils.GetIBCDenomAddress(denom)
+			suite.Require().NoError(err)
+			suite.Require().Equal(balance.Amount.BigInt(), suite.BalanceOf(address, receiver))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// GetAccountConversionPreference returns how the coins received by an account
+// over IBC are converted. It defaults to the conversion of the whole balance.
+func (k Keeper) GetAccountConversionPreference(ctx sdk.Context, address sdk.AccAddress) types.ConversionPreference {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionPreference)
+	bz := store.Get(address)
+	if len(bz) == 0 {
+		return types.CONVERSION_PREFERENCE_BALANCE
+	}
+
+	return types.ConversionPreference(bz[0])
+}
+
+// SetAccountConversionPreference stores the conversion preference of an
+// account. The default preference isn't stored.
+func (k Keeper) SetAccountConversionPreference(ctx sdk.Context, address sdk.AccAddress, preference types.ConversionPreference) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionPreference)
+	if preference == types.CONVERSION_PREFERENCE_BALANCE {
+		store.Delete(address)
+		return
+	}
+
+	store.Set(address, []byte{byte(preference)})
+}
+
+// IterateAccountConversionPreferences iterates over the non-default conversion
+// preferences of the accounts.
+func (k Keeper) IterateAccountConversionPreferences(
+	ctx sdk.Context,
+	cb func(address sdk.AccAddress, preference types.ConversionPreference) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixConversionPreference)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key()[len(types.KeyPrefixConversionPreference):])
+		if cb(address, types.ConversionPreference(iterator.Value()[0])) {
+			break
+		}
+	}
+}
+
+// GetAccountConversionPreferences returns the non-default conversion
+// preferences of the accounts.
+func (k Keeper) GetAccountConversionPreferences(ctx sdk.Context) []types.AccountConversionPreference {
+	preferences := []types.AccountConversionPreference{}
+
+	k.IterateAccountConversionPreferences(ctx, func(address sdk.AccAddress, preference types.ConversionPreference) (stop bool) {
+		preferences = append(preferences, types.NewAccountConversionPreference(address, preference))
+		return false
+	})
+
+	return preferences
+}
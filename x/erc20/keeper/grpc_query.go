@@ -12,8 +12,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v16/types"
 
+	"github.com/evmos/evmos/v16/utils"
 	"github.com/evmos/evmos/v16/x/erc20/types"
 )
 
@@ -86,3 +88,67 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Audit returns the supply backing audits of the registered token pairs
+func (k Keeper) Audit(c context.Context, req *types.QueryAuditRequest) (*types.QueryAuditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var audits []types.TokenPairAudit
+	if req.Token != "" {
+		id := k.GetTokenPairID(ctx, req.Token)
+		pair, found := k.GetTokenPair(ctx, id)
+		if len(id) == 0 || !found {
+			return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+		}
+
+		if k.isAuditable(ctx, pair) {
+			audits = append(audits, k.AuditTokenPair(ctx, pair))
+		}
+	} else {
+		audits = k.AuditTokenPairs(ctx)
+	}
+
+	if req.DiscrepanciesOnly {
+		discrepancies := make([]types.TokenPairAudit, 0, len(audits))
+		for _, audit := range audits {
+			if !audit.IsBacked() {
+				discrepancies = append(discrepancies, audit)
+			}
+		}
+		audits = discrepancies
+	}
+
+	return &types.QueryAuditResponse{Audits: audits}, nil
+}
+
+// ConversionPreference returns how the coins received by an account over IBC
+// are converted
+func (k Keeper) ConversionPreference(
+	c context.Context,
+	req *types.QueryConversionPreferenceRequest,
+) (*types.QueryConversionPreferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var address sdk.AccAddress
+	if common.IsHexAddress(req.Address) {
+		address = common.HexToAddress(req.Address).Bytes()
+	} else {
+		var err error
+		address, err = utils.GetEvmosAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address '%s': %s", req.Address, err)
+		}
+	}
+
+	return &types.QueryConversionPreferenceResponse{
+		Preference: k.GetAccountConversionPreference(ctx, address),
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -168,3 +169,139 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestConversionPreference() {
+	address := utiltx.GenerateAddress()
+	accAddress := sdk.AccAddress(address.Bytes())
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		address       string
+		expPass       bool
+		expPreference types.ConversionPreference
+	}{
+		{
+			"fail - invalid address",
+			func() {},
+			"invalid",
+			false,
+			types.CONVERSION_PREFERENCE_BALANCE,
+		},
+		{
+			"pass - default preference",
+			func() {},
+			accAddress.String(),
+			true,
+			types.CONVERSION_PREFERENCE_BALANCE,
+		},
+		{
+			"pass - preference queried by bech32 address",
+			func() {
+				suite.app.Erc20Keeper.SetAccountConversionPreference(suite.ctx, accAddress, types.CONVERSION_PREFERENCE_NONE)
+			},
+			accAddress.String(),
+			true,
+			types.CONVERSION_PREFERENCE_NONE,
+		},
+		{
+			"pass - preference queried by hex address",
+			func() {
+				suite.app.Erc20Keeper.SetAccountConversionPreference(suite.ctx, accAddress, types.CONVERSION_PREFERENCE_RECEIVED)
+			},
+			address.Hex(),
+			true,
+			types.CONVERSION_PREFERENCE_RECEIVED,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			req := &types.QueryConversionPreferenceRequest{Address: tc.address}
+			res, err := suite.queryClient.ConversionPreference(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPreference, res.Preference)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAudit() {
+	var (
+		req    *types.QueryAuditRequest
+		expLen int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(modulePair, externalPair types.TokenPair)
+		expPass  bool
+	}{
+		{
+			"all token pairs",
+			func(_, _ types.TokenPair) {
+				req = &types.QueryAuditRequest{}
+				expLen = 2
+			},
+			true,
+		},
+		{
+			"token pair by denom",
+			func(modulePair, _ types.TokenPair) {
+				req = &types.QueryAuditRequest{Token: modulePair.Denom}
+				expLen = 1
+			},
+			true,
+		},
+		{
+			"discrepancies only - none",
+			func(_, _ types.TokenPair) {
+				req = &types.QueryAuditRequest{DiscrepanciesOnly: true}
+				expLen = 0
+			},
+			true,
+		},
+		{
+			"discrepancies only - unbacked ERC20",
+			func(_, externalPair types.TokenPair) {
+				suite.TransferERC20TokenToModule(externalPair.GetERC20Contract(), suite.address, big.NewInt(5))
+				suite.Commit()
+				req = &types.QueryAuditRequest{DiscrepanciesOnly: true}
+				expLen = 1
+			},
+			true,
+		},
+		{
+			"token pair not found",
+			func(_, _ types.TokenPair) {
+				req = &types.QueryAuditRequest{Token: utiltx.GenerateAddress().Hex()}
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+			modulePair, externalPair := suite.setupBackedTokenPairs()
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate(modulePair, externalPair)
+
+			res, err := suite.queryClient.Audit(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Audits, expLen)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
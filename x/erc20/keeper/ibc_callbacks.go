@@ -4,6 +4,8 @@
 package keeper
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -23,8 +25,9 @@ import (
 // OnRecvPacket performs the ICS20 middleware receive callback for automatically
 // converting an IBC Coin to their ERC20 representation.
 // For the conversion to succeed, the IBC denomination must have previously been
-// registered via governance. Note that the native staking denomination (e.g. "aevmos"),
-// is excluded from the conversion.
+// registered via governance, or automatically on its first transfer over one
+// of the auto-registration channels. Note that the native staking denomination
+// (e.g. "aevmos"), is excluded from the conversion.
 //
 // CONTRACT: This middleware MUST be executed transfer after the ICS20 OnRecvPacket
 // Return acknowledgement and continue with the next layer of the IBC middleware
@@ -32,6 +35,8 @@ import (
 // - ERC20s are disabled
 // - Denomination is native staking token
 // - The base denomination is not registered as ERC20
+// - The ERC20 of the token pair is a precompile
+// - The recipient opted out of the conversion of the received coins
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -54,6 +59,31 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
+	// parse the transferred denom
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	// register the IBC vouchers on their first transfer over an auto-registration
+	// channel. The registration failures don't fail the transfer.
+	if strings.HasPrefix(coin.Denom, "ibc/") &&
+		k.IsAutoRegistrationChannel(ctx, packet.DestinationChannel) &&
+		!k.IsDenomRegistered(ctx, coin.Denom) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.AutoRegisterIBCCoin(cacheCtx, coin.Denom, packet.DestinationChannel); err != nil {
+			k.Logger(ctx).Error(
+				"failed to register IBC coin",
+				"denom", coin.Denom,
+				"channel", packet.DestinationChannel,
+				"error", err.Error(),
+			)
+		} else {
+			writeCache()
+		}
+	}
+
 	// Get addresses in `evmos1` and the original bech32 format
 	sender, recipient, _, _, err := ibc.GetTransferSenderRecipient(packet)
 	if err != nil {
@@ -75,13 +105,6 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
-	// parse the transferred denom
-	coin := ibc.GetReceivedCoin(
-		packet.SourcePort, packet.SourceChannel,
-		packet.DestinationPort, packet.DestinationChannel,
-		data.Denom, data.Amount,
-	)
-
 	// check if the coin is a native staking token
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
 	if coin.Denom == bondDenom {
@@ -102,12 +125,26 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
-	// Instead of converting just the received coins, convert the whole user balance
-	// which includes the received coins.
-	balance := k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
+	if k.IsPrecompilePair(ctx, pair) {
+		// no-op: the ERC20 precompile balances are the bank balances
+		return ack
+	}
+
+	// The recipient chooses whether the received coins are converted. By default,
+	// instead of converting just the received coins, convert the whole user
+	// balance which includes the received coins.
+	switch k.GetAccountConversionPreference(ctx, recipient) {
+	case types.CONVERSION_PREFERENCE_NONE:
+		// no-op: continue with the rest of the stack without conversion
+		return ack
+	case types.CONVERSION_PREFERENCE_RECEIVED:
+		// convert the received coins only
+	default:
+		coin = k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
+	}
 
 	// Build MsgConvertCoin, from recipient to recipient since IBC transfer already occurred
-	msg := types.NewMsgConvertCoin(balance, common.BytesToAddress(recipient.Bytes()), recipient)
+	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(recipient.Bytes()), recipient)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated
 	// the ICS20 packet data
@@ -192,6 +229,12 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 		return nil
 	}
 
+	pair, _ := k.GetTokenPair(ctx, k.GetDenomMap(ctx, coin.Denom))
+	if k.IsPrecompilePair(ctx, pair) {
+		// no-op, the ERC20 precompile balances are the bank balances
+		return nil
+	}
+
 	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(sender), sender)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated the
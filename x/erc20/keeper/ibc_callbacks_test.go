@@ -76,6 +76,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		checkBalances    bool
 		disableERC20     bool
 		disableTokenPair bool
+		preference       types.ConversionPreference
 	}{
 		{
 			name: "error - non ics-20 packet",
@@ -266,6 +267,46 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				sdk.NewCoin(ibcBase, math.NewInt(1000)),
 			),
 		},
+		{
+			name: "ibc conversion - receiver converts the received coins only",
+			malleate: func() {
+				pk1 := secp256k1.GenPrivKey()
+				sourcePrefix := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel)
+				prefixedDenom := sourcePrefix + registeredDenom
+				otherSecpAddrEvmos := sdk.AccAddress(pk1.PubKey().Address()).String()
+				transfer := transfertypes.NewFungibleTokenPacketData(prefixedDenom, "500", otherSecpAddrEvmos, ethsecpAddrEvmos, "")
+				bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+				packet = channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+			},
+			receiver:      ethsecpAddr,
+			ackSuccess:    true,
+			expErc20s:     big.NewInt(500),
+			checkBalances: true,
+			expCoins: sdk.NewCoins(
+				sdk.NewCoin(utils.BaseDenom, math.NewInt(1000)),
+				sdk.NewCoin(registeredDenom, math.NewInt(500)),
+				sdk.NewCoin(ibcBase, math.NewInt(1000)),
+			),
+			preference: types.CONVERSION_PREFERENCE_RECEIVED,
+		},
+		{
+			name: "no-op - receiver opted out of the conversion",
+			malleate: func() {
+				pk1 := secp256k1.GenPrivKey()
+				sourcePrefix := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel)
+				prefixedDenom := sourcePrefix + registeredDenom
+				otherSecpAddrEvmos := sdk.AccAddress(pk1.PubKey().Address()).String()
+				transfer := transfertypes.NewFungibleTokenPacketData(prefixedDenom, "500", otherSecpAddrEvmos, ethsecpAddrEvmos, "")
+				bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+				packet = channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+			},
+			receiver:      ethsecpAddr,
+			ackSuccess:    true,
+			expErc20s:     big.NewInt(0),
+			checkBalances: true,
+			expCoins:      coins,
+			preference:    types.CONVERSION_PREFERENCE_NONE,
+		},
 		{
 			name: "ibc conversion - receiver is a vesting account (eth address)",
 			malleate: func() {
@@ -350,6 +391,8 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				suite.Require().NoError(err)
 			}
 
+			suite.app.Erc20Keeper.SetAccountConversionPreference(suite.ctx, tc.receiver, tc.preference)
+
 			// Perform IBC callback
 			ack := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, expAck)
 
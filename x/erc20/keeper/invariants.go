@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-owned-supply", ModuleOwnedSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "external-owned-escrow", ExternalOwnedEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the erc20 module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleOwnedSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ExternalOwnedEscrowInvariant(k)(ctx)
+	}
+}
+
+// ModuleOwnedSupplyInvariant checks that the ERC20 total supply of each
+// module-owned token pair equals the Cosmos coins escrowed by the module
+// account.
+func ModuleOwnedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return tokenPairsInvariant(ctx, k, types.OWNER_MODULE, "module-owned-supply")
+	}
+}
+
+// ExternalOwnedEscrowInvariant checks that the ERC20 balance of the module
+// account for each externally-owned token pair equals the Cosmos coin supply.
+func ExternalOwnedEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return tokenPairsInvariant(ctx, k, types.OWNER_EXTERNAL, "external-owned-escrow")
+	}
+}
+
+// tokenPairsInvariant audits the token pairs of the given owner and reports the
+// ones whose supply isn't backed.
+func tokenPairsInvariant(ctx sdk.Context, k Keeper, owner types.Owner, route string) (string, bool) {
+	var (
+		msg    string
+		broken int
+	)
+
+	k.IterateTokenPairs(ctx, func(tokenPair types.TokenPair) bool {
+		if tokenPair.ContractOwner != owner || !k.isAuditable(ctx, tokenPair) {
+			return false
+		}
+
+		if audit := k.AuditTokenPair(ctx, tokenPair); !audit.IsBacked() {
+			broken++
+			msg += fmt.Sprintf("\t%s\n", audit.Summary())
+		}
+		return false
+	})
+
+	return sdk.FormatInvariant(
+		types.ModuleName, route,
+		fmt.Sprintf("%d token pairs with unbacked supply found\n%s", broken, msg),
+	), broken != 0
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/x/erc20/keeper"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// setupBackedTokenPairs registers a module-owned and an externally-owned token
+// pair and converts part of their supply
+func (suite *KeeperTestSuite) setupBackedTokenPairs() (modulePair, externalPair types.TokenPair) {
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	modulePair = *suite.setupRegisterCoin(metadataCoin)
+	coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, math.NewInt(100)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+	_, err := suite.app.Erc20Keeper.ConvertCoin(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, math.NewInt(10)), suite.address, sender),
+	)
+	suite.Require().NoError(err)
+
+	contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contract.String())
+	externalPair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(found)
+	suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+	_, err = suite.app.Erc20Keeper.ConvertERC20(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgConvertERC20(math.NewInt(10), sender, contract, suite.address),
+	)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	return modulePair, externalPair
+}
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	var modulePair, externalPair types.TokenPair
+
+	testCases := []struct {
+		name              string
+		malleate          func()
+		expModuleBroken   bool
+		expExternalBroken bool
+	}{
+		{
+			"pass - backed supply",
+			func() {},
+			false,
+			false,
+		},
+		{
+			"fail - coins sent to the module account",
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin(modulePair.Denom, math.NewInt(5)))
+				err := suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, suite.address.Bytes(), types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+			false,
+		},
+		{
+			"fail - ERC20 tokens sent to the module account",
+			func() {
+				suite.TransferERC20TokenToModule(externalPair.GetERC20Contract(), suite.address, big.NewInt(5))
+			},
+			false,
+			true,
+		},
+		{
+			"fail - selfdestructed contract",
+			func() {
+				stateDB := suite.StateDB()
+				suite.Require().True(stateDB.Suicide(modulePair.GetERC20Contract()))
+				suite.Require().NoError(stateDB.Commit())
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			modulePair, externalPair = suite.setupBackedTokenPairs()
+
+			tc.malleate()
+
+			msg, broken := keeper.ModuleOwnedSupplyInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().Equal(tc.expModuleBroken, broken, msg)
+			if broken {
+				suite.Require().Contains(msg, modulePair.Erc20Address)
+			}
+
+			msg, broken = keeper.ExternalOwnedEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().Equal(tc.expExternalBroken, broken, msg)
+			if broken {
+				suite.Require().Contains(msg, externalPair.Erc20Address)
+			}
+
+			_, broken = keeper.AllInvariants(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().Equal(tc.expModuleBroken || tc.expExternalBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAuditTokenPairs() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	modulePair, externalPair := suite.setupBackedTokenPairs()
+
+	audits := suite.app.Erc20Keeper.AuditTokenPairs(suite.ctx)
+	suite.Require().Len(audits, 2)
+	for _, audit := range audits {
+		suite.Require().True(audit.IsBacked(), audit.Summary())
+		suite.Require().Equal(math.NewInt(10), audit.Erc20Amount)
+	}
+
+	// the ERC20 precompiles are not audited
+	holders := map[common.Address][]common.Address{modulePair.GetERC20Contract(): {suite.address}}
+	suite.Require().NoError(suite.app.Erc20Keeper.RegisterERC20Extensions(suite.ctx, holders))
+	audits = suite.app.Erc20Keeper.AuditTokenPairs(suite.ctx)
+	suite.Require().Len(audits, 1)
+	suite.Require().Equal(externalPair.Erc20Address, audits[0].Erc20Address)
+
+	// neither are the ERC20 precompiles of the auto-registered IBC coins, which
+	// are only activated in the EVM parameters
+	trace := transfertypes.DenomTrace{Path: "transfer/channel-3", BaseDenom: "uatom"}
+	suite.app.TransferKeeper.SetDenomTrace(suite.ctx, trace)
+	coins := sdk.NewCoins(sdk.NewCoin(trace.IBCDenom(), math.NewInt(100)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), coins))
+	ibcPair, err := suite.app.Erc20Keeper.AutoRegisterIBCCoin(suite.ctx, trace.IBCDenom(), "channel-3")
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.EvmKeeper.IsAvailablePrecompile(ibcPair.GetERC20Contract()))
+
+	audits = suite.app.Erc20Keeper.AuditTokenPairs(suite.ctx)
+	suite.Require().Len(audits, 1)
+	suite.Require().Equal(externalPair.Erc20Address, audits[0].Erc20Address)
+	msg, broken := keeper.ModuleOwnedSupplyInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	// a pair with an undefined owner can't be audited
+	audit := suite.app.Erc20Keeper.AuditTokenPair(suite.ctx, types.NewTokenPair(common.Address{}, modulePair.Denom, types.OWNER_UNSPECIFIED))
+	suite.Require().False(audit.IsBacked())
+	suite.Require().NotEmpty(audit.Error)
+}
@@ -7,10 +7,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	v3 "github.com/evmos/evmos/v16/x/erc20/migrations/v3"
+	v4 "github.com/evmos/evmos/v16/x/erc20/migrations/v4"
 	"github.com/evmos/evmos/v16/x/erc20/types"
 )
 
-var _ module.MigrationHandler = Migrator{}.Migrate2to3
+var (
+	_ module.MigrationHandler = Migrator{}.Migrate2to3
+	_ module.MigrationHandler = Migrator{}.Migrate3to4
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
@@ -29,3 +33,14 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace)
 }
+
+// Migrate3to4 replaces the ERC20 contracts of the module-owned token pairs by
+// the ERC20 precompiles, after migrating the balances of their holders to the
+// bank module.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	holders, err := v4.ERC20Holders()
+	if err != nil {
+		return err
+	}
+	return m.keeper.RegisterERC20Extensions(ctx, holders)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -67,3 +68,29 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.mintFeeCollector = false
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	pair := suite.setupRegisterCoin(metadataCoin)
+	coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, math.NewInt(100)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	_, err := suite.app.Erc20Keeper.ConvertCoin(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, math.NewInt(40)), suite.address, sender),
+	)
+	suite.Require().NoError(err)
+
+	// the holders with an account are read from the state
+	migrator := erc20keeper.NewMigrator(suite.app.Erc20Keeper, nil)
+	suite.Require().NoError(migrator.Migrate3to4(suite.ctx))
+
+	suite.Require().True(suite.app.Erc20Keeper.IsPrecompilePair(suite.ctx, *pair))
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, cosmosTokenBase)
+	suite.Require().Equal(int64(100), balance.Amount.Int64())
+}
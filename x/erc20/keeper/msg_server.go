@@ -8,6 +8,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +39,13 @@ func (k Keeper) ConvertCoin(
 		return nil, err
 	}
 
+	// The ERC20 precompile balances are the bank balances
+	if k.IsPrecompilePair(ctx, pair) {
+		return nil, errorsmod.Wrapf(
+			types.ErrPrecompileTokenPair, "coin '%s' has no separate ERC20 balance", pair.Denom,
+		)
+	}
+
 	// Remove token pair if contract is suicided
 	erc20 := common.HexToAddress(pair.Erc20Address)
 	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
@@ -80,6 +88,13 @@ func (k Keeper) ConvertERC20(
 		return nil, err
 	}
 
+	// The ERC20 precompile balances are the bank balances
+	if k.IsPrecompilePair(ctx, pair) {
+		return nil, errorsmod.Wrapf(
+			types.ErrPrecompileTokenPair, "token '%s' has no separate ERC20 balance", pair.Erc20Address,
+		)
+	}
+
 	// Remove token pair if contract is suicided
 	erc20 := common.HexToAddress(pair.Erc20Address)
 	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
@@ -105,6 +120,35 @@ func (k Keeper) ConvertERC20(
 	}
 }
 
+// ConvertCoins converts multiple native Cosmos coins into ERC20 tokens. The
+// conversions are atomic: the message fails if any of the coins can't be
+// converted.
+func (k Keeper) ConvertCoins(
+	goCtx context.Context,
+	msg *types.MsgConvertCoins,
+) (*types.MsgConvertCoinsResponse, error) {
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	for _, coin := range msg.Coins {
+		res, err := k.ConvertCoin(goCtx, types.NewMsgConvertCoin(coin, receiver, sender))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert %s", coin)
+		}
+
+		// NOTE: a nil response without error is returned when the token pair
+		// is removed because the ERC20 contract was self-destructed
+		if res == nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrTokenPairNotFound, "token pair of coin '%s' has been removed", coin.Denom,
+			)
+		}
+	}
+
+	return &types.MsgConvertCoinsResponse{}, nil
+}
+
 // convertCoinNativeCoin handles the coin conversion for a native Cosmos coin
 // token pair:
 //   - escrow coins on module account
@@ -289,11 +333,15 @@ func (k Keeper) convertERC20NativeCoin(
 
 // convertERC20NativeToken handles the erc20 conversion for a native erc20 token
 // pair:
+//   - reject the conversion if the token is flagged as non-standard
+//   - check that the escrowed tokens back the coin supply, i.e. that the token
+//     hasn't rebased since the last conversion
 //   - escrow tokens on module account
+//   - check if the escrowed balance increased by amount, or mint the received
+//     amount if the token pair allows it
 //   - mint coins on bank module
 //   - send minted coins to the receiver
-//   - check if coin balance increased by amount
-//   - check if token balance decreased by amount
+//   - check if coin balance increased by the minted amount
 //   - check for unexpected `Approval` event in logs
 func (k Keeper) convertERC20NativeToken(
 	ctx sdk.Context,
@@ -302,6 +350,12 @@ func (k Keeper) convertERC20NativeToken(
 	receiver sdk.AccAddress,
 	sender common.Address,
 ) (*types.MsgConvertERC20Response, error) {
+	if pair.Behavior == types.TOKEN_BEHAVIOR_REJECT {
+		return nil, errorsmod.Wrapf(
+			types.ErrNonStandardToken, "conversions of token '%s' to coins are rejected", pair.Erc20Address,
+		)
+	}
+
 	// NOTE: coin fields already validated
 	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: msg.Amount}}
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
@@ -312,6 +366,18 @@ func (k Keeper) convertERC20NativeToken(
 		return nil, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
 	}
 
+	// Check that the escrowed balance hasn't decreased without coins being
+	// burned, e.g. by a negative rebase
+	if pair.Behavior == types.TOKEN_BEHAVIOR_STANDARD {
+		supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+		if balanceToken.Cmp(supply.BigInt()) < 0 {
+			return nil, errorsmod.Wrapf(
+				types.ErrNonStandardToken,
+				"escrowed token balance %v is lower than the coin supply %v", balanceToken, supply,
+			)
+		}
+	}
+
 	// Escrow tokens on module account
 	transferData, err := erc20.Pack("transfer", types.ModuleAddress, msg.Amount.BigInt())
 	if err != nil {
@@ -343,11 +409,18 @@ func (k Keeper) convertERC20NativeToken(
 	expToken := big.NewInt(0).Add(balanceToken, tokens)
 
 	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
-		return nil, errorsmod.Wrapf(
-			types.ErrBalanceInvariance,
-			"invalid token balance - expected: %v, actual: %v",
-			expToken, balanceTokenAfter,
-		)
+		received := big.NewInt(0).Sub(balanceTokenAfter, balanceToken)
+
+		// Mint the amount actually received for the fee-on-transfer tokens
+		if pair.Behavior != types.TOKEN_BEHAVIOR_RECEIVED_DELTA || received.Sign() <= 0 || r > 0 {
+			return nil, errorsmod.Wrapf(
+				types.ErrBalanceInvariance,
+				"invalid token balance - expected: %v, actual: %v",
+				expToken, balanceTokenAfter,
+			)
+		}
+
+		coins = sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: math.NewIntFromBigInt(received)}}
 	}
 
 	// Mint coins
@@ -418,7 +491,8 @@ func (k Keeper) convertERC20NativeToken(
 //   - escrow Coins on module account
 //   - unescrow Tokens that have been previously escrowed with ConvertERC20 and send to receiver
 //   - burn escrowed Coins
-//   - check if token balance increased by amount
+//   - check if token balance increased by amount, or if the escrowed token
+//     balance decreased by amount for the non-standard tokens
 //   - check for unexpected `Approval` event in logs
 func (k Keeper) convertCoinNativeERC20(
 	ctx sdk.Context,
@@ -432,7 +506,15 @@ func (k Keeper) convertCoinNativeERC20(
 
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
-	balanceToken := k.BalanceOf(ctx, erc20, contract, receiver)
+
+	// The non-standard tokens may deliver less than the transferred amount, so
+	// the balance of the module account is checked instead of the receiver's
+	account := receiver
+	if pair.Behavior != types.TOKEN_BEHAVIOR_STANDARD {
+		account = types.ModuleAddress
+	}
+
+	balanceToken := k.BalanceOf(ctx, erc20, contract, account)
 	if balanceToken == nil {
 		return nil, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
 	}
@@ -458,14 +540,17 @@ func (k Keeper) convertCoinNativeERC20(
 		return nil, errorsmod.Wrap(errortypes.ErrLogic, "failed to execute unescrow tokens from user")
 	}
 
-	// Check expected Receiver balance after transfer execution
+	// Check expected Receiver or escrow balance after transfer execution
 	tokens := msg.Coin.Amount.BigInt()
-	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, receiver)
+	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, account)
 	if balanceTokenAfter == nil {
 		return nil, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
 	}
 
 	exp := big.NewInt(0).Add(balanceToken, tokens)
+	if account == types.ModuleAddress {
+		exp = big.NewInt(0).Sub(balanceToken, tokens)
+	}
 
 	if r := balanceTokenAfter.Cmp(exp); r != 0 {
 		return nil, errorsmod.Wrapf(
@@ -535,3 +620,105 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetTokenPairBehavior implements the gRPC MsgServer interface. After a successful governance vote
+// it sets how the conversions of a token pair handle non-standard ERC20 tokens only if the
+// requested authority is the Cosmos SDK governance module account
+func (k *Keeper) SetTokenPairBehavior(goCtx context.Context, req *types.MsgSetTokenPairBehavior) (*types.MsgSetTokenPairBehaviorResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.UpdateTokenPairBehavior(ctx, req.Token, req.Behavior)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetTokenPairBehavior,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyBehavior, pair.Behavior.String()),
+		),
+	)
+
+	return &types.MsgSetTokenPairBehaviorResponse{}, nil
+}
+
+// UpdateTokenPairMetadata implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the name and symbol of a module-owned token pair only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateTokenPairMetadata(goCtx context.Context, req *types.MsgUpdateTokenPairMetadata) (*types.MsgUpdateTokenPairMetadataResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.SetERC20Metadata(ctx, req.Token, req.Name, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateTokenMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyName, req.Name),
+			sdk.NewAttribute(types.AttributeKeySymbol, req.Symbol),
+		),
+	)
+
+	return &types.MsgUpdateTokenPairMetadataResponse{}, nil
+}
+
+// UpgradeTokenPairContract implements the gRPC MsgServer interface. After a successful governance vote
+// it replaces the code of the ERC20 contract of a module-owned token pair only if the requested
+// authority is the Cosmos SDK governance module account
+func (k *Keeper) UpgradeTokenPairContract(goCtx context.Context, req *types.MsgUpgradeTokenPairContract) (*types.MsgUpgradeTokenPairContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, codeHash, err := k.UpgradeERC20Contract(ctx, req.Token, common.HexToAddress(req.Implementation))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgradeTokenContract,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+		),
+	)
+
+	return &types.MsgUpgradeTokenPairContractResponse{}, nil
+}
+
+// SetConversionPreference sets how the coins received by the sender over IBC
+// are converted to their ERC20 representation
+func (k Keeper) SetConversionPreference(
+	goCtx context.Context,
+	msg *types.MsgSetConversionPreference,
+) (*types.MsgSetConversionPreferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	k.SetAccountConversionPreference(ctx, sender, msg.Preference)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConversionPreference,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPreference, msg.Preference.String()),
+		),
+	)
+
+	return &types.MsgSetConversionPreferenceResponse{}, nil
+}
@@ -9,6 +9,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/testutil"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/erc20/keeper"
 	"github.com/evmos/evmos/v16/x/erc20/types"
 	erc20mocks "github.com/evmos/evmos/v16/x/erc20/types/mocks"
@@ -105,6 +108,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			}, false, false,
 		},
 		{
@@ -130,6 +135,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				// Extra call on test
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			}, false, false,
 		},
 		{
@@ -148,6 +155,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				mockEVMKeeper.On("EstimateGasInternal", mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Times(4)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			}, false, false,
 		},
 	}
@@ -247,6 +256,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			false,
 		},
@@ -273,6 +284,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				// Extra call on test
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			false,
 		},
@@ -299,6 +312,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				// Extra call on test
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			false,
 		},
@@ -544,6 +559,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -570,6 +587,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Twice()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced balance error"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -595,6 +614,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -621,6 +642,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -645,6 +668,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
 				mockBankKeeper.On("GetBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(sdk.Coin{Denom: "coin", Amount: math.OneInt()})
+				mockBankKeeper.On("GetSupply", mock.Anything, mock.Anything).Return(sdk.Coin{Denom: coinName, Amount: math.ZeroInt()})
 			},
 			contractMinterBurner,
 			false,
@@ -668,6 +692,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
 				mockBankKeeper.On("GetBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(sdk.Coin{Denom: "coin", Amount: math.OneInt()})
+				mockBankKeeper.On("GetSupply", mock.Anything, mock.Anything).Return(sdk.Coin{Denom: coinName, Amount: math.ZeroInt()})
 			},
 			contractMinterBurner,
 			false,
@@ -692,6 +717,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
 				mockBankKeeper.On("GetBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(sdk.Coin{Denom: coinName, Amount: math.NewInt(int64(10))})
+				mockBankKeeper.On("GetSupply", mock.Anything, mock.Anything).Return(sdk.Coin{Denom: coinName, Amount: math.ZeroInt()})
 			},
 			contractMinterBurner,
 			false,
@@ -799,7 +825,12 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 			"fail - direct balance manipulation contract",
 			100,
 			10,
-			func(common.Address) {},
+			func(contract common.Address) {
+				// the token is flagged on registration, so its behavior is set back
+				// to test the balance invariant
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairBehavior(suite.ctx, contract.String(), types.TOKEN_BEHAVIOR_STANDARD)
+				suite.Require().NoError(err)
+			},
 			func() {},
 			contractDirectBalanceManipulation,
 			false,
@@ -845,6 +876,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -869,6 +902,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -894,6 +929,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Twice()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("fail second balance"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -917,6 +954,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				mockEVMKeeper.On("EstimateGasInternal", mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			contractMinterBurner,
 			false,
@@ -1123,6 +1162,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			}, false, false,
 		},
 		{
@@ -1148,6 +1189,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				// Extra call on test
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			}, false, false,
 		},
 		{
@@ -1166,6 +1209,8 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				mockEVMKeeper.On("EstimateGasInternal", mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Times(4)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			}, false, false,
 		},
 	}
@@ -1263,6 +1308,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: balance}, nil).Once()
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			false,
 		},
@@ -1289,6 +1336,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				// Extra call on test
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			false,
 		},
@@ -1315,6 +1364,8 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				// Extra call on test
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 				mockEVMKeeper.On("GetAccountWithoutBalance", mock.Anything, mock.Anything).Return(existingAcc, nil)
+				mockEVMKeeper.On("IsAvailablePrecompile", mock.Anything).Return(false)
+				mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
 			},
 			false,
 		},
@@ -1447,3 +1498,105 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestConvertCoins() {
+	var coins sdk.Coins
+
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	receiver := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - coin not registered",
+			func() {
+				coins = coins.Add(sdk.NewCoin("unregistered", math.NewInt(10)))
+			},
+			false,
+		},
+		{
+			"fail - insufficient funds for one of the coins",
+			func() {
+				coins = sdk.NewCoins(
+					sdk.NewCoin(metadataCoin.Base, math.NewInt(10)),
+					sdk.NewCoin(metadataIbc.Base, math.NewInt(1000)),
+				)
+			},
+			false,
+		},
+		{
+			"pass - convert multiple coins",
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			pairCoin := suite.setupRegisterCoin(metadataCoin)
+			pairIbc := suite.setupRegisterCoin(metadataIbc)
+
+			funds := sdk.NewCoins(
+				sdk.NewCoin(metadataCoin.Base, math.NewInt(100)),
+				sdk.NewCoin(metadataIbc.Base, math.NewInt(100)),
+			)
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, funds)
+			suite.Require().NoError(err)
+
+			coins = sdk.NewCoins(
+				sdk.NewCoin(metadataCoin.Base, math.NewInt(10)),
+				sdk.NewCoin(metadataIbc.Base, math.NewInt(20)),
+			)
+
+			tc.malleate()
+
+			msg := types.NewMsgConvertCoins(coins, receiver, sender)
+			_, err = suite.app.Erc20Keeper.ConvertCoins(sdk.WrapSDKContext(suite.ctx), msg)
+
+			erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			balanceCoin := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20ABI, pairCoin.GetERC20Contract(), receiver)
+			balanceIbc := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20ABI, pairIbc.GetERC20Contract(), receiver)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(big.NewInt(10), balanceCoin)
+			suite.Require().Equal(big.NewInt(20), balanceIbc)
+			suite.Require().Equal(funds.Sub(coins...), suite.app.BankKeeper.GetAllBalances(suite.ctx, sender))
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestSetConversionPreference() {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		preference types.ConversionPreference
+	}{
+		{"convert the received coins only", types.CONVERSION_PREFERENCE_RECEIVED},
+		{"opt out of the conversion", types.CONVERSION_PREFERENCE_NONE},
+		{"reset to the default preference", types.CONVERSION_PREFERENCE_BALANCE},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			msg := types.NewMsgSetConversionPreference(sender, tc.preference)
+			_, err := suite.app.Erc20Keeper.SetConversionPreference(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.preference, suite.app.Erc20Keeper.GetAccountConversionPreference(suite.ctx, sender))
+		})
+	}
+
+	// the default preference isn't stored
+	suite.Require().Empty(suite.app.Erc20Keeper.GetAccountConversionPreferences(suite.ctx))
+}
@@ -4,6 +4,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v16/x/erc20/types"
 )
@@ -14,8 +15,9 @@ var isTrue = []byte("0x01")
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	enableEvmHook := k.GetEnableEVMHook(ctx)
+	autoRegistrationChannels := k.GetAutoRegistrationChannels(ctx)
 
-	return types.NewParams(enableErc20, enableEvmHook)
+	return types.NewParams(enableErc20, enableEvmHook, autoRegistrationChannels)
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -26,6 +28,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setEnableEVMHook(ctx, params.EnableEVMHook)
+	k.setAutoRegistrationChannels(ctx, params.AutoRegistrationChannels)
 
 	return nil
 }
@@ -42,6 +45,28 @@ func (k Keeper) GetEnableEVMHook(ctx sdk.Context) bool {
 	return store.Has(types.ParamStoreKeyEnableEVMHook)
 }
 
+// GetAutoRegistrationChannels returns the channels on which the inbound IBC
+// denominations are registered automatically
+func (k Keeper) GetAutoRegistrationChannels(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamStoreKeyAutoRegistrationChannel)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var channels []string
+	for ; iterator.Valid(); iterator.Next() {
+		channels = append(channels, string(iterator.Key()))
+	}
+
+	return channels
+}
+
+// IsAutoRegistrationChannel returns true if the inbound IBC denominations are
+// registered automatically on the given channel
+func (k Keeper) IsAutoRegistrationChannel(ctx sdk.Context, channel string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamStoreKeyAutoRegistrationChannel)
+	return store.Has([]byte(channel))
+}
+
 // setERC20Enabled sets the EnableERC20 param in the store
 func (k Keeper) setERC20Enabled(ctx sdk.Context, enable bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -61,3 +86,24 @@ func (k Keeper) setEnableEVMHook(ctx sdk.Context, enable bool) {
 	}
 	store.Delete(types.ParamStoreKeyEnableEVMHook)
 }
+
+// setAutoRegistrationChannels replaces the auto-registration channels in the
+// store
+func (k Keeper) setAutoRegistrationChannels(ctx sdk.Context, channels []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamStoreKeyAutoRegistrationChannel)
+	iterator := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, channel := range channels {
+		store.Set([]byte(channel), isTrue)
+	}
+}
@@ -26,6 +26,32 @@ func (suite *KeeperTestSuite) TestParams() {
 			},
 			true,
 		},
+		{
+			"success - Checks if the auto-registration channels are set correctly",
+			func() interface{} {
+				params := types.DefaultParams()
+				params.AutoRegistrationChannels = []string{"channel-0", "channel-3"}
+				suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, params))
+				return params
+			},
+			func() interface{} {
+				return suite.app.Erc20Keeper.GetParams(suite.ctx)
+			},
+			true,
+		},
+		{
+			"success - Checks if the auto-registration channels are replaced",
+			func() interface{} {
+				params := types.DefaultParams()
+				params.AutoRegistrationChannels = []string{"channel-1"}
+				suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, params))
+				return params
+			},
+			func() interface{} {
+				return suite.app.Erc20Keeper.GetParams(suite.ctx)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,26 +4,40 @@
 package keeper
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v16/precompiles/erc20"
 	"github.com/evmos/evmos/v16/precompiles/werc20"
 	"github.com/evmos/evmos/v16/x/erc20/types"
+	"golang.org/x/exp/slices"
 )
 
-// RegisterERC20Extensions registers the ERC20 precompiles with the EVM.
-func (k Keeper) RegisterERC20Extensions(ctx sdk.Context) error {
+// RegisterERC20Extensions registers the ERC20 precompiles with the EVM. The
+// balances of the ERC20 contracts that are replaced by the precompiles are
+// migrated to the bank module first, so that the token pairs never hold a
+// separate ERC20 balance. The holders of each contract are the accounts of the
+// chain, along with the given holders that don't have an account. It fails if
+// the balances of any pair can't be migrated, as the tokens of the missing
+// holders would be lost otherwise.
+func (k Keeper) RegisterERC20Extensions(ctx sdk.Context, holders map[common.Address][]common.Address) error {
 	precompiles := make([]vm.PrecompiledContract, 0)
+	pairs := make([]types.TokenPair, 0)
 	params := k.evmKeeper.GetParams(ctx)
 	evmDenom := params.EvmDenom
+	accounts := k.accountAddresses(ctx)
 
 	var err error
 	k.IterateTokenPairs(ctx, func(tokenPair types.TokenPair) bool {
 		// skip registration if token is native or if it has already been registered
 		// NOTE: this should handle failure during the selfdestruct
-		if tokenPair.ContractOwner != types.OWNER_MODULE ||
-			k.evmKeeper.IsAvailablePrecompile(tokenPair.GetERC20Contract()) {
+		if tokenPair.ContractOwner != types.OWNER_MODULE || k.IsPrecompilePair(ctx, tokenPair) {
 			return false
 		}
 
@@ -40,6 +54,22 @@ func (k Keeper) RegisterERC20Extensions(ctx sdk.Context) error {
 			return true
 		}
 
+		pairHolders := append(slices.Clone(accounts), holders[tokenPair.GetERC20Contract()]...)
+		if err = k.MigrateERC20Balances(ctx, tokenPair, pairHolders); err != nil {
+			err = errorsmod.Wrapf(err, "failed to migrate ERC20 balances of denom %s", tokenPair.Denom)
+			return true
+		}
+
+		precompiles = append(precompiles, precompile)
+		pairs = append(pairs, tokenPair)
+		return false
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, tokenPair := range pairs {
 		address := tokenPair.GetERC20Contract()
 
 		// try selfdestruct ERC20 contract
@@ -48,20 +78,160 @@ func (k Keeper) RegisterERC20Extensions(ctx sdk.Context) error {
 		// of the ERC20MinterBurner contract. We try to force a selfdestruct to remove the unnecessary
 		// code and storage from the state machine. In any case, the precompiles are handled in the EVM
 		// before the regular contracts so not removing them doesn't create any issues in the implementation.
-		err = k.evmKeeper.DeleteAccount(ctx, address)
-		if err != nil {
-			err = errorsmod.Wrapf(err, "failed to selfdestruct account %s", address)
-			return true
+		if err := k.evmKeeper.DeleteAccount(ctx, address); err != nil {
+			return errorsmod.Wrapf(err, "failed to selfdestruct account %s", address)
 		}
+	}
 
-		precompiles = append(precompiles, precompile)
+	// add the ERC20s to the EVM active and available precompiles
+	return k.evmKeeper.AddEVMExtensions(ctx, precompiles...)
+}
+
+// MigrateERC20Balances moves the ERC20 balances of a module-owned token pair to
+// the bank module, by releasing the coins escrowed for the tokens of each
+// holder. The balances are read from the storage of the ERC20 contract instead
+// of calling it, and the tokens aren't burned as the contract is expected to be
+// replaced by the ERC20 precompile afterwards, which uses the bank balances.
+//
+// The holders are expected to include every address with an ERC20 balance,
+// i.e. the accounts and the receivers of Transfer logs without an account. The
+// migration fails if their balances don't add up to the total supply, so that
+// no balance is lost when the contract is deleted.
+func (k Keeper) MigrateERC20Balances(ctx sdk.Context, pair types.TokenPair, holders []common.Address) error {
+	if !pair.IsNativeCoin() {
+		return errorsmod.Wrapf(
+			types.ErrUndefinedOwner, "token pair %s is not owned by the module", pair.Denom,
+		)
+	}
+
+	// only the pairs with a deployed contract hold ERC20 balances
+	contract := pair.GetERC20Contract()
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil
+	}
+
+	// the tokens held by the module are backed by its own coins
+	migrated := k.erc20StorageValue(ctx, contract, erc20BalanceSlot(types.ModuleAddress))
+
+	balances := make([]*big.Int, len(holders))
+	seen := make(map[common.Address]bool, len(holders))
+	for i, holder := range holders {
+		if holder == types.ModuleAddress || seen[holder] {
+			continue
+		}
+		seen[holder] = true
+
+		balances[i] = k.erc20StorageValue(ctx, contract, erc20BalanceSlot(holder))
+		migrated.Add(migrated, balances[i])
+	}
+
+	totalSupply := k.erc20StorageValue(ctx, contract, erc20TotalSupplySlot)
+	if migrated.Cmp(totalSupply) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"balances of the %d holders of %s add up to %s instead of the total supply %s",
+			len(seen), pair.Erc20Address, migrated, totalSupply,
+		)
+	}
+
+	for i, holder := range holders {
+		if balances[i] == nil || balances[i].Sign() == 0 {
+			continue
+		}
+
+		coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: math.NewIntFromBigInt(balances[i])}}
+		if err := k.bankKeeper.SendCoins(ctx, types.ModuleAddress.Bytes(), holder.Bytes(), coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to migrate %s balance of %s", pair.Denom, holder)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMigrateERC20Balance,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyReceiver, sdk.AccAddress(holder.Bytes()).String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, balances[i].String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// accountAddresses returns the addresses of all the accounts of the chain.
+func (k Keeper) accountAddresses(ctx sdk.Context) []common.Address {
+	addresses := make([]common.Address, 0)
+	k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		addresses = append(addresses, common.BytesToAddress(account.GetAddress()))
 		return false
 	})
+	return addresses
+}
+
+// Storage slots of the module-owned ERC20MinterBurnerDecimals contract, as laid
+// out by the inherited OpenZeppelin contracts: AccessControl (_roles),
+// AccessControlEnumerable (_roleMembers), then ERC20 (_balances, _allowances,
+// _totalSupply).
+var (
+	erc20BalancesSlot    = common.BigToHash(big.NewInt(2))
+	erc20TotalSupplySlot = common.BigToHash(big.NewInt(4))
+)
+
+// erc20BalanceSlot returns the storage slot of the balance of a holder in the
+// _balances mapping of the module-owned ERC20 contract.
+func erc20BalanceSlot(holder common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(holder.Bytes(), 32), erc20BalancesSlot.Bytes())
+}
+
+// erc20StorageValue returns the value stored at a slot of an ERC20 contract.
+func (k Keeper) erc20StorageValue(ctx sdk.Context, contract common.Address, slot common.Hash) *big.Int {
+	return k.evmKeeper.GetState(ctx, contract, slot).Big()
+}
+
+// IsPrecompilePair returns true if the ERC20 of a module-owned token pair is
+// served by the ERC20 precompile. The ERC20 balances of these pairs are the bank
+// balances, so they are never converted.
+func (k Keeper) IsPrecompilePair(ctx sdk.Context, pair types.TokenPair) bool {
+	if !pair.IsNativeCoin() {
+		return false
+	}
+
+	address := pair.GetERC20Contract()
+	return k.evmKeeper.IsAvailablePrecompile(address) ||
+		k.evmKeeper.GetParams(ctx).IsActivePrecompile(address.String())
+}
+
+// GetDynamicPrecompile implements evmtypes.DynamicPrecompileKeeper. It
+// instantiates the ERC20 precompile of a module-owned token pair from the state,
+// so that the precompiles of the token pairs registered while the node is
+// running, e.g. by the IBC coin auto-registration, are never kept in memory.
+func (k Keeper) GetDynamicPrecompile(ctx sdk.Context, address common.Address) (vm.PrecompiledContract, bool) {
+	id := k.GetERC20Map(ctx, address)
+	if len(id) == 0 {
+		return nil, false
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found || !pair.IsNativeCoin() {
+		return nil, false
+	}
+
+	var (
+		precompile vm.PrecompiledContract
+		err        error
+	)
+
+	if pair.Denom == k.evmKeeper.GetParams(ctx).EvmDenom {
+		precompile, err = werc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+	} else {
+		precompile, err = erc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+	}
 
 	if err != nil {
-		return err
+		k.Logger(ctx).Error("failed to instantiate ERC-20 precompile", "denom", pair.Denom, "error", err.Error())
+		return nil, false
 	}
 
-	// add the ERC20s to the EVM active and available precompiles
-	return k.evmKeeper.AddEVMExtensions(ctx, precompiles...)
+	return precompile, true
 }
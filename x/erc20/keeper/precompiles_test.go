@@ -4,6 +4,11 @@
 package keeper_test
 
 import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	erc20precompile "github.com/evmos/evmos/v16/precompiles/erc20"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/utils"
@@ -132,7 +137,7 @@ func (suite *KeeperTestSuite) TestRegisterERC20Extensions() {
 				tc.malleate()
 			}
 
-			err := suite.app.Erc20Keeper.RegisterERC20Extensions(suite.ctx)
+			err := suite.app.Erc20Keeper.RegisterERC20Extensions(suite.ctx, nil)
 
 			if tc.expPass {
 				suite.Require().NoError(err, "expected no error registering ERC20 extensions")
@@ -147,3 +152,76 @@ func (suite *KeeperTestSuite) TestRegisterERC20Extensions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrateERC20Balances() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.mintFeeCollector = false
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	pair := suite.setupRegisterCoin(metadataCoin)
+	coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, math.NewInt(100)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	_, err := suite.app.Erc20Keeper.ConvertCoin(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, math.NewInt(40)), suite.address, sender),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(40), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+
+	// the receiver has no account, only an ERC20 balance
+	receiver := utiltx.GenerateAddress()
+	suite.TransferERC20Token(pair.GetERC20Contract(), suite.address, receiver, big.NewInt(15))
+	suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, receiver.Bytes()))
+
+	// the registration fails if a holder without an account is missing, as
+	// the holders with an account are read from the state
+	err = suite.app.Erc20Keeper.RegisterERC20Extensions(suite.ctx, nil)
+	suite.Require().ErrorIs(err, types.ErrBalanceInvariance)
+	suite.Require().False(suite.app.Erc20Keeper.IsPrecompilePair(suite.ctx, *pair))
+	acc := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(acc != nil && acc.IsContract())
+	suite.Require().Equal(big.NewInt(25), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+	suite.Require().Equal(big.NewInt(15), suite.BalanceOf(pair.GetERC20Contract(), receiver))
+
+	holders := map[common.Address][]common.Address{pair.GetERC20Contract(): {receiver, receiver}}
+	err = suite.app.Erc20Keeper.RegisterERC20Extensions(suite.ctx, holders)
+	suite.Require().NoError(err)
+
+	// the ERC20 balance is the bank balance
+	suite.Require().True(suite.app.Erc20Keeper.IsPrecompilePair(suite.ctx, *pair))
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, cosmosTokenBase)
+	suite.Require().Equal(int64(85), balance.Amount.Int64())
+	suite.Require().Equal(big.NewInt(85), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, receiver.Bytes(), cosmosTokenBase)
+	suite.Require().Equal(int64(15), balance.Amount.Int64())
+	suite.Require().Equal(big.NewInt(15), suite.BalanceOf(pair.GetERC20Contract(), receiver))
+
+	// the escrowed coins are released
+	escrow := suite.app.BankKeeper.GetBalance(suite.ctx, types.ModuleAddress.Bytes(), cosmosTokenBase)
+	suite.Require().True(escrow.IsZero())
+
+	found := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMigrateERC20Balance {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+
+	// the precompile pairs are never converted
+	_, err = suite.app.Erc20Keeper.ConvertCoin(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, math.NewInt(10)), suite.address, sender),
+	)
+	suite.Require().ErrorIs(err, types.ErrPrecompileTokenPair)
+
+	_, err = suite.app.Erc20Keeper.ConvertERC20(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgConvertERC20(math.NewInt(10), sender, pair.GetERC20Contract(), suite.address),
+	)
+	suite.Require().ErrorIs(err, types.ErrPrecompileTokenPair)
+	suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, cosmosTokenBase))
+}
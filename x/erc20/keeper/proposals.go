@@ -75,6 +75,22 @@ func (k Keeper) RegisterERC20(
 	}
 
 	pair := types.NewTokenPair(contract, metadata.Name, types.OWNER_EXTERNAL)
+
+	// reject the conversions of the tokens that look non-standard until
+	// governance sets how they are handled
+	if reason, found := k.findNonStandardTokenBehavior(ctx, contract); found {
+		pair.Behavior = types.TOKEN_BEHAVIOR_REJECT
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFlagNonStandardToken,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyReason, reason),
+			),
+		)
+	}
+
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
@@ -0,0 +1,168 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+// UpdateTokenPairBehavior sets how the conversions of an externally-owned token
+// pair handle non-standard ERC20 tokens
+func (k Keeper) UpdateTokenPairBehavior(
+	ctx sdk.Context,
+	token string,
+	behavior types.TokenBehavior,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest, "token '%s' is not owned by an external account", token,
+		)
+	}
+
+	pair.Behavior = behavior
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// findNonStandardTokenBehavior returns the reason why the token of the contract
+// looks non-standard, if any. The code of the contract is looked up for methods
+// that are characteristic of the fee-on-transfer and rebasing tokens, and a
+// transfer is probed to detect the tokens whose code doesn't tell, e.g. the ones
+// behind a proxy.
+func (k Keeper) findNonStandardTokenBehavior(ctx sdk.Context, contract common.Address) (string, bool) {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return "", false
+	}
+
+	code := k.evmKeeper.GetCode(ctx, common.BytesToHash(acc.CodeHash))
+	if method, found := types.FindNonStandardTokenMethod(code); found {
+		return fmt.Sprintf("contract implements %s", method), true
+	}
+
+	return k.probeTokenTransfer(ctx, contract)
+}
+
+var (
+	// probeSender and probeReceiver are the accounts between which the tokens are
+	// transferred to probe the behavior of a token on its registration
+	probeSender   = common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName + "/probe/sender"))
+	probeReceiver = common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName + "/probe/receiver"))
+	// probeBalance is the balance of the probe sender written in the storage of the
+	// token contract
+	probeBalance = new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil)
+	// erc7201ERC20Slot is the base slot of the namespaced storage of the OpenZeppelin
+	// upgradeable ERC20 contracts, whose first field is the balances mapping.
+	erc7201ERC20Slot = common.HexToHash("0x52c63247e1f47db19d5ce0460030c497f067ca4cebf71ba98eeadabe20bace00")
+)
+
+// probeBalanceSlots is the number of storage slots, starting from zero, that are
+// probed for the balances mapping of a token contract
+const probeBalanceSlots = 10
+
+// probeTokenTransfer transfers tokens between two probe accounts in a cached
+// context, and returns the transfer if the balances of the accounts don't change
+// by the transferred amount, as it happens with the fee-on-transfer tokens.
+//
+// NOTE: the probe sender is funded by writing its balance in the storage of the
+// contract, at its entry of the candidate slots of the balances mapping. So the
+// probe is inconclusive for the tokens that store their balances in other slots,
+// like the reflection tokens, and for the tokens that only charge fees on some
+// transfers, e.g. the ones to liquidity pools.
+func (k Keeper) probeTokenTransfer(ctx sdk.Context, contract common.Address) (string, bool) {
+	cacheCtx, _ := ctx.CacheContext()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	if err := k.evmKeeper.SetAccount(cacheCtx, probeSender, *statedb.NewEmptyAccount()); err != nil {
+		return "", false
+	}
+
+	balance := k.fundProbeSender(cacheCtx, erc20, contract)
+	if balance == nil {
+		return "", false
+	}
+
+	amount := new(big.Int).Quo(balance, big.NewInt(2))
+	receiverBalance := k.BalanceOf(cacheCtx, erc20, contract, probeReceiver)
+	if receiverBalance == nil {
+		return "", false
+	}
+
+	// the tokens that fail the transfer, e.g. paused ones, are left to the checks
+	// of the conversions
+	if _, err := k.CallEVM(cacheCtx, erc20, probeSender, contract, true, "transfer", probeReceiver, amount); err != nil {
+		return "", false
+	}
+
+	senderBalanceAfter := k.BalanceOf(cacheCtx, erc20, contract, probeSender)
+	receiverBalanceAfter := k.BalanceOf(cacheCtx, erc20, contract, probeReceiver)
+	if senderBalanceAfter == nil || receiverBalanceAfter == nil {
+		return "", false
+	}
+
+	sent := new(big.Int).Sub(balance, senderBalanceAfter)
+	received := new(big.Int).Sub(receiverBalanceAfter, receiverBalance)
+	if sent.Cmp(amount) != 0 || received.Cmp(amount) != 0 {
+		return fmt.Sprintf("transfer of %s tokens debits %s from the sender and credits %s to the receiver", amount, sent, received), true
+	}
+
+	return "", false
+}
+
+// fundProbeSender writes the balance of the probe sender in the candidate slots
+// of the balances mapping of the contract, laid out by Solidity or Vyper, until
+// the contract reports a balance. It returns nil if none of the slots holds the
+// balances.
+func (k Keeper) fundProbeSender(ctx sdk.Context, erc20 abi.ABI, contract common.Address) *big.Int {
+	key := common.LeftPadBytes(probeSender.Bytes(), 32)
+	value := common.BigToHash(probeBalance).Bytes()
+
+	slots := make([]common.Hash, 0, probeBalanceSlots+1)
+	for i := int64(0); i < probeBalanceSlots; i++ {
+		slots = append(slots, common.BigToHash(big.NewInt(i)))
+	}
+	slots = append(slots, erc7201ERC20Slot)
+
+	for _, slot := range slots {
+		for _, entry := range []common.Hash{
+			crypto.Keccak256Hash(key, slot.Bytes()), // Solidity
+			crypto.Keccak256Hash(slot.Bytes(), key), // Vyper
+		} {
+			k.evmKeeper.SetState(ctx, contract, entry, value)
+			if balance := k.BalanceOf(ctx, erc20, contract, probeSender); balance != nil && balance.Sign() > 0 {
+				return balance
+			}
+			k.evmKeeper.SetState(ctx, contract, entry, nil)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestConvertNonStandardERC20() {
+	testCases := []struct {
+		name         string
+		contractType int
+		malleate     func(pair types.TokenPair)
+		expErr       error
+		expMinted    int64
+	}{
+		{
+			"fail - standard behavior with fee-on-transfer token",
+			contractDirectBalanceManipulation,
+			func(pair types.TokenPair) {
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairBehavior(suite.ctx, pair.Erc20Address, types.TOKEN_BEHAVIOR_STANDARD)
+				suite.Require().NoError(err)
+			},
+			types.ErrBalanceInvariance,
+			0,
+		},
+		{
+			"pass - received delta behavior with fee-on-transfer token",
+			contractDirectBalanceManipulation,
+			func(pair types.TokenPair) {
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairBehavior(suite.ctx, pair.Erc20Address, types.TOKEN_BEHAVIOR_RECEIVED_DELTA)
+				suite.Require().NoError(err)
+			},
+			nil,
+			5,
+		},
+		{
+			"pass - received delta behavior with standard token",
+			contractMinterBurner,
+			func(pair types.TokenPair) {
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairBehavior(suite.ctx, pair.Erc20Address, types.TOKEN_BEHAVIOR_RECEIVED_DELTA)
+				suite.Require().NoError(err)
+			},
+			nil,
+			10,
+		},
+		{
+			"fail - reject behavior",
+			contractMinterBurner,
+			func(pair types.TokenPair) {
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairBehavior(suite.ctx, pair.Erc20Address, types.TOKEN_BEHAVIOR_REJECT)
+				suite.Require().NoError(err)
+			},
+			types.ErrNonStandardToken,
+			0,
+		},
+		{
+			"fail - escrowed balance lower than the coin supply",
+			contractMinterBurner,
+			func(pair types.TokenPair) {
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, math.NewInt(1)))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			types.ErrNonStandardToken,
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			contract := suite.setupRegisterERC20Pair(tc.contractType)
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contract.String())
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+
+			tc.malleate(pair)
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			_, err := suite.app.Erc20Keeper.ConvertERC20(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgConvertERC20(math.NewInt(10), sender, contract, suite.address),
+			)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+			suite.Require().Equal(tc.expMinted, balance.Amount.Int64())
+
+			// the minted coins are convertible back to tokens
+			_, err = suite.app.Erc20Keeper.ConvertCoin(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgConvertCoin(balance, suite.address, sender),
+			)
+			suite.Require().NoError(err)
+			suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).IsZero())
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestRegisterNonStandardERC20() {
+	suite.SetupTest()
+
+	contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	// append a PUSH4 of the rebasing tokens' sharesOf(address) selector to the
+	// contract code
+	stateDB := suite.StateDB()
+	code := append(stateDB.GetCode(contract), make([]byte, 33)...)
+	code = append(code, byte(vm.PUSH4))
+	code = append(code, crypto.Keccak256([]byte("sharesOf(address)"))[:4]...)
+	stateDB.SetCode(contract, code)
+	suite.Require().NoError(stateDB.Commit())
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.TOKEN_BEHAVIOR_REJECT, pair.Behavior)
+
+	found := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFlagNonStandardToken {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestRegisterProxiedNonStandardERC20() {
+	testCases := []struct {
+		name         string
+		contractType int
+		expBehavior  types.TokenBehavior
+	}{
+		{
+			"standard token",
+			contractMinterBurner,
+			types.TOKEN_BEHAVIOR_STANDARD,
+		},
+		{
+			"fee-on-transfer token",
+			contractDirectBalanceManipulation,
+			types.TOKEN_BEHAVIOR_REJECT,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var (
+				implementation common.Address
+				err            error
+			)
+			if tc.contractType == contractDirectBalanceManipulation {
+				implementation, err = suite.DeployContractDirectBalanceManipulation()
+			} else {
+				implementation, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			}
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			// the proxy delegates all the calls to the implementation, so none of
+			// the methods of the token are found in its code
+			proxy := utiltx.GenerateAddress()
+			code := []byte{
+				byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+				byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0,
+				byte(vm.PUSH20),
+			}
+			code = append(code, implementation.Bytes()...)
+			code = append(code,
+				byte(vm.GAS), byte(vm.DELEGATECALL),
+				byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+				byte(vm.PUSH1), 49, byte(vm.JUMPI),
+				byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT),
+				byte(vm.JUMPDEST), byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN),
+			)
+
+			// the proxy holds the state of the token, as if it was initialized
+			stateDB := suite.StateDB()
+			stateDB.SetCode(proxy, code)
+			err = stateDB.ForEachStorage(implementation, func(key, value common.Hash) bool {
+				stateDB.SetState(proxy, key, value)
+				return true
+			})
+			suite.Require().NoError(err)
+			suite.Require().NoError(stateDB.Commit())
+
+			_, found := types.FindNonStandardTokenMethod(stateDB.GetCode(proxy))
+			suite.Require().False(found)
+
+			pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, proxy)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBehavior, pair.Behavior)
+
+			// the probe transfer is not persisted
+			suite.Require().Zero(suite.BalanceOf(proxy, common.HexToAddress("0x4dC6ac40Af078661fc43823086E1513635Eeab14")).(*big.Int).Sign())
+
+			flagged := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeFlagNonStandardToken {
+					flagged = true
+				}
+			}
+			suite.Require().Equal(tc.expBehavior == types.TOKEN_BEHAVIOR_REJECT, flagged)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetTokenPairBehavior() {
+	var (
+		contract   common.Address
+		modulePair types.TokenPair
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   func() *types.MsgSetTokenPairBehavior
+		expectErr bool
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgSetTokenPairBehavior {
+				return &types.MsgSetTokenPairBehavior{Authority: "foobar", Token: contract.String()}
+			},
+			true,
+		},
+		{
+			"fail - token pair not registered",
+			func() *types.MsgSetTokenPairBehavior {
+				return &types.MsgSetTokenPairBehavior{Authority: authority, Token: "unregistered"}
+			},
+			true,
+		},
+		{
+			"fail - module-owned token pair",
+			func() *types.MsgSetTokenPairBehavior {
+				return &types.MsgSetTokenPairBehavior{
+					Authority: authority,
+					Token:     modulePair.Denom,
+					Behavior:  types.TOKEN_BEHAVIOR_RECEIVED_DELTA,
+				}
+			},
+			true,
+		},
+		{
+			"pass - externally-owned token pair",
+			func() *types.MsgSetTokenPairBehavior {
+				return &types.MsgSetTokenPairBehavior{
+					Authority: authority,
+					Token:     contract.String(),
+					Behavior:  types.TOKEN_BEHAVIOR_RECEIVED_DELTA,
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			modulePair = *suite.setupRegisterCoin(metadataCoin)
+			contract = suite.setupRegisterERC20Pair(contractMinterBurner)
+
+			req := tc.request()
+			_, err := suite.app.Erc20Keeper.SetTokenPairBehavior(sdk.WrapSDKContext(suite.ctx), req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, req.Token)
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(req.Behavior, pair.Behavior)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// SetERC20Metadata updates the name and symbol of a module-owned token
+// pair. The Cosmos coin metadata is always updated, and so is the state of the
+// ERC20 contract deployed by the module, if any. The ERC20 precompiles read the
+// name and symbol from the Cosmos coin metadata.
+func (k Keeper) SetERC20Metadata(
+	ctx sdk.Context,
+	token, name, symbol string,
+) (types.TokenPair, error) {
+	pair, err := k.getModuleOwnedTokenPair(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			banktypes.ErrDenomMetadataNotFound, "denom %s", pair.Denom,
+		)
+	}
+
+	metadata.Name = name
+	metadata.Symbol = symbol
+	if err := metadata.Validate(); err != nil {
+		return types.TokenPair{}, errorsmod.Wrapf(err, "invalid metadata for denom %s", pair.Denom)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	if !k.hasERC20Contract(ctx, pair) {
+		return pair, nil
+	}
+
+	contract := pair.GetERC20Contract()
+	current, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if err := k.setContractString(ctx, contract, types.ERC20NameSlot, current.Name, name); err != nil {
+		return types.TokenPair{}, err
+	}
+	if err := k.setContractString(ctx, contract, types.ERC20SymbolSlot, current.Symbol, symbol); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	updated, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if updated.Name != name || updated.Symbol != symbol {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrIncompatibleContract,
+			"contract %s returns name '%s' and symbol '%s' after the update", contract, updated.Name, updated.Symbol,
+		)
+	}
+
+	return pair, nil
+}
+
+// UpgradeERC20Contract replaces the code of the ERC20 contract of a
+// module-owned token pair with the code of a deployed implementation and
+// returns the new code hash. The contract storage is kept, so the balances are
+// preserved as long as the implementation has a compatible storage layout. The
+// upgrade fails if it changes the token metadata or total supply, or if the
+// module can't mint and burn the tokens anymore.
+func (k Keeper) UpgradeERC20Contract(
+	ctx sdk.Context,
+	token string,
+	implementation common.Address,
+) (types.TokenPair, common.Hash, error) {
+	pair, err := k.getModuleOwnedTokenPair(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, common.Hash{}, err
+	}
+
+	if !k.hasERC20Contract(ctx, pair) {
+		return types.TokenPair{}, common.Hash{}, errorsmod.Wrapf(
+			types.ErrPrecompileTokenPair, "token '%s' doesn't have a deployed ERC20 contract", token,
+		)
+	}
+
+	contract := pair.GetERC20Contract()
+	if implementation == contract {
+		return types.TokenPair{}, common.Hash{}, errorsmod.Wrapf(
+			types.ErrIncompatibleContract, "implementation %s is the ERC20 contract", implementation,
+		)
+	}
+
+	implAcc := k.evmKeeper.GetAccountWithoutBalance(ctx, implementation)
+	if implAcc == nil || !implAcc.IsContract() {
+		return types.TokenPair{}, common.Hash{}, errorsmod.Wrapf(
+			types.ErrIncompatibleContract, "implementation %s is not a contract", implementation,
+		)
+	}
+
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, common.Hash{}, err
+	}
+
+	totalSupply, err := k.queryERC20Amount(ctx, pair, "totalSupply")
+	if err != nil {
+		return types.TokenPair{}, common.Hash{}, err
+	}
+
+	acc := k.evmKeeper.GetAccount(ctx, contract)
+	acc.CodeHash = implAcc.CodeHash
+	if err := k.evmKeeper.SetAccount(ctx, contract, *acc); err != nil {
+		return types.TokenPair{}, common.Hash{}, err
+	}
+
+	upgradedData, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, common.Hash{}, errorsmod.Wrapf(types.ErrIncompatibleContract, "%s", err)
+	}
+
+	if upgradedData != erc20Data {
+		return types.TokenPair{}, common.Hash{}, errorsmod.Wrapf(
+			types.ErrIncompatibleContract,
+			"token data changed from %+v to %+v", erc20Data, upgradedData,
+		)
+	}
+
+	upgradedSupply, err := k.queryERC20Amount(ctx, pair, "totalSupply")
+	if err != nil {
+		return types.TokenPair{}, common.Hash{}, errorsmod.Wrapf(types.ErrIncompatibleContract, "%s", err)
+	}
+
+	if upgradedSupply.Cmp(totalSupply) != 0 {
+		return types.TokenPair{}, common.Hash{}, errorsmod.Wrapf(
+			types.ErrIncompatibleContract,
+			"total supply changed from %s to %s", totalSupply, upgradedSupply,
+		)
+	}
+
+	if err := k.checkMinterBurner(ctx, contract); err != nil {
+		return types.TokenPair{}, common.Hash{}, err
+	}
+
+	return pair, common.BytesToHash(implAcc.CodeHash), nil
+}
+
+// getModuleOwnedTokenPair returns the token pair of a token identifier and
+// checks that its ERC20 is owned by the module.
+func (k Keeper) getModuleOwnedTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.IsNativeCoin() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrUndefinedOwner, "token '%s' is not owned by the module", token,
+		)
+	}
+
+	return pair, nil
+}
+
+// hasERC20Contract returns true if the ERC20 of the token pair is a contract
+// deployed on the EVM instead of a precompile.
+func (k Keeper) hasERC20Contract(ctx sdk.Context, pair types.TokenPair) bool {
+	if k.IsPrecompilePair(ctx, pair) {
+		return false
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, pair.GetERC20Contract())
+	return acc != nil && acc.IsContract()
+}
+
+// setContractString replaces a Solidity string stored at the given slot of a
+// contract after checking that the slot contains the current value, so that
+// the storage layout of the contract is the expected one.
+func (k Keeper) setContractString(
+	ctx sdk.Context,
+	contract common.Address,
+	slot common.Hash,
+	current, value string,
+) error {
+	stored, err := types.ReadStorageString(slot, func(key common.Hash) common.Hash {
+		return k.evmKeeper.GetState(ctx, contract, key)
+	})
+	if err != nil || stored != current {
+		return errorsmod.Wrapf(
+			types.ErrIncompatibleContract, "slot %s of contract %s doesn't store the value '%s'", slot, contract, current,
+		)
+	}
+
+	// clear the current entries, as the new value can use fewer slots
+	for _, entry := range types.StorageString(slot, current) {
+		k.evmKeeper.SetState(ctx, contract, entry.Key, nil)
+	}
+
+	for _, entry := range types.StorageString(slot, value) {
+		if entry.Value == (common.Hash{}) {
+			continue
+		}
+		k.evmKeeper.SetState(ctx, contract, entry.Key, entry.Value.Bytes())
+	}
+
+	return nil
+}
+
+// checkMinterBurner checks that the module can still mint and burn the tokens
+// of a contract. The calls are executed on a cache context to discard their
+// state changes.
+func (k Keeper) checkMinterBurner(ctx sdk.Context, contract common.Address) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	cacheCtx, _ := ctx.CacheContext()
+	amount := big.NewInt(1)
+
+	if _, err := k.CallEVM(cacheCtx, erc20, types.ModuleAddress, contract, true, "mint", types.ModuleAddress, amount); err != nil {
+		return errorsmod.Wrapf(types.ErrIncompatibleContract, "module can't mint tokens: %s", err)
+	}
+
+	if _, err := k.CallEVM(cacheCtx, erc20, types.ModuleAddress, contract, true, "burnCoins", types.ModuleAddress, amount); err != nil {
+		return errorsmod.Wrapf(types.ErrIncompatibleContract, "module can't burn tokens: %s", err)
+	}
+
+	return nil
+}
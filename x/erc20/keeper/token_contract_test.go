@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v16/contracts"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadata() {
+	var (
+		pair *types.TokenPair
+		req  *types.MsgUpdateTokenPairMetadata
+	)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	longName := "Cosmos Coin bridged from the channel 14 of the Cosmos Hub"
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expName   string
+		expSymbol string
+	}{
+		{
+			"fail - invalid authority",
+			func() {
+				req.Authority = "foobar"
+			},
+			false,
+			"",
+			"",
+		},
+		{
+			"fail - token pair not registered",
+			func() {
+				req.Token = "unregistered"
+			},
+			false,
+			"",
+			"",
+		},
+		{
+			"fail - token pair not owned by the module",
+			func() {
+				contract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+				suite.Require().NoError(err)
+				req.Token = contract.String()
+			},
+			false,
+			"",
+			"",
+		},
+		{
+			"fail - invalid string encoding in contract storage",
+			func() {
+				// short string flag with a length of 40 bytes
+				invalid := common.BigToHash(big.NewInt(80))
+				suite.app.EvmKeeper.SetState(suite.ctx, pair.GetERC20Contract(), types.ERC20NameSlot, invalid.Bytes())
+			},
+			false,
+			"",
+			"",
+		},
+		{
+			"pass - short name and symbol",
+			func() {},
+			true,
+			"Cosmos Coin",
+			"COIN",
+		},
+		{
+			"pass - long name",
+			func() {
+				req.Name = longName
+			},
+			true,
+			longName,
+			"COIN",
+		},
+		{
+			"pass - short name replacing a long name",
+			func() {
+				req.Name = longName
+				_, err := suite.app.Erc20Keeper.UpdateTokenPairMetadata(sdk.WrapSDKContext(suite.ctx), req)
+				suite.Require().NoError(err)
+				req.Name = "Cosmos Coin"
+			},
+			true,
+			"Cosmos Coin",
+			"COIN",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			pair = suite.setupRegisterCoin(metadataCoin)
+			req = &types.MsgUpdateTokenPairMetadata{
+				Authority: authority,
+				Token:     pair.Denom,
+				Name:      "Cosmos Coin",
+				Symbol:    "COIN",
+			}
+
+			tc.malleate()
+
+			_, err := suite.app.Erc20Keeper.UpdateTokenPairMetadata(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expName, metadata.Name)
+			suite.Require().Equal(tc.expSymbol, metadata.Symbol)
+
+			erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pair.GetERC20Contract())
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.NewERC20Data(tc.expName, tc.expSymbol, uint8(defaultExponent)), erc20Data)
+
+			// the stale slots of a previous long name are cleared
+			if len(tc.expName) < common.HashLength {
+				dataSlot := common.BytesToHash(crypto.Keccak256(types.ERC20NameSlot.Bytes()))
+				suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, pair.GetERC20Contract(), dataSlot))
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestUpgradeTokenPairContract() {
+	var (
+		pair           *types.TokenPair
+		req            *types.MsgUpgradeTokenPairContract
+		implementation common.Address
+	)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	holder := utiltx.GenerateAddress()
+	amount := big.NewInt(100)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid authority",
+			func() {
+				req.Authority = "foobar"
+			},
+			false,
+		},
+		{
+			"fail - token pair not registered",
+			func() {
+				req.Token = "unregistered"
+			},
+			false,
+		},
+		{
+			"fail - implementation is not a contract",
+			func() {
+				req.Implementation = utiltx.GenerateAddress().String()
+			},
+			false,
+		},
+		{
+			"fail - implementation is the ERC20 contract",
+			func() {
+				req.Implementation = pair.Erc20Address
+			},
+			false,
+		},
+		{
+			"fail - module can't burn tokens with the implementation",
+			func() {
+				contract, err := suite.DeployContractDirectBalanceManipulation()
+				suite.Require().NoError(err)
+				req.Implementation = contract.String()
+			},
+			false,
+		},
+		{
+			"pass - code upgraded",
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			pair = suite.setupRegisterCoin(metadataCoin)
+			contract := pair.GetERC20Contract()
+
+			_, err := suite.app.Erc20Keeper.CallEVM(
+				suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI,
+				types.ModuleAddress, contract, true, "mint", holder, amount,
+			)
+			suite.Require().NoError(err)
+
+			// the implementation runs the same code with different trailing
+			// metadata, so that its code hash differs
+			acc := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, contract)
+			code := append(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acc.CodeHash)), 0x00)
+			codeHash := crypto.Keccak256Hash(code)
+			implementation = utiltx.GenerateAddress()
+			suite.app.EvmKeeper.SetCode(suite.ctx, codeHash.Bytes(), code)
+			err = suite.app.EvmKeeper.SetAccount(suite.ctx, implementation, statedb.Account{
+				CodeHash: codeHash.Bytes(),
+				Balance:  big.NewInt(0),
+			})
+			suite.Require().NoError(err)
+
+			req = &types.MsgUpgradeTokenPairContract{
+				Authority:      authority,
+				Token:          pair.Denom,
+				Implementation: implementation.String(),
+			}
+
+			tc.malleate()
+
+			_, err = suite.app.Erc20Keeper.UpgradeTokenPairContract(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			acc = suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, contract)
+			suite.Require().Equal(codeHash.Bytes(), acc.CodeHash)
+
+			balance := suite.app.Erc20Keeper.BalanceOf(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, contract, holder)
+			suite.Require().Equal(amount, balance)
+
+			erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.NewERC20Data(cosmosTokenBase, erc20Symbol, uint8(defaultExponent)), erc20Data)
+		})
+	}
+	suite.mintFeeCollector = false
+}
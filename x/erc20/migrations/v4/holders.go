@@ -0,0 +1,32 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v4
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// holdersJSON maps the ERC20 contracts of the module-owned token pairs to the
+// addresses of their holders that don't have an account, as the holders with
+// an account are read from the state during the migration. It's built off-chain
+// from the receivers of the Transfer logs of each contract. The migration fails
+// if a holder is missing, so it needs to be filled in for the networks with
+// such holders before the upgrade.
+//
+//go:embed holders.json
+var holdersJSON []byte
+
+// ERC20Holders returns the holders without an account of the ERC20 contracts of
+// the module-owned token pairs, whose balances are migrated to the bank module.
+func ERC20Holders() (map[common.Address][]common.Address, error) {
+	var holders map[common.Address][]common.Address
+	if err := json.Unmarshal(holdersJSON, &holders); err != nil {
+		return nil, fmt.Errorf("failed to decode the ERC20 holders: %w", err)
+	}
+	return holders, nil
+}
@@ -0,0 +1,14 @@
+package v4_test
+
+import (
+	"testing"
+
+	v4 "github.com/evmos/evmos/v16/x/erc20/migrations/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestERC20Holders(t *testing.T) {
+	holders, err := v4.ERC20Holders()
+	require.NoError(t, err)
+	require.NotNil(t, holders)
+}
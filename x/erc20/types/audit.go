@@ -0,0 +1,26 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+)
+
+// IsBacked returns true if the ERC20 amount of the token pair has been queried
+// and equals the Cosmos coin amount.
+func (a TokenPairAudit) IsBacked() bool {
+	return a.Error == "" && a.Erc20Amount.Equal(a.CoinAmount)
+}
+
+// Summary returns a one-line description of the audit result.
+func (a TokenPairAudit) Summary() string {
+	if a.Error != "" {
+		return fmt.Sprintf("%s (%s): failed to query ERC20 amount: %s", a.Denom, a.Erc20Address, a.Error)
+	}
+
+	return fmt.Sprintf(
+		"%s (%s): ERC20 amount %s, coin amount %s, discrepancy %s",
+		a.Denom, a.Erc20Address, a.Erc20Amount, a.CoinAmount, a.Erc20Amount.Sub(a.CoinAmount),
+	)
+}
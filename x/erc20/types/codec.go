@@ -30,6 +30,11 @@ const (
 	convertERC20Name = "evmos/MsgConvertERC20"
 	convertCoinName  = "evmos/MsgConvertCoin"
 	updateParams     = "evmos/erc20/MsgUpdateParams"
+	setBehavior      = "evmos/erc20/MsgSetTokenPairBehavior"
+	updateMetadata   = "evmos/erc20/MsgUpdateTokenPairMetadata"
+	upgradeContract  = "evmos/erc20/MsgUpgradeTokenPairContract"
+	convertCoinsName = "evmos/erc20/MsgConvertCoins"
+	setPreference    = "evmos/erc20/MsgSetConversionPreference"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -45,6 +50,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
 		&MsgUpdateParams{},
+		&MsgSetTokenPairBehavior{},
+		&MsgUpdateTokenPairMetadata{},
+		&MsgUpgradeTokenPairContract{},
+		&MsgConvertCoins{},
+		&MsgSetConversionPreference{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -61,6 +71,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
+	cdc.RegisterConcrete(&MsgSetTokenPairBehavior{}, setBehavior, nil)
+	cdc.RegisterConcrete(&MsgUpdateTokenPairMetadata{}, updateMetadata, nil)
+	cdc.RegisterConcrete(&MsgUpgradeTokenPairContract{}, upgradeContract, nil)
 	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgConvertCoins{}, convertCoinsName, nil)
+	cdc.RegisterConcrete(&MsgSetConversionPreference{}, setPreference, nil)
 }
@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAccountConversionPreference returns a new AccountConversionPreference instance
+func NewAccountConversionPreference(address sdk.AccAddress, preference ConversionPreference) AccountConversionPreference {
+	return AccountConversionPreference{
+		Address:    address.String(),
+		Preference: preference,
+	}
+}
+
+// GetAccAddress returns the account address of the conversion preference
+func (acp AccountConversionPreference) GetAccAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(acp.Address)
+}
+
+// Validate performs a stateless validation of an account conversion preference
+func (acp AccountConversionPreference) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(acp.Address); err != nil {
+		return fmt.Errorf("invalid conversion preference address '%s': %w", acp.Address, err)
+	}
+
+	return ValidateConversionPreference(acp.Preference)
+}
+
+// ValidateConversionPreference checks that the conversion preference is defined
+func ValidateConversionPreference(preference ConversionPreference) error {
+	if _, ok := ConversionPreference_name[int32(preference)]; !ok {
+		return fmt.Errorf("invalid conversion preference %d", preference)
+	}
+	return nil
+}
+
+// ParseConversionPreference parses a conversion preference from either its
+// enum name or its lowercase suffix, e.g. "CONVERSION_PREFERENCE_NONE" or "none".
+func ParseConversionPreference(preference string) (ConversionPreference, error) {
+	name := strings.ToUpper(preference)
+	if !strings.HasPrefix(name, "CONVERSION_PREFERENCE_") {
+		name = "CONVERSION_PREFERENCE_" + name
+	}
+
+	value, ok := ConversionPreference_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid conversion preference '%s'", preference)
+	}
+	return ConversionPreference(value), nil
+}
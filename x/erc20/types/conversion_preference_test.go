@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+func TestParseConversionPreference(t *testing.T) {
+	testCases := []struct {
+		name          string
+		preference    string
+		expPass       bool
+		expPreference types.ConversionPreference
+	}{
+		{"short name", "received", true, types.CONVERSION_PREFERENCE_RECEIVED},
+		{"uppercase short name", "NONE", true, types.CONVERSION_PREFERENCE_NONE},
+		{"enum name", "CONVERSION_PREFERENCE_BALANCE", true, types.CONVERSION_PREFERENCE_BALANCE},
+		{"unknown preference", "all", false, 0},
+		{"empty preference", "", false, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			preference, err := types.ParseConversionPreference(tc.preference)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expPreference, preference)
+		})
+	}
+}
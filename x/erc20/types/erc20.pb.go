@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -56,6 +57,80 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_668d5dc537f45142, []int{0}
 }
 
+// TokenBehavior enumerates how the conversions of a token pair handle the ERC20
+// tokens whose balances don't change by the transferred amount, e.g.
+// fee-on-transfer or rebasing tokens.
+type TokenBehavior int32
+
+const (
+	// TOKEN_BEHAVIOR_STANDARD - the balances must change by the exact transferred
+	// amount. The token pair is flagged with TOKEN_BEHAVIOR_REJECT when a
+	// deviation is detected.
+	TOKEN_BEHAVIOR_STANDARD TokenBehavior = 0
+	// TOKEN_BEHAVIOR_REJECT - the token is non-standard and its conversions to
+	// Cosmos coins are rejected.
+	TOKEN_BEHAVIOR_REJECT TokenBehavior = 1
+	// TOKEN_BEHAVIOR_RECEIVED_DELTA - the token is non-standard and its
+	// conversions to Cosmos coins mint the amount actually received by the module
+	// account.
+	TOKEN_BEHAVIOR_RECEIVED_DELTA TokenBehavior = 2
+)
+
+var TokenBehavior_name = map[int32]string{
+	0: "TOKEN_BEHAVIOR_STANDARD",
+	1: "TOKEN_BEHAVIOR_REJECT",
+	2: "TOKEN_BEHAVIOR_RECEIVED_DELTA",
+}
+
+var TokenBehavior_value = map[string]int32{
+	"TOKEN_BEHAVIOR_STANDARD":       0,
+	"TOKEN_BEHAVIOR_REJECT":         1,
+	"TOKEN_BEHAVIOR_RECEIVED_DELTA": 2,
+}
+
+func (x TokenBehavior) String() string {
+	return proto.EnumName(TokenBehavior_name, int32(x))
+}
+
+func (TokenBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+
+// ConversionPreference enumerates how the Cosmos coins of the registered token
+// pairs received by an account over IBC are converted to their ERC20
+// representation.
+type ConversionPreference int32
+
+const (
+	// CONVERSION_PREFERENCE_BALANCE - the whole balance of the received
+	// denomination is converted, including the received coins.
+	CONVERSION_PREFERENCE_BALANCE ConversionPreference = 0
+	// CONVERSION_PREFERENCE_RECEIVED - only the received coins are converted.
+	CONVERSION_PREFERENCE_RECEIVED ConversionPreference = 1
+	// CONVERSION_PREFERENCE_NONE - the received coins are not converted.
+	CONVERSION_PREFERENCE_NONE ConversionPreference = 2
+)
+
+var ConversionPreference_name = map[int32]string{
+	0: "CONVERSION_PREFERENCE_BALANCE",
+	1: "CONVERSION_PREFERENCE_RECEIVED",
+	2: "CONVERSION_PREFERENCE_NONE",
+}
+
+var ConversionPreference_value = map[string]int32{
+	"CONVERSION_PREFERENCE_BALANCE":  0,
+	"CONVERSION_PREFERENCE_RECEIVED": 1,
+	"CONVERSION_PREFERENCE_NONE":     2,
+}
+
+func (x ConversionPreference) String() string {
+	return proto.EnumName(ConversionPreference_name, int32(x))
+}
+
+func (ConversionPreference) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{2}
+}
+
 // TokenPair defines an instance that records a pairing consisting of a native
 // Cosmos Coin and an ERC20 token address.
 type TokenPair struct {
@@ -67,6 +142,9 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// contract_owner is the an ENUM specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// behavior defines how the conversions handle non-standard ERC20 tokens. It
+	// only applies to the externally-owned token pairs.
+	Behavior TokenBehavior `protobuf:"varint,5,opt,name=behavior,proto3,enum=evmos.erc20.v1.TokenBehavior" json:"behavior,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -130,6 +208,148 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetBehavior() TokenBehavior {
+	if m != nil {
+		return m.Behavior
+	}
+	return TOKEN_BEHAVIOR_STANDARD
+}
+
+// AccountConversionPreference defines the conversion preference of an account.
+type AccountConversionPreference struct {
+	// address is the bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// preference defines how the coins received by the account over IBC are
+	// converted
+	Preference ConversionPreference `protobuf:"varint,2,opt,name=preference,proto3,enum=evmos.erc20.v1.ConversionPreference" json:"preference,omitempty"`
+}
+
+func (m *AccountConversionPreference) Reset()         { *m = AccountConversionPreference{} }
+func (m *AccountConversionPreference) String() string { return proto.CompactTextString(m) }
+func (*AccountConversionPreference) ProtoMessage()    {}
+func (*AccountConversionPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+func (m *AccountConversionPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountConversionPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountConversionPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountConversionPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountConversionPreference.Merge(m, src)
+}
+func (m *AccountConversionPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountConversionPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountConversionPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountConversionPreference proto.InternalMessageInfo
+
+func (m *AccountConversionPreference) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountConversionPreference) GetPreference() ConversionPreference {
+	if m != nil {
+		return m.Preference
+	}
+	return CONVERSION_PREFERENCE_BALANCE
+}
+
+// TokenPairAudit is the result of the supply backing audit of a token pair.
+type TokenPairAudit struct {
+	// erc20_address is the hex address of ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// denom defines the cosmos base denomination of the token pair
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// contract_owner is the type of ERC20 owner of the token pair
+	ContractOwner Owner `protobuf:"varint,3,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// erc20_amount is the total supply of the ERC20 for module-owned pairs, or
+	// the ERC20 balance of the module account for externally-owned pairs
+	Erc20Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=erc20_amount,json=erc20Amount,proto3,customtype=cosmossdk.io/math.Int" json:"erc20_amount"`
+	// coin_amount is the Cosmos coin balance escrowed by the module account for
+	// module-owned pairs, or the Cosmos coin supply for externally-owned pairs
+	CoinAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=coin_amount,json=coinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"coin_amount"`
+	// error is the reason why the ERC20 amount couldn't be queried, if any
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TokenPairAudit) Reset()         { *m = TokenPairAudit{} }
+func (m *TokenPairAudit) String() string { return proto.CompactTextString(m) }
+func (*TokenPairAudit) ProtoMessage()    {}
+func (*TokenPairAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{2}
+}
+func (m *TokenPairAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPairAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairAudit.Merge(m, src)
+}
+func (m *TokenPairAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairAudit proto.InternalMessageInfo
+
+func (m *TokenPairAudit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPairAudit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenPairAudit) GetContractOwner() Owner {
+	if m != nil {
+		return m.ContractOwner
+	}
+	return OWNER_UNSPECIFIED
+}
+
+func (m *TokenPairAudit) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin. We're keeping it to remove the existing proposals from
 // store. After that, remove this message.
@@ -146,7 +366,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{3}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +428,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{4}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,7 +479,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +545,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{6}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +597,11 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("evmos.erc20.v1.TokenBehavior", TokenBehavior_name, TokenBehavior_value)
+	proto.RegisterEnum("evmos.erc20.v1.ConversionPreference", ConversionPreference_name, ConversionPreference_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*AccountConversionPreference)(nil), "evmos.erc20.v1.AccountConversionPreference")
+	proto.RegisterType((*TokenPairAudit)(nil), "evmos.erc20.v1.TokenPairAudit")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
@@ -387,39 +611,56 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xce, 0x74, 0xb7, 0xda, 0x9d, 0xb6, 0x21, 0x0e, 0xbb, 0x10, 0x16, 0x9a, 0x86, 0x15, 0x24,
-	0x78, 0x48, 0x9a, 0x15, 0x3c, 0x88, 0x20, 0xdd, 0x6d, 0x84, 0x4a, 0xbb, 0xbb, 0xa4, 0x5b, 0x14,
-	0x2f, 0xcb, 0x24, 0x19, 0x62, 0xe8, 0x6e, 0x66, 0x99, 0x19, 0xa3, 0x1e, 0xbc, 0x7b, 0xf4, 0xe2,
-	0x5d, 0xd0, 0x3f, 0xa6, 0xc7, 0x1e, 0x3d, 0x89, 0xec, 0x5e, 0xfc, 0x33, 0x24, 0x33, 0x89, 0x58,
-	0x8f, 0xed, 0x65, 0x78, 0xdf, 0xf7, 0x7e, 0xf0, 0xde, 0xf7, 0xe6, 0xc1, 0x2e, 0x29, 0x16, 0x94,
-	0x7b, 0x84, 0xc5, 0xfd, 0x03, 0xaf, 0xf0, 0x95, 0xe1, 0x2e, 0x19, 0x15, 0x14, 0xe9, 0xd2, 0xe7,
-	0x2a, 0xaa, 0xf0, 0xbb, 0x56, 0x4c, 0x79, 0x19, 0x1c, 0xe1, 0xfc, 0xc2, 0x2b, 0xfc, 0x88, 0x08,
-	0xec, 0x4b, 0xa0, 0xe2, 0xbb, 0xed, 0x94, 0xa6, 0x54, 0x9a, 0x5e, 0x69, 0x29, 0xb6, 0xf7, 0x1d,
-	0xc0, 0xd6, 0x94, 0x5e, 0x90, 0x7c, 0x82, 0x33, 0x86, 0xee, 0xc3, 0x5d, 0x59, 0x6f, 0x86, 0x93,
-	0x84, 0x11, 0xce, 0x4d, 0x60, 0x03, 0xa7, 0x15, 0xee, 0x48, 0xf2, 0x50, 0x71, 0xa8, 0x0d, 0x37,
-	0x13, 0x92, 0xd3, 0x85, 0xb9, 0x21, 0x9d, 0x0a, 0x20, 0x13, 0xde, 0x25, 0x39, 0x8e, 0xe6, 0x24,
-	0x31, 0x1b, 0x36, 0x70, 0xb6, 0xc2, 0x1a, 0xa2, 0xa7, 0x50, 0x8f, 0x69, 0x2e, 0x18, 0x8e, 0xc5,
-	0x8c, 0xbe, 0xcb, 0x09, 0x33, 0x9b, 0x36, 0x70, 0xf4, 0x7e, 0xc7, 0xbd, 0x3e, 0x81, 0x3b, 0x2e,
-	0x9d, 0xe1, 0x6e, 0x1d, 0x2c, 0xe1, 0x93, 0xe6, 0xef, 0xaf, 0xfb, 0xa0, 0xf7, 0x05, 0xc0, 0x76,
-	0x48, 0xd2, 0x8c, 0x0b, 0xc2, 0x86, 0x34, 0xcb, 0x27, 0x8c, 0x2e, 0x29, 0xc7, 0xf3, 0xb2, 0x19,
-	0x91, 0x89, 0x39, 0xa9, 0x3a, 0x55, 0x00, 0xd9, 0x70, 0x3b, 0x21, 0x3c, 0x66, 0xd9, 0x52, 0x64,
-	0x34, 0xaf, 0x1a, 0xfd, 0x97, 0x42, 0xcf, 0xe0, 0xd6, 0x82, 0x08, 0x9c, 0x60, 0x81, 0xcd, 0x86,
-	0xdd, 0x70, 0xb6, 0xfb, 0x7b, 0xae, 0x12, 0xd0, 0x95, 0x9a, 0x55, 0x02, 0xba, 0xa7, 0x55, 0xd0,
-	0xa0, 0x79, 0xf9, 0x73, 0x5f, 0x0b, 0xff, 0x26, 0xc9, 0xbe, 0xb4, 0xde, 0x19, 0x34, 0xea, 0x56,
-	0xea, 0xc8, 0x6b, 0xa5, 0xc1, 0x0d, 0x4a, 0xf7, 0x3e, 0xc2, 0x4e, 0x3d, 0x6b, 0x10, 0x0e, 0xfb,
-	0x07, 0xb7, 0x1e, 0xf6, 0x01, 0xd4, 0xa5, 0xc8, 0xd5, 0x56, 0x09, 0x97, 0x23, 0xb7, 0xc2, 0xff,
-	0xd8, 0x6a, 0x26, 0x0e, 0xf7, 0xa6, 0x34, 0x4d, 0xe7, 0x44, 0xfe, 0x8b, 0x21, 0xcd, 0x0b, 0xc2,
-	0x78, 0x46, 0x6f, 0xaf, 0x79, 0x99, 0x57, 0x96, 0x94, 0x1f, 0xa4, 0xcc, 0x2b, 0x81, 0x5a, 0xf0,
-	0xc3, 0x17, 0x70, 0x53, 0xee, 0x1b, 0x75, 0xe0, 0xbd, 0xf1, 0xcb, 0x51, 0x10, 0xce, 0xce, 0x47,
-	0x67, 0x93, 0x60, 0x78, 0xfc, 0xfc, 0x38, 0x38, 0x32, 0x34, 0x64, 0xc0, 0x1d, 0x45, 0x9f, 0x8e,
-	0x8f, 0xce, 0x4f, 0x02, 0x03, 0x20, 0x04, 0x75, 0xc5, 0x04, 0xaf, 0xa6, 0x41, 0x38, 0x3a, 0x3c,
-	0x31, 0x36, 0xba, 0xcd, 0x4f, 0xdf, 0x2c, 0x6d, 0x30, 0xb8, 0x5c, 0x59, 0xe0, 0x6a, 0x65, 0x81,
-	0x5f, 0x2b, 0x0b, 0x7c, 0x5e, 0x5b, 0xda, 0xd5, 0xda, 0xd2, 0x7e, 0xac, 0x2d, 0xed, 0xb5, 0x93,
-	0x66, 0xe2, 0xcd, 0xdb, 0xc8, 0x8d, 0xe9, 0xc2, 0xab, 0x4e, 0x4b, 0xbe, 0x85, 0xff, 0xd8, 0x7b,
-	0x5f, 0x9d, 0x99, 0xf8, 0xb0, 0x24, 0x3c, 0xba, 0x23, 0xcf, 0xe3, 0xd1, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x45, 0x20, 0x88, 0xdc, 0x82, 0x03, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xb6, 0x9b, 0x66, 0x69, 0x5f, 0xdb, 0xc8, 0x8c, 0x12, 0x61, 0xb2, 0x8a, 0x9b, 0x0d, 0x08,
+	0x45, 0x7b, 0x70, 0x36, 0x41, 0x42, 0x02, 0x21, 0xc0, 0x71, 0x66, 0x45, 0x96, 0xae, 0x1d, 0x4d,
+	0xb2, 0x05, 0x71, 0xb1, 0x1c, 0x7b, 0x48, 0xad, 0x26, 0x9e, 0x68, 0x3c, 0x0d, 0x70, 0xd8, 0x13,
+	0x17, 0x8e, 0x5c, 0xb8, 0x83, 0xf8, 0x33, 0x7b, 0xdc, 0x23, 0xe2, 0xb0, 0xa0, 0xf6, 0xc2, 0xcf,
+	0x40, 0x1e, 0xdb, 0x51, 0x93, 0xcd, 0x01, 0xe8, 0xc5, 0x9a, 0xf7, 0xde, 0xf7, 0xbd, 0x79, 0xdf,
+	0xf3, 0x7b, 0x36, 0xd4, 0xe9, 0x6a, 0xc1, 0x92, 0x0e, 0xe5, 0x41, 0xef, 0x51, 0x67, 0xd5, 0xcd,
+	0x0e, 0xe6, 0x92, 0x33, 0xc1, 0x50, 0x45, 0xc6, 0xcc, 0xcc, 0xb5, 0xea, 0xd6, 0x8d, 0x80, 0x25,
+	0x29, 0x78, 0xea, 0xc7, 0x97, 0x9d, 0x55, 0x77, 0x4a, 0x85, 0xdf, 0x95, 0x46, 0x86, 0xaf, 0x57,
+	0x67, 0x6c, 0xc6, 0xe4, 0xb1, 0x93, 0x9e, 0x32, 0x6f, 0xeb, 0x4f, 0x15, 0x0e, 0x27, 0xec, 0x92,
+	0xc6, 0x23, 0x3f, 0xe2, 0xe8, 0x1d, 0x38, 0x91, 0xf9, 0x3c, 0x3f, 0x0c, 0x39, 0x4d, 0x12, 0x5d,
+	0x6d, 0xaa, 0xed, 0x43, 0x72, 0x2c, 0x9d, 0x56, 0xe6, 0x43, 0x55, 0x28, 0x87, 0x34, 0x66, 0x0b,
+	0x7d, 0x4f, 0x06, 0x33, 0x03, 0xe9, 0xf0, 0x06, 0x8d, 0xfd, 0xe9, 0x9c, 0x86, 0x7a, 0xa9, 0xa9,
+	0xb6, 0x0f, 0x48, 0x61, 0xa2, 0x8f, 0xa1, 0x12, 0xb0, 0x58, 0x70, 0x3f, 0x10, 0x1e, 0xfb, 0x36,
+	0xa6, 0x5c, 0xdf, 0x6f, 0xaa, 0xed, 0x4a, 0xaf, 0x66, 0x6e, 0x2a, 0x30, 0xdd, 0x34, 0x48, 0x4e,
+	0x0a, 0xb0, 0x34, 0xd1, 0x87, 0x70, 0x30, 0xa5, 0x17, 0xfe, 0x2a, 0x62, 0x5c, 0x2f, 0x4b, 0x5e,
+	0x63, 0x9b, 0x27, 0xeb, 0xef, 0xe7, 0x20, 0xb2, 0x86, 0x7f, 0xb4, 0xff, 0xf7, 0x2f, 0xa7, 0x6a,
+	0xeb, 0x39, 0xdc, 0xb7, 0x82, 0x80, 0x5d, 0xc5, 0xc2, 0x66, 0xf1, 0x8a, 0xf2, 0x24, 0x62, 0xf1,
+	0x88, 0xd3, 0x6f, 0x28, 0xa7, 0x71, 0x40, 0xd3, 0xba, 0x37, 0xc5, 0x16, 0x26, 0x1a, 0x00, 0x2c,
+	0xd7, 0x38, 0x29, 0xb6, 0xd2, 0x7b, 0x77, 0xfb, 0xee, 0x5d, 0x39, 0xc9, 0x2d, 0x5e, 0xeb, 0xd7,
+	0x3d, 0xa8, 0xac, 0x1b, 0x6c, 0x5d, 0x85, 0x91, 0xb8, 0x4b, 0x97, 0x5f, 0xef, 0x65, 0xe9, 0x3f,
+	0xf4, 0xf2, 0x33, 0x38, 0xce, 0x2f, 0x5e, 0xa4, 0xfd, 0x90, 0xef, 0xe1, 0xb0, 0xdf, 0x78, 0xf1,
+	0xea, 0x54, 0xf9, 0xe3, 0xd5, 0x69, 0x2d, 0x1b, 0xa0, 0x24, 0xbc, 0x34, 0x23, 0xd6, 0x59, 0xf8,
+	0xe2, 0xc2, 0x1c, 0xc6, 0x82, 0x1c, 0x65, 0x65, 0x49, 0x06, 0xfa, 0x04, 0x8e, 0x02, 0x16, 0xc5,
+	0x45, 0x82, 0xf2, 0xbf, 0x49, 0x00, 0x29, 0x23, 0xe7, 0x57, 0xa1, 0x4c, 0x39, 0x67, 0x5c, 0xbf,
+	0x97, 0xa9, 0x92, 0x46, 0xeb, 0x67, 0x15, 0xaa, 0x84, 0xce, 0xa2, 0x44, 0x50, 0x6e, 0xb3, 0x28,
+	0x1e, 0x71, 0xb6, 0x64, 0x89, 0x3f, 0x4f, 0xe1, 0x22, 0x12, 0x73, 0x9a, 0x77, 0x28, 0x33, 0x50,
+	0x13, 0x8e, 0x42, 0x9a, 0x04, 0x3c, 0x5a, 0x8a, 0x88, 0xc5, 0x79, 0x83, 0x6e, 0xbb, 0xd0, 0xa7,
+	0x70, 0xb0, 0xa0, 0xc2, 0x0f, 0x7d, 0xe1, 0xeb, 0xa5, 0x66, 0xa9, 0x7d, 0xd4, 0x6b, 0x98, 0x59,
+	0x71, 0xa6, 0xdc, 0x88, 0x7c, 0x3d, 0xcc, 0xa7, 0x39, 0xa8, 0xbf, 0x9f, 0x4a, 0x20, 0x6b, 0x92,
+	0x1c, 0x1d, 0xa5, 0x35, 0x06, 0xad, 0x28, 0xa5, 0x40, 0x6e, 0xa4, 0x56, 0xff, 0x47, 0xea, 0xd6,
+	0x73, 0xa8, 0x15, 0x5a, 0x31, 0xb1, 0x7b, 0x8f, 0xee, 0x2c, 0xf6, 0x3d, 0xa8, 0xc8, 0x57, 0x94,
+	0x4f, 0x13, 0x4d, 0xa4, 0xe4, 0x43, 0xb2, 0xe5, 0xcd, 0x35, 0x25, 0xd0, 0x98, 0xb0, 0xd9, 0x6c,
+	0x4e, 0xe5, 0x50, 0xde, 0x1e, 0xdf, 0x3b, 0x96, 0x91, 0xf2, 0xd2, 0x94, 0x7a, 0x29, 0xe7, 0xa5,
+	0x46, 0xb6, 0x83, 0x0f, 0x9f, 0x40, 0x39, 0x9b, 0xc0, 0x1a, 0xbc, 0xe9, 0x7e, 0xe9, 0x60, 0xe2,
+	0x3d, 0x73, 0xc6, 0x23, 0x6c, 0x0f, 0x1f, 0x0f, 0xf1, 0x40, 0x53, 0x90, 0x06, 0xc7, 0x99, 0xfb,
+	0xa9, 0x3b, 0x78, 0x76, 0x86, 0x35, 0x15, 0x21, 0xa8, 0x64, 0x1e, 0xfc, 0xd5, 0x04, 0x13, 0xc7,
+	0x3a, 0xd3, 0xf6, 0xea, 0xfb, 0x3f, 0xfe, 0x66, 0x28, 0x0f, 0x97, 0x70, 0xb2, 0xb1, 0xf0, 0xe8,
+	0x3e, 0xbc, 0x35, 0x71, 0xbf, 0xc0, 0x8e, 0xd7, 0xc7, 0x9f, 0x5b, 0xe7, 0x43, 0x97, 0x78, 0xe3,
+	0x89, 0xe5, 0x0c, 0x2c, 0x92, 0x66, 0x7e, 0x1b, 0x6a, 0x5b, 0x41, 0x82, 0x9f, 0x60, 0x7b, 0xa2,
+	0xa9, 0xe8, 0x01, 0x34, 0x5e, 0x0b, 0xd9, 0x78, 0x78, 0x8e, 0x07, 0xde, 0x00, 0x9f, 0x4d, 0xac,
+	0xf5, 0x8d, 0x3f, 0xa8, 0x50, 0xdd, 0xf9, 0xed, 0x78, 0x00, 0x0d, 0xdb, 0x75, 0xce, 0x31, 0x19,
+	0x0f, 0x5d, 0xc7, 0x1b, 0x11, 0xfc, 0x18, 0x13, 0xec, 0xd8, 0xd8, 0xeb, 0x5b, 0x67, 0x96, 0x63,
+	0x63, 0x4d, 0x41, 0x2d, 0x30, 0x76, 0x43, 0x8a, 0xbb, 0x34, 0x15, 0x19, 0x50, 0xdf, 0x8d, 0x71,
+	0x5c, 0x07, 0x17, 0x55, 0xf4, 0xfb, 0x2f, 0xae, 0x0d, 0xf5, 0xe5, 0xb5, 0xa1, 0xfe, 0x75, 0x6d,
+	0xa8, 0x3f, 0xdd, 0x18, 0xca, 0xcb, 0x1b, 0x43, 0xf9, 0xfd, 0xc6, 0x50, 0xbe, 0x6e, 0xcf, 0x22,
+	0x71, 0x71, 0x35, 0x35, 0x03, 0xb6, 0xe8, 0xe4, 0x3f, 0x0c, 0xf9, 0x5c, 0x75, 0x3f, 0xe8, 0x7c,
+	0x97, 0xff, 0x3c, 0xc4, 0xf7, 0x4b, 0x9a, 0x4c, 0xef, 0xc9, 0x8f, 0xfe, 0xfb, 0xff, 0x0c, 0x00,
+	0xf7, 0x73, 0x33, 0x80, 0x58, 0x06, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -453,6 +694,9 @@ func (this *TokenPair) Equal(that interface{}) bool {
 	if this.ContractOwner != that1.ContractOwner {
 		return false
 	}
+	if this.Behavior != that1.Behavior {
+		return false
+	}
 	return true
 }
 func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
@@ -505,6 +749,11 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Behavior != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Behavior))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ContractOwner != 0 {
 		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
 		i--
@@ -537,6 +786,110 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountConversionPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountConversionPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountConversionPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Preference != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Preference))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.CoinAmount.Size()
+		i -= size
+		if _, err := m.CoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Erc20Amount.Size()
+		i -= size
+		if _, err := m.Erc20Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -746,6 +1099,53 @@ func (m *TokenPair) Size() (n int) {
 	if m.ContractOwner != 0 {
 		n += 1 + sovErc20(uint64(m.ContractOwner))
 	}
+	if m.Behavior != 0 {
+		n += 1 + sovErc20(uint64(m.Behavior))
+	}
+	return n
+}
+
+func (m *AccountConversionPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Preference != 0 {
+		n += 1 + sovErc20(uint64(m.Preference))
+	}
+	return n
+}
+
+func (m *TokenPairAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	l = m.Erc20Amount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.CoinAmount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
 	return n
 }
 
@@ -969,6 +1369,359 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behavior", wireType)
+			}
+			m.Behavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behavior |= TokenBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountConversionPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountConversionPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountConversionPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preference", wireType)
+			}
+			m.Preference = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Preference |= ConversionPreference(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -21,4 +21,7 @@ var (
 	ErrEVMDenom               = errorsmod.Register(ModuleName, 11, "EVM denomination registration")
 	ErrEVMCall                = errorsmod.Register(ModuleName, 12, "EVM call unexpected error")
 	ErrERC20TokenPairDisabled = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrNonStandardToken       = errorsmod.Register(ModuleName, 14, "non-standard erc20 token")
+	ErrPrecompileTokenPair    = errorsmod.Register(ModuleName, 15, "token pair is served by the erc20 precompile")
+	ErrIncompatibleContract   = errorsmod.Register(ModuleName, 16, "incompatible erc20 contract")
 )
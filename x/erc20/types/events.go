@@ -11,19 +11,33 @@ import (
 
 // erc20 events
 const (
-	EventTypeTokenLock             = "token_lock"
-	EventTypeTokenUnlock           = "token_unlock"
-	EventTypeMint                  = "mint"
-	EventTypeConvertCoin           = "convert_coin"
-	EventTypeConvertERC20          = "convert_erc20"
-	EventTypeBurn                  = "burn"
-	EventTypeRegisterCoin          = "register_coin"
-	EventTypeRegisterERC20         = "register_erc20"
-	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeTokenLock               = "token_lock"
+	EventTypeTokenUnlock             = "token_unlock"
+	EventTypeMint                    = "mint"
+	EventTypeConvertCoin             = "convert_coin"
+	EventTypeConvertERC20            = "convert_erc20"
+	EventTypeBurn                    = "burn"
+	EventTypeRegisterCoin            = "register_coin"
+	EventTypeRegisterERC20           = "register_erc20"
+	EventTypeToggleTokenConversion   = "toggle_token_conversion" // #nosec
+	EventTypeFlagNonStandardToken    = "flag_non_standard_token" // #nosec
+	EventTypeSetTokenPairBehavior    = "set_token_pair_behavior" // #nosec
+	EventTypeAutoRegisterCoin        = "auto_register_coin"
+	EventTypeMigrateERC20Balance     = "migrate_erc20_balance"
+	EventTypeUpdateTokenMetadata     = "update_token_pair_metadata"  // #nosec
+	EventTypeUpgradeTokenContract    = "upgrade_token_pair_contract" // #nosec
+	EventTypeSetConversionPreference = "set_conversion_preference"
 
 	AttributeKeyCosmosCoin = "cosmos_coin"
 	AttributeKeyERC20Token = "erc20_token" // #nosec
 	AttributeKeyReceiver   = "receiver"
+	AttributeKeyBehavior   = "behavior"
+	AttributeKeyReason     = "reason"
+	AttributeKeyChannel    = "channel"
+	AttributeKeyName       = "name"
+	AttributeKeySymbol     = "symbol"
+	AttributeKeyCodeHash   = "code_hash"
+	AttributeKeyPreference = "preference"
 
 	// ERC20EventTransfer defines the transfer event for ERC20
 	ERC20EventTransfer = "Transfer"
@@ -6,10 +6,11 @@ package types
 import "fmt"
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
+func NewGenesisState(params Params, pairs []TokenPair, preferences ...AccountConversionPreference) GenesisState {
 	return GenesisState{
-		Params:     params,
-		TokenPairs: pairs,
+		Params:                params,
+		TokenPairs:            pairs,
+		ConversionPreferences: preferences,
 	}
 }
 
@@ -43,5 +44,18 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenAccount := make(map[string]bool)
+	for _, preference := range gs.ConversionPreferences {
+		if err := preference.Validate(); err != nil {
+			return err
+		}
+
+		address := preference.GetAccAddress().String()
+		if seenAccount[address] {
+			return fmt.Errorf("conversion preference duplicated on genesis for account '%s'", address)
+		}
+		seenAccount[address] = true
+	}
+
 	return gs.Params.Validate()
 }
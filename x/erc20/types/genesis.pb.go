@@ -29,6 +29,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// conversion_preferences are the non-default conversion preferences of the
+	// accounts at genesis
+	ConversionPreferences []AccountConversionPreference `protobuf:"bytes,3,rep,name=conversion_preferences,json=conversionPreferences,proto3" json:"conversion_preferences"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetConversionPreferences() []AccountConversionPreference {
+	if m != nil {
+		return m.ConversionPreferences
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -85,6 +95,10 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// auto_registration_channels are the IBC channels on which the first inbound ICS20 transfer
+	// of an unregistered IBC denomination registers its token pair and ERC20 precompile without
+	// a governance proposal. The automatic registration is disabled if the list is empty.
+	AutoRegistrationChannels []string `protobuf:"bytes,3,rep,name=auto_registration_channels,json=autoRegistrationChannels,proto3" json:"auto_registration_channels,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,6 +148,13 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetAutoRegistrationChannels() []string {
+	if m != nil {
+		return m.AutoRegistrationChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -142,26 +163,32 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0x54, 0x43, 0x24, 0xc0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x33, 0xb8,
-	0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0xd5, 0x0e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42, 0x0e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xf1,
-	0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0xe8, 0x5a, 0x43,
-	0x40, 0x4a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xba, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69, 0x5c,
-	0x6c, 0x10, 0x93, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1, 0x1a,
-	0xc1, 0xee, 0xe0, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61, 0x4a,
-	0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd, 0x93,
-	0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c, 0xcb,
-	0x05, 0x71, 0x9d, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x8e, 0x60, 0xb2, 0xcc,
-	0xd0, 0x4c, 0xbf, 0x02, 0x1a, 0xa6, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xb7, 0x98, 0x63, 0x9d, 0x01, 0x00, 0x00,
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x6a, 0xdb, 0x40,
+	0x14, 0x86, 0x25, 0xbb, 0x98, 0x76, 0x64, 0xb7, 0x54, 0xb4, 0x46, 0x15, 0x45, 0x76, 0xbd, 0x32,
+	0x14, 0xa4, 0xda, 0x2d, 0x85, 0x42, 0x17, 0xad, 0x8c, 0x69, 0x37, 0x01, 0xa3, 0x84, 0x2c, 0xb2,
+	0x11, 0xe3, 0xe1, 0x45, 0x12, 0xb6, 0x66, 0xc4, 0xcc, 0x58, 0x24, 0xb7, 0xc8, 0x21, 0x72, 0x18,
+	0x2f, 0xbd, 0xcc, 0xca, 0x04, 0xf9, 0x02, 0x39, 0x42, 0xd0, 0x48, 0x36, 0x89, 0xc9, 0x46, 0x3c,
+	0xbd, 0xff, 0xfb, 0xff, 0xe1, 0xe7, 0xa1, 0xcf, 0x90, 0xa7, 0x4c, 0x78, 0xc0, 0xc9, 0xf8, 0x9b,
+	0x97, 0x8f, 0xbc, 0x08, 0x28, 0x88, 0x44, 0xb8, 0x19, 0x67, 0x92, 0x99, 0x6f, 0x95, 0xea, 0x2a,
+	0xd5, 0xcd, 0x47, 0xb6, 0x7d, 0x44, 0x57, 0x82, 0x62, 0xed, 0x0f, 0x11, 0x8b, 0x98, 0x1a, 0xbd,
+	0x72, 0xaa, 0xb6, 0x83, 0x07, 0x1d, 0xb5, 0xff, 0x55, 0x99, 0xa7, 0x12, 0x4b, 0x30, 0x7f, 0xa0,
+	0x56, 0x86, 0x39, 0x4e, 0x85, 0xa5, 0xf7, 0xf5, 0xa1, 0x31, 0xee, 0xba, 0xcf, 0xdf, 0x70, 0x67,
+	0x4a, 0xf5, 0x5f, 0xad, 0xb7, 0x3d, 0x2d, 0xa8, 0x59, 0xf3, 0x0f, 0x32, 0x24, 0x5b, 0x00, 0x0d,
+	0x33, 0x9c, 0x70, 0x61, 0x35, 0xfa, 0xcd, 0xa1, 0x31, 0xfe, 0x74, 0x6c, 0x3d, 0x2b, 0x91, 0x19,
+	0x4e, 0x78, 0xed, 0x46, 0x72, 0xbf, 0x10, 0x66, 0x8c, 0xba, 0x84, 0xd1, 0x1c, 0xb8, 0x48, 0x18,
+	0x0d, 0x33, 0x0e, 0x97, 0xc0, 0x81, 0x12, 0x10, 0x56, 0x53, 0x85, 0x7d, 0x3d, 0x0e, 0xfb, 0x4b,
+	0x08, 0x5b, 0x51, 0x39, 0x39, 0x98, 0x66, 0x07, 0x4f, 0x1d, 0xff, 0x91, 0xbc, 0xa0, 0x89, 0xc1,
+	0xad, 0x8e, 0x5a, 0x55, 0x09, 0xf3, 0x0b, 0x6a, 0x03, 0xc5, 0xf3, 0x25, 0x84, 0x2a, 0x56, 0x55,
+	0x7e, 0x1d, 0x18, 0xd5, 0x6e, 0x5a, 0xae, 0xcc, 0x5f, 0xe8, 0xdd, 0x1e, 0xc9, 0xd3, 0x30, 0x66,
+	0x6c, 0x61, 0x35, 0x4a, 0xca, 0x7f, 0x5f, 0x6c, 0x7b, 0x9d, 0x69, 0x45, 0x9e, 0x9f, 0xfc, 0x67,
+	0x6c, 0x11, 0x74, 0x6a, 0x63, 0x9e, 0x96, 0xbf, 0xe6, 0x6f, 0x64, 0xe3, 0x95, 0x64, 0x21, 0x87,
+	0x28, 0x11, 0x92, 0x63, 0x59, 0x36, 0x23, 0x31, 0xa6, 0x14, 0x96, 0x55, 0xad, 0x37, 0x81, 0x55,
+	0x12, 0xc1, 0x13, 0x60, 0x52, 0xeb, 0xbe, 0xbf, 0x2e, 0x1c, 0x7d, 0x53, 0x38, 0xfa, 0x7d, 0xe1,
+	0xe8, 0x37, 0x3b, 0x47, 0xdb, 0xec, 0x1c, 0xed, 0x6e, 0xe7, 0x68, 0x17, 0xc3, 0x28, 0x91, 0xf1,
+	0x6a, 0xee, 0x12, 0x96, 0x7a, 0xf5, 0xc1, 0xd5, 0x37, 0x1f, 0xfd, 0xf4, 0xae, 0xea, 0xe3, 0xcb,
+	0xeb, 0x0c, 0xc4, 0xbc, 0xa5, 0x8e, 0xfc, 0xfd, 0x71, 0x00, 0xaf, 0x39, 0x18, 0xda, 0x46, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -184,6 +211,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConversionPreferences) > 0 {
+		for iNdEx := len(m.ConversionPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -231,6 +272,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoRegistrationChannels) > 0 {
+		for iNdEx := len(m.AutoRegistrationChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoRegistrationChannels[iNdEx])
+			copy(dAtA[i:], m.AutoRegistrationChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoRegistrationChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -279,6 +329,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConversionPreferences) > 0 {
+		for _, e := range m.ConversionPreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -294,6 +350,12 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if len(m.AutoRegistrationChannels) > 0 {
+		for _, s := range m.AutoRegistrationChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -399,6 +461,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionPreferences = append(m.ConversionPreferences, AccountConversionPreference{})
+			if err := m.ConversionPreferences[len(m.ConversionPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -489,6 +585,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegistrationChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRegistrationChannels = append(m.AutoRegistrationChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
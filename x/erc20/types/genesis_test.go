@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/erc20/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -20,6 +22,7 @@ func TestGenesisTestSuite(t *testing.T) {
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	newGen := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{})
+	account := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
 
 	testCases := []struct {
 		name     string
@@ -129,6 +132,47 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with conversion preferences",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConversionPreferences: []types.AccountConversionPreference{
+					types.NewAccountConversionPreference(account, types.CONVERSION_PREFERENCE_NONE),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated conversion preference",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConversionPreferences: []types.AccountConversionPreference{
+					types.NewAccountConversionPreference(account, types.CONVERSION_PREFERENCE_NONE),
+					types.NewAccountConversionPreference(account, types.CONVERSION_PREFERENCE_RECEIVED),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid conversion preference address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConversionPreferences: []types.AccountConversionPreference{
+					{Address: "invalid", Preference: types.CONVERSION_PREFERENCE_NONE},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - undefined conversion preference",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConversionPreferences: []types.AccountConversionPreference{
+					types.NewAccountConversionPreference(account, types.ConversionPreference(3)),
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
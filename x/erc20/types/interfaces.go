@@ -24,6 +24,7 @@ type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 	GetSequence(sdk.Context, sdk.AccAddress) (uint64, error)
 	GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI
+	IterateAccounts(ctx sdk.Context, cb func(account authtypes.AccountI) (stop bool))
 }
 
 // StakingKeeper defines the expected interface needed to retrieve the staking denom.
@@ -35,9 +36,15 @@ type StakingKeeper interface {
 type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
 	EstimateGasInternal(c context.Context, req *evmtypes.EthCallRequest, fromType evmtypes.CallType) (*evmtypes.EstimateGasResponse, error)
 	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 	AddEVMExtensions(ctx sdk.Context, precompiles ...vm.PrecompiledContract) error
+	EnablePrecompiles(ctx sdk.Context, addresses ...common.Address) error
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
 	IsAvailablePrecompile(addr common.Address) bool
 }
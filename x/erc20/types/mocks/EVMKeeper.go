@@ -86,6 +86,27 @@ func (_m *EVMKeeper) DeleteAccount(ctx types.Context, addr common.Address) error
 	return r0
 }
 
+// EnablePrecompiles provides a mock function with given fields: ctx, addresses
+func (_m *EVMKeeper) EnablePrecompiles(ctx types.Context, addresses ...common.Address) error {
+	_va := make([]interface{}, len(addresses))
+	for _i := range addresses {
+		_va[_i] = addresses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, ...common.Address) error); ok {
+		r0 = rf(ctx, addresses...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EstimateGasInternal provides a mock function with given fields: c, req, fromType
 func (_m *EVMKeeper) EstimateGasInternal(c context.Context, req *evmtypes.EthCallRequest, fromType evmtypes.CallType) (*evmtypes.EstimateGasResponse, error) {
 	ret := _m.Called(c, req, fromType)
@@ -112,6 +133,22 @@ func (_m *EVMKeeper) EstimateGasInternal(c context.Context, req *evmtypes.EthCal
 	return r0, r1
 }
 
+// GetAccount provides a mock function with given fields: ctx, addr
+func (_m *EVMKeeper) GetAccount(ctx types.Context, addr common.Address) *statedb.Account {
+	ret := _m.Called(ctx, addr)
+
+	var r0 *statedb.Account
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address) *statedb.Account); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statedb.Account)
+		}
+	}
+
+	return r0
+}
+
 // GetAccountWithoutBalance provides a mock function with given fields: ctx, addr
 func (_m *EVMKeeper) GetAccountWithoutBalance(ctx types.Context, addr common.Address) *statedb.Account {
 	ret := _m.Called(ctx, addr)
@@ -128,6 +165,38 @@ func (_m *EVMKeeper) GetAccountWithoutBalance(ctx types.Context, addr common.Add
 	return r0
 }
 
+// GetCode provides a mock function with given fields: ctx, codeHash
+func (_m *EVMKeeper) GetCode(ctx types.Context, codeHash common.Hash) []byte {
+	ret := _m.Called(ctx, codeHash)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(types.Context, common.Hash) []byte); ok {
+		r0 = rf(ctx, codeHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	return r0
+}
+
+// GetState provides a mock function with given fields: ctx, addr, key
+func (_m *EVMKeeper) GetState(ctx types.Context, addr common.Address, key common.Hash) common.Hash {
+	ret := _m.Called(ctx, addr, key)
+
+	var r0 common.Hash
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, common.Hash) common.Hash); ok {
+		r0 = rf(ctx, addr, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	return r0
+}
+
 // GetParams provides a mock function with given fields: ctx
 func (_m *EVMKeeper) GetParams(ctx types.Context) evmtypes.Params {
 	ret := _m.Called(ctx)
@@ -156,6 +225,25 @@ func (_m *EVMKeeper) IsAvailablePrecompile(addr common.Address) bool {
 	return r0
 }
 
+// SetAccount provides a mock function with given fields: ctx, addr, account
+func (_m *EVMKeeper) SetAccount(ctx types.Context, addr common.Address, account statedb.Account) error {
+	ret := _m.Called(ctx, addr, account)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, common.Address, statedb.Account) error); ok {
+		r0 = rf(ctx, addr, account)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetState provides a mock function with given fields: ctx, addr, key, value
+func (_m *EVMKeeper) SetState(ctx types.Context, addr common.Address, key common.Hash, value []byte) {
+	_m.Called(ctx, addr, key, value)
+}
+
 // NewEVMKeeper creates a new instance of EVMKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEVMKeeper(t interface {
@@ -4,6 +4,8 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,17 +13,26 @@ import (
 
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v16/types"
 )
 
 var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetTokenPairBehavior{}
+	_ sdk.Msg = &MsgUpdateTokenPairMetadata{}
+	_ sdk.Msg = &MsgUpgradeTokenPairContract{}
+	_ sdk.Msg = &MsgConvertCoins{}
+	_ sdk.Msg = &MsgSetConversionPreference{}
 )
 
 const (
-	TypeMsgConvertCoin  = "convert_coin"
-	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgConvertCoin             = "convert_coin"
+	TypeMsgConvertERC20            = "convert_ERC20"
+	TypeMsgConvertCoins            = "convert_coins"
+	TypeMsgSetConversionPreference = "set_conversion_preference"
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -135,3 +146,193 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgSetTokenPairBehavior message.
+func (m *MsgSetTokenPairBehavior) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetTokenPairBehavior) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := validateToken(m.Token); err != nil {
+		return err
+	}
+
+	return ValidateTokenBehavior(m.Behavior)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetTokenPairBehavior) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateTokenPairMetadata message.
+func (m *MsgUpdateTokenPairMetadata) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateTokenPairMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := validateToken(m.Token); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(m.Name) == "" || strings.TrimSpace(m.Symbol) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "name and symbol cannot be blank")
+	}
+
+	if len(m.Name) > MaxStorageStringLength || len(m.Symbol) > MaxStorageStringLength {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest, "name and symbol cannot be longer than %d bytes", MaxStorageStringLength,
+		)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateTokenPairMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpgradeTokenPairContract message.
+func (m *MsgUpgradeTokenPairContract) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpgradeTokenPairContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := validateToken(m.Token); err != nil {
+		return err
+	}
+
+	if err := evmostypes.ValidateNonZeroAddress(m.Implementation); err != nil {
+		return errorsmod.Wrapf(err, "invalid implementation address %s", m.Implementation)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpgradeTokenPairContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgConvertCoins creates a new instance of MsgConvertCoins
+func NewMsgConvertCoins(coins sdk.Coins, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoins { //nolint: interfacer
+	return &MsgConvertCoins{
+		Coins:    coins,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertCoins) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCoins) Type() string { return TypeMsgConvertCoins }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCoins) ValidateBasic() error {
+	if msg.Coins.Empty() {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, "coins cannot be empty")
+	}
+
+	// NOTE: the validation rejects the non-positive amounts and the unsorted or
+	// duplicated denominations
+	if err := msg.Coins.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+
+	for _, coin := range msg.Coins {
+		if err := ValidateErc20Denom(coin.Denom); err != nil {
+			if err := ibctransfertypes.ValidateIBCDenom(coin.Denom); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertCoins) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCoins) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetConversionPreference creates a new instance of MsgSetConversionPreference
+func NewMsgSetConversionPreference(sender sdk.AccAddress, preference ConversionPreference) *MsgSetConversionPreference { //nolint: interfacer
+	return &MsgSetConversionPreference{
+		Sender:     sender.String(),
+		Preference: preference,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetConversionPreference) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetConversionPreference) Type() string { return TypeMsgSetConversionPreference }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetConversionPreference) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := ValidateConversionPreference(msg.Preference); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetConversionPreference) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetConversionPreference) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// validateToken checks that a token identifier is either a hex address or a
+// valid Cosmos denomination.
+func validateToken(token string) error {
+	if !common.IsHexAddress(token) {
+		if err := sdk.ValidateDenom(token); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid token '%s': %s", token, err)
+		}
+	}
+	return nil
+}
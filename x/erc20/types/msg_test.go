@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -286,3 +287,254 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgSetTokenPairBehaviorValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetTokenPairBehavior
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgSetTokenPairBehavior{Authority: "invalid", Token: "test"},
+			false,
+		},
+		{
+			"fail - invalid token",
+			&types.MsgSetTokenPairBehavior{Authority: authority, Token: "0x"},
+			false,
+		},
+		{
+			"fail - invalid behavior",
+			&types.MsgSetTokenPairBehavior{Authority: authority, Token: "test", Behavior: 3},
+			false,
+		},
+		{
+			"pass - valid msg with denom",
+			&types.MsgSetTokenPairBehavior{Authority: authority, Token: "test", Behavior: types.TOKEN_BEHAVIOR_REJECT},
+			true,
+		},
+		{
+			"pass - valid msg with contract address",
+			&types.MsgSetTokenPairBehavior{Authority: authority, Token: utiltx.GenerateAddress().Hex(), Behavior: types.TOKEN_BEHAVIOR_RECEIVED_DELTA},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateTokenPairMetadataValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateTokenPairMetadata
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateTokenPairMetadata{Authority: "invalid", Token: "test", Name: "Test", Symbol: "TEST"},
+			false,
+		},
+		{
+			"fail - invalid token",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "0x", Name: "Test", Symbol: "TEST"},
+			false,
+		},
+		{
+			"fail - blank name",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "test", Name: "  ", Symbol: "TEST"},
+			false,
+		},
+		{
+			"fail - blank symbol",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "test", Name: "Test"},
+			false,
+		},
+		{
+			"fail - name too long",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "test", Name: strings.Repeat("a", types.MaxStorageStringLength+1), Symbol: "TEST"},
+			false,
+		},
+		{
+			"pass - valid msg with denom",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "test", Name: "Test", Symbol: "TEST"},
+			true,
+		},
+		{
+			"pass - valid msg with contract address",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: utiltx.GenerateAddress().Hex(), Name: "Test", Symbol: "TEST"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpgradeTokenPairContractValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	implementation := utiltx.GenerateAddress().Hex()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpgradeTokenPairContract
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpgradeTokenPairContract{Authority: "invalid", Token: "test", Implementation: implementation},
+			false,
+		},
+		{
+			"fail - invalid token",
+			&types.MsgUpgradeTokenPairContract{Authority: authority, Token: "0x", Implementation: implementation},
+			false,
+		},
+		{
+			"fail - invalid implementation address",
+			&types.MsgUpgradeTokenPairContract{Authority: authority, Token: "test", Implementation: "0x"},
+			false,
+		},
+		{
+			"fail - zero implementation address",
+			&types.MsgUpgradeTokenPairContract{Authority: authority, Token: "test", Implementation: common.Address{}.Hex()},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpgradeTokenPairContract{Authority: authority, Token: "test", Implementation: implementation},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinsValidateBasic() {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	receiver := utiltx.GenerateAddress().String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("acoin", 10), sdk.NewInt64Coin("test", 5))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConvertCoins
+		expPass bool
+	}{
+		{
+			"fail - empty coins",
+			&types.MsgConvertCoins{Sender: sender, Receiver: receiver},
+			false,
+		},
+		{
+			"fail - duplicated denominations",
+			&types.MsgConvertCoins{
+				Coins:    sdk.Coins{sdk.NewInt64Coin("test", 5), sdk.NewInt64Coin("test", 5)},
+				Sender:   sender,
+				Receiver: receiver,
+			},
+			false,
+		},
+		{
+			"fail - non-positive amount",
+			&types.MsgConvertCoins{
+				Coins:    sdk.Coins{sdk.NewInt64Coin("acoin", 10), sdk.NewInt64Coin("test", 0)},
+				Sender:   sender,
+				Receiver: receiver,
+			},
+			false,
+		},
+		{
+			"fail - invalid sender",
+			&types.MsgConvertCoins{Coins: coins, Sender: "invalid", Receiver: receiver},
+			false,
+		},
+		{
+			"fail - invalid receiver",
+			&types.MsgConvertCoins{Coins: coins, Sender: sender, Receiver: "invalid"},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgConvertCoins{Coins: coins, Sender: sender, Receiver: receiver},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetConversionPreferenceValidateBasic() {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetConversionPreference
+		expPass bool
+	}{
+		{
+			"fail - invalid sender",
+			&types.MsgSetConversionPreference{Sender: "invalid", Preference: types.CONVERSION_PREFERENCE_NONE},
+			false,
+		},
+		{
+			"fail - undefined preference",
+			&types.MsgSetConversionPreference{Sender: sender, Preference: types.ConversionPreference(3)},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgSetConversionPreference{Sender: sender, Preference: types.CONVERSION_PREFERENCE_RECEIVED},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -5,22 +5,29 @@ package types
 
 import (
 	fmt "fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
 // Parameter store key
 var (
 	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
 	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+	// ParamStoreKeyAutoRegistrationChannel is the prefix of the allow-listed
+	// auto-registration channels
+	ParamStoreKeyAutoRegistrationChannel = []byte("AutoRegistrationChannel/")
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	autoRegistrationChannels []string,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:              enableErc20,
+		EnableEVMHook:            enableEVMHook,
+		AutoRegistrationChannels: autoRegistrationChannels,
 	}
 }
 
@@ -40,10 +47,48 @@ func ValidateBool(i interface{}) error {
 	return nil
 }
 
+// ValidateChannels checks that the channels are valid IBC channel identifiers
+// without duplicates
+func ValidateChannels(i interface{}) error {
+	channels, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid auto-registration channel %q: %w", channel, err)
+		}
+
+		if seen[channel] {
+			return fmt.Errorf("duplicate auto-registration channel %q", channel)
+		}
+		seen[channel] = true
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := ValidateBool(p.EnableEVMHook); err != nil {
 		return err
 	}
 
+	if err := ValidateChannels(p.AutoRegistrationChannels); err != nil {
+		return err
+	}
+
 	return ValidateBool(p.EnableErc20)
 }
+
+// IsAutoRegistrationChannel returns true if the first inbound transfer of an
+// IBC denomination over the given channel registers its token pair
+func (p Params) IsAutoRegistrationChannel(channel string) bool {
+	for _, c := range p.AutoRegistrationChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
@@ -24,9 +24,24 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", types.DefaultParams(), false},
 		{
 			"valid",
-			types.NewParams(true, true),
+			types.NewParams(true, true, nil),
 			false,
 		},
+		{
+			"valid - auto-registration channels",
+			types.NewParams(true, true, []string{"channel-0", "channel-12"}),
+			false,
+		},
+		{
+			"invalid - auto-registration channel",
+			types.NewParams(true, true, []string{"channel 0"}),
+			true,
+		},
+		{
+			"invalid - duplicate auto-registration channel",
+			types.NewParams(true, true, []string{"channel-0", "channel-0"}),
+			true,
+		},
 		{
 			"empty",
 			types.Params{},
@@ -48,4 +63,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(types.ValidateBool(1))
 	suite.Require().NoError(types.ValidateBool(true))
+	suite.Require().Error(types.ValidateChannels(true))
+	suite.Require().NoError(types.ValidateChannels([]string{"channel-0"}))
+}
+
+func (suite *ParamsTestSuite) TestIsAutoRegistrationChannel() {
+	params := types.NewParams(true, true, []string{"channel-0"})
+	suite.Require().True(params.IsAutoRegistrationChannel("channel-0"))
+	suite.Require().False(params.IsAutoRegistrationChannel("channel-1"))
+	suite.Require().False(types.DefaultParams().IsAutoRegistrationChannel("channel-0"))
 }
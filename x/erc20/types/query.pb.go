@@ -311,6 +311,204 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryAuditRequest is the request type for the Query/Audit RPC method.
+type QueryAuditRequest struct {
+	// token optionally restricts the audit to a token pair, identified by either
+	// the hex contract address of the ERC20 or the Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// discrepancies_only restricts the response to the token pairs whose supply
+	// isn't backed
+	DiscrepanciesOnly bool `protobuf:"varint,2,opt,name=discrepancies_only,json=discrepanciesOnly,proto3" json:"discrepancies_only,omitempty"`
+}
+
+func (m *QueryAuditRequest) Reset()         { *m = QueryAuditRequest{} }
+func (m *QueryAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditRequest) ProtoMessage()    {}
+func (*QueryAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{6}
+}
+func (m *QueryAuditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditRequest.Merge(m, src)
+}
+func (m *QueryAuditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditRequest proto.InternalMessageInfo
+
+func (m *QueryAuditRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *QueryAuditRequest) GetDiscrepanciesOnly() bool {
+	if m != nil {
+		return m.DiscrepanciesOnly
+	}
+	return false
+}
+
+// QueryAuditResponse is the response type for the Query/Audit RPC method.
+type QueryAuditResponse struct {
+	// audits are the supply backing audits of the token pairs
+	Audits []TokenPairAudit `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits"`
+}
+
+func (m *QueryAuditResponse) Reset()         { *m = QueryAuditResponse{} }
+func (m *QueryAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditResponse) ProtoMessage()    {}
+func (*QueryAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{7}
+}
+func (m *QueryAuditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditResponse.Merge(m, src)
+}
+func (m *QueryAuditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditResponse proto.InternalMessageInfo
+
+func (m *QueryAuditResponse) GetAudits() []TokenPairAudit {
+	if m != nil {
+		return m.Audits
+	}
+	return nil
+}
+
+// QueryConversionPreferenceRequest is the request type for the
+// Query/ConversionPreference RPC method.
+type QueryConversionPreferenceRequest struct {
+	// address is the bech32 or hex address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryConversionPreferenceRequest) Reset()         { *m = QueryConversionPreferenceRequest{} }
+func (m *QueryConversionPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionPreferenceRequest) ProtoMessage()    {}
+func (*QueryConversionPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{8}
+}
+func (m *QueryConversionPreferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionPreferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionPreferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionPreferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionPreferenceRequest.Merge(m, src)
+}
+func (m *QueryConversionPreferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionPreferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionPreferenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionPreferenceRequest proto.InternalMessageInfo
+
+func (m *QueryConversionPreferenceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryConversionPreferenceResponse is the response type for the
+// Query/ConversionPreference RPC method.
+type QueryConversionPreferenceResponse struct {
+	// preference defines how the coins received by the account over IBC are
+	// converted
+	Preference ConversionPreference `protobuf:"varint,1,opt,name=preference,proto3,enum=evmos.erc20.v1.ConversionPreference" json:"preference,omitempty"`
+}
+
+func (m *QueryConversionPreferenceResponse) Reset()         { *m = QueryConversionPreferenceResponse{} }
+func (m *QueryConversionPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionPreferenceResponse) ProtoMessage()    {}
+func (*QueryConversionPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{9}
+}
+func (m *QueryConversionPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConversionPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionPreferenceResponse.Merge(m, src)
+}
+func (m *QueryConversionPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConversionPreferenceResponse proto.InternalMessageInfo
+
+func (m *QueryConversionPreferenceResponse) GetPreference() ConversionPreference {
+	if m != nil {
+		return m.Preference
+	}
+	return CONVERSION_PREFERENCE_BALANCE
+}
+
 func init() {
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "evmos.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
@@ -318,44 +516,60 @@ func init() {
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAuditRequest)(nil), "evmos.erc20.v1.QueryAuditRequest")
+	proto.RegisterType((*QueryAuditResponse)(nil), "evmos.erc20.v1.QueryAuditResponse")
+	proto.RegisterType((*QueryConversionPreferenceRequest)(nil), "evmos.erc20.v1.QueryConversionPreferenceRequest")
+	proto.RegisterType((*QueryConversionPreferenceResponse)(nil), "evmos.erc20.v1.QueryConversionPreferenceResponse")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
 
 var fileDescriptor_fba814bce17cabdf = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xef, 0xec, 0xba, 0x85, 0xbe, 0x82, 0x87, 0xb1, 0xd6, 0x1a, 0x35, 0x2e, 0x29, 0xdb, 0x2d,
-	0x8a, 0x33, 0xa6, 0x8a, 0x47, 0x91, 0x1e, 0xf4, 0xe0, 0xa5, 0x16, 0x0f, 0xe2, 0x45, 0xa7, 0x65,
-	0x88, 0x41, 0x9b, 0x49, 0x33, 0xd3, 0xe0, 0x22, 0x5e, 0xf6, 0x22, 0xde, 0x04, 0xbf, 0x82, 0x1f,
-	0x66, 0x8f, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0x7e, 0x10, 0xc9, 0xcc, 0x24, 0xdd, 0xc4, 0xd2, 0x5e,
-	0x4a, 0xf2, 0xde, 0xfb, 0xfd, 0x7b, 0x2f, 0x05, 0x87, 0xa7, 0x33, 0x21, 0x29, 0x4f, 0xa6, 0x83,
-	0xfb, 0x34, 0xf5, 0xe9, 0x7c, 0xc1, 0x93, 0x13, 0x12, 0x27, 0x42, 0x09, 0x7c, 0x59, 0xf7, 0x88,
-	0xee, 0x91, 0xd4, 0x77, 0xee, 0x4c, 0x85, 0xcc, 0x86, 0x27, 0x4c, 0x72, 0x33, 0x48, 0x53, 0x7f,
-	0xc2, 0x15, 0xf3, 0x69, 0xcc, 0x82, 0x30, 0x62, 0x2a, 0x14, 0x91, 0xc1, 0x3a, 0x55, 0x5e, 0x43,
-	0x62, 0x7a, 0x37, 0x2b, 0xbd, 0x80, 0x47, 0x5c, 0x86, 0xd2, 0x76, 0x5b, 0x81, 0x08, 0x84, 0x7e,
-	0xa4, 0xd9, 0x53, 0x8e, 0x09, 0x84, 0x08, 0x3e, 0x70, 0xca, 0xe2, 0x90, 0xb2, 0x28, 0x12, 0x4a,
-	0x8b, 0x59, 0x8c, 0xf7, 0x16, 0xda, 0x2f, 0x32, 0x3f, 0x2f, 0xc5, 0x7b, 0x1e, 0x8d, 0x58, 0x98,
-	0xc8, 0x31, 0x9f, 0x2f, 0xb8, 0x54, 0xf8, 0x29, 0xc0, 0xda, 0x5b, 0x07, 0x1d, 0xa2, 0x7e, 0x73,
-	0xd0, 0x23, 0x26, 0x08, 0xc9, 0x82, 0x10, 0x93, 0xd8, 0x06, 0x21, 0x23, 0x16, 0x70, 0x8b, 0x1d,
-	0x5f, 0x40, 0x7a, 0x3f, 0x10, 0x5c, 0xfb, 0x4f, 0x42, 0xc6, 0x22, 0x92, 0x1c, 0x3f, 0x81, 0xa6,
-	0xca, 0xaa, 0x6f, 0xe2, 0xac, 0xdc, 0x41, 0x87, 0xfb, 0xfd, 0xe6, 0xe0, 0x3a, 0x29, 0x6f, 0x8f,
-	0x14, 0xc0, 0xe1, 0xa5, 0xb3, 0xdf, 0xb7, 0x6b, 0x63, 0x50, 0x05, 0x13, 0x7e, 0x56, 0x72, 0xb9,
-	0xa7, 0x5d, 0x1e, 0xef, 0x74, 0x69, 0xe4, 0x4b, 0x36, 0xef, 0xc1, 0xd5, 0xb2, 0xcb, 0x7c, 0x0f,
-	0x2d, 0x38, 0xd0, 0x7a, 0x7a, 0x05, 0x8d, 0xb1, 0x79, 0xf1, 0x5e, 0x55, 0xf7, 0x56, 0x64, 0x7a,
-	0x0c, 0xb0, 0xce, 0x64, 0xf7, 0xb6, 0x33, 0x52, 0xa3, 0x88, 0xe4, 0xb5, 0x00, 0x6b, 0xe6, 0x11,
-	0x4b, 0xd8, 0x2c, 0xbf, 0x86, 0xf7, 0x1c, 0xae, 0x94, 0xaa, 0x56, 0xec, 0x21, 0xd4, 0x63, 0x5d,
-	0xb1, 0x42, 0xed, 0xaa, 0x90, 0x99, 0xb7, 0x2a, 0x76, 0x76, 0xf0, 0x75, 0x1f, 0x0e, 0x34, 0x1b,
-	0x3e, 0x45, 0x00, 0xeb, 0xbb, 0xe0, 0x5e, 0x15, 0xbe, 0xf9, 0xdb, 0x70, 0x8e, 0x77, 0xce, 0x19,
-	0x7f, 0x5e, 0xf7, 0xf4, 0xe7, 0xdf, 0xef, 0x7b, 0xb7, 0xf0, 0x0d, 0x5a, 0xf9, 0x72, 0x2f, 0x9c,
-	0x1d, 0x7f, 0x41, 0xd0, 0x28, 0xb0, 0xf8, 0x68, 0x3b, 0x77, 0x6e, 0xa1, 0xb7, 0x6b, 0xcc, 0x3a,
-	0xb8, 0xab, 0x1d, 0x1c, 0xe1, 0xee, 0x16, 0x07, 0xf4, 0x93, 0x7e, 0xf9, 0x8c, 0xe7, 0x50, 0x37,
-	0x0b, 0xc3, 0xde, 0x46, 0xfa, 0xd2, 0x4d, 0x9c, 0xee, 0xd6, 0x19, 0xab, 0xef, 0x6a, 0xfd, 0x0e,
-	0x6e, 0x57, 0xf5, 0xcd, 0x2d, 0x86, 0xc3, 0xb3, 0xa5, 0x8b, 0xce, 0x97, 0x2e, 0xfa, 0xb3, 0x74,
-	0xd1, 0xb7, 0x95, 0x5b, 0x3b, 0x5f, 0xb9, 0xb5, 0x5f, 0x2b, 0xb7, 0xf6, 0xba, 0x1f, 0x84, 0xea,
-	0xdd, 0x62, 0x42, 0xa6, 0x62, 0x96, 0x63, 0xf5, 0x6f, 0xea, 0x3f, 0xa2, 0x1f, 0x2d, 0x8f, 0x3a,
-	0x89, 0xb9, 0x9c, 0xd4, 0xf5, 0x7f, 0xf9, 0xc1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xf7,
-	0x7c, 0xb6, 0x93, 0x04, 0x00, 0x00,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x0b, 0x09, 0x74, 0x2a, 0x55, 0xea, 0x92, 0xb6, 0xc1, 0x50, 0xd3, 0xba, 0xf4, 0x47,
+	0xa0, 0xda, 0x4d, 0x40, 0x88, 0x43, 0x85, 0xa0, 0x20, 0x38, 0x70, 0x20, 0x44, 0x1c, 0x2a, 0x2e,
+	0x65, 0xe3, 0x2c, 0xc6, 0xa2, 0xd9, 0x75, 0xbd, 0x4e, 0x44, 0x54, 0xf5, 0xd2, 0x0b, 0x57, 0x24,
+	0x5e, 0x81, 0x07, 0xe0, 0xc2, 0x3b, 0xf4, 0x58, 0x89, 0x0b, 0x27, 0x84, 0x1a, 0x1e, 0x04, 0x79,
+	0x77, 0xed, 0xc4, 0xc6, 0x38, 0xe2, 0x12, 0x79, 0x67, 0xe6, 0xfb, 0x99, 0xf1, 0x6c, 0x0c, 0x3a,
+	0xe9, 0x77, 0x19, 0xb7, 0x49, 0xe0, 0x34, 0xb6, 0xed, 0x7e, 0xdd, 0x3e, 0xec, 0x91, 0x60, 0x60,
+	0xf9, 0x01, 0x0b, 0x19, 0x9a, 0x15, 0x39, 0x4b, 0xe4, 0xac, 0x7e, 0x5d, 0xbf, 0xe5, 0x30, 0x1e,
+	0x15, 0xb7, 0x31, 0x27, 0xb2, 0xd0, 0xee, 0xd7, 0xdb, 0x24, 0xc4, 0x75, 0xdb, 0xc7, 0xae, 0x47,
+	0x71, 0xe8, 0x31, 0x2a, 0xb1, 0x7a, 0x96, 0x57, 0x92, 0xc8, 0xdc, 0xf5, 0x4c, 0xce, 0x25, 0x94,
+	0x70, 0x8f, 0xab, 0x6c, 0xd5, 0x65, 0x2e, 0x13, 0x8f, 0x76, 0xf4, 0x14, 0x63, 0x5c, 0xc6, 0xdc,
+	0x03, 0x62, 0x63, 0xdf, 0xb3, 0x31, 0xa5, 0x2c, 0x14, 0x62, 0x0a, 0x63, 0xbe, 0x81, 0x85, 0x97,
+	0x91, 0x9f, 0x57, 0xec, 0x3d, 0xa1, 0x4d, 0xec, 0x05, 0xbc, 0x45, 0x0e, 0x7b, 0x84, 0x87, 0xe8,
+	0x29, 0xc0, 0xc8, 0x5b, 0x4d, 0x5b, 0xd6, 0x36, 0x67, 0x1a, 0xeb, 0x96, 0x6c, 0xc4, 0x8a, 0x1a,
+	0xb1, 0x64, 0xc7, 0xaa, 0x11, 0xab, 0x89, 0x5d, 0xa2, 0xb0, 0xad, 0x31, 0xa4, 0xf9, 0x45, 0x83,
+	0xc5, 0xbf, 0x24, 0xb8, 0xcf, 0x28, 0x27, 0xe8, 0x21, 0xcc, 0x84, 0x51, 0x74, 0xdf, 0x8f, 0xc2,
+	0x35, 0x6d, 0xf9, 0xc2, 0xe6, 0x4c, 0xe3, 0xaa, 0x95, 0x9e, 0x9e, 0x95, 0x00, 0x77, 0x2f, 0x9e,
+	0xfe, 0xbc, 0x51, 0x6a, 0x41, 0x98, 0x30, 0xa1, 0x67, 0x29, 0x97, 0x53, 0xc2, 0xe5, 0xc6, 0x44,
+	0x97, 0x52, 0x3e, 0x65, 0x73, 0x0b, 0xe6, 0xd3, 0x2e, 0xe3, 0x39, 0x54, 0xa1, 0x2c, 0xf4, 0xc4,
+	0x08, 0xa6, 0x5b, 0xf2, 0x60, 0xee, 0x65, 0xe7, 0x96, 0xf4, 0xf4, 0x00, 0x60, 0xd4, 0x93, 0x9a,
+	0xdb, 0xc4, 0x96, 0xa6, 0x93, 0x96, 0xcc, 0x2a, 0x20, 0xc1, 0xdc, 0xc4, 0x01, 0xee, 0xc6, 0x6f,
+	0xc3, 0x7c, 0x0e, 0x57, 0x52, 0x51, 0x25, 0x76, 0x17, 0x2a, 0xbe, 0x88, 0x28, 0xa1, 0x85, 0xac,
+	0x90, 0xac, 0x57, 0x2a, 0xaa, 0xd6, 0xdc, 0x83, 0x39, 0x41, 0xf6, 0xa8, 0xd7, 0xf1, 0xc2, 0xc2,
+	0x3e, 0xd1, 0x16, 0xa0, 0x8e, 0xc7, 0x9d, 0x80, 0xf8, 0x98, 0x3a, 0x1e, 0xe1, 0xfb, 0x8c, 0x1e,
+	0x0c, 0xc4, 0x9c, 0x2f, 0xb7, 0xe6, 0x52, 0x99, 0x17, 0xf4, 0x60, 0x60, 0xb6, 0x00, 0x8d, 0x33,
+	0x2b, 0x97, 0x3b, 0x50, 0xc1, 0x51, 0x20, 0x7e, 0xc3, 0xc6, 0x3f, 0xc7, 0x21, 0x70, 0xb1, 0x5b,
+	0x89, 0x31, 0x77, 0x60, 0x59, 0x70, 0x3e, 0x66, 0xb4, 0x4f, 0x02, 0xee, 0x31, 0xda, 0x0c, 0xc8,
+	0x5b, 0x12, 0x10, 0xea, 0xc4, 0x0b, 0x87, 0x6a, 0x70, 0x09, 0x77, 0x3a, 0x01, 0xe1, 0x5c, 0xd9,
+	0x8f, 0x8f, 0xa6, 0x07, 0x2b, 0x05, 0x68, 0x65, 0xf0, 0x09, 0x80, 0x9f, 0x44, 0x05, 0xc3, 0x6c,
+	0xe3, 0x66, 0xd6, 0x64, 0x2e, 0xc3, 0x18, 0xae, 0xf1, 0xb5, 0x0c, 0x65, 0xa1, 0x85, 0x4e, 0x34,
+	0x80, 0xd1, 0xba, 0xa3, 0xf5, 0x2c, 0x55, 0xfe, 0x95, 0xd3, 0x37, 0x26, 0xd6, 0x49, 0xbf, 0xe6,
+	0xea, 0xc9, 0xf7, 0xdf, 0x9f, 0xa7, 0x96, 0xd0, 0x35, 0x3b, 0xf3, 0x87, 0x30, 0x76, 0x9b, 0xd0,
+	0x47, 0x0d, 0xa6, 0x13, 0x2c, 0x5a, 0x2b, 0xe6, 0x8e, 0x2d, 0xac, 0x4f, 0x2a, 0x53, 0x0e, 0x6e,
+	0x0b, 0x07, 0x6b, 0x68, 0xb5, 0xc0, 0x81, 0x7d, 0x24, 0x0e, 0xc7, 0xe8, 0x10, 0x2a, 0x72, 0x0f,
+	0x91, 0x99, 0x4b, 0x9f, 0x5a, 0x75, 0x7d, 0xb5, 0xb0, 0x46, 0xe9, 0x1b, 0x42, 0xbf, 0x86, 0x16,
+	0xb2, 0xfa, 0x72, 0xc5, 0x51, 0x17, 0xca, 0x62, 0x97, 0xd0, 0x4a, 0x2e, 0xdb, 0xf8, 0xe6, 0xeb,
+	0x66, 0x51, 0x89, 0xd2, 0x5b, 0x12, 0x7a, 0x8b, 0x68, 0x3e, 0xab, 0x27, 0x96, 0x14, 0x7d, 0xd3,
+	0xa0, 0x9a, 0xb7, 0x1f, 0x68, 0x3b, 0x97, 0xbb, 0x60, 0x95, 0xf5, 0xfa, 0x7f, 0x20, 0x94, 0xb9,
+	0xfb, 0xc2, 0x5c, 0x03, 0x6d, 0x67, 0xcd, 0x39, 0x09, 0x6a, 0x7f, 0xb4, 0xa7, 0xdc, 0x3e, 0x52,
+	0x97, 0xe3, 0x78, 0x77, 0xf7, 0xf4, 0xdc, 0xd0, 0xce, 0xce, 0x0d, 0xed, 0xd7, 0xb9, 0xa1, 0x7d,
+	0x1a, 0x1a, 0xa5, 0xb3, 0xa1, 0x51, 0xfa, 0x31, 0x34, 0x4a, 0xaf, 0x37, 0x5d, 0x2f, 0x7c, 0xd7,
+	0x6b, 0x5b, 0x0e, 0xeb, 0xc6, 0xac, 0xe2, 0xb7, 0x5f, 0xbf, 0x67, 0x7f, 0x50, 0x0a, 0xe1, 0xc0,
+	0x27, 0xbc, 0x5d, 0x11, 0x5f, 0x92, 0x3b, 0x7f, 0x06, 0x00, 0x30, 0x7e, 0xc7, 0xbf, 0x11, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -376,6 +590,11 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Audit retrieves the supply backing audit of the registered token pairs
+	Audit(ctx context.Context, in *QueryAuditRequest, opts ...grpc.CallOption) (*QueryAuditResponse, error)
+	// ConversionPreference retrieves how the coins received by an account over
+	// IBC are converted to ERC20 tokens
+	ConversionPreference(ctx context.Context, in *QueryConversionPreferenceRequest, opts ...grpc.CallOption) (*QueryConversionPreferenceResponse, error)
 }
 
 type queryClient struct {
@@ -413,6 +632,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Audit(ctx context.Context, in *QueryAuditRequest, opts ...grpc.CallOption) (*QueryAuditResponse, error) {
+	out := new(QueryAuditResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/Audit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionPreference(ctx context.Context, in *QueryConversionPreferenceRequest, opts ...grpc.CallOption) (*QueryConversionPreferenceResponse, error) {
+	out := new(QueryConversionPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/ConversionPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TokenPairs retrieves registered token pairs
@@ -421,6 +658,11 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Audit retrieves the supply backing audit of the registered token pairs
+	Audit(context.Context, *QueryAuditRequest) (*QueryAuditResponse, error)
+	// ConversionPreference retrieves how the coins received by an account over
+	// IBC are converted to ERC20 tokens
+	ConversionPreference(context.Context, *QueryConversionPreferenceRequest) (*QueryConversionPreferenceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -436,6 +678,12 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Audit(ctx context.Context, req *QueryAuditRequest) (*QueryAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
+func (*UnimplementedQueryServer) ConversionPreference(ctx context.Context, req *QueryConversionPreferenceRequest) (*QueryConversionPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionPreference not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -495,6 +743,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/Audit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Audit(ctx, req.(*QueryAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/ConversionPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionPreference(ctx, req.(*QueryConversionPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -511,6 +795,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Audit",
+			Handler:    _Query_Audit_Handler,
+		},
+		{
+			MethodName: "ConversionPreference",
+			Handler:    _Query_ConversionPreference_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/query.proto",
@@ -719,100 +1011,497 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAuditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTokenPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryAuditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokenPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAuditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TokenPairs) > 0 {
-		for _, e := range m.TokenPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.DiscrepanciesOnly {
+		i--
+		if m.DiscrepanciesOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Audits) > 0 {
+		for iNdEx := len(m.Audits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Audits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConversionPreferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConversionPreferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionPreferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Preference != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Preference))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DiscrepanciesOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAuditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Audits) > 0 {
+		for _, e := range m.Audits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConversionPreferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Preference != 0 {
+		n += 1 + sovQuery(uint64(m.Preference))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -835,17 +1524,17 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -855,27 +1544,23 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -898,7 +1583,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -921,15 +1606,15 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -956,14 +1641,113 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -990,10 +1774,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1018,7 +1799,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuditRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1041,10 +1822,10 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuditRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1079,6 +1860,26 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscrepanciesOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DiscrepanciesOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1100,7 +1901,7 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1123,15 +1924,15 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Audits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1158,7 +1959,8 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Audits = append(m.Audits, TokenPairAudit{})
+			if err := m.Audits[len(m.Audits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1183,7 +1985,7 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionPreferenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1206,12 +2008,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionPreferenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionPreferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1233,7 +2067,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConversionPreferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1256,17 +2090,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConversionPreferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConversionPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preference", wireType)
 			}
-			var msglen int
+			m.Preference = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1276,25 +2110,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Preference |= ConversionPreference(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
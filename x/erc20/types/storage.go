@@ -0,0 +1,93 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxStorageStringLength is the maximum length of the strings read from the
+// contract storage, which bounds the number of slots read for a string.
+const MaxStorageStringLength = 1024
+
+var (
+	// ERC20NameSlot is the storage slot of the name of the ERC20MinterBurnerDecimals
+	// contracts deployed by the module
+	ERC20NameSlot = common.BigToHash(big.NewInt(5))
+	// ERC20SymbolSlot is the storage slot of the symbol of the ERC20MinterBurnerDecimals
+	// contracts deployed by the module
+	ERC20SymbolSlot = common.BigToHash(big.NewInt(6))
+)
+
+// StorageEntry is a key-value pair of a contract storage.
+type StorageEntry struct {
+	Key   common.Hash
+	Value common.Hash
+}
+
+// StorageString returns the storage entries of a Solidity string stored at the
+// given slot. The strings shorter than 32 bytes are stored in the slot itself
+// along with their length. Otherwise, the slot contains the length and the data
+// is stored in consecutive slots starting at keccak256(slot).
+func StorageString(slot common.Hash, value string) []StorageEntry {
+	data := []byte(value)
+
+	if len(data) < common.HashLength {
+		var entry common.Hash
+		copy(entry[:], data)
+		entry[common.HashLength-1] = byte(len(data) * 2)
+		return []StorageEntry{{Key: slot, Value: entry}}
+	}
+
+	entries := []StorageEntry{
+		{Key: slot, Value: common.BigToHash(big.NewInt(int64(len(data)*2 + 1)))},
+	}
+
+	dataSlot := new(big.Int).SetBytes(crypto.Keccak256(slot.Bytes()))
+	for i := 0; i < len(data); i += common.HashLength {
+		var entry common.Hash
+		copy(entry[:], data[i:])
+		entries = append(entries, StorageEntry{Key: common.BigToHash(dataSlot), Value: entry})
+		dataSlot.Add(dataSlot, big.NewInt(1))
+	}
+
+	return entries
+}
+
+// ReadStorageString decodes a Solidity string stored at the given slot, reading
+// the storage entries with the given getter. It returns an error if the slot
+// doesn't contain a valid string encoding.
+func ReadStorageString(slot common.Hash, getState func(key common.Hash) common.Hash) (string, error) {
+	entry := getState(slot)
+
+	// short string: the lowest-order byte contains length * 2
+	if entry[common.HashLength-1]%2 == 0 {
+		length := int(entry[common.HashLength-1] / 2)
+		if length >= common.HashLength {
+			return "", fmt.Errorf("invalid short string length %d at slot %s", length, slot)
+		}
+		return string(entry[:length]), nil
+	}
+
+	// long string: the slot contains length * 2 + 1
+	encodedLength := entry.Big()
+	length := new(big.Int).Rsh(encodedLength, 1)
+	if !length.IsInt64() || length.Int64() < common.HashLength || length.Int64() > MaxStorageStringLength {
+		return "", fmt.Errorf("invalid long string length %s at slot %s", length, slot)
+	}
+
+	data := make([]byte, 0, length.Int64()+common.HashLength)
+	dataSlot := new(big.Int).SetBytes(crypto.Keccak256(slot.Bytes()))
+	for int64(len(data)) < length.Int64() {
+		value := getState(common.BigToHash(dataSlot))
+		data = append(data, value.Bytes()...)
+		dataSlot.Add(dataSlot, big.NewInt(1))
+	}
+
+	return string(data[:length.Int64()]), nil
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+func TestStorageString(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		expEntries int
+	}{
+		{"empty string", "", 1},
+		{"short string", "SYM", 1},
+		{"31 bytes string", strings.Repeat("a", 31), 1},
+		{"32 bytes string", strings.Repeat("a", 32), 2},
+		{"long string", strings.Repeat("a", 65), 4},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			entries := types.StorageString(types.ERC20SymbolSlot, tc.value)
+			require.Len(t, entries, tc.expEntries)
+			require.Equal(t, types.ERC20SymbolSlot, entries[0].Key)
+
+			storage := make(map[common.Hash]common.Hash)
+			for _, entry := range entries {
+				storage[entry.Key] = entry.Value
+			}
+
+			value, err := types.ReadStorageString(types.ERC20SymbolSlot, func(key common.Hash) common.Hash {
+				return storage[key]
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.value, value)
+		})
+	}
+
+	// "SYM" followed by its length * 2 in the lowest-order byte
+	entries := types.StorageString(types.ERC20SymbolSlot, "SYM")
+	require.Equal(t, common.HexToHash("0x53594d0000000000000000000000000000000000000000000000000000000006"), entries[0].Value)
+}
+
+func TestReadStorageStringInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		entry common.Hash
+	}{
+		{"short string too long", common.BigToHash(big.NewInt(64))},
+		{"long string too short", common.BigToHash(big.NewInt(31*2 + 1))},
+		{"long string too long", common.BigToHash(big.NewInt((types.MaxStorageStringLength+1)*2 + 1))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.ReadStorageString(types.ERC20NameSlot, func(common.Hash) common.Hash {
+				return tc.entry
+			})
+			require.Error(t, err)
+		})
+	}
+}
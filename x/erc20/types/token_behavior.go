@@ -0,0 +1,67 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// nonStandardTokenSelectors maps the selectors of the methods that are
+// characteristic of the fee-on-transfer and rebasing tokens to their signatures.
+var nonStandardTokenSelectors = newSelectors(
+	// rebasing tokens
+	"sharesOf(address)",
+	"scaledBalanceOf(address)",
+	"rebase(uint256,int256)",
+	"getSharesByPooledEth(uint256)",
+	// fee-on-transfer and reflection tokens
+	"isExcludedFromFee(address)",
+	"excludeFromFee(address)",
+	"tokenFromReflection(uint256)",
+	"reflectionFromToken(uint256,bool)",
+)
+
+// newSelectors maps the selectors of the given methods to their signatures.
+func newSelectors(methods ...string) map[[4]byte]string {
+	selectors := make(map[[4]byte]string, len(methods))
+	for _, method := range methods {
+		var selector [4]byte
+		copy(selector[:], crypto.Keccak256([]byte(method)))
+		selectors[selector] = method
+	}
+	return selectors
+}
+
+// FindNonStandardTokenMethod returns the signature of the first method of the
+// contract code that is characteristic of the fee-on-transfer and rebasing
+// tokens, if any. The method selectors are looked up in the PUSH4 instructions
+// of the code, which is where the Solidity and Vyper function dispatchers hold
+// them.
+//
+// NOTE: this is a heuristic. It misses the tokens behind a proxy, whose code only
+// forwards the calls, and the ones that take fees without exposing any of these
+// methods. It also flags the contracts that merely contain the selectors, e.g. as
+// the arguments of calls to other contracts. Thus the registration also probes a
+// transfer of the token, see the keeper.
+func FindNonStandardTokenMethod(code []byte) (string, bool) {
+	for pc := 0; pc < len(code); pc++ {
+		op := vm.OpCode(code[pc])
+		if !op.IsPush() {
+			continue
+		}
+
+		size := int(op - vm.PUSH1 + 1)
+		if op == vm.PUSH4 && pc+size < len(code) {
+			var selector [4]byte
+			copy(selector[:], code[pc+1:pc+1+size])
+			if method, ok := nonStandardTokenSelectors[selector]; ok {
+				return method, true
+			}
+		}
+		pc += size
+	}
+
+	return "", false
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+func TestFindNonStandardTokenMethod(t *testing.T) {
+	selector := func(method string) []byte {
+		return crypto.Keccak256([]byte(method))[:4]
+	}
+
+	testCases := []struct {
+		name      string
+		code      []byte
+		expMethod string
+		expFound  bool
+	}{
+		{
+			"empty code",
+			nil,
+			"",
+			false,
+		},
+		{
+			"standard token selector",
+			append([]byte{byte(vm.PUSH4)}, selector("transfer(address,uint256)")...),
+			"",
+			false,
+		},
+		{
+			"rebasing token selector",
+			append([]byte{byte(vm.PUSH1), 0x01, byte(vm.PUSH4)}, selector("sharesOf(address)")...),
+			"sharesOf(address)",
+			true,
+		},
+		{
+			"fee-on-transfer token selector",
+			append([]byte{byte(vm.PUSH4)}, selector("isExcludedFromFee(address)")...),
+			"isExcludedFromFee(address)",
+			true,
+		},
+		{
+			"selector in push data",
+			append([]byte{byte(vm.PUSH5), byte(vm.PUSH4)}, selector("sharesOf(address)")...),
+			"",
+			false,
+		},
+		{
+			"truncated selector",
+			append([]byte{byte(vm.PUSH4)}, selector("sharesOf(address)")[:3]...),
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method, found := types.FindNonStandardTokenMethod(tc.code)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expMethod, method)
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -37,9 +39,21 @@ func (tp TokenPair) Validate() error {
 		return err
 	}
 
+	if err := ValidateTokenBehavior(tp.Behavior); err != nil {
+		return err
+	}
+
 	return evmostypes.ValidateAddress(tp.Erc20Address)
 }
 
+// ValidateTokenBehavior returns an error if the token behavior is undefined.
+func ValidateTokenBehavior(behavior TokenBehavior) error {
+	if _, ok := TokenBehavior_name[int32(behavior)]; !ok {
+		return fmt.Errorf("invalid token behavior %d", behavior)
+	}
+	return nil
+}
+
 // IsNativeCoin returns true if the owner of the ERC20 contract is the
 // erc20 module account
 func (tp TokenPair) IsNativeCoin() bool {
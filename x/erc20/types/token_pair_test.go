@@ -57,10 +57,11 @@ func (suite *TokenPairTestSuite) TestTokenPair() {
 		pair       types.TokenPair
 		expectPass bool
 	}{
-		{msg: "Register token pair - invalid address (no hex)", pair: types.TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, types.OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", pair: types.TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, types.OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", pair: types.TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, types.OWNER_MODULE}, expectPass: false},
-		{msg: "pass", pair: types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - invalid address (no hex)", pair: types.TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, types.OWNER_MODULE, types.TOKEN_BEHAVIOR_STANDARD}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", pair: types.TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, types.OWNER_MODULE, types.TOKEN_BEHAVIOR_STANDARD}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", pair: types.TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, types.OWNER_MODULE, types.TOKEN_BEHAVIOR_STANDARD}, expectPass: false},
+		{msg: "Register token pair - invalid behavior", pair: types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_EXTERNAL, 3}, expectPass: false},
+		{msg: "pass", pair: types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_MODULE, types.TOKEN_BEHAVIOR_STANDARD}, expectPass: true},
 	}
 
 	for i, tc := range testCases {
@@ -99,17 +100,17 @@ func (suite *TokenPairTestSuite) TestIsNativeCoin() {
 	}{
 		{
 			"no owner",
-			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_UNSPECIFIED},
+			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_UNSPECIFIED, types.TOKEN_BEHAVIOR_STANDARD},
 			false,
 		},
 		{
 			"external ERC20 owner",
-			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_EXTERNAL},
+			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_EXTERNAL, types.TOKEN_BEHAVIOR_STANDARD},
 			false,
 		},
 		{
 			"pass",
-			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_MODULE},
+			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_MODULE, types.TOKEN_BEHAVIOR_STANDARD},
 			true,
 		},
 	}
@@ -132,17 +133,17 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 	}{
 		{
 			"no owner",
-			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_UNSPECIFIED},
+			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_UNSPECIFIED, types.TOKEN_BEHAVIOR_STANDARD},
 			false,
 		},
 		{
 			"module owner",
-			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_MODULE},
+			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_MODULE, types.TOKEN_BEHAVIOR_STANDARD},
 			false,
 		},
 		{
 			"pass",
-			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_EXTERNAL},
+			types.TokenPair{utiltx.GenerateAddress().String(), "test", true, types.OWNER_EXTERNAL, types.TOKEN_BEHAVIOR_STANDARD},
 			true,
 		},
 	}
@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -335,533 +336,2555 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
-	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
-	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
-	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
-	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+// MsgSetTokenPairBehavior is the Msg/SetTokenPairBehavior request type.
+type MsgSetTokenPairBehavior struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// behavior defines how the conversions handle non-standard ERC20 tokens
+	Behavior TokenBehavior `protobuf:"varint,3,opt,name=behavior,proto3,enum=evmos.erc20.v1.TokenBehavior" json:"behavior,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
-
-var fileDescriptor_f8926fc6cb676914 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcd, 0x6e, 0x13, 0x3f,
-	0x14, 0xc5, 0x33, 0x6d, 0x14, 0xfd, 0xe3, 0x54, 0xe9, 0x5f, 0x56, 0xc9, 0x97, 0x60, 0x12, 0xb2,
-	0xa0, 0x01, 0x89, 0x71, 0x93, 0x42, 0x17, 0xec, 0x48, 0xc4, 0x82, 0x45, 0x25, 0x34, 0x08, 0x09,
-	0xb1, 0x89, 0x9c, 0x89, 0x35, 0x19, 0xc1, 0xd8, 0x23, 0xdb, 0x19, 0x35, 0x1b, 0x16, 0x79, 0x01,
-	0x90, 0x78, 0x08, 0xb6, 0x2c, 0x78, 0x88, 0x2e, 0x2b, 0xd8, 0x20, 0x16, 0x15, 0x4a, 0x2a, 0xf1,
-	0x1a, 0x68, 0x6c, 0xe7, 0x63, 0x42, 0x5a, 0x36, 0x51, 0xec, 0x73, 0x7c, 0xef, 0xef, 0xf8, 0x7a,
-	0x40, 0x99, 0xc4, 0x21, 0x13, 0x88, 0x70, 0xaf, 0x73, 0x84, 0xe2, 0x36, 0x92, 0x67, 0x4e, 0xc4,
-	0x99, 0x64, 0xb0, 0xa8, 0x04, 0x47, 0x09, 0x4e, 0xdc, 0xae, 0xd9, 0x1e, 0x13, 0x89, 0x73, 0x80,
-	0x05, 0x41, 0x71, 0x7b, 0x40, 0x24, 0x6e, 0x23, 0x8f, 0x05, 0x54, 0xfb, 0x6b, 0x65, 0xa3, 0x87,
-	0xc2, 0x4f, 0xea, 0x84, 0xc2, 0x37, 0x42, 0x55, 0x0b, 0x7d, 0xb5, 0x42, 0x7a, 0x61, 0xa4, 0xdb,
-	0x1b, 0xcd, 0x7d, 0x42, 0x89, 0x08, 0x16, 0xea, 0x81, 0xcf, 0x7c, 0xa6, 0x4f, 0x25, 0xff, 0x16,
-	0x67, 0x7c, 0xc6, 0xfc, 0x77, 0x04, 0xe1, 0x28, 0x40, 0x98, 0x52, 0x26, 0xb1, 0x0c, 0x18, 0x35,
-	0x67, 0x9a, 0x13, 0x50, 0x3c, 0x15, 0x7e, 0x8f, 0xd1, 0x98, 0x70, 0xd9, 0x63, 0x01, 0x85, 0xc7,
-	0x20, 0x9b, 0x50, 0x56, 0xac, 0x86, 0xd5, 0x2a, 0x74, 0xaa, 0x8e, 0x01, 0x48, 0x62, 0x38, 0x26,
-	0x86, 0x93, 0x18, 0xbb, 0xd9, 0xf3, 0xcb, 0x7a, 0xc6, 0x55, 0x66, 0x58, 0x03, 0xff, 0x71, 0xe2,
-	0x91, 0x20, 0x26, 0xbc, 0xb2, 0xd3, 0xb0, 0x5a, 0x79, 0x77, 0xb9, 0x86, 0x25, 0x90, 0x13, 0x84,
-	0x0e, 0x09, 0xaf, 0xec, 0x2a, 0xc5, 0xac, 0x9a, 0x15, 0x50, 0x4a, 0xb7, 0x76, 0x89, 0x88, 0x18,
-	0x15, 0xa4, 0xf9, 0xd9, 0x02, 0xfb, 0x2b, 0xe9, 0x99, 0xdb, 0xeb, 0x1c, 0xc1, 0xfb, 0xe0, 0x7f,
-	0x8f, 0x51, 0xc9, 0xb1, 0x27, 0xfb, 0x78, 0x38, 0xe4, 0x44, 0x08, 0x85, 0x98, 0x77, 0xf7, 0x17,
-	0xfb, 0x4f, 0xf5, 0x36, 0x7c, 0x0c, 0x72, 0x38, 0x64, 0x63, 0x2a, 0x35, 0x4a, 0xf7, 0x4e, 0x02,
-	0xfa, 0xf3, 0xb2, 0x7e, 0x4b, 0x47, 0x11, 0xc3, 0xb7, 0x4e, 0xc0, 0x50, 0x88, 0xe5, 0xc8, 0x79,
-	0x4e, 0xa5, 0x6b, 0xcc, 0xa9, 0x0c, 0xbb, 0xd7, 0x66, 0xc8, 0xa6, 0x32, 0x54, 0x41, 0x79, 0x03,
-	0x74, 0x19, 0xe2, 0x83, 0x0e, 0xf1, 0x2a, 0x1a, 0x62, 0x49, 0x5e, 0x60, 0x8e, 0x43, 0x01, 0x4f,
-	0x40, 0x1e, 0x8f, 0xe5, 0x88, 0xf1, 0x40, 0x4e, 0x34, 0x7d, 0xb7, 0xf2, 0xed, 0xeb, 0xc3, 0x03,
-	0x73, 0xc7, 0x26, 0xc0, 0x4b, 0xc9, 0x03, 0xea, 0xbb, 0x2b, 0x2b, 0x7c, 0x04, 0x72, 0x91, 0xaa,
-	0xa0, 0x12, 0x15, 0x3a, 0x25, 0x27, 0xfd, 0xd8, 0x1c, 0x5d, 0xdf, 0x8c, 0xc4, 0x78, 0x9f, 0x14,
-	0xa7, 0xbf, 0xbf, 0x3c, 0x58, 0x55, 0x31, 0xb0, 0xeb, 0x40, 0x0b, 0xd8, 0xce, 0xd5, 0x0e, 0xd8,
-	0x3d, 0x15, 0x3e, 0x7c, 0x0f, 0x0a, 0xeb, 0x6f, 0xc1, 0xde, 0xec, 0x93, 0x1e, 0x58, 0xed, 0xde,
-	0xcd, 0xfa, 0xf2, 0x2e, 0x0e, 0xa7, 0xdf, 0xaf, 0x3e, 0xed, 0xdc, 0x85, 0x75, 0xf4, 0xd7, 0xd7,
-	0x83, 0x3c, 0xed, 0xef, 0xab, 0x77, 0x34, 0xb5, 0xc0, 0x5e, 0x6a, 0xec, 0xf5, 0xeb, 0x3b, 0x28,
-	0x43, 0xed, 0xf0, 0x1f, 0x86, 0x25, 0x43, 0x4b, 0x31, 0x34, 0x61, 0xe3, 0x06, 0x06, 0xb5, 0x07,
-	0x5f, 0x83, 0xbd, 0xd4, 0xd4, 0xb6, 0x31, 0xac, 0x1b, 0xb6, 0x32, 0x6c, 0xbb, 0xe6, 0x6e, 0xf7,
-	0x7c, 0x66, 0x5b, 0x17, 0x33, 0xdb, 0xfa, 0x35, 0xb3, 0xad, 0x8f, 0x73, 0x3b, 0x73, 0x31, 0xb7,
-	0x33, 0x3f, 0xe6, 0x76, 0xe6, 0x4d, 0xcb, 0x0f, 0xe4, 0x68, 0x3c, 0x70, 0x3c, 0x16, 0x2e, 0xf8,
-	0xd4, 0x6f, 0xdc, 0x3e, 0x41, 0x67, 0x86, 0x55, 0x4e, 0x22, 0x22, 0x06, 0x39, 0xf5, 0xe1, 0x1e,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x27, 0xf3, 0xf5, 0xf1, 0x89, 0x04, 0x00, 0x00,
+func (m *MsgSetTokenPairBehavior) Reset()         { *m = MsgSetTokenPairBehavior{} }
+func (m *MsgSetTokenPairBehavior) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTokenPairBehavior) ProtoMessage()    {}
+func (*MsgSetTokenPairBehavior) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{6}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
-	// that is registered on the token mapping.
-	ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error)
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
-	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSetTokenPairBehavior) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetTokenPairBehavior) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenPairBehavior.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetTokenPairBehavior) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenPairBehavior.Merge(m, src)
+}
+func (m *MsgSetTokenPairBehavior) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenPairBehavior) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenPairBehavior.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error) {
-	out := new(MsgConvertCoinResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetTokenPairBehavior proto.InternalMessageInfo
+
+func (m *MsgSetTokenPairBehavior) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
-	out := new(MsgConvertERC20Response)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTokenPairBehavior) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetTokenPairBehavior) GetBehavior() TokenBehavior {
+	if m != nil {
+		return m.Behavior
 	}
-	return out, nil
+	return TOKEN_BEHAVIOR_STANDARD
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
-	// that is registered on the token mapping.
-	ConvertCoin(context.Context, *MsgConvertCoin) (*MsgConvertCoinResponse, error)
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
-	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgSetTokenPairBehaviorResponse defines the response structure for executing
+// a MsgSetTokenPairBehavior message.
+type MsgSetTokenPairBehaviorResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetTokenPairBehaviorResponse) Reset()         { *m = MsgSetTokenPairBehaviorResponse{} }
+func (m *MsgSetTokenPairBehaviorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTokenPairBehaviorResponse) ProtoMessage()    {}
+func (*MsgSetTokenPairBehaviorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{7}
 }
-
-func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertCoin) (*MsgConvertCoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoin not implemented")
+func (m *MsgSetTokenPairBehaviorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+func (m *MsgSetTokenPairBehaviorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTokenPairBehaviorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetTokenPairBehaviorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTokenPairBehaviorResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetTokenPairBehaviorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTokenPairBehaviorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTokenPairBehaviorResponse.DiscardUnknown(m)
 }
 
-func _Msg_ConvertCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCoin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCoin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCoin(ctx, req.(*MsgConvertCoin))
+var xxx_messageInfo_MsgSetTokenPairBehaviorResponse proto.InternalMessageInfo
+
+// MsgUpdateTokenPairMetadata is the Msg/UpdateTokenPairMetadata request type.
+type MsgUpdateTokenPairMetadata struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// name is the new name of the token
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the new symbol of the token
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *MsgUpdateTokenPairMetadata) Reset()         { *m = MsgUpdateTokenPairMetadata{} }
+func (m *MsgUpdateTokenPairMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTokenPairMetadata) ProtoMessage()    {}
+func (*MsgUpdateTokenPairMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{8}
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTokenPairMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTokenPairMetadata.Merge(m, src)
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTokenPairMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTokenPairMetadata.DiscardUnknown(m)
 }
 
-func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertERC20)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertERC20(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/ConvertERC20",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
+var xxx_messageInfo_MsgUpdateTokenPairMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateTokenPairMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgUpdateTokenPairMetadata) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.erc20.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertCoin",
-			Handler:    _Msg_ConvertCoin_Handler,
-		},
-		{
-			MethodName: "ConvertERC20",
-			Handler:    _Msg_ConvertERC20_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/erc20/v1/tx.proto",
+func (m *MsgUpdateTokenPairMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTokenPairMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateTokenPairMetadataResponse defines the response structure for
+// executing a MsgUpdateTokenPairMetadata message.
+type MsgUpdateTokenPairMetadataResponse struct {
 }
 
-func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateTokenPairMetadataResponse) Reset()         { *m = MsgUpdateTokenPairMetadataResponse{} }
+func (m *MsgUpdateTokenPairMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTokenPairMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateTokenPairMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{9}
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTokenPairMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTokenPairMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTokenPairMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTokenPairMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateTokenPairMetadataResponse proto.InternalMessageInfo
+
+// MsgUpgradeTokenPairContract is the Msg/UpgradeTokenPairContract request type.
+type MsgUpgradeTokenPairContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// implementation is the hex address of a deployed contract whose code
+	// replaces the code of the ERC20 contract. Its storage layout must be
+	// compatible with the one of the ERC20 contract.
+	Implementation string `protobuf:"bytes,3,opt,name=implementation,proto3" json:"implementation,omitempty"`
+}
+
+func (m *MsgUpgradeTokenPairContract) Reset()         { *m = MsgUpgradeTokenPairContract{} }
+func (m *MsgUpgradeTokenPairContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUpgradeTokenPairContract) ProtoMessage()    {}
+func (*MsgUpgradeTokenPairContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{10}
+}
+func (m *MsgUpgradeTokenPairContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpgradeTokenPairContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpgradeTokenPairContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpgradeTokenPairContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpgradeTokenPairContract.Merge(m, src)
+}
+func (m *MsgUpgradeTokenPairContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpgradeTokenPairContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpgradeTokenPairContract.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpgradeTokenPairContract proto.InternalMessageInfo
+
+func (m *MsgUpgradeTokenPairContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpgradeTokenPairContract) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpgradeTokenPairContract) GetImplementation() string {
+	if m != nil {
+		return m.Implementation
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpgradeTokenPairContractResponse defines the response structure for
+// executing a MsgUpgradeTokenPairContract message.
+type MsgUpgradeTokenPairContractResponse struct {
 }
 
-func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgUpgradeTokenPairContractResponse) Reset()         { *m = MsgUpgradeTokenPairContractResponse{} }
+func (m *MsgUpgradeTokenPairContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpgradeTokenPairContractResponse) ProtoMessage()    {}
+func (*MsgUpgradeTokenPairContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{11}
+}
+func (m *MsgUpgradeTokenPairContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpgradeTokenPairContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpgradeTokenPairContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgUpgradeTokenPairContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpgradeTokenPairContractResponse.Merge(m, src)
+}
+func (m *MsgUpgradeTokenPairContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpgradeTokenPairContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpgradeTokenPairContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpgradeTokenPairContractResponse proto.InternalMessageInfo
+
+// MsgConvertCoins defines a Msg to convert multiple native Cosmos coins to
+// their ERC20 tokens
+type MsgConvertCoins struct {
+	// coins are Cosmos coins whose denominations are registered in token pairs.
+	// The coin amounts define the amounts of coins to convert.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// receiver is the hex address to receive the ERC20 tokens
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the cosmos bech32 address from the owner of the given Cosmos coins
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertCoins) Reset()         { *m = MsgConvertCoins{} }
+func (m *MsgConvertCoins) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoins) ProtoMessage()    {}
+func (*MsgConvertCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{12}
+}
+func (m *MsgConvertCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgConvertCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoins.Merge(m, src)
+}
+func (m *MsgConvertCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCoins proto.InternalMessageInfo
+
+func (m *MsgConvertCoins) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConvertCoins) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgConvertCoins) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgConvertCoinsResponse returns no fields
+type MsgConvertCoinsResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConvertCoinsResponse) Reset()         { *m = MsgConvertCoinsResponse{} }
+func (m *MsgConvertCoinsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoinsResponse) ProtoMessage()    {}
+func (*MsgConvertCoinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{13}
+}
+func (m *MsgConvertCoinsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoinsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgConvertCoinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoinsResponse.Merge(m, src)
+}
+func (m *MsgConvertCoinsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoinsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgConvertCoinsResponse proto.InternalMessageInfo
+
+// MsgSetConversionPreference defines a Msg to set how the coins received by the
+// sender over IBC are converted
+type MsgSetConversionPreference struct {
+	// sender is the cosmos bech32 address of the account
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// preference defines how the coins received by the account over IBC are
+	// converted
+	Preference ConversionPreference `protobuf:"varint,2,opt,name=preference,proto3,enum=evmos.erc20.v1.ConversionPreference" json:"preference,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetConversionPreference) Reset()         { *m = MsgSetConversionPreference{} }
+func (m *MsgSetConversionPreference) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConversionPreference) ProtoMessage()    {}
+func (*MsgSetConversionPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{14}
+}
+func (m *MsgSetConversionPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConversionPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConversionPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetConversionPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConversionPreference.Merge(m, src)
+}
+func (m *MsgSetConversionPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConversionPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConversionPreference.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetConversionPreference proto.InternalMessageInfo
+
+func (m *MsgSetConversionPreference) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetConversionPreference) GetPreference() ConversionPreference {
+	if m != nil {
+		return m.Preference
+	}
+	return CONVERSION_PREFERENCE_BALANCE
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetConversionPreferenceResponse returns no fields
+type MsgSetConversionPreferenceResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetConversionPreferenceResponse) Reset()         { *m = MsgSetConversionPreferenceResponse{} }
+func (m *MsgSetConversionPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConversionPreferenceResponse) ProtoMessage()    {}
+func (*MsgSetConversionPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{15}
 }
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetConversionPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConversionPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConversionPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetConversionPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConversionPreferenceResponse.Merge(m, src)
+}
+func (m *MsgSetConversionPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConversionPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConversionPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConversionPreferenceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
+	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
+	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
+	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
+	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetTokenPairBehavior)(nil), "evmos.erc20.v1.MsgSetTokenPairBehavior")
+	proto.RegisterType((*MsgSetTokenPairBehaviorResponse)(nil), "evmos.erc20.v1.MsgSetTokenPairBehaviorResponse")
+	proto.RegisterType((*MsgUpdateTokenPairMetadata)(nil), "evmos.erc20.v1.MsgUpdateTokenPairMetadata")
+	proto.RegisterType((*MsgUpdateTokenPairMetadataResponse)(nil), "evmos.erc20.v1.MsgUpdateTokenPairMetadataResponse")
+	proto.RegisterType((*MsgUpgradeTokenPairContract)(nil), "evmos.erc20.v1.MsgUpgradeTokenPairContract")
+	proto.RegisterType((*MsgUpgradeTokenPairContractResponse)(nil), "evmos.erc20.v1.MsgUpgradeTokenPairContractResponse")
+	proto.RegisterType((*MsgConvertCoins)(nil), "evmos.erc20.v1.MsgConvertCoins")
+	proto.RegisterType((*MsgConvertCoinsResponse)(nil), "evmos.erc20.v1.MsgConvertCoinsResponse")
+	proto.RegisterType((*MsgSetConversionPreference)(nil), "evmos.erc20.v1.MsgSetConversionPreference")
+	proto.RegisterType((*MsgSetConversionPreferenceResponse)(nil), "evmos.erc20.v1.MsgSetConversionPreferenceResponse")
+}
+
+func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
+
+var fileDescriptor_f8926fc6cb676914 = []byte{
+	// 920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x9b, 0x34, 0x34, 0x2f, 0xd1, 0x16, 0x8d, 0x42, 0xb2, 0x31, 0x74, 0x37, 0x5d, 0x4a,
+	0x13, 0x82, 0x6a, 0x67, 0x1d, 0xa8, 0x44, 0x6f, 0x6c, 0xe0, 0xc0, 0x21, 0x52, 0xe4, 0x80, 0x54,
+	0x71, 0x89, 0x66, 0xbd, 0x83, 0x63, 0x25, 0x9e, 0xb1, 0x3c, 0x93, 0x55, 0x57, 0x42, 0x1c, 0x72,
+	0xe1, 0x08, 0x12, 0x3f, 0x01, 0x04, 0x12, 0x27, 0x84, 0xe0, 0x3f, 0xf4, 0x58, 0xc1, 0x05, 0x71,
+	0x28, 0x28, 0x41, 0xe2, 0x6f, 0x20, 0xcf, 0xcc, 0xce, 0xda, 0xbb, 0xf6, 0x26, 0xad, 0x72, 0x49,
+	0x3c, 0xf3, 0xbe, 0xf9, 0xde, 0xf7, 0x8d, 0xdf, 0x7b, 0x5e, 0x58, 0x25, 0xfd, 0x98, 0x71, 0x97,
+	0xa4, 0x81, 0xb7, 0xed, 0xf6, 0xdb, 0xae, 0x78, 0xe2, 0x24, 0x29, 0x13, 0x0c, 0xd5, 0x64, 0xc0,
+	0x91, 0x01, 0xa7, 0xdf, 0xb6, 0x1b, 0x01, 0xe3, 0x19, 0xb2, 0x8b, 0x39, 0x71, 0xfb, 0xed, 0x2e,
+	0x11, 0xb8, 0xed, 0x06, 0x2c, 0xa2, 0x0a, 0x6f, 0xaf, 0xea, 0x78, 0xcc, 0xc3, 0x8c, 0x27, 0xe6,
+	0xa1, 0x0e, 0xac, 0xa9, 0xc0, 0xa1, 0x5c, 0xb9, 0x6a, 0xa1, 0x43, 0xf6, 0x58, 0x72, 0x95, 0x4c,
+	0xc5, 0xde, 0x18, 0x8b, 0x85, 0x84, 0x12, 0x1e, 0x0d, 0x4f, 0x2e, 0x87, 0x2c, 0x64, 0x8a, 0x31,
+	0x7b, 0x1a, 0x9e, 0x09, 0x19, 0x0b, 0x4f, 0x88, 0x8b, 0x93, 0xc8, 0xc5, 0x94, 0x32, 0x81, 0x45,
+	0xc4, 0xa8, 0x3e, 0xd3, 0x1a, 0x40, 0x6d, 0x8f, 0x87, 0xbb, 0x8c, 0xf6, 0x49, 0x2a, 0x76, 0x59,
+	0x44, 0xd1, 0x0e, 0xcc, 0x65, 0x0e, 0xea, 0xd6, 0xba, 0xb5, 0xb9, 0xe8, 0xad, 0x39, 0x5a, 0x5c,
+	0x66, 0xd1, 0xd1, 0x16, 0x9d, 0x0c, 0xd8, 0x99, 0x7b, 0xfa, 0xbc, 0x39, 0xe3, 0x4b, 0x30, 0xb2,
+	0xe1, 0x56, 0x4a, 0x02, 0x12, 0xf5, 0x49, 0x5a, 0xbf, 0xb1, 0x6e, 0x6d, 0x2e, 0xf8, 0x66, 0x8d,
+	0x56, 0x60, 0x9e, 0x13, 0xda, 0x23, 0x69, 0x7d, 0x56, 0x46, 0xf4, 0xaa, 0x55, 0x87, 0x95, 0x62,
+	0x6a, 0x9f, 0xf0, 0x84, 0x51, 0x4e, 0x5a, 0x3f, 0x5a, 0x70, 0x7b, 0x14, 0xfa, 0xc8, 0xdf, 0xf5,
+	0xb6, 0xd1, 0xdb, 0xf0, 0x6a, 0xc0, 0xa8, 0x48, 0x71, 0x20, 0x0e, 0x71, 0xaf, 0x97, 0x12, 0xce,
+	0xa5, 0xc4, 0x05, 0xff, 0xf6, 0x70, 0xff, 0x03, 0xb5, 0x8d, 0xde, 0x83, 0x79, 0x1c, 0xb3, 0x53,
+	0x2a, 0x94, 0x94, 0xce, 0x9d, 0x4c, 0xe8, 0x5f, 0xcf, 0x9b, 0xaf, 0x29, 0x2b, 0xbc, 0x77, 0xec,
+	0x44, 0xcc, 0x8d, 0xb1, 0x38, 0x72, 0x3e, 0xa6, 0xc2, 0xd7, 0xe0, 0x82, 0x87, 0xd9, 0x4a, 0x0f,
+	0x73, 0x05, 0x0f, 0x6b, 0xb0, 0x3a, 0x26, 0xd4, 0x98, 0xf8, 0x5a, 0x99, 0xf8, 0x34, 0xe9, 0x61,
+	0x41, 0xf6, 0x71, 0x8a, 0x63, 0x8e, 0x1e, 0xc2, 0x02, 0x3e, 0x15, 0x47, 0x2c, 0x8d, 0xc4, 0x40,
+	0xa9, 0xef, 0xd4, 0x7f, 0xff, 0xf5, 0xc1, 0xb2, 0xbe, 0x63, 0x6d, 0xe0, 0x40, 0xa4, 0x11, 0x0d,
+	0xfd, 0x11, 0x14, 0xbd, 0x0b, 0xf3, 0x89, 0x64, 0x90, 0x8e, 0x16, 0xbd, 0x15, 0xa7, 0x58, 0x88,
+	0x8e, 0xe2, 0xd7, 0xaf, 0x44, 0x63, 0x1f, 0xd5, 0xce, 0xfe, 0xfb, 0x79, 0x6b, 0xc4, 0xa2, 0xc5,
+	0xe6, 0x05, 0x19, 0xb1, 0xbf, 0x58, 0x32, 0x76, 0x40, 0xc4, 0x27, 0xec, 0x98, 0xd0, 0x7d, 0x1c,
+	0xa5, 0x1d, 0x72, 0x84, 0xfb, 0x11, 0x4b, 0x5f, 0x5a, 0xf4, 0x32, 0xdc, 0x14, 0x19, 0x99, 0x2e,
+	0x08, 0xb5, 0x40, 0xef, 0xc3, 0xad, 0xae, 0x66, 0x96, 0xb7, 0x5c, 0xf3, 0xee, 0x8c, 0x9b, 0x91,
+	0x12, 0x86, 0xe9, 0x7d, 0x03, 0x9f, 0xf0, 0x73, 0x17, 0x9a, 0x15, 0x9a, 0x8d, 0xaf, 0x1f, 0x2c,
+	0xb0, 0x8d, 0x67, 0x03, 0xdb, 0x23, 0x02, 0xf7, 0xb0, 0xc0, 0xd7, 0x6c, 0x0d, 0xc1, 0x1c, 0xc5,
+	0x31, 0xd1, 0xc5, 0x23, 0x9f, 0x65, 0xe1, 0x0c, 0xe2, 0x2e, 0x3b, 0x31, 0x85, 0x23, 0x57, 0x13,
+	0x5e, 0xee, 0x41, 0xab, 0x5a, 0xa7, 0xb1, 0xf3, 0x9d, 0x05, 0xaf, 0x4b, 0x58, 0x98, 0xe2, 0xde,
+	0x08, 0xb7, 0xab, 0x1b, 0xe0, 0x9a, 0xfd, 0xdc, 0x87, 0x5a, 0x14, 0x27, 0x27, 0x24, 0x26, 0x54,
+	0x0d, 0x0d, 0xed, 0x6c, 0x6c, 0x77, 0xc2, 0xcb, 0x5b, 0xf0, 0xe6, 0x14, 0x91, 0xc6, 0xcc, 0x6f,
+	0x85, 0x2e, 0xcf, 0x06, 0x00, 0x47, 0x18, 0x6e, 0x66, 0xf3, 0x24, 0x6b, 0xed, 0xd9, 0xe9, 0xd3,
+	0x67, 0x3b, 0x2b, 0xf5, 0x9f, 0xfe, 0x6e, 0x6e, 0x86, 0x91, 0x38, 0x3a, 0xed, 0x3a, 0x01, 0x8b,
+	0xf5, 0x1c, 0xd5, 0xff, 0x1e, 0xf0, 0xde, 0xb1, 0x2b, 0x06, 0x09, 0xe1, 0xf2, 0x00, 0xf7, 0x15,
+	0xf3, 0xcb, 0x8c, 0xaa, 0x47, 0x8b, 0x99, 0xc3, 0xd2, 0x9e, 0x57, 0xd4, 0x43, 0x4b, 0x5f, 0xa9,
+	0x72, 0x3b, 0x20, 0x42, 0x85, 0x79, 0xc4, 0xe8, 0x7e, 0x4a, 0x3e, 0x27, 0x29, 0xa1, 0x01, 0xc9,
+	0xd1, 0x5b, 0x79, 0x7a, 0xf4, 0x21, 0x40, 0x62, 0x50, 0x52, 0x54, 0xcd, 0xbb, 0x37, 0xde, 0x15,
+	0x65, 0x8c, 0x7e, 0xee, 0x5c, 0x51, 0xa4, 0xaa, 0xa7, 0x0a, 0x21, 0x43, 0xbd, 0xde, 0xf7, 0xaf,
+	0xc0, 0xec, 0x1e, 0x0f, 0xd1, 0x97, 0xb0, 0x98, 0xff, 0x04, 0x34, 0xc6, 0x73, 0x17, 0xfd, 0xda,
+	0xf7, 0xa7, 0xc7, 0xcd, 0x75, 0x6c, 0x9c, 0xfd, 0xf1, 0xef, 0xb7, 0x37, 0xee, 0xa2, 0xa6, 0x3b,
+	0xf1, 0x41, 0x75, 0x03, 0x85, 0x3f, 0x94, 0x9f, 0x8f, 0x33, 0x0b, 0x96, 0x0a, 0xd3, 0xbe, 0x59,
+	0x9d, 0x41, 0x02, 0xec, 0x8d, 0x4b, 0x00, 0x46, 0xc3, 0xa6, 0xd4, 0xd0, 0x42, 0xeb, 0x53, 0x34,
+	0xc8, 0x3d, 0xf4, 0x18, 0x96, 0x0a, 0xc3, 0xba, 0x4c, 0x43, 0x1e, 0x60, 0x6f, 0x5c, 0x02, 0x18,
+	0x6a, 0x40, 0x09, 0x2c, 0x97, 0x4e, 0xd6, 0x32, 0x82, 0x32, 0xa0, 0xed, 0x5e, 0x11, 0x68, 0x32,
+	0x0e, 0x60, 0xb5, 0x6a, 0xe6, 0x6d, 0x55, 0xaa, 0x9e, 0xc0, 0xda, 0xde, 0xd5, 0xb1, 0x26, 0xf5,
+	0x17, 0x50, 0xaf, 0x9c, 0x4f, 0xef, 0x94, 0xf2, 0x95, 0x83, 0xed, 0x9d, 0x17, 0x00, 0x9b, 0xec,
+	0x8f, 0x61, 0x29, 0x57, 0x89, 0x7c, 0x5a, 0x21, 0x49, 0x80, 0xbd, 0x71, 0x09, 0x20, 0x7f, 0xa5,
+	0x55, 0x7d, 0xbd, 0x55, 0xfe, 0x7a, 0xca, 0xb0, 0xb6, 0x77, 0x75, 0xec, 0x30, 0x75, 0xa7, 0xf3,
+	0xf4, 0xbc, 0x61, 0x3d, 0x3b, 0x6f, 0x58, 0xff, 0x9c, 0x37, 0xac, 0x6f, 0x2e, 0x1a, 0x33, 0xcf,
+	0x2e, 0x1a, 0x33, 0x7f, 0x5e, 0x34, 0x66, 0x3e, 0xcb, 0x4f, 0x3f, 0x5d, 0xdf, 0xf2, 0x6f, 0xbf,
+	0xfd, 0xd0, 0x7d, 0xa2, 0x6b, 0x5d, 0xce, 0xc0, 0xee, 0xbc, 0xfc, 0xbd, 0xb7, 0xf3, 0xff, 0x00,
+	0x8b, 0x7d, 0x57, 0x1f, 0xdc, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
+	// that is registered on the token mapping.
+	ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error)
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
+	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetTokenPairBehavior defines a governance operation for setting how the
+	// conversions of a token pair handle non-standard ERC20 tokens.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairBehavior(ctx context.Context, in *MsgSetTokenPairBehavior, opts ...grpc.CallOption) (*MsgSetTokenPairBehaviorResponse, error)
+	// UpdateTokenPairMetadata defines a governance operation for updating the
+	// name and symbol of a module-owned token pair, both in the Cosmos coin
+	// metadata and in the state of its ERC20 contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error)
+	// UpgradeTokenPairContract defines a governance operation for replacing the
+	// code of the ERC20 contract of a module-owned token pair with the code of a
+	// deployed implementation, preserving the contract storage and balances.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpgradeTokenPairContract(ctx context.Context, in *MsgUpgradeTokenPairContract, opts ...grpc.CallOption) (*MsgUpgradeTokenPairContractResponse, error)
+	// ConvertCoins mints the ERC20 representations of multiple native Cosmos
+	// coins that are registered on the token mapping.
+	ConvertCoins(ctx context.Context, in *MsgConvertCoins, opts ...grpc.CallOption) (*MsgConvertCoinsResponse, error)
+	// SetConversionPreference sets how the coins received by the sender over IBC
+	// are converted to their ERC20 representation.
+	SetConversionPreference(ctx context.Context, in *MsgSetConversionPreference, opts ...grpc.CallOption) (*MsgSetConversionPreferenceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error) {
+	out := new(MsgConvertCoinResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
+	out := new(MsgConvertERC20Response)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgConvertCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgConvertERC20) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetTokenPairBehavior(ctx context.Context, in *MsgSetTokenPairBehavior, opts ...grpc.CallOption) (*MsgSetTokenPairBehaviorResponse, error) {
+	out := new(MsgSetTokenPairBehaviorResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/SetTokenPairBehavior", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error) {
+	out := new(MsgUpdateTokenPairMetadataResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpdateTokenPairMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpgradeTokenPairContract(ctx context.Context, in *MsgUpgradeTokenPairContract, opts ...grpc.CallOption) (*MsgUpgradeTokenPairContractResponse, error) {
+	out := new(MsgUpgradeTokenPairContractResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/UpgradeTokenPairContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ConvertCoins(ctx context.Context, in *MsgConvertCoins, opts ...grpc.CallOption) (*MsgConvertCoinsResponse, error) {
+	out := new(MsgConvertCoinsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgConvertERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetConversionPreference(ctx context.Context, in *MsgSetConversionPreference, opts ...grpc.CallOption) (*MsgSetConversionPreferenceResponse, error) {
+	out := new(MsgSetConversionPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/SetConversionPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
+	// that is registered on the token mapping.
+	ConvertCoin(context.Context, *MsgConvertCoin) (*MsgConvertCoinResponse, error)
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetTokenPairBehavior defines a governance operation for setting how the
+	// conversions of a token pair handle non-standard ERC20 tokens.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairBehavior(context.Context, *MsgSetTokenPairBehavior) (*MsgSetTokenPairBehaviorResponse, error)
+	// UpdateTokenPairMetadata defines a governance operation for updating the
+	// name and symbol of a module-owned token pair, both in the Cosmos coin
+	// metadata and in the state of its ERC20 contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(context.Context, *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error)
+	// UpgradeTokenPairContract defines a governance operation for replacing the
+	// code of the ERC20 contract of a module-owned token pair with the code of a
+	// deployed implementation, preserving the contract storage and balances.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpgradeTokenPairContract(context.Context, *MsgUpgradeTokenPairContract) (*MsgUpgradeTokenPairContractResponse, error)
+	// ConvertCoins mints the ERC20 representations of multiple native Cosmos
+	// coins that are registered on the token mapping.
+	ConvertCoins(context.Context, *MsgConvertCoins) (*MsgConvertCoinsResponse, error)
+	// SetConversionPreference sets how the coins received by the sender over IBC
+	// are converted to their ERC20 representation.
+	SetConversionPreference(context.Context, *MsgSetConversionPreference) (*MsgSetConversionPreferenceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertCoin) (*MsgConvertCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoin not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetTokenPairBehavior(ctx context.Context, req *MsgSetTokenPairBehavior) (*MsgSetTokenPairBehaviorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTokenPairBehavior not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTokenPairMetadata(ctx context.Context, req *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenPairMetadata not implemented")
+}
+func (*UnimplementedMsgServer) UpgradeTokenPairContract(ctx context.Context, req *MsgUpgradeTokenPairContract) (*MsgUpgradeTokenPairContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeTokenPairContract not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCoins(ctx context.Context, req *MsgConvertCoins) (*MsgConvertCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoins not implemented")
+}
+func (*UnimplementedMsgServer) SetConversionPreference(ctx context.Context, req *MsgSetConversionPreference) (*MsgSetConversionPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversionPreference not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ConvertCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoin(ctx, in)
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoin(ctx, req.(*MsgConvertCoin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_SetTokenPairBehavior_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTokenPairBehavior)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTokenPairBehavior(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/SetTokenPairBehavior",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTokenPairBehavior(ctx, req.(*MsgSetTokenPairBehavior))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTokenPairMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTokenPairMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/UpdateTokenPairMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, req.(*MsgUpdateTokenPairMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpgradeTokenPairContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpgradeTokenPairContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpgradeTokenPairContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/UpgradeTokenPairContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpgradeTokenPairContract(ctx, req.(*MsgUpgradeTokenPairContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoins(ctx, req.(*MsgConvertCoins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetConversionPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConversionPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConversionPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/SetConversionPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConversionPreference(ctx, req.(*MsgSetConversionPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.erc20.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertCoin",
+			Handler:    _Msg_ConvertCoin_Handler,
+		},
+		{
+			MethodName: "ConvertERC20",
+			Handler:    _Msg_ConvertERC20_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetTokenPairBehavior",
+			Handler:    _Msg_SetTokenPairBehavior_Handler,
+		},
+		{
+			MethodName: "UpdateTokenPairMetadata",
+			Handler:    _Msg_UpdateTokenPairMetadata_Handler,
+		},
+		{
+			MethodName: "UpgradeTokenPairContract",
+			Handler:    _Msg_UpgradeTokenPairContract_Handler,
+		},
+		{
+			MethodName: "ConvertCoins",
+			Handler:    _Msg_ConvertCoins_Handler,
+		},
+		{
+			MethodName: "SetConversionPreference",
+			Handler:    _Msg_SetConversionPreference_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/erc20/v1/tx.proto",
+}
+
+func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTokenPairBehavior) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenPairBehavior) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenPairBehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Behavior != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Behavior))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTokenPairBehaviorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTokenPairBehaviorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTokenPairBehaviorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTokenPairMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTokenPairMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTokenPairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgradeTokenPairContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradeTokenPairContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradeTokenPairContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Implementation) > 0 {
+		i -= len(m.Implementation)
+		copy(dAtA[i:], m.Implementation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Implementation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgradeTokenPairContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradeTokenPairContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradeTokenPairContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConversionPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConversionPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConversionPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Preference != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Preference))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConversionPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConversionPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConversionPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTokenPairBehavior) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Behavior != 0 {
+		n += 1 + sovTx(uint64(m.Behavior))
+	}
+	return n
+}
+
+func (m *MsgSetTokenPairBehaviorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTokenPairMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTokenPairMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpgradeTokenPairContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Implementation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpgradeTokenPairContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConversionPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Preference != 0 {
+		n += 1 + sovTx(uint64(m.Preference))
+	}
+	return n
+}
+
+func (m *MsgSetConversionPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTokenPairBehavior) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTokenPairBehavior: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTokenPairBehavior: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behavior", wireType)
+			}
+			m.Behavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behavior |= TokenBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTokenPairBehaviorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTokenPairBehaviorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTokenPairBehaviorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTokenPairMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -884,17 +2907,17 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -904,28 +2927,27 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -953,11 +2975,11 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -985,7 +3007,39 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1008,7 +3062,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTokenPairMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,10 +3085,10 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTokenPairMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1058,7 +3112,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgUpgradeTokenPairContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1081,15 +3135,15 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpgradeTokenPairContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpgradeTokenPairContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1117,11 +3171,11 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1149,11 +3203,175 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Implementation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Implementation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgradeTokenPairContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgradeTokenPairContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgradeTokenPairContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
@@ -1185,7 +3403,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -1238,7 +3456,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoinsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +3479,10 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCoinsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1288,7 +3506,7 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConversionPreference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1311,15 +3529,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConversionPreference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConversionPreference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1347,13 +3565,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preference", wireType)
 			}
-			var msglen int
+			m.Preference = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1363,25 +3581,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Preference |= ConversionPreference(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1403,7 +3607,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConversionPreferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1426,10 +3630,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConversionPreferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConversionPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10,6 +10,7 @@ import (
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
 const (
@@ -86,6 +87,60 @@ func EqualStringSlice(aliasesA, aliasesB []string) bool {
 	return true
 }
 
+// NewIBCCoinMetadata derives the bank metadata of an IBC voucher from its denom
+// trace. As for the ERC20 precompiles without metadata, the decimals are inferred
+// from the first character of the base denomination, i.e. micro (u) -> 6 and
+// atto (a) -> 18, and the symbol is the remaining part in uppercase (e.g.
+// uatom -> ATOM). Other base denominations have a single denom unit.
+func NewIBCCoinMetadata(trace transfertypes.DenomTrace) (banktypes.Metadata, error) {
+	denom := trace.IBCDenom()
+	baseDenom := trace.BaseDenom
+
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("IBC voucher of %s received through %s", baseDenom, trace.Path),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+				Aliases:  []string{baseDenom},
+			},
+		},
+		Base:   denom,
+		Name:   baseDenom,
+		Symbol: strings.ToUpper(baseDenom),
+	}
+
+	var exponent uint32
+	if len(baseDenom) > 3 {
+		switch baseDenom[0] {
+		case 'u':
+			exponent = 6
+		case 'a':
+			exponent = 18
+		}
+	}
+
+	if exponent != 0 {
+		display := baseDenom[1:]
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: exponent,
+		})
+		metadata.Display = display
+		metadata.Name = strings.ToUpper(display[:1]) + display[1:]
+		metadata.Symbol = strings.ToUpper(display)
+	} else {
+		// the display denom must be one of the denom units
+		metadata.Display = denom
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, err
+	}
+
+	return metadata, nil
+}
+
 // IsModuleAccount returns true if the given account is a module account
 func IsModuleAccount(acc authtypes.AccountI) bool {
 	_, isModuleAccount := acc.(authtypes.ModuleAccountI)
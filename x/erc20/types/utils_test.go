@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/evmos/evmos/v16/x/erc20/types"
 	"github.com/stretchr/testify/require"
 )
@@ -248,3 +249,40 @@ func TestEqualAliases(t *testing.T) {
 		require.Equal(t, tc.expEqual, types.EqualStringSlice(tc.aliasesA, tc.aliasesB), tc.name)
 	}
 }
+
+func TestNewIBCCoinMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		baseDenom  string
+		expDisplay string
+		expName    string
+		expSymbol  string
+		expUnits   int
+		expPass    bool
+	}{
+		{"micro base denomination", "uatom", "atom", "Atom", "ATOM", 2, true},
+		{"atto base denomination", "aevmos", "evmos", "Evmos", "EVMOS", 2, true},
+		{"other base denomination", "gravity0xdac17f958d2ee523a2206206994597c13d831ec7", "", "gravity0xdac17f958d2ee523a2206206994597c13d831ec7", "GRAVITY0XDAC17F958D2EE523A2206206994597C13D831EC7", 1, true},
+		{"short micro base denomination", "uxy", "", "uxy", "UXY", 1, true},
+		{"empty base denomination", "", "", "", "", 0, false},
+	}
+
+	for _, tc := range testCases {
+		trace := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: tc.baseDenom}
+		metadata, err := types.NewIBCCoinMetadata(trace)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, trace.IBCDenom(), metadata.Base, tc.name)
+		if tc.expDisplay == "" {
+			tc.expDisplay = trace.IBCDenom()
+		}
+		require.Equal(t, tc.expDisplay, metadata.Display, tc.name)
+		require.Equal(t, tc.expName, metadata.Name, tc.name)
+		require.Equal(t, tc.expSymbol, metadata.Symbol, tc.name)
+		require.Len(t, metadata.DenomUnits, tc.expUnits, tc.name)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+const (
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagMemo                   = "memo"
+)
+
+// defaultPacketTimeoutTimestamp is the default packet timeout timestamp
+// relative to the local clock (10 minutes)
+var defaultPacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+// NewTxCmd returns a root CLI command handler for erc721 transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "erc721 subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTransferCmd(),
+	)
+	return txCmd
+}
+
+// NewTransferCmd returns a CLI command handler for transferring non-fungible
+// tokens over IBC
+func NewTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer SRC_PORT SRC_CHANNEL RECEIVER CLASS TOKEN_IDS",
+		Short: "Transfer non-fungible tokens of a registered class pair through IBC",
+		Long: strings.TrimSpace(`Transfer non-fungible tokens of a registered class pair through IBC.
+The class is either the hex address of the ERC721 contract or the class id. The token ids are separated by commas.
+The timeout height is absolute and is set in the form {revision}-{height}, while the timeout timestamp is added to
+the local clock time. Any timeout set to 0 is disabled.`),
+		Example: fmt.Sprintf("%s tx %s transfer %s channel-0 stars1... 0x... 1,2,3", version.AppName, types.ModuleName, types.PortID),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransfer(
+				args[0], args[1], args[3], strings.Split(args[4], ","),
+				clientCtx.GetFromAddress(), args[2],
+				timeoutHeight, timeoutTimestamp, memo,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Absolute packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultPacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc721
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/erc721/keeper"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	// only try to bind to port if it is not already bound, since we may already
+	// own port capability from capability InitGenesis
+	if !k.IsBound(ctx, types.PortID) {
+		// erc721 module binds to the nft-transfer port on InitChain
+		// and claims the returned capability
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	for _, pair := range data.ClassPairs {
+		id := pair.GetID()
+		k.SetClassPair(ctx, pair)
+		k.SetClassMap(ctx, pair.ClassId, id)
+		k.SetERC721Map(ctx, pair.GetERC721Contract(), id)
+	}
+
+	for _, trace := range data.ClassTraces {
+		k.SetClassTrace(ctx, trace)
+	}
+
+	for _, class := range data.Classes {
+		k.SetClass(ctx, class)
+	}
+
+	for _, nft := range data.Nfts {
+		if err := k.MintNFT(ctx, nft); err != nil {
+			panic(fmt.Errorf("error minting nft %s: %s", nft.Id, err))
+		}
+	}
+
+	if err := k.RegisterClassPrecompiles(ctx); err != nil {
+		panic(fmt.Errorf("error registering class precompiles %s", err))
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		ClassPairs:  k.GetClassPairs(ctx),
+		ClassTraces: k.GetAllClassTraces(ctx),
+		Classes:     k.GetAllClasses(ctx),
+		Nfts:        k.GetAllNFTs(ctx),
+	}
+}
@@ -0,0 +1,147 @@
+package erc721_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/exp/slices"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/utils"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/x/erc721"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState(), chainID)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: chainID,
+		Time:    time.Now().UTC(),
+	})
+}
+
+// customGenesis returns a genesis state with a class received over IBC and an
+// ERC721 contract registered.
+func customGenesis() types.GenesisState {
+	trace := types.ParseClassTrace("nft-transfer/channel-0/punks")
+	voucherAddress, err := utils.GetIBCDenomAddress(trace.IBCClassID())
+	if err != nil {
+		panic(err)
+	}
+
+	contract := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+
+	return types.NewGenesisState(
+		types.DefaultParams(),
+		[]types.ClassPair{
+			types.NewClassPair(voucherAddress, trace.IBCClassID(), types.OWNER_MODULE),
+			types.NewClassPair(contract, types.CreateERC721ClassID(contract), types.OWNER_EXTERNAL),
+		},
+		[]types.ClassTrace{trace},
+		[]types.Class{
+			{Erc721Address: voucherAddress.String(), Uri: "ipfs://punks", Name: "Punks", Symbol: "PUNK"},
+		},
+		[]types.NFT{
+			{Erc721Address: voucherAddress.String(), Id: "1", Uri: "ipfs://1", Owner: owner.String()},
+			{Erc721Address: voucherAddress.String(), Id: "alien", Owner: owner.String()},
+		},
+	)
+}
+
+func (suite *GenesisTestSuite) TestERC721InitGenesis() {
+	testCases := []struct {
+		name         string
+		genesisState types.GenesisState
+	}{
+		{
+			"default genesis",
+			*types.DefaultGenesisState(),
+		},
+		{
+			"custom genesis",
+			customGenesis(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.Require().NotPanics(func() {
+				erc721.InitGenesis(suite.ctx, suite.app.Erc721Keeper, tc.genesisState)
+			})
+
+			suite.Require().Equal(tc.genesisState.Params, suite.app.Erc721Keeper.GetParams(suite.ctx))
+			suite.Require().ElementsMatch(tc.genesisState.ClassPairs, suite.app.Erc721Keeper.GetClassPairs(suite.ctx))
+
+			for _, nft := range tc.genesisState.Nfts {
+				stored, found := suite.app.Erc721Keeper.GetNFT(suite.ctx, nft.GetERC721Contract(), nft.GetTokenID())
+				suite.Require().True(found)
+				suite.Require().Equal(nft, stored)
+			}
+
+			// the precompiles of the classes received over IBC are activated in the EVM
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			for _, pair := range tc.genesisState.ClassPairs {
+				if !pair.IsNativeClass() {
+					continue
+				}
+				precompile, found := suite.app.Erc721Keeper.GetDynamicPrecompile(suite.ctx, pair.GetERC721Contract())
+				suite.Require().True(found)
+				suite.Require().Equal(pair.GetERC721Contract(), precompile.Address())
+				suite.Require().True(slices.Contains(params.ActivePrecompiles, pair.Erc721Address))
+			}
+		})
+	}
+}
+
+func (suite *GenesisTestSuite) TestERC721ExportGenesis() {
+	testCases := []struct {
+		name         string
+		genesisState types.GenesisState
+	}{
+		{
+			"default genesis",
+			*types.DefaultGenesisState(),
+		},
+		{
+			"custom genesis",
+			customGenesis(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			erc721.InitGenesis(suite.ctx, suite.app.Erc721Keeper, tc.genesisState)
+			genesisExported := erc721.ExportGenesis(suite.ctx, suite.app.Erc721Keeper)
+
+			suite.Require().Equal(tc.genesisState.Params, genesisExported.Params)
+			suite.Require().ElementsMatch(tc.genesisState.ClassPairs, genesisExported.ClassPairs)
+			suite.Require().ElementsMatch(tc.genesisState.ClassTraces, genesisExported.ClassTraces)
+			suite.Require().ElementsMatch(tc.genesisState.Classes, genesisExported.Classes)
+			suite.Require().ElementsMatch(tc.genesisState.Nfts, genesisExported.Nfts)
+		})
+	}
+}
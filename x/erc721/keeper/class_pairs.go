@@ -0,0 +1,112 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+// GetClassPairs gets all registered class pairs.
+func (k Keeper) GetClassPairs(ctx sdk.Context) []types.ClassPair {
+	classPairs := []types.ClassPair{}
+
+	k.IterateClassPairs(ctx, func(classPair types.ClassPair) (stop bool) {
+		classPairs = append(classPairs, classPair)
+		return false
+	})
+
+	return classPairs
+}
+
+// IterateClassPairs iterates over all the stored class pairs.
+func (k Keeper) IterateClassPairs(ctx sdk.Context, cb func(classPair types.ClassPair) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixClassPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var classPair types.ClassPair
+		k.cdc.MustUnmarshal(iterator.Value(), &classPair)
+
+		if cb(classPair) {
+			break
+		}
+	}
+}
+
+// GetClassPairID returns the pair id from either of the registered tokens.
+// Hex address or class id can be used as token argument.
+func (k Keeper) GetClassPairID(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		addr := common.HexToAddress(token)
+		return k.GetERC721Map(ctx, addr)
+	}
+	return k.GetClassMap(ctx, token)
+}
+
+// GetClassPair gets a registered class pair from the identifier.
+func (k Keeper) GetClassPair(ctx sdk.Context, id []byte) (types.ClassPair, bool) {
+	if id == nil {
+		return types.ClassPair{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPair)
+	var classPair types.ClassPair
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.ClassPair{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &classPair)
+	return classPair, true
+}
+
+// SetClassPair stores a class pair.
+func (k Keeper) SetClassPair(ctx sdk.Context, classPair types.ClassPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPair)
+	key := classPair.GetID()
+	bz := k.cdc.MustMarshal(&classPair)
+	store.Set(key, bz)
+}
+
+// GetERC721Map returns the class pair id for the given address.
+func (k Keeper) GetERC721Map(ctx sdk.Context, erc721 common.Address) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPairByERC721)
+	return store.Get(erc721.Bytes())
+}
+
+// GetClassMap returns the class pair id for the given class id.
+func (k Keeper) GetClassMap(ctx sdk.Context, classID string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPairByClass)
+	return store.Get([]byte(classID))
+}
+
+// SetERC721Map sets the class pair id for the given address.
+func (k Keeper) SetERC721Map(ctx sdk.Context, erc721 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPairByERC721)
+	store.Set(erc721.Bytes(), id)
+}
+
+// SetClassMap sets the class pair id for the class id.
+func (k Keeper) SetClassMap(ctx sdk.Context, classID string, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPairByClass)
+	store.Set([]byte(classID), id)
+}
+
+// IsERC721Registered checks if the ERC721 contract is registered.
+func (k Keeper) IsERC721Registered(ctx sdk.Context, erc721 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPairByERC721)
+	return store.Has(erc721.Bytes())
+}
+
+// IsClassRegistered checks if the class id is registered.
+func (k Keeper) IsClassRegistered(ctx sdk.Context, classID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPairByClass)
+	return store.Has([]byte(classID))
+}
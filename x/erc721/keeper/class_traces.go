@@ -0,0 +1,75 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+// GetClassTrace retrieves the full identifiers trace and base class id from the
+// store.
+func (k Keeper) GetClassTrace(ctx sdk.Context, traceHash tmbytes.HexBytes) (types.ClassTrace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassTrace)
+	bz := store.Get(traceHash)
+	if len(bz) == 0 {
+		return types.ClassTrace{}, false
+	}
+
+	var classTrace types.ClassTrace
+	k.cdc.MustUnmarshal(bz, &classTrace)
+	return classTrace, true
+}
+
+// SetClassTrace sets a new {trace hash -> class trace} pair to the store.
+func (k Keeper) SetClassTrace(ctx sdk.Context, classTrace types.ClassTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassTrace)
+	bz := k.cdc.MustMarshal(&classTrace)
+	store.Set(classTrace.Hash(), bz)
+}
+
+// GetAllClassTraces returns the trace information for all the classes received
+// over IBC.
+func (k Keeper) GetAllClassTraces(ctx sdk.Context) []types.ClassTrace {
+	traces := []types.ClassTrace{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixClassTrace)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var classTrace types.ClassTrace
+		k.cdc.MustUnmarshal(iterator.Value(), &classTrace)
+		traces = append(traces, classTrace)
+	}
+
+	return traces
+}
+
+// ClassPathFromID returns the full class path of a class id. The identifiers of
+// the classes received over IBC are resolved from their trace hash.
+func (k Keeper) ClassPathFromID(ctx sdk.Context, classID string) (string, error) {
+	if !strings.HasPrefix(classID, "ibc/") {
+		return classID, nil
+	}
+
+	hash, err := types.ParseClassTraceHash(classID)
+	if err != nil {
+		return "", err
+	}
+
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrTraceNotFound, "class trace not found for %s", classID)
+	}
+
+	return classTrace.GetFullClassPath(), nil
+}
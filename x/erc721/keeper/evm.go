@@ -0,0 +1,191 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v16/server/config"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+// QueryERC721 returns the name and symbol of a deployed ERC721 contract
+func (k Keeper) QueryERC721(
+	ctx sdk.Context,
+	contract common.Address,
+) (name, symbol string, err error) {
+	name, err = k.queryString(ctx, contract, "name")
+	if err != nil {
+		return "", "", err
+	}
+
+	symbol, err = k.queryString(ctx, contract, "symbol")
+	if err != nil {
+		return "", "", err
+	}
+
+	return name, symbol, nil
+}
+
+// OwnerOf queries the owner of a token for a given ERC721 contract
+func (k Keeper) OwnerOf(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) (common.Address, error) {
+	res, err := k.CallEVM(ctx, types.ModuleAddress, contract, false, "ownerOf", tokenID)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	unpacked, err := k.erc721ABI.Unpack("ownerOf", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return common.Address{}, errorsmod.Wrapf(types.ErrABIUnpack, "failed to unpack owner: %v", err)
+	}
+
+	owner, ok := unpacked[0].(common.Address)
+	if !ok {
+		return common.Address{}, errorsmod.Wrapf(types.ErrABIUnpack, "invalid owner type %T", unpacked[0])
+	}
+
+	return owner, nil
+}
+
+// TokenURI queries the URI of a token for a given ERC721 contract. The
+// metadata extension is optional, so an empty string is returned if the
+// contract does not implement it.
+func (k Keeper) TokenURI(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) string {
+	uri, err := k.queryString(ctx, contract, "tokenURI", tokenID)
+	if err != nil {
+		return ""
+	}
+	return uri
+}
+
+// queryString calls a view method of an ERC721 contract that returns a single
+// string
+func (k Keeper) queryString(
+	ctx sdk.Context,
+	contract common.Address,
+	method string,
+	args ...interface{},
+) (string, error) {
+	res, err := k.CallEVM(ctx, types.ModuleAddress, contract, false, method, args...)
+	if err != nil {
+		return "", err
+	}
+
+	unpacked, err := k.erc721ABI.Unpack(method, res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return "", errorsmod.Wrapf(types.ErrABIUnpack, "failed to unpack %s: %v", method, err)
+	}
+
+	value, ok := unpacked[0].(string)
+	if !ok {
+		return "", errorsmod.Wrapf(types.ErrABIUnpack, "invalid %s type %T", method, unpacked[0])
+	}
+
+	return value, nil
+}
+
+// CallEVM performs a smart contract method call using given args
+func (k Keeper) CallEVM(
+	ctx sdk.Context,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	data, err := k.erc721ABI.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			types.ErrABIPack,
+			errorsmod.Wrap(err, "failed to create transaction data").Error(),
+		)
+	}
+
+	resp, err := k.CallEVMWithData(ctx, from, &contract, data, commit)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "contract call failed: method '%s', contract '%s'", method, contract)
+	}
+	return resp, nil
+}
+
+// CallEVMWithData performs a smart contract method call using contract data.
+// The nonce is read from the EVM state so that calls can be performed on
+// behalf of escrow addresses that don't have an account.
+func (k Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	commit bool,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	nonce := uint64(0)
+	if account := k.evmKeeper.GetAccountWithoutBalance(ctx, from); account != nil {
+		nonce = account.Nonce
+	}
+
+	gasCap := config.DefaultGasCap
+	if commit {
+		args, err := json.Marshal(evmtypes.TransactionArgs{
+			From: &from,
+			To:   contract,
+			Data: (*hexutil.Bytes)(&data),
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(errortypes.ErrJSONMarshal, "failed to marshal tx args: %s", err.Error())
+		}
+
+		// NOTE: the internal gas estimation runs on the given context, so a cache
+		// context is used to discard the state changes of the ERC721 precompiles
+		cacheCtx, _ := ctx.CacheContext()
+		gasRes, err := k.evmKeeper.EstimateGasInternal(sdk.WrapSDKContext(cacheCtx), &evmtypes.EthCallRequest{
+			Args:   args,
+			GasCap: config.DefaultGasCap,
+		}, evmtypes.Internal)
+		if err != nil {
+			return nil, err
+		}
+		gasCap = gasRes.Gas
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		contract,
+		nonce,
+		big.NewInt(0), // amount
+		gasCap,        // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		!commit,               // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), commit)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	return res, nil
+}
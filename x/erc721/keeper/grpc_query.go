@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	evmostypes "github.com/evmos/evmos/v16/types"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassPairs returns all registered class pairs
+func (k Keeper) ClassPairs(c context.Context, req *types.QueryClassPairsRequest) (*types.QueryClassPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pairs []types.ClassPair
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassPair)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.ClassPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryClassPairsResponse{
+		ClassPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ClassPair returns a given registered class pair
+func (k Keeper) ClassPair(c context.Context, req *types.QueryClassPairRequest) (*types.QueryClassPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid
+	// class id
+	if err := evmostypes.ValidateAddress(req.Token); err != nil {
+		if err := types.ValidateClassID(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') or class id", req.Token,
+			)
+		}
+	}
+
+	id := k.GetClassPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "class pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetClassPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "class pair with token '%s'", req.Token)
+	}
+
+	return &types.QueryClassPairResponse{ClassPair: pair}, nil
+}
+
+// Params returns the params of the erc721 module
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
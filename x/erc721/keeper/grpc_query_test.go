@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestClassPairs() {
+	var (
+		req    *types.QueryClassPairsRequest
+		expRes *types.QueryClassPairsResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"no pagination",
+			func() {
+				req = &types.QueryClassPairsRequest{}
+				expRes = &types.QueryClassPairsResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"1 pair registered w/pagination",
+			func() {
+				req = &types.QueryClassPairsRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				pair := types.NewClassPair(tx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.app.Erc721Keeper.SetClassPair(suite.ctx, pair)
+
+				expRes = &types.QueryClassPairsResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					ClassPairs: []types.ClassPair{pair},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx := suite.ctx.Context()
+
+			tc.malleate()
+			res, err := suite.queryClient.ClassPairs(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().ElementsMatch(expRes.ClassPairs, res.ClassPairs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClassPair() {
+	var (
+		req    *types.QueryClassPairRequest
+		expRes *types.QueryClassPairResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QueryClassPairRequest{}
+				expRes = &types.QueryClassPairResponse{}
+			},
+			false,
+		},
+		{
+			"class pair not found",
+			func() {
+				req = &types.QueryClassPairRequest{Token: tx.GenerateAddress().Hex()}
+				expRes = &types.QueryClassPairResponse{}
+			},
+			false,
+		},
+		{
+			"class pair found by address",
+			func() {
+				pair := suite.receiveClass(sourceChannel, "punks", suite.address, "1")
+				req = &types.QueryClassPairRequest{Token: pair.Erc721Address}
+				expRes = &types.QueryClassPairResponse{ClassPair: pair}
+			},
+			true,
+		},
+		{
+			"class pair found by class id",
+			func() {
+				pair := suite.receiveClass(sourceChannel, "punks", suite.address, "1")
+				req = &types.QueryClassPairRequest{Token: pair.ClassId}
+				expRes = &types.QueryClassPairResponse{ClassPair: pair}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx := suite.ctx.Context()
+
+			tc.malleate()
+			res, err := suite.queryClient.ClassPair(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.ctx.Context()
+	expParams := types.DefaultParams()
+
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, res.Params)
+}
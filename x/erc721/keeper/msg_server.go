@@ -0,0 +1,75 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// Transfer defines a rpc handler method for MsgTransfer. It sends the tokens
+// of a registered class pair to another chain over IBC.
+func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Sender))
+
+	sequence, err := k.SendTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassId, msg.TokenIds,
+		sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC non-fungible token transfer", "class", msg.ClassId, "token_ids", msg.TokenIds, "sender", msg.Sender, "receiver", msg.Receiver)
+
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+// RegisterERC721 implements the gRPC MsgServer interface. After a successful governance vote
+// it registers the class pairs of the given ERC721 contracts only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) RegisterERC721(goCtx context.Context, req *types.MsgRegisterERC721) (*types.MsgRegisterERC721Response, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pairs := make([]types.ClassPair, 0, len(req.Erc721Addresses))
+	for _, address := range req.Erc721Addresses {
+		pair, err := k.registerERC721(ctx, common.HexToAddress(address))
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, *pair)
+	}
+
+	return &types.MsgRegisterERC721Response{ClassPairs: pairs}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterERC721() {
+	var msg *types.MsgRegisterERC721
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid authority",
+			func() {
+				msg.Authority = sdk.AccAddress(tx.GenerateAddress().Bytes()).String()
+			},
+			false,
+		},
+		{
+			"fail - not a contract",
+			func() {
+				msg.Erc721Addresses = []string{tx.GenerateAddress().String()}
+			},
+			false,
+		},
+		{
+			"fail - precompile address",
+			func() {
+				pair := suite.receiveClass(sourceChannel, "punks", suite.address, "1")
+				msg.Erc721Addresses = []string{pair.Erc721Address}
+			},
+			false,
+		},
+		{
+			"fail - contract already registered",
+			func() {
+				_, err := suite.app.Erc721Keeper.RegisterERC721(suite.ctx, msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"pass - contract registered",
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			contract := suite.deployMetadataContract("Punks", "PUNK")
+			msg = &types.MsgRegisterERC721{
+				Authority:       authority,
+				Erc721Addresses: []string{contract.String()},
+			}
+
+			tc.malleate()
+
+			res, err := suite.app.Erc721Keeper.RegisterERC721(suite.ctx, msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			expPair := types.NewClassPair(contract, types.CreateERC721ClassID(contract), types.OWNER_EXTERNAL)
+			suite.Require().Equal([]types.ClassPair{expPair}, res.ClassPairs)
+
+			id := suite.app.Erc721Keeper.GetClassPairID(suite.ctx, expPair.ClassId)
+			pair, found := suite.app.Erc721Keeper.GetClassPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(expPair, pair)
+			suite.Require().True(suite.app.Erc721Keeper.IsERC721Registered(suite.ctx, contract))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name    string
+		request *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "foobar"},
+			false,
+		},
+		{
+			"pass - valid Update msg",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.Params{EnableErc721: false},
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, err := suite.app.Erc721Keeper.UpdateParams(suite.ctx, tc.request)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params, suite.app.Erc721Keeper.GetParams(suite.ctx))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransfer() {
+	pair := suite.receiveClass(sourceChannel, "punks", suite.address, "1")
+
+	msg := types.NewMsgTransfer(
+		types.PortID, sourceChannel, pair.ClassId, []string{"1"},
+		sdk.AccAddress(suite.address.Bytes()), "receiver", timeoutHeight, 0, "",
+	)
+
+	res, err := suite.app.Erc721Keeper.Transfer(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Sequence)
+
+	_, found := suite.ownerOf(pair, "1")
+	suite.Require().False(found)
+}
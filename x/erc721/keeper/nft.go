@@ -0,0 +1,207 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc721precompile "github.com/evmos/evmos/v16/precompiles/erc721"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+var _ erc721precompile.ClassKeeper = Keeper{}
+
+// GetClass returns the metadata of a class received over IBC.
+func (k Keeper) GetClass(ctx sdk.Context, contract common.Address) (types.Class, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClass)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Class{}, false
+	}
+
+	var class types.Class
+	k.cdc.MustUnmarshal(bz, &class)
+	return class, true
+}
+
+// SetClass stores the metadata of a class received over IBC.
+func (k Keeper) SetClass(ctx sdk.Context, class types.Class) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClass)
+	bz := k.cdc.MustMarshal(&class)
+	store.Set(class.GetERC721Contract().Bytes(), bz)
+}
+
+// GetAllClasses returns the metadata of all the classes received over IBC.
+func (k Keeper) GetAllClasses(ctx sdk.Context) []types.Class {
+	classes := []types.Class{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixClass)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshal(iterator.Value(), &class)
+		classes = append(classes, class)
+	}
+
+	return classes
+}
+
+// GetNFT returns a token of a class received over IBC.
+func (k Keeper) GetNFT(ctx sdk.Context, contract common.Address, tokenID *big.Int) (types.NFT, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFT)
+	bz := store.Get(types.NFTKey(contract, common.BigToHash(tokenID)))
+	if len(bz) == 0 {
+		return types.NFT{}, false
+	}
+
+	var nft types.NFT
+	k.cdc.MustUnmarshal(bz, &nft)
+	return nft, true
+}
+
+// GetAllNFTs returns the tokens of all the classes received over IBC.
+func (k Keeper) GetAllNFTs(ctx sdk.Context) []types.NFT {
+	nfts := []types.NFT{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixNFT)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshal(iterator.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+
+	return nfts
+}
+
+// MintNFT stores a new token of a class received over IBC and increases the
+// token count of its owner.
+func (k Keeper) MintNFT(ctx sdk.Context, nft types.NFT) error {
+	contract := nft.GetERC721Contract()
+	tokenID := nft.GetTokenID()
+
+	if _, found := k.GetNFT(ctx, contract, tokenID); found {
+		return errorsmod.Wrapf(types.ErrInvalidTokenID, "token %s of class %s already exists", nft.Id, contract)
+	}
+
+	k.setNFT(ctx, nft)
+
+	owner := nft.GetOwnerAddress()
+	k.setNFTBalance(ctx, contract, owner, k.GetNFTBalance(ctx, contract, owner)+1)
+	return nil
+}
+
+// BurnNFT removes a token of a class received over IBC and decreases the token
+// count of its owner.
+func (k Keeper) BurnNFT(ctx sdk.Context, contract common.Address, tokenID *big.Int) error {
+	nft, found := k.GetNFT(ctx, contract, tokenID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNFTNotFound, "token %s of class %s", tokenID, contract)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFT)
+	store.Delete(types.NFTKey(contract, common.BigToHash(tokenID)))
+	k.SetApproved(ctx, contract, tokenID, common.Address{})
+
+	owner := nft.GetOwnerAddress()
+	k.setNFTBalance(ctx, contract, owner, k.GetNFTBalance(ctx, contract, owner)-1)
+	return nil
+}
+
+// TransferNFT changes the owner of a token of a class received over IBC and
+// clears its approval.
+func (k Keeper) TransferNFT(ctx sdk.Context, contract common.Address, tokenID *big.Int, to common.Address) error {
+	nft, found := k.GetNFT(ctx, contract, tokenID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNFTNotFound, "token %s of class %s", tokenID, contract)
+	}
+
+	from := nft.GetOwnerAddress()
+	nft.Owner = to.String()
+	k.setNFT(ctx, nft)
+	k.SetApproved(ctx, contract, tokenID, common.Address{})
+
+	k.setNFTBalance(ctx, contract, from, k.GetNFTBalance(ctx, contract, from)-1)
+	k.setNFTBalance(ctx, contract, to, k.GetNFTBalance(ctx, contract, to)+1)
+	return nil
+}
+
+// GetNFTBalance returns the number of tokens of a class received over IBC owned
+// by the given address.
+func (k Keeper) GetNFTBalance(ctx sdk.Context, contract, owner common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBalance)
+	bz := store.Get(types.BalanceKey(contract, owner))
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetApproved returns the address approved to transfer a token of a class
+// received over IBC.
+func (k Keeper) GetApproved(ctx sdk.Context, contract common.Address, tokenID *big.Int) common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixApproval)
+	return common.BytesToAddress(store.Get(types.NFTKey(contract, common.BigToHash(tokenID))))
+}
+
+// SetApproved sets the address approved to transfer a token of a class received
+// over IBC. The approval is removed if the address is the zero address.
+func (k Keeper) SetApproved(ctx sdk.Context, contract common.Address, tokenID *big.Int, approved common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixApproval)
+	key := types.NFTKey(contract, common.BigToHash(tokenID))
+	if approved == (common.Address{}) {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, approved.Bytes())
+}
+
+// IsApprovedForAll returns true if the operator is allowed to manage all the
+// tokens of the owner for a class received over IBC.
+func (k Keeper) IsApprovedForAll(ctx sdk.Context, contract, owner, operator common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorApproval)
+	return store.Has(types.OperatorApprovalKey(contract, owner, operator))
+}
+
+// SetApprovalForAll approves or removes an operator of all the tokens of the
+// owner for a class received over IBC.
+func (k Keeper) SetApprovalForAll(ctx sdk.Context, contract, owner, operator common.Address, approved bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorApproval)
+	key := types.OperatorApprovalKey(contract, owner, operator)
+	if !approved {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte{1})
+}
+
+// setNFT stores a token of a class received over IBC.
+func (k Keeper) setNFT(ctx sdk.Context, nft types.NFT) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFT)
+	bz := k.cdc.MustMarshal(&nft)
+	store.Set(types.NFTKey(nft.GetERC721Contract(), common.BigToHash(nft.GetTokenID())), bz)
+}
+
+// setNFTBalance sets the token count of an owner. The entry is removed when the
+// count is zero.
+func (k Keeper) setNFTBalance(ctx sdk.Context, contract, owner common.Address, balance uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBalance)
+	key := types.BalanceKey(contract, owner)
+	if balance == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(balance))
+}
@@ -0,0 +1,181 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	erc721precompile "github.com/evmos/evmos/v16/precompiles/erc721"
+	"github.com/evmos/evmos/v16/utils"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+// registerERC721 registers the class pair between an ERC721 contract deployed
+// on the EVM and its ICS-721 class, so that its tokens can be transferred over
+// IBC.
+func (k Keeper) registerERC721(
+	ctx sdk.Context,
+	contract common.Address,
+) (*types.ClassPair, error) {
+	if k.IsERC721Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrClassPairAlreadyExists, "ERC721 contract already registered: %s", contract,
+		)
+	}
+
+	if k.evmKeeper.IsAvailablePrecompile(contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrClassPairAlreadyExists, "address is a precompile: %s", contract,
+		)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, errorsmod.Wrapf(
+			types.ErrClassPairNotFound, "ERC721 contract not found: %s", contract,
+		)
+	}
+
+	// the metadata of the ERC721 is propagated in the class data of the packets
+	if _, _, err := k.QueryERC721(ctx, contract); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to query ERC721 metadata of %s", contract)
+	}
+
+	pair := types.NewClassPair(contract, types.CreateERC721ClassID(contract), types.OWNER_EXTERNAL)
+	k.setClassPairWithMaps(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC721,
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+		),
+	)
+
+	return &pair, nil
+}
+
+// registerClass creates the class pair of a class received over IBC and adds
+// its ERC721 precompile to the EVM. The precompile address is derived from the
+// hash of the class trace.
+func (k Keeper) registerClass(
+	ctx sdk.Context,
+	classTrace types.ClassTrace,
+	classURI, classData string,
+) (types.ClassPair, error) {
+	classID := classTrace.IBCClassID()
+
+	address, err := utils.GetIBCDenomAddress(classID)
+	if err != nil {
+		return types.ClassPair{}, err
+	}
+
+	if k.IsERC721Registered(ctx, address) {
+		return types.ClassPair{}, errorsmod.Wrapf(
+			types.ErrClassPairAlreadyExists, "precompile address already in use: %s", address,
+		)
+	}
+
+	pair := types.NewClassPair(address, classID, types.OWNER_MODULE)
+
+	if err := k.activateClassPrecompile(ctx, pair); err != nil {
+		return types.ClassPair{}, err
+	}
+
+	k.SetClassTrace(ctx, classTrace)
+	k.setClassPairWithMaps(ctx, pair)
+
+	// fall back to the base class id when the class data doesn't contain the
+	// ERC721 metadata
+	name, symbol := classTrace.BaseClassId, ""
+	if metadata, ok := types.DecodeClassData(classData); ok {
+		name, symbol = metadata.Name, metadata.Symbol
+	}
+
+	k.SetClass(ctx, types.Class{
+		Erc721Address: pair.Erc721Address,
+		Uri:           classURI,
+		Data:          classData,
+		Name:          name,
+		Symbol:        symbol,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterClass,
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			sdk.NewAttribute(types.AttributeKeyTraceHash, classTrace.Hash().String()),
+		),
+	)
+
+	return pair, nil
+}
+
+// activateClassPrecompile adds the address of the ERC721 precompile of a class
+// received over IBC to the EVM active precompiles. The precompile itself is
+// instantiated from the class pair when it's called, see GetDynamicPrecompile.
+func (k Keeper) activateClassPrecompile(ctx sdk.Context, pair types.ClassPair) error {
+	address := pair.GetERC721Contract()
+
+	if k.evmKeeper.IsAvailablePrecompile(address) ||
+		k.evmKeeper.GetParams(ctx).IsActivePrecompile(address.String()) {
+		return errorsmod.Wrapf(
+			types.ErrClassPairAlreadyExists, "precompile address already in use: %s", address,
+		)
+	}
+
+	return k.evmKeeper.EnablePrecompiles(ctx, address)
+}
+
+// setClassPairWithMaps stores a class pair and the maps of its ERC721 address
+// and class id.
+func (k Keeper) setClassPairWithMaps(ctx sdk.Context, pair types.ClassPair) {
+	k.SetClassPair(ctx, pair)
+	k.SetERC721Map(ctx, pair.GetERC721Contract(), pair.GetID())
+	k.SetClassMap(ctx, pair.ClassId, pair.GetID())
+}
+
+// RegisterClassPrecompiles activates the ERC721 precompiles of the classes
+// received over IBC that aren't active in the EVM parameters, e.g. when the
+// chain is started from a genesis without them.
+func (k Keeper) RegisterClassPrecompiles(ctx sdk.Context) error {
+	params := k.evmKeeper.GetParams(ctx)
+	addresses := make([]common.Address, 0)
+
+	k.IterateClassPairs(ctx, func(pair types.ClassPair) bool {
+		address := pair.GetERC721Contract()
+		if pair.IsNativeClass() && !params.IsActivePrecompile(address.String()) {
+			addresses = append(addresses, address)
+		}
+		return false
+	})
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	return k.evmKeeper.EnablePrecompiles(ctx, addresses...)
+}
+
+// GetDynamicPrecompile implements evmtypes.DynamicPrecompileKeeper. It
+// instantiates the ERC721 precompile of a class received over IBC from its
+// class pair, so that the precompiles are available after the node restarts.
+func (k Keeper) GetDynamicPrecompile(ctx sdk.Context, address common.Address) (vm.PrecompiledContract, bool) {
+	pair, found := k.GetClassPair(ctx, k.GetERC721Map(ctx, address))
+	if !found || !pair.IsNativeClass() {
+		return nil, false
+	}
+
+	precompile, err := erc721precompile.NewPrecompile(pair, k)
+	if err != nil {
+		k.Logger(ctx).Error("failed to instantiate ERC-721 precompile", "class_id", pair.ClassId, "error", err.Error())
+		return nil, false
+	}
+
+	return precompile, true
+}
@@ -0,0 +1,393 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+// SendTransfer handles the transfer of non-fungible tokens to another chain
+// following the ICS-721 specification. The tokens of the classes for which
+// this chain is the source are escrowed in the escrow address of the channel,
+// which is the ERC721 owner of the tokens until they return. Otherwise, the
+// vouchers are burned as the tokens return to their source chain.
+//
+// The class identifier of a native ERC721 contract is 'erc721/{address}', so
+// that the tokens are identified as such when they return to this chain. The
+// metadata of the class and of the tokens is propagated in the packet.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	token string,
+	tokenIDs []string,
+	sender common.Address,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	if !k.IsERC721Enabled(ctx) {
+		return 0, types.ErrERC721Disabled
+	}
+
+	pair, err := k.getEnabledClassPair(ctx, token)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel); !found {
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	fullClassPath, err := k.ClassPathFromID(ctx, pair.ClassId)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		classURI, classData  string
+		tokenURIs, tokenData []string
+	)
+
+	switch {
+	case pair.IsNativeERC721():
+		name, symbol, err := k.QueryERC721(ctx, pair.GetERC721Contract())
+		if err != nil {
+			return 0, err
+		}
+		classData = types.EncodeClassData(name, symbol)
+	case pair.IsNativeClass():
+		class, found := k.GetClass(ctx, pair.GetERC721Contract())
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrClassPairNotFound, "class metadata not found for %s", pair.ClassId)
+		}
+		classURI, classData = class.Uri, class.Data
+	default:
+		return 0, types.ErrUndefinedOwner
+	}
+
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+	senderIsSource := types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath)
+
+	packetTokenIDs := make([]string, len(tokenIDs))
+	for i, id := range tokenIDs {
+		var uri, data string
+
+		if pair.IsNativeERC721() {
+			tokenID, ok := types.ParseERC721TokenID(id)
+			if !ok {
+				return 0, errorsmod.Wrapf(types.ErrInvalidTokenID, "token id %s is not an ERC721 token id", id)
+			}
+
+			// NOTE: the tokens of ERC721 contracts are always sent from their
+			// source chain
+			if err := k.escrowERC721Token(ctx, pair.GetERC721Contract(), sender, escrowAddress, tokenID); err != nil {
+				return 0, err
+			}
+
+			packetTokenIDs[i] = id
+			uri = k.TokenURI(ctx, pair.GetERC721Contract(), tokenID)
+		} else {
+			nft, found := k.GetNFT(ctx, pair.GetERC721Contract(), types.TokenIDToBigInt(id))
+			if !found {
+				return 0, errorsmod.Wrapf(types.ErrNFTNotFound, "token %s of class %s", id, pair.ClassId)
+			}
+
+			if nft.GetOwnerAddress() != sender {
+				return 0, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of token %s", sender, id)
+			}
+
+			if senderIsSource {
+				err = k.TransferNFT(ctx, pair.GetERC721Contract(), nft.GetTokenID(), escrowAddress)
+			} else {
+				err = k.BurnNFT(ctx, pair.GetERC721Contract(), nft.GetTokenID())
+			}
+			if err != nil {
+				return 0, err
+			}
+
+			// NOTE: the packet contains the identifier of the token as it was
+			// received over IBC
+			packetTokenIDs[i] = nft.Id
+			uri, data = nft.Uri, nft.Data
+		}
+
+		tokenURIs = append(tokenURIs, uri)
+		tokenData = append(tokenData, data)
+	}
+
+	if !hasNonEmpty(tokenURIs) {
+		tokenURIs = nil
+	}
+	if !hasNonEmpty(tokenData) {
+		tokenData = nil
+	}
+
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, classURI, classData,
+		packetTokenIDs, tokenURIs, tokenData,
+		sdk.AccAddress(sender.Bytes()).String(), receiver, memo,
+	)
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeySender, packetData.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, fullClassPath),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(packetTokenIDs, ",")),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+		),
+	)
+
+	return sequence, nil
+}
+
+// OnRecvPacket processes a cross chain non-fungible token transfer. If the
+// sender chain is the source of the class, the class is registered with an
+// ERC721 precompile when it's received for the first time and the vouchers are
+// minted to the receiver. Otherwise, the tokens this chain originally
+// transferred are unescrowed to the receiver.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !k.IsERC721Enabled(ctx) {
+		return types.ErrERC721Disabled
+	}
+
+	receiver, err := parseAddress(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: we use the source port and channel here, because the counterparty
+	// chain would have prefixed the class with them when originally receiving
+	// the tokens from this chain
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// remove the prefix added by the sender chain
+		classPrefix := types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedClassID := data.ClassId[len(classPrefix):]
+
+		pair, err := k.getEnabledClassPair(ctx, types.ParseClassTrace(unprefixedClassID).IBCClassID())
+		if err != nil {
+			return err
+		}
+
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for _, id := range data.TokenIds {
+			if err := k.unescrowToken(ctx, pair, escrowAddress, receiver, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// sender chain is the source, mint the vouchers of the class
+	classPrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	classTrace := types.ParseClassTrace(classPrefix + data.ClassId)
+	classID := classTrace.IBCClassID()
+
+	var pair types.ClassPair
+	if k.IsClassRegistered(ctx, classID) {
+		pair, err = k.getEnabledClassPair(ctx, classID)
+	} else {
+		pair, err = k.registerClass(ctx, classTrace, data.ClassUri, data.ClassData)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !pair.IsNativeClass() {
+		return errorsmod.Wrapf(types.ErrUndefinedOwner, "class %s is not owned by the module", classID)
+	}
+
+	return k.mintVouchers(ctx, pair, receiver, data)
+}
+
+// OnAcknowledgementPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement was a
+// success then nothing occurs. If the acknowledgement failed, then the sender
+// is refunded their tokens.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketToken(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender since the original packet sent was
+// never received and has been timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketToken(ctx, packet, data)
+}
+
+// refundPacketToken returns the tokens of a failed transfer to the sender. The
+// escrowed tokens are unescrowed and the burned vouchers are minted again.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	sender, err := parseAddress(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: the class pair isn't required to be enabled to refund the tokens
+	id := k.GetClassPairID(ctx, types.ParseClassTrace(data.ClassId).IBCClassID())
+	pair, found := k.GetClassPair(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrClassPairNotFound, "class pair not found for %s", data.ClassId)
+	}
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		for _, id := range data.TokenIds {
+			if err := k.unescrowToken(ctx, pair, escrowAddress, sender, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return k.mintVouchers(ctx, pair, sender, data)
+}
+
+// escrowERC721Token transfers a token of an ERC721 contract to the escrow
+// address and checks that the escrow address is its owner afterwards.
+func (k Keeper) escrowERC721Token(ctx sdk.Context, contract, sender, escrowAddress common.Address, tokenID *big.Int) error {
+	if _, err := k.CallEVM(ctx, sender, contract, true, "transferFrom", sender, escrowAddress, tokenID); err != nil {
+		return err
+	}
+
+	owner, err := k.OwnerOf(ctx, contract, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if owner != escrowAddress {
+		return errorsmod.Wrapf(
+			types.ErrEscrowInvariance, "token %s of %s is owned by %s instead of %s", tokenID, contract, owner, escrowAddress,
+		)
+	}
+
+	return nil
+}
+
+// unescrowToken transfers an escrowed token from the escrow address to the
+// receiver.
+func (k Keeper) unescrowToken(ctx sdk.Context, pair types.ClassPair, escrowAddress, receiver common.Address, id string) error {
+	contract := pair.GetERC721Contract()
+
+	switch {
+	case pair.IsNativeERC721():
+		tokenID, ok := types.ParseERC721TokenID(id)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidTokenID, "token id %s is not an ERC721 token id", id)
+		}
+
+		_, err := k.CallEVM(ctx, escrowAddress, contract, true, "transferFrom", escrowAddress, receiver, tokenID)
+		return err
+	case pair.IsNativeClass():
+		nft, found := k.GetNFT(ctx, contract, types.TokenIDToBigInt(id))
+		if !found || nft.GetOwnerAddress() != escrowAddress {
+			return errorsmod.Wrapf(types.ErrNFTNotFound, "token %s of class %s is not escrowed", id, pair.ClassId)
+		}
+
+		return k.TransferNFT(ctx, contract, nft.GetTokenID(), receiver)
+	default:
+		return types.ErrUndefinedOwner
+	}
+}
+
+// mintVouchers mints the vouchers of the tokens of a packet with their
+// metadata to the receiver.
+func (k Keeper) mintVouchers(ctx sdk.Context, pair types.ClassPair, receiver common.Address, data types.NonFungibleTokenPacketData) error {
+	for i, id := range data.TokenIds {
+		nft := types.NFT{
+			Erc721Address: pair.Erc721Address,
+			Id:            id,
+			Uri:           data.GetTokenURI(i),
+			Data:          data.GetTokenData(i),
+			Owner:         receiver.String(),
+		}
+
+		if err := k.MintNFT(ctx, nft); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getEnabledClassPair returns the class pair of a token identifier, which can
+// be either the hex address of the ERC721 or the class id, and checks that it
+// is enabled.
+func (k Keeper) getEnabledClassPair(ctx sdk.Context, token string) (types.ClassPair, error) {
+	id := k.GetClassPairID(ctx, token)
+	if len(id) == 0 {
+		return types.ClassPair{}, errorsmod.Wrapf(types.ErrClassPairNotFound, "class pair not found for %s", token)
+	}
+
+	pair, found := k.GetClassPair(ctx, id)
+	if !found {
+		return types.ClassPair{}, errorsmod.Wrapf(types.ErrClassPairNotFound, "class pair not found for %s", token)
+	}
+
+	if !pair.Enabled {
+		return types.ClassPair{}, errorsmod.Wrapf(types.ErrClassPairDisabled, "class pair %s is disabled", pair.ClassId)
+	}
+
+	return pair, nil
+}
+
+// parseAddress parses an address that can be either in hex or bech32 format.
+func parseAddress(address string) (common.Address, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address), nil
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid address %s: %s", address, err)
+	}
+
+	return common.BytesToAddress(accAddress), nil
+}
+
+// hasNonEmpty returns true if any of the values isn't empty.
+func hasNonEmpty(values []string) bool {
+	for _, value := range values {
+		if value != "" {
+			return true
+		}
+	}
+	return false
+}
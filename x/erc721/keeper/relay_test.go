@@ -0,0 +1,461 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/exp/slices"
+
+	"github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/utils"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var data types.NonFungibleTokenPacketData
+
+	receiver := tx.GenerateAddress()
+	sender := sdk.AccAddress(tx.GenerateAddress().Bytes()).String()
+	classTrace := types.ParseClassTrace(types.GetClassPrefix(types.PortID, sourceChannel) + "punks")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expName  string
+	}{
+		{
+			"fail - invalid packet data",
+			func() {
+				data.TokenIds = nil
+			},
+			false,
+			"",
+		},
+		{
+			"fail - erc721 module disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableErc721 = false
+				suite.Require().NoError(suite.app.Erc721Keeper.SetParams(suite.ctx, params))
+			},
+			false,
+			"",
+		},
+		{
+			"fail - invalid receiver",
+			func() {
+				data.Receiver = "receiver"
+			},
+			false,
+			"",
+		},
+		{
+			"fail - token already minted",
+			func() {
+				err := suite.app.Erc721Keeper.OnRecvPacket(suite.ctx, recvPacket(sourceChannel, data), data)
+				suite.Require().NoError(err)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - class registered with the metadata of the class data",
+			func() {},
+			true,
+			"Punks",
+		},
+		{
+			"pass - class without metadata",
+			func() {
+				data.ClassData = ""
+			},
+			true,
+			"punks",
+		},
+		{
+			"pass - bech32 receiver",
+			func() {
+				data.Receiver = sdk.AccAddress(receiver.Bytes()).String()
+			},
+			true,
+			"Punks",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			data = types.NewNonFungibleTokenPacketData(
+				"punks", "ipfs://punks", types.EncodeClassData("Punks", "PUNK"),
+				[]string{"1", "alien"}, []string{"ipfs://1", ""}, nil,
+				sender, receiver.String(), "",
+			)
+
+			tc.malleate()
+
+			err := suite.app.Erc721Keeper.OnRecvPacket(suite.ctx, recvPacket(sourceChannel, data), data)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			classID := classTrace.IBCClassID()
+			address, err := utils.GetIBCDenomAddress(classID)
+			suite.Require().NoError(err)
+
+			id := suite.app.Erc721Keeper.GetClassPairID(suite.ctx, classID)
+			pair, found := suite.app.Erc721Keeper.GetClassPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewClassPair(address, classID, types.OWNER_MODULE), pair)
+
+			trace, found := suite.app.Erc721Keeper.GetClassTrace(suite.ctx, classTrace.Hash())
+			suite.Require().True(found)
+			suite.Require().Equal(classTrace, trace)
+
+			// the precompile is instantiated from the state instead of being kept in memory
+			suite.Require().False(suite.app.EvmKeeper.IsAvailablePrecompile(address))
+			precompile, found := suite.app.Erc721Keeper.GetDynamicPrecompile(suite.ctx, address)
+			suite.Require().True(found)
+			suite.Require().Equal(address, precompile.Address())
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			suite.Require().True(slices.Contains(params.ActivePrecompiles, address.String()))
+
+			class, found := suite.app.Erc721Keeper.GetClass(suite.ctx, address)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expName, class.Name)
+			suite.Require().Equal("ipfs://punks", class.Uri)
+
+			for i, id := range data.TokenIds {
+				nft, found := suite.app.Erc721Keeper.GetNFT(suite.ctx, address, types.TokenIDToBigInt(id))
+				suite.Require().True(found)
+				suite.Require().Equal(receiver, nft.GetOwnerAddress())
+				suite.Require().Equal(data.GetTokenURI(i), nft.Uri)
+			}
+			suite.Require().Equal(uint64(2), suite.app.Erc721Keeper.GetNFTBalance(suite.ctx, address, receiver))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTransferVoucher() {
+	var (
+		pair     types.ClassPair
+		tokenIDs []string
+		sender   common.Address
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		channel   string
+		expPass   bool
+		expEscrow bool
+		expClass  string
+	}{
+		{
+			"fail - class pair not registered",
+			func() {
+				pair = types.NewClassPair(tx.GenerateAddress(), "ibc/", types.OWNER_MODULE)
+			},
+			sourceChannel,
+			false,
+			false,
+			"",
+		},
+		{
+			"fail - class pair disabled",
+			func() {
+				pair.Enabled = false
+				suite.app.Erc721Keeper.SetClassPair(suite.ctx, pair)
+			},
+			sourceChannel,
+			false,
+			false,
+			"",
+		},
+		{
+			"fail - channel not found",
+			func() {},
+			"channel-9",
+			false,
+			false,
+			"",
+		},
+		{
+			"fail - token not found",
+			func() {
+				tokenIDs = []string{"2"}
+			},
+			sourceChannel,
+			false,
+			false,
+			"",
+		},
+		{
+			"fail - sender isn't the owner",
+			func() {
+				sender = tx.GenerateAddress()
+			},
+			sourceChannel,
+			false,
+			false,
+			"",
+		},
+		{
+			"pass - voucher returned to its source chain is burned",
+			func() {},
+			sourceChannel,
+			true,
+			false,
+			"nft-transfer/channel-0/punks",
+		},
+		{
+			"pass - voucher sent over another channel is escrowed",
+			func() {
+				pair = suite.receiveClass("channel-3", "punks", sender, "1")
+			},
+			sourceChannel,
+			true,
+			true,
+			"nft-transfer/channel-3/punks",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			sender = suite.address
+			tokenIDs = []string{"1"}
+			pair = suite.receiveClass(sourceChannel, "punks", sender, "1")
+
+			tc.malleate()
+
+			_, err := suite.app.Erc721Keeper.SendTransfer(
+				suite.ctx, types.PortID, tc.channel, pair.Erc721Address, tokenIDs,
+				sender, "receiver", timeoutHeight, 0, "memo",
+			)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			owner, found := suite.ownerOf(pair, "1")
+			if tc.expEscrow {
+				suite.Require().True(found)
+				suite.Require().Equal(types.GetEscrowAddress(types.PortID, tc.channel), owner)
+			} else {
+				suite.Require().False(found)
+			}
+
+			_, data := suite.sentPacket()
+			suite.Require().Equal(tc.expClass, data.ClassId)
+			suite.Require().Equal("ipfs://class", data.ClassUri)
+			suite.Require().Equal(types.EncodeClassData("Punks", "PUNK"), data.ClassData)
+			suite.Require().Equal([]string{"1"}, data.TokenIds)
+			suite.Require().Nil(data.TokenUris)
+			suite.Require().Equal(sdk.AccAddress(sender.Bytes()).String(), data.Sender)
+			suite.Require().Equal("memo", data.Memo)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecvEscrowedVoucher() {
+	pair := suite.receiveClass("channel-3", "punks", suite.address, "1")
+
+	_, err := suite.app.Erc721Keeper.SendTransfer(
+		suite.ctx, types.PortID, sourceChannel, pair.Erc721Address, []string{"1"},
+		suite.address, "receiver", timeoutHeight, 0, "",
+	)
+	suite.Require().NoError(err)
+
+	// the counterparty chain sends the voucher back
+	_, sent := suite.sentPacket()
+	receiver := tx.GenerateAddress()
+	data := types.NewNonFungibleTokenPacketData(
+		types.GetClassPrefix(types.PortID, counterpartyChannel)+sent.ClassId, "", "",
+		sent.TokenIds, nil, nil,
+		sent.Receiver, receiver.String(), "",
+	)
+
+	err = suite.app.Erc721Keeper.OnRecvPacket(suite.ctx, recvPacket(sourceChannel, data), data)
+	suite.Require().NoError(err)
+
+	owner, found := suite.ownerOf(pair, "1")
+	suite.Require().True(found)
+	suite.Require().Equal(receiver, owner)
+
+	// the token isn't escrowed anymore
+	err = suite.app.Erc721Keeper.OnRecvPacket(suite.ctx, recvPacket(sourceChannel, data), data)
+	suite.Require().ErrorIs(err, types.ErrNFTNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRefundPacketToken() {
+	testCases := []struct {
+		name     string
+		channel  string
+		refund   func(packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error
+		expOwner func() common.Address
+	}{
+		{
+			"burned voucher minted again on error acknowledgement",
+			sourceChannel,
+			func(packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+				ack := channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacket)
+				return suite.app.Erc721Keeper.OnAcknowledgementPacket(suite.ctx, packet, data, ack)
+			},
+			func() common.Address { return suite.address },
+		},
+		{
+			"escrowed voucher unescrowed on timeout",
+			"channel-3",
+			func(packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+				return suite.app.Erc721Keeper.OnTimeoutPacket(suite.ctx, packet, data)
+			},
+			func() common.Address { return suite.address },
+		},
+		{
+			"nothing refunded on success acknowledgement",
+			"channel-3",
+			func(packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				return suite.app.Erc721Keeper.OnAcknowledgementPacket(suite.ctx, packet, data, ack)
+			},
+			func() common.Address { return types.GetEscrowAddress(types.PortID, sourceChannel) },
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			pair := suite.receiveClass(tc.channel, "punks", suite.address, "1")
+			_, err := suite.app.Erc721Keeper.SendTransfer(
+				suite.ctx, types.PortID, sourceChannel, pair.Erc721Address, []string{"1"},
+				suite.address, "receiver", timeoutHeight, 0, "",
+			)
+			suite.Require().NoError(err)
+
+			packet, data := suite.sentPacket()
+			err = tc.refund(packet, data)
+			suite.Require().NoError(err)
+
+			owner, found := suite.ownerOf(pair, "1")
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOwner(), owner)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTransferERC721() {
+	var (
+		pair     types.ClassPair
+		tokenIDs []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - token id isn't an ERC721 token id",
+			func() {
+				tokenIDs = []string{"punk"}
+			},
+			false,
+		},
+		{
+			"fail - token not owned by the sender",
+			func() {
+				tokenIDs = []string{"6"}
+			},
+			false,
+		},
+		{
+			"pass - token escrowed",
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			pair = suite.deployERC721(suite.address, "5")
+			tokenIDs = []string{"5"}
+
+			tc.malleate()
+
+			_, err := suite.app.Erc721Keeper.SendTransfer(
+				suite.ctx, types.PortID, sourceChannel, pair.Erc721Address, tokenIDs,
+				suite.address, "receiver", timeoutHeight, 0, "",
+			)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			escrowAddress := types.GetEscrowAddress(types.PortID, sourceChannel)
+			owner, err := suite.app.Erc721Keeper.OwnerOf(suite.ctx, pair.GetERC721Contract(), types.TokenIDToBigInt("5"))
+			suite.Require().NoError(err)
+			suite.Require().Equal(escrowAddress, owner)
+
+			_, data := suite.sentPacket()
+			suite.Require().Equal(pair.ClassId, data.ClassId)
+			suite.Require().Equal(types.EncodeClassData("Punks", "PUNK"), data.ClassData)
+			suite.Require().Equal([]string{"5"}, data.TokenIds)
+			suite.Require().Equal([]string{"ipfs://5"}, data.TokenUris)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecvERC721() {
+	pair := suite.deployERC721(suite.address, "5")
+	escrowAddress := types.GetEscrowAddress(types.PortID, sourceChannel)
+	tokenID := types.TokenIDToBigInt("5")
+
+	_, err := suite.app.Erc721Keeper.SendTransfer(
+		suite.ctx, types.PortID, sourceChannel, pair.Erc721Address, []string{"5"},
+		suite.address, "receiver", timeoutHeight, 0, "",
+	)
+	suite.Require().NoError(err)
+
+	// the token is refunded on timeout
+	packet, sent := suite.sentPacket()
+	err = suite.app.Erc721Keeper.OnTimeoutPacket(suite.ctx, packet, sent)
+	suite.Require().NoError(err)
+
+	owner, err := suite.app.Erc721Keeper.OwnerOf(suite.ctx, pair.GetERC721Contract(), tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.address, owner)
+
+	_, err = suite.app.Erc721Keeper.SendTransfer(
+		suite.ctx, types.PortID, sourceChannel, pair.Erc721Address, []string{"5"},
+		suite.address, "receiver", timeoutHeight, 0, "",
+	)
+	suite.Require().NoError(err)
+
+	owner, err = suite.app.Erc721Keeper.OwnerOf(suite.ctx, pair.GetERC721Contract(), tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowAddress, owner)
+
+	// the counterparty chain sends the token back
+	_, sent = suite.sentPacket()
+	receiver := tx.GenerateAddress()
+	data := types.NewNonFungibleTokenPacketData(
+		types.GetClassPrefix(types.PortID, counterpartyChannel)+sent.ClassId, "", "",
+		sent.TokenIds, nil, nil,
+		sent.Receiver, receiver.String(), "",
+	)
+
+	err = suite.app.Erc721Keeper.OnRecvPacket(suite.ctx, recvPacket(sourceChannel, data), data)
+	suite.Require().NoError(err)
+
+	owner, err = suite.app.Erc721Keeper.OwnerOf(suite.ctx, pair.GetERC721Contract(), tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiver, owner)
+}
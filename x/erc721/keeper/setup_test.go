@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v16/testutil"
+	"github.com/evmos/evmos/v16/utils"
+	"github.com/evmos/evmos/v16/x/erc721/keeper"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+const (
+	// sourceChannel is the channel of the test chain on which the packets are sent
+	sourceChannel = "channel-0"
+	// counterpartyChannel is the channel of the counterparty chain
+	counterpartyChannel = "channel-7"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Evmos
+	queryClient types.QueryClient
+	address     common.Address
+
+	// ics4Wrapper records the packets sent by the erc721 keeper
+	ics4Wrapper *MockICS4Wrapper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	t := suite.T()
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	suite.address = common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState(), chainID)
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	// set the block proposer as validator for the EVM calls
+	valAddr := sdk.ValAddress(suite.address.Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator = stakingkeeper.TestingUpdateValidator(&suite.app.StakingKeeper, suite.ctx, validator, true)
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator)
+	require.NoError(t, err)
+
+	// use a keeper that sends the packets through the mock ICS4 wrapper over a
+	// channel owned by the erc721 module
+	_, err = suite.app.ScopedERC721Keeper.NewCapability(suite.ctx, host.ChannelCapabilityPath(types.PortID, sourceChannel))
+	require.NoError(t, err)
+
+	suite.ics4Wrapper = &MockICS4Wrapper{}
+	suite.app.Erc721Keeper = keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey), suite.app.AppCodec(), authtypes.NewModuleAddress(govtypes.ModuleName),
+		suite.app.EvmKeeper, suite.ics4Wrapper, &MockChannelKeeper{},
+		&suite.app.IBCKeeper.PortKeeper, suite.app.ScopedERC721Keeper,
+	)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.Erc721Keeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+var _ types.ChannelKeeper = &MockChannelKeeper{}
+
+// MockChannelKeeper returns an open channel for the source channel.
+type MockChannelKeeper struct{}
+
+func (MockChannelKeeper) GetChannel(_ sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool) {
+	if srcPort != types.PortID || srcChan != sourceChannel {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(types.PortID, counterpartyChannel),
+		[]string{"connection-0"}, types.Version,
+	), true
+}
+
+var _ porttypes.ICS4Wrapper = &MockICS4Wrapper{}
+
+// MockICS4Wrapper records the data of the packets sent.
+type MockICS4Wrapper struct {
+	packets [][]byte
+}
+
+func (*MockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, _ exported.PacketI, _ exported.Acknowledgement) error {
+	return nil
+}
+
+func (*MockICS4Wrapper) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return types.Version, true
+}
+
+func (m *MockICS4Wrapper) SendPacket(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	_ string,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+	data []byte,
+) (uint64, error) {
+	m.packets = append(m.packets, data)
+	return uint64(len(m.packets)), nil
+}
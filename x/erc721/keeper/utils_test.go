@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v16/contracts"
+	erc721precompile "github.com/evmos/evmos/v16/precompiles/erc721"
+	"github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+var timeoutHeight = clienttypes.NewHeight(0, 100)
+
+// recvPacket returns a packet received from the counterparty chain on the given
+// channel of the test chain.
+func recvPacket(destChannel string, data types.NonFungibleTokenPacketData) channeltypes.Packet {
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1, types.PortID, counterpartyChannel, types.PortID, destChannel, timeoutHeight, 0,
+	)
+}
+
+// sentPacket returns the last packet sent by the erc721 keeper with its data.
+func (suite *KeeperTestSuite) sentPacket() (channeltypes.Packet, types.NonFungibleTokenPacketData) {
+	packets := suite.ics4Wrapper.packets
+	suite.Require().NotEmpty(packets, "no packet sent")
+
+	bz := packets[len(packets)-1]
+	var data types.NonFungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(bz, &data))
+
+	packet := channeltypes.NewPacket(
+		bz, uint64(len(packets)), types.PortID, sourceChannel, types.PortID, counterpartyChannel, timeoutHeight, 0,
+	)
+	return packet, data
+}
+
+// receiveClass receives the tokens of a class from the counterparty chain on
+// the given channel and returns the class pair of the vouchers.
+func (suite *KeeperTestSuite) receiveClass(destChannel, classID string, receiver common.Address, tokenIDs ...string) types.ClassPair {
+	data := types.NewNonFungibleTokenPacketData(
+		classID, "ipfs://class", types.EncodeClassData("Punks", "PUNK"),
+		tokenIDs, nil, nil,
+		sdk.AccAddress(tx.GenerateAddress().Bytes()).String(), receiver.String(), "",
+	)
+
+	err := suite.app.Erc721Keeper.OnRecvPacket(suite.ctx, recvPacket(destChannel, data), data)
+	suite.Require().NoError(err)
+
+	classTrace := types.ParseClassTrace(types.GetClassPrefix(types.PortID, destChannel) + classID)
+	id := suite.app.Erc721Keeper.GetClassPairID(suite.ctx, classTrace.IBCClassID())
+	pair, found := suite.app.Erc721Keeper.GetClassPair(suite.ctx, id)
+	suite.Require().True(found)
+	return pair
+}
+
+// deployERC721 emulates an ERC721 contract deployed on the test chain with an
+// ERC721 precompile, mints a token to the owner and registers the contract.
+func (suite *KeeperTestSuite) deployERC721(owner common.Address, tokenID string) types.ClassPair {
+	contract := tx.GenerateAddress()
+
+	precompile, err := erc721precompile.NewPrecompile(
+		types.NewClassPair(contract, "emulated", types.OWNER_MODULE), suite.app.Erc721Keeper,
+	)
+	suite.Require().NoError(err)
+	err = suite.app.EvmKeeper.AddEVMExtensions(suite.ctx, precompile)
+	suite.Require().NoError(err)
+
+	suite.app.Erc721Keeper.SetClass(suite.ctx, types.Class{Erc721Address: contract.String(), Name: "Punks", Symbol: "PUNK"})
+	err = suite.app.Erc721Keeper.MintNFT(suite.ctx, types.NFT{
+		Erc721Address: contract.String(),
+		Id:            tokenID,
+		Uri:           "ipfs://" + tokenID,
+		Owner:         owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	pair := types.NewClassPair(contract, types.CreateERC721ClassID(contract), types.OWNER_EXTERNAL)
+	suite.app.Erc721Keeper.SetClassPair(suite.ctx, pair)
+	suite.app.Erc721Keeper.SetERC721Map(suite.ctx, contract, pair.GetID())
+	suite.app.Erc721Keeper.SetClassMap(suite.ctx, pair.ClassId, pair.GetID())
+	return pair
+}
+
+// ownerOf returns the owner of a token stored by the erc721 keeper.
+func (suite *KeeperTestSuite) ownerOf(pair types.ClassPair, id string) (common.Address, bool) {
+	nft, found := suite.app.Erc721Keeper.GetNFT(suite.ctx, pair.GetERC721Contract(), types.TokenIDToBigInt(id))
+	return nft.GetOwnerAddress(), found
+}
+
+// deployMetadataContract deploys a contract that implements the ERC721
+// metadata methods name and symbol.
+func (suite *KeeperTestSuite) deployMetadataContract(name, symbol string) common.Address {
+	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("", name, symbol, uint8(0))
+	suite.Require().NoError(err)
+
+	data := append(contracts.ERC20MinterBurnerDecimalsContract.Bin, ctorArgs...) //nolint:gocritic
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	_, err = suite.app.Erc721Keeper.CallEVMWithData(suite.ctx, suite.address, nil, data, true)
+	suite.Require().NoError(err)
+
+	return crypto.CreateAddress(suite.address, nonce)
+}
@@ -0,0 +1,57 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v16/types"
+)
+
+// NewClassPair returns an instance of ClassPair
+func NewClassPair(erc721Address common.Address, classID string, contractOwner Owner) ClassPair {
+	return ClassPair{
+		Erc721Address: erc721Address.String(),
+		ClassId:       classID,
+		Enabled:       true,
+		ContractOwner: contractOwner,
+	}
+}
+
+// GetID returns the SHA256 hash of the ERC721 address and class identifier
+func (cp ClassPair) GetID() []byte {
+	id := cp.Erc721Address + "|" + cp.ClassId
+	return tmhash.Sum([]byte(id))
+}
+
+// GetERC721Contract casts the hex string address of the ERC721 to common.Address
+func (cp ClassPair) GetERC721Contract() common.Address {
+	return common.HexToAddress(cp.Erc721Address)
+}
+
+// Validate performs a stateless validation of a ClassPair
+func (cp ClassPair) Validate() error {
+	if err := ValidateClassID(cp.ClassId); err != nil {
+		return err
+	}
+
+	return evmostypes.ValidateAddress(cp.Erc721Address)
+}
+
+// IsNativeClass returns true if the tokens of the ERC721 are managed by the
+// erc721 module, i.e. the class was received over IBC
+func (cp ClassPair) IsNativeClass() bool {
+	return cp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC721 returns true if the ERC721 contract is deployed on the EVM
+func (cp ClassPair) IsNativeERC721() bool {
+	return cp.ContractOwner == OWNER_EXTERNAL
+}
+
+// CreateERC721ClassID returns the ICS-721 class identifier of an ERC721
+// contract deployed on the EVM.
+func CreateERC721ClassID(contract common.Address) string {
+	return "erc721/" + contract.String()
+}
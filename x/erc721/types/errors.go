@@ -0,0 +1,27 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrERC721Disabled         = errorsmod.Register(ModuleName, 2, "erc721 module is disabled")
+	ErrClassPairNotFound      = errorsmod.Register(ModuleName, 3, "class pair not found")
+	ErrClassPairAlreadyExists = errorsmod.Register(ModuleName, 4, "class pair already exists")
+	ErrClassPairDisabled      = errorsmod.Register(ModuleName, 5, "class pair is disabled")
+	ErrInvalidClassID         = errorsmod.Register(ModuleName, 6, "invalid class id")
+	ErrInvalidTokenID         = errorsmod.Register(ModuleName, 7, "invalid token id")
+	ErrInvalidPacket          = errorsmod.Register(ModuleName, 8, "invalid non-fungible token packet")
+	ErrInvalidVersion         = errorsmod.Register(ModuleName, 9, "invalid ICS-721 version")
+	ErrTraceNotFound          = errorsmod.Register(ModuleName, 10, "class trace not found")
+	ErrNFTNotFound            = errorsmod.Register(ModuleName, 11, "nft not found")
+	ErrUnauthorized           = errorsmod.Register(ModuleName, 12, "unauthorized nft owner")
+	ErrABIPack                = errorsmod.Register(ModuleName, 13, "contract ABI pack failed")
+	ErrABIUnpack              = errorsmod.Register(ModuleName, 14, "contract ABI unpack failed")
+	ErrEscrowInvariance       = errorsmod.Register(ModuleName, 15, "post transfer escrow invariant failed")
+	ErrUndefinedOwner         = errorsmod.Register(ModuleName, 16, "undefined owner of class pair")
+)
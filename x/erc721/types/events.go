@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// erc721 events
+const (
+	EventTypeTransfer       = "ibc_nft_transfer"
+	EventTypePacket         = "non_fungible_token_packet"
+	EventTypeTimeout        = "timeout"
+	EventTypeRegisterERC721 = "register_erc721"
+	EventTypeRegisterClass  = "register_class"
+
+	AttributeKeySender         = "sender"
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyTokenIDs       = "token_ids"
+	AttributeKeyERC721Token    = "erc721_token"
+	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAckError       = "error"
+	AttributeKeyRefundReceiver = "refund_receiver"
+	AttributeKeyMemo           = "memo"
+)
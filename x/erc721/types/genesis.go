@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pairs []ClassPair, traces []ClassTrace, classes []Class, nfts []NFT) GenesisState {
+	return GenesisState{
+		Params:      params,
+		ClassPairs:  pairs,
+		ClassTraces: traces,
+		Classes:     classes,
+		Nfts:        nfts,
+	}
+}
+
+// DefaultGenesisState sets default erc721 genesis state with empty class pairs
+// and default params.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenErc721 := make(map[string]bool)
+	seenClass := make(map[string]bool)
+	moduleClasses := make(map[string]bool)
+
+	for _, pair := range gs.ClassPairs {
+		if seenErc721[pair.Erc721Address] {
+			return fmt.Errorf("ERC721 contract duplicated on genesis '%s'", pair.Erc721Address)
+		}
+		if seenClass[pair.ClassId] {
+			return fmt.Errorf("class id duplicated on genesis: '%s'", pair.ClassId)
+		}
+
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		seenErc721[pair.Erc721Address] = true
+		seenClass[pair.ClassId] = true
+		if pair.IsNativeClass() {
+			moduleClasses[pair.Erc721Address] = true
+		}
+	}
+
+	for _, trace := range gs.ClassTraces {
+		if err := trace.Validate(); err != nil {
+			return fmt.Errorf("invalid class trace %s: %w", trace.GetFullClassPath(), err)
+		}
+	}
+
+	for _, class := range gs.Classes {
+		if err := class.Validate(); err != nil {
+			return err
+		}
+		if !moduleClasses[class.Erc721Address] {
+			return fmt.Errorf("class '%s' has no module-owned class pair", class.Erc721Address)
+		}
+	}
+
+	seenNFT := make(map[string]bool)
+	for _, nft := range gs.Nfts {
+		if err := nft.Validate(); err != nil {
+			return err
+		}
+		if !moduleClasses[nft.Erc721Address] {
+			return fmt.Errorf("nft '%s' of class '%s' has no module-owned class pair", nft.Id, nft.Erc721Address)
+		}
+
+		key := nft.Erc721Address + "/" + nft.GetTokenID().String()
+		if seenNFT[key] {
+			return fmt.Errorf("nft '%s' of class '%s' duplicated on genesis", nft.Id, nft.Erc721Address)
+		}
+		seenNFT[key] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	trace := types.ParseClassTrace("nft-transfer/channel-0/class")
+	voucherAddress := utiltx.GenerateAddress()
+	erc721Address := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+
+	voucherPair := types.NewClassPair(voucherAddress, trace.IBCClassID(), types.OWNER_MODULE)
+	erc721Pair := types.NewClassPair(erc721Address, types.CreateERC721ClassID(erc721Address), types.OWNER_EXTERNAL)
+	class := types.Class{Erc721Address: voucherAddress.String(), Name: "class"}
+	nft := types.NFT{Erc721Address: voucherAddress.String(), Id: "1", Owner: owner.String()}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			&types.GenesisState{
+				Params:      types.DefaultParams(),
+				ClassPairs:  []types.ClassPair{voucherPair, erc721Pair},
+				ClassTraces: []types.ClassTrace{trace},
+				Classes:     []types.Class{class},
+				Nfts:        []types.NFT{nft},
+			},
+			true,
+		},
+		{
+			"duplicated ERC721 address",
+			&types.GenesisState{
+				Params:     types.DefaultParams(),
+				ClassPairs: []types.ClassPair{voucherPair, types.NewClassPair(voucherAddress, "class", types.OWNER_MODULE)},
+			},
+			false,
+		},
+		{
+			"duplicated class id",
+			&types.GenesisState{
+				Params:     types.DefaultParams(),
+				ClassPairs: []types.ClassPair{voucherPair, types.NewClassPair(erc721Address, voucherPair.ClassId, types.OWNER_MODULE)},
+			},
+			false,
+		},
+		{
+			"invalid class trace",
+			&types.GenesisState{
+				Params:      types.DefaultParams(),
+				ClassTraces: []types.ClassTrace{{Path: "nft-transfer", BaseClassId: "class"}},
+			},
+			false,
+		},
+		{
+			"class without module-owned class pair",
+			&types.GenesisState{
+				Params:     types.DefaultParams(),
+				ClassPairs: []types.ClassPair{erc721Pair},
+				Classes:    []types.Class{{Erc721Address: erc721Address.String()}},
+			},
+			false,
+		},
+		{
+			"nft without module-owned class pair",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Nfts:   []types.NFT{nft},
+			},
+			false,
+		},
+		{
+			"duplicated nft",
+			&types.GenesisState{
+				Params:     types.DefaultParams(),
+				ClassPairs: []types.ClassPair{voucherPair},
+				Nfts:       []types.NFT{nft, nft},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "erc721"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+
+	// PortID is the default port id that the module binds to
+	PortID = "nft-transfer"
+
+	// Version defines the current version of the ICS-721 protocol
+	Version = "ics721-1"
+)
+
+// ModuleAddress is the native module address for EVM
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
+// prefix bytes for the erc721 persistent store
+const (
+	prefixClassPair = iota + 1
+	prefixClassPairByERC721
+	prefixClassPairByClass
+	prefixClassTrace
+	prefixClass
+	prefixNFT
+	prefixBalance
+	prefixApproval
+	prefixOperatorApproval
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixClassPair         = []byte{prefixClassPair}
+	KeyPrefixClassPairByERC721 = []byte{prefixClassPairByERC721}
+	KeyPrefixClassPairByClass  = []byte{prefixClassPairByClass}
+	KeyPrefixClassTrace        = []byte{prefixClassTrace}
+	KeyPrefixClass             = []byte{prefixClass}
+	KeyPrefixNFT               = []byte{prefixNFT}
+	KeyPrefixBalance           = []byte{prefixBalance}
+	KeyPrefixApproval          = []byte{prefixApproval}
+	KeyPrefixOperatorApproval  = []byte{prefixOperatorApproval}
+	ParamsKey                  = []byte{prefixParams}
+)
+
+// NFTKey returns the key of a token of a class precompile. The token identifier
+// is the 32-byte big-endian representation of the ERC721 token ID.
+func NFTKey(contract common.Address, tokenID common.Hash) []byte {
+	return append(contract.Bytes(), tokenID.Bytes()...)
+}
+
+// BalanceKey returns the key of the token count of an owner of a class
+// precompile.
+func BalanceKey(contract, owner common.Address) []byte {
+	return append(contract.Bytes(), owner.Bytes()...)
+}
+
+// OperatorApprovalKey returns the key of the operator approval given by an owner
+// of a class precompile.
+func OperatorApprovalKey(contract, owner, operator common.Address) []byte {
+	key := append(contract.Bytes(), owner.Bytes()...)
+	return append(key, operator.Bytes()...)
+}
+
+// GetEscrowAddress returns the escrow address of the tokens sent over the
+// specified channel. The address is derived as in the ICS-20 specification,
+// using the ICS-721 version as the preimage prefix.
+func GetEscrowAddress(portID, channelID string) common.Address {
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return common.BytesToAddress(hash[:20])
+}
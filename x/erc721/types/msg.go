@@ -0,0 +1,151 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgTransfer{}
+	_ sdk.Msg = &MsgRegisterERC721{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+const (
+	TypeMsgTransfer = "transfer"
+)
+
+// NewMsgTransfer creates a new instance of MsgTransfer
+func NewMsgTransfer(
+	sourcePort, sourceChannel, classID string, tokenIDs []string,
+	sender sdk.AccAddress, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
+) *MsgTransfer { //nolint: interfacer
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassId:          classID,
+		TokenIds:         tokenIDs,
+		Sender:           sender.String(),
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransfer) Type() string { return TypeMsgTransfer }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+
+	if !common.IsHexAddress(msg.ClassId) {
+		if err := ValidateClassID(msg.ClassId); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateTokenIDs(msg.TokenIds); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "missing receiver address")
+	}
+
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "timeout height and timeout timestamp cannot both be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSigners returns the expected signers for a MsgRegisterERC721 message.
+func (m *MsgRegisterERC721) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterERC721) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if len(m.Erc721Addresses) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "ERC721 addresses cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(m.Erc721Addresses))
+	for _, address := range m.Erc721Addresses {
+		if !common.IsHexAddress(address) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid ERC721 contract address %s", address)
+		}
+
+		address = common.HexToAddress(address).String()
+		if seen[address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated ERC721 contract address %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterERC721) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
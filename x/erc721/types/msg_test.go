@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func TestMsgTransferValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+	timeoutHeight := clienttypes.NewHeight(1, 100)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgTransfer
+		expPass bool
+	}{
+		{
+			"valid ERC721 address",
+			types.NewMsgTransfer(types.PortID, "channel-0", contract.String(), []string{"1"}, sender, "receiver", timeoutHeight, 0, ""),
+			true,
+		},
+		{
+			"valid class id",
+			types.NewMsgTransfer(types.PortID, "channel-0", types.ParseClassTrace("nft-transfer/channel-0/class").IBCClassID(), []string{"a", "b"}, sender, "receiver", clienttypes.ZeroHeight(), 100, "memo"),
+			true,
+		},
+		{
+			"invalid port",
+			types.NewMsgTransfer("", "channel-0", contract.String(), []string{"1"}, sender, "receiver", timeoutHeight, 0, ""),
+			false,
+		},
+		{
+			"invalid channel",
+			types.NewMsgTransfer(types.PortID, "c", contract.String(), []string{"1"}, sender, "receiver", timeoutHeight, 0, ""),
+			false,
+		},
+		{
+			"invalid class id",
+			types.NewMsgTransfer(types.PortID, "channel-0", "ibc/ZZ", []string{"1"}, sender, "receiver", timeoutHeight, 0, ""),
+			false,
+		},
+		{
+			"no token ids",
+			types.NewMsgTransfer(types.PortID, "channel-0", contract.String(), nil, sender, "receiver", timeoutHeight, 0, ""),
+			false,
+		},
+		{
+			"invalid sender",
+			types.NewMsgTransfer(types.PortID, "channel-0", contract.String(), []string{"1"}, sdk.AccAddress{}, "receiver", timeoutHeight, 0, ""),
+			false,
+		},
+		{
+			"blank receiver",
+			types.NewMsgTransfer(types.PortID, "channel-0", contract.String(), []string{"1"}, sender, " ", timeoutHeight, 0, ""),
+			false,
+		},
+		{
+			"no timeout",
+			types.NewMsgTransfer(types.PortID, "channel-0", contract.String(), []string{"1"}, sender, "receiver", clienttypes.ZeroHeight(), 0, ""),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{sender}, tc.msg.GetSigners())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRegisterERC721ValidateBasic(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name    string
+		msg     types.MsgRegisterERC721
+		expPass bool
+	}{
+		{"valid", types.MsgRegisterERC721{Authority: authority, Erc721Addresses: []string{contract.String()}}, true},
+		{"invalid authority", types.MsgRegisterERC721{Authority: "invalid", Erc721Addresses: []string{contract.String()}}, false},
+		{"no addresses", types.MsgRegisterERC721{Authority: authority}, false},
+		{"invalid address", types.MsgRegisterERC721{Authority: authority, Erc721Addresses: []string{"0x"}}, false},
+		{"duplicated address", types.MsgRegisterERC721{Authority: authority, Erc721Addresses: []string{contract.String(), strings.ToLower(contract.Hex())}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmostypes "github.com/evmos/evmos/v16/types"
+)
+
+// TokenIDToBigInt returns the ERC721 token ID of an ICS-721 token identifier.
+// The identifiers that are canonical decimal representations of an uint256 keep
+// their value, so that the tokens of ERC721 contracts keep their ID when they
+// return to the EVM. Otherwise, the token ID is the Keccak256 hash of the
+// identifier.
+func TokenIDToBigInt(id string) *big.Int {
+	if tokenID, ok := ParseERC721TokenID(id); ok {
+		return tokenID
+	}
+	return new(big.Int).SetBytes(crypto.Keccak256([]byte(id)))
+}
+
+// ParseERC721TokenID parses a canonical decimal representation of an uint256
+// ERC721 token ID.
+func ParseERC721TokenID(id string) (*big.Int, bool) {
+	tokenID, ok := new(big.Int).SetString(id, 10)
+	if !ok || tokenID.Sign() < 0 || tokenID.BitLen() > 256 || tokenID.String() != id {
+		return nil, false
+	}
+	return tokenID, true
+}
+
+// GetERC721Contract casts the hex string address of the class precompile to
+// common.Address
+func (c Class) GetERC721Contract() common.Address {
+	return common.HexToAddress(c.Erc721Address)
+}
+
+// Validate performs a stateless validation of a Class
+func (c Class) Validate() error {
+	return evmostypes.ValidateAddress(c.Erc721Address)
+}
+
+// GetERC721Contract casts the hex string address of the class precompile to
+// common.Address
+func (n NFT) GetERC721Contract() common.Address {
+	return common.HexToAddress(n.Erc721Address)
+}
+
+// GetOwnerAddress casts the hex string address of the token owner to common.Address
+func (n NFT) GetOwnerAddress() common.Address {
+	return common.HexToAddress(n.Owner)
+}
+
+// GetTokenID returns the ERC721 token ID of the token
+func (n NFT) GetTokenID() *big.Int {
+	return TokenIDToBigInt(n.Id)
+}
+
+// Validate performs a stateless validation of a NFT
+func (n NFT) Validate() error {
+	if strings.TrimSpace(n.Id) == "" {
+		return fmt.Errorf("token id cannot be blank")
+	}
+
+	if err := evmostypes.ValidateNonZeroAddress(n.Owner); err != nil {
+		return err
+	}
+
+	return evmostypes.ValidateAddress(n.Erc721Address)
+}
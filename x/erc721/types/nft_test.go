@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func TestTokenIDToBigInt(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	testCases := []struct {
+		name  string
+		id    string
+		expID *big.Int
+		expOk bool
+	}{
+		{"zero", "0", big.NewInt(0), true},
+		{"decimal", "42", big.NewInt(42), true},
+		{"max uint256", maxUint256.String(), maxUint256, true},
+		{"overflow", new(big.Int).Add(maxUint256, big.NewInt(1)).String(), nil, false},
+		{"leading zero", "042", nil, false},
+		{"negative", "-1", nil, false},
+		{"hex", "0x2a", nil, false},
+		{"text", "punk", nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, ok := types.ParseERC721TokenID(tc.id)
+			require.Equal(t, tc.expOk, ok)
+
+			expID := tc.expID
+			if !ok {
+				expID = new(big.Int).SetBytes(crypto.Keccak256([]byte(tc.id)))
+			} else {
+				require.Equal(t, tc.expID, id)
+			}
+			require.Equal(t, expID, types.TokenIDToBigInt(tc.id))
+		})
+	}
+}
+
+func TestNFTValidate(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name    string
+		nft     types.NFT
+		expPass bool
+	}{
+		{"valid", types.NFT{Erc721Address: contract.String(), Id: "1", Owner: owner.String()}, true},
+		{"invalid contract", types.NFT{Erc721Address: "0x", Id: "1", Owner: owner.String()}, false},
+		{"blank id", types.NFT{Erc721Address: contract.String(), Id: "", Owner: owner.String()}, false},
+		{"invalid owner", types.NFT{Erc721Address: contract.String(), Id: "1", Owner: "owner"}, false},
+		{"zero owner", types.NFT{Erc721Address: contract.String(), Id: "1", Owner: "0x0000000000000000000000000000000000000000"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.nft.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
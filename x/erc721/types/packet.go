@@ -0,0 +1,170 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NonFungibleTokenPacketData defines a struct for the packet payload of the
+// ICS-721 specification. The JSON field names follow the specification.
+type NonFungibleTokenPacketData struct {
+	// ClassId is the class identifier, including the trace path of the class
+	ClassId string `json:"classId"` //nolint:revive,stylecheck // follows the ICS-721 field naming
+	// ClassUri is the optional off-chain class metadata URI
+	ClassUri string `json:"classUri,omitempty"` //nolint:revive,stylecheck // follows the ICS-721 field naming
+	// ClassData is the optional on-chain class metadata
+	ClassData string `json:"classData,omitempty"`
+	// TokenIds are the identifiers of the transferred tokens
+	TokenIds []string `json:"tokenIds"` //nolint:revive,stylecheck // follows the ICS-721 field naming
+	// TokenUris are the optional off-chain metadata URIs of the transferred tokens
+	TokenUris []string `json:"tokenUris,omitempty"` //nolint:revive,stylecheck // follows the ICS-721 field naming
+	// TokenData are the optional on-chain metadata of the transferred tokens
+	TokenData []string `json:"tokenData,omitempty"`
+	// Sender is the address of the sender on the source chain
+	Sender string `json:"sender"`
+	// Receiver is the address of the receiver on the destination chain
+	Receiver string `json:"receiver"`
+	// Memo is an optional memo
+	Memo string `json:"memo,omitempty"`
+}
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver, memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		ClassData: classData,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic performs a basic check of the packet fields.
+func (data NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(data.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+
+	if err := ParseClassTrace(data.ClassId).Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenIDs(data.TokenIds); err != nil {
+		return err
+	}
+
+	if len(data.TokenUris) != 0 && len(data.TokenUris) != len(data.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacket, "token uris length (%d) must match the token ids length (%d)", len(data.TokenUris), len(data.TokenIds))
+	}
+
+	if len(data.TokenData) != 0 && len(data.TokenData) != len(data.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacket, "token data length (%d) must match the token ids length (%d)", len(data.TokenData), len(data.TokenIds))
+	}
+
+	if strings.TrimSpace(data.Sender) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "sender address cannot be blank")
+	}
+
+	if strings.TrimSpace(data.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "receiver address cannot be blank")
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising the packet data to sorted JSON bytes.
+func (data NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// GetTokenURI returns the URI of the token at the given index or an empty
+// string if the packet doesn't contain the token URIs.
+func (data NonFungibleTokenPacketData) GetTokenURI(i int) string {
+	if len(data.TokenUris) == 0 {
+		return ""
+	}
+	return data.TokenUris[i]
+}
+
+// GetTokenData returns the data of the token at the given index or an empty
+// string if the packet doesn't contain the token data.
+func (data NonFungibleTokenPacketData) GetTokenData(i int) string {
+	if len(data.TokenData) == 0 {
+		return ""
+	}
+	return data.TokenData[i]
+}
+
+// ValidateTokenIDs checks that the token identifiers of a transfer are not
+// blank nor duplicated.
+func ValidateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(tokenIDs))
+	for _, id := range tokenIDs {
+		if strings.TrimSpace(id) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+		if seen[id] {
+			return errorsmod.Wrapf(ErrInvalidTokenID, "duplicated token id %s", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// ClassMetadata defines the ERC721 metadata of a class that is propagated in
+// the class data of the packets.
+type ClassMetadata struct {
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+// EncodeClassData returns the base64 encoded JSON of the ERC721 metadata of a
+// class.
+func EncodeClassData(name, symbol string) string {
+	bz, err := json.Marshal(ClassMetadata{Name: name, Symbol: symbol})
+	if err != nil {
+		panic(err)
+	}
+	return base64.StdEncoding.EncodeToString(bz)
+}
+
+// DecodeClassData returns the ERC721 metadata contained in the class data of a
+// packet. It returns false if the class data wasn't encoded with
+// EncodeClassData.
+func DecodeClassData(classData string) (ClassMetadata, bool) {
+	bz, err := base64.StdEncoding.DecodeString(classData)
+	if err != nil {
+		return ClassMetadata{}, false
+	}
+
+	var metadata ClassMetadata
+	if err := json.Unmarshal(bz, &metadata); err != nil || metadata.Name == "" {
+		return ClassMetadata{}, false
+	}
+
+	return metadata, true
+}
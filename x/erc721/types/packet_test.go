@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		data    types.NonFungibleTokenPacketData
+		expPass bool
+	}{
+		{
+			"valid packet",
+			types.NewNonFungibleTokenPacketData("class", "", "", []string{"1", "2"}, nil, nil, "sender", "receiver", ""),
+			true,
+		},
+		{
+			"valid packet with metadata",
+			types.NewNonFungibleTokenPacketData("nft-transfer/channel-0/class", "uri", "data", []string{"1", "2"}, []string{"uri1", ""}, []string{"", "data2"}, "sender", "receiver", "memo"),
+			true,
+		},
+		{
+			"blank class id",
+			types.NewNonFungibleTokenPacketData(" ", "", "", []string{"1"}, nil, nil, "sender", "receiver", ""),
+			false,
+		},
+		{
+			"no token ids",
+			types.NewNonFungibleTokenPacketData("class", "", "", nil, nil, nil, "sender", "receiver", ""),
+			false,
+		},
+		{
+			"duplicated token ids",
+			types.NewNonFungibleTokenPacketData("class", "", "", []string{"1", "1"}, nil, nil, "sender", "receiver", ""),
+			false,
+		},
+		{
+			"token uris length mismatch",
+			types.NewNonFungibleTokenPacketData("class", "", "", []string{"1", "2"}, []string{"uri1"}, nil, "sender", "receiver", ""),
+			false,
+		},
+		{
+			"token data length mismatch",
+			types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, []string{"data1", "data2"}, "sender", "receiver", ""),
+			false,
+		},
+		{
+			"blank sender",
+			types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, nil, "", "receiver", ""),
+			false,
+		},
+		{
+			"blank receiver",
+			types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, nil, "sender", " ", ""),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.data.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNonFungibleTokenPacketDataGetBytes(t *testing.T) {
+	data := types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, nil, "sender", "receiver", "")
+	require.Equal(t, `{"classId":"class","receiver":"receiver","sender":"sender","tokenIds":["1"]}`, string(data.GetBytes()))
+
+	require.Equal(t, "", data.GetTokenURI(0))
+	require.Equal(t, "", data.GetTokenData(0))
+}
+
+func TestClassData(t *testing.T) {
+	metadata, ok := types.DecodeClassData(types.EncodeClassData("Punks", "PUNK"))
+	require.True(t, ok)
+	require.Equal(t, types.ClassMetadata{Name: "Punks", Symbol: "PUNK"}, metadata)
+
+	_, ok = types.DecodeClassData("not base64")
+	require.False(t, ok)
+
+	_, ok = types.DecodeClassData("")
+	require.False(t, ok)
+}
@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewParams creates a new Params object
+func NewParams(enableErc721 bool) Params {
+	return Params{
+		EnableErc721: enableErc721,
+	}
+}
+
+// DefaultParams returns the default erc721 module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableErc721: true,
+	}
+}
+
+// ValidateBool validates that the given parameter is a boolean
+func ValidateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the erc721 module parameters
+func (p Params) Validate() error {
+	return ValidateBool(p.EnableErc721)
+}
@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// ParseClassTrace parses a string with the ibc prefix (class trace) and the base
+// class identifier into a ClassTrace type.
+//
+// Examples:
+//
+//   - "portidone/channel-0/classid" => ClassTrace{Path: "portidone/channel-0", BaseClassId: "classid"}
+//   - "portidone/channel-0/erc721/0x1" => ClassTrace{Path: "portidone/channel-0", BaseClassId: "erc721/0x1"}
+//   - "classid" => ClassTrace{Path: "", BaseClassId: "classid"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	parts := strings.Split(rawClassID, "/")
+
+	// the path is made of the leading port/channel identifier pairs
+	i := 0
+	for ; i+2 < len(parts); i += 2 {
+		if host.PortIdentifierValidator(parts[i]) != nil ||
+			!strings.HasPrefix(parts[i+1], "channel-") ||
+			host.ChannelIdentifierValidator(parts[i+1]) != nil {
+			break
+		}
+	}
+
+	return ClassTrace{
+		Path:        strings.Join(parts[:i], "/"),
+		BaseClassId: strings.Join(parts[i:], "/"),
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the ClassTrace fields using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseClassId)
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetPrefix returns the receiving class prefix composed by the trace info and a separator.
+func (ct ClassTrace) GetPrefix() string {
+	return ct.Path + "/"
+}
+
+// IBCClassID a class identifier for an ICS-721 non-fungible token in the
+// format 'ibc/{hash(tracePath + baseClassId)}'. If the trace is empty, it will
+// return the base class identifier.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path != "" {
+		return fmt.Sprintf("ibc/%s", ct.Hash())
+	}
+	return ct.BaseClassId
+}
+
+// GetFullClassPath returns the full class path according to the ICS-721
+// specification: tracePath + "/" + baseClassId. If there exists no trace then
+// the base class identifier is returned.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.GetPrefix() + ct.BaseClassId
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	if strings.TrimSpace(ct.BaseClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "base class id cannot be blank")
+	}
+
+	if ct.Path == "" {
+		return nil
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return errorsmod.Wrapf(ErrInvalidClassID, "trace path %s must contain port/channel identifier pairs", ct.Path)
+	}
+
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return errorsmod.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateClassID performs a basic validation of an ICS-721 class identifier.
+// The identifiers of the classes received over IBC must have the format
+// 'ibc/{hash(tracePath + baseClassId)}'.
+func ValidateClassID(classID string) error {
+	if strings.TrimSpace(classID) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+
+	parts := strings.Split(classID, "/")
+	if parts[0] != "ibc" {
+		return nil
+	}
+
+	if len(parts) != 2 || parts[1] == "" {
+		return errorsmod.Wrapf(ErrInvalidClassID, "class id %s must have the format 'ibc/{hash}'", classID)
+	}
+
+	if _, err := transfertypes.ParseHexHash(parts[1]); err != nil {
+		return errorsmod.Wrapf(ErrInvalidClassID, "invalid class trace hash %s: %s", parts[1], err)
+	}
+
+	return nil
+}
+
+// ParseClassTraceHash returns the trace hash of a class identifier with the
+// 'ibc/{hash}' format.
+func ParseClassTraceHash(classID string) (tmbytes.HexBytes, error) {
+	if err := ValidateClassID(classID); err != nil {
+		return nil, err
+	}
+
+	hash, found := strings.CutPrefix(classID, "ibc/")
+	if !found {
+		return nil, errorsmod.Wrapf(ErrInvalidClassID, "class id %s is not an IBC class", classID)
+	}
+
+	return transfertypes.ParseHexHash(hash)
+}
+
+// ReceiverChainIsSource returns true if the class originally came from the
+// receiving chain and false otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return strings.HasPrefix(classID, GetClassPrefix(sourcePort, sourceChannel))
+}
+
+// SenderChainIsSource returns false if the class originally came from the
+// receiving chain and true otherwise.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// GetClassPrefix returns the receiving class prefix
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
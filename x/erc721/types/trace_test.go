@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/x/erc721/types"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expTrace types.ClassTrace
+	}{
+		{"base class id", "class", types.ClassTrace{BaseClassId: "class"}},
+		{"base class id with slashes", "erc721/0x1", types.ClassTrace{BaseClassId: "erc721/0x1"}},
+		{"single hop", "nft-transfer/channel-0/class", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "class"}},
+		{"single hop with slashes", "nft-transfer/channel-0/erc721/0x1", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "erc721/0x1"}},
+		{"multiple hops", "nft-transfer/channel-1/wasm.stars1/channel-4/class", types.ClassTrace{Path: "nft-transfer/channel-1/wasm.stars1/channel-4", BaseClassId: "class"}},
+		{"port and channel only", "nft-transfer/channel-0", types.ClassTrace{BaseClassId: "nft-transfer/channel-0"}},
+		{"not a channel identifier", "nft-transfer/collection/class", types.ClassTrace{BaseClassId: "nft-transfer/collection/class"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trace := types.ParseClassTrace(tc.classID)
+			require.Equal(t, tc.expTrace, trace)
+			require.Equal(t, tc.classID, trace.GetFullClassPath())
+		})
+	}
+}
+
+func TestClassTraceIBCClassID(t *testing.T) {
+	trace := types.ParseClassTrace("nft-transfer/channel-0/class")
+	classID := trace.IBCClassID()
+	require.Equal(t, "ibc/"+trace.Hash().String(), classID)
+	require.NoError(t, types.ValidateClassID(classID))
+
+	hash, err := types.ParseClassTraceHash(classID)
+	require.NoError(t, err)
+	require.Equal(t, trace.Hash(), hash)
+
+	require.Equal(t, "class", types.ParseClassTrace("class").IBCClassID())
+}
+
+func TestClassTraceValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		trace   types.ClassTrace
+		expPass bool
+	}{
+		{"valid base class id", types.ClassTrace{BaseClassId: "class"}, true},
+		{"valid trace", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "class"}, true},
+		{"empty base class id", types.ClassTrace{Path: "nft-transfer/channel-0"}, false},
+		{"odd number of identifiers", types.ClassTrace{Path: "nft-transfer/channel-0/nft-transfer", BaseClassId: "class"}, false},
+		{"invalid channel identifier", types.ClassTrace{Path: "nft-transfer/c", BaseClassId: "class"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.trace.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateClassID(t *testing.T) {
+	testCases := []struct {
+		name    string
+		classID string
+		expPass bool
+	}{
+		{"valid class id", "class", true},
+		{"valid ERC721 class id", "erc721/0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", true},
+		{"valid IBC class id", types.ParseClassTrace("nft-transfer/channel-0/class").IBCClassID(), true},
+		{"blank class id", " ", false},
+		{"IBC class id without hash", "ibc/", false},
+		{"IBC class id with invalid hash", "ibc/ZZ", false},
+		{"IBC class id with extra segments", "ibc/" + types.ParseClassTrace("nft-transfer/channel-0/class").Hash().String() + "/class", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateClassID(tc.classID)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSenderChainIsSource(t *testing.T) {
+	require.True(t, types.SenderChainIsSource("nft-transfer", "channel-0", "class"))
+	require.True(t, types.SenderChainIsSource("nft-transfer", "channel-0", "nft-transfer/channel-1/class"))
+	require.False(t, types.SenderChainIsSource("nft-transfer", "channel-0", "nft-transfer/channel-0/class"))
+	require.True(t, types.ReceiverChainIsSource("nft-transfer", "channel-0", "nft-transfer/channel-0/class"))
+}
@@ -12,6 +12,12 @@ import (
 	"github.com/evmos/evmos/v16/x/evm/types"
 )
 
+const (
+	flagFromHeight = "from"
+	flagToHeight   = "to"
+	flagLimit      = "limit"
+)
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +32,11 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetDeploymentPolicyCmd(),
+		GetDeployerPermissionCmd(),
+		GetDeploymentFactoriesCmd(),
+		GetStateDiffCmd(),
+		GetContractStatsCmd(),
 	)
 	return cmd
 }
@@ -134,3 +145,229 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetDeploymentPolicyCmd queries the contract deployment policy
+func GetDeploymentPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment-policy",
+		Short: "Get the contract deployment policy",
+		Long:  "Get the contract deployment mode, the deployer allowlist and the denied callees.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeploymentPolicy(cmd.Context(), &types.QueryDeploymentPolicyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDeployerPermissionCmd queries if an account is allowed to deploy contracts
+func GetDeployerPermissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployer-permission ADDRESS",
+		Short: "Get if an account is allowed to deploy contracts",
+		Long:  "Get if an account is allowed to deploy contracts under the current deployment policy.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeployerPermissionRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.DeployerPermission(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDeploymentFactoriesCmd queries the factory contracts of the allowlisted deployers
+func GetDeploymentFactoriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment-factories",
+		Short: "Get the factory contracts deployed by the allowlisted deployers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeploymentFactoriesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DeploymentFactories(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deployment factories")
+	return cmd
+}
+
+// GetStateDiffCmd queries the EVM state changes between two heights
+func GetStateDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [ADDRESS]",
+		Short: "Get the EVM state changes between two heights",
+		Long: `Get the balance, nonce, code hash and storage changes of an account between the --from and --to heights.
+If no address is provided, get the addresses of all the accounts whose EVM state was written between the two heights,
+from the changeset index of the node (enabled with --evm.state-diff-index).
+The node must keep the state of both heights, either on the application store or on versiondb.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				req := &types.QueryTouchedAccountsRequest{
+					FromHeight: fromHeight,
+					ToHeight:   toHeight,
+					Pagination: pageReq,
+				}
+
+				res, err := queryClient.TouchedAccounts(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryStateDiffRequest{
+				Address:    address,
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			}
+
+			res, err := queryClient.StateDiff(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "Height of the original state")
+	cmd.Flags().Int64(flagToHeight, 0, "Height of the modified state")
+	_ = cmd.MarkFlagRequired(flagFromHeight)
+	_ = cmd.MarkFlagRequired(flagToHeight)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "touched accounts")
+	return cmd
+}
+
+// GetContractStatsCmd queries the contract statistics aggregated over the recent blocks
+func GetContractStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-stats [ADDRESS]",
+		Short: "Get the gas used, call and revert counts of the contracts over the recent blocks",
+		Long: `Get the gas used, call and revert counts of the contracts aggregated over the recent blocks, sorted by gas used.
+If an address is provided, only get the statistics of that contract.
+The node must collect the contract statistics (evm.contract-stats).`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint32(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryContractStatsRequest{
+				Limit: limit,
+			}
+
+			if len(args) == 1 {
+				req.Address, err = accountToHex(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.ContractStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flagLimit, 0, "Maximum number of contracts to return (0 = all)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -62,11 +62,19 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			txBuilder := clientCtx.TxConfig.NewTxBuilder()
+			tx, err := msg.BuildTx(txBuilder, rsp.Params.EvmDenom)
 			if err != nil {
 				return err
 			}
 
+			// the fee granter pays the fees of the sender through its fee grant allowance,
+			// as long as it's in the access list of the signed transaction
+			if clientCtx.FeeGranter != nil {
+				txBuilder.SetFeeGranter(clientCtx.FeeGranter)
+				tx = txBuilder.GetTx()
+			}
+
 			if clientCtx.GenerateOnly {
 				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
 				if err != nil {
@@ -70,6 +70,10 @@ func InitGenesis(
 		}
 	}
 
+	for _, factory := range data.DeploymentFactories {
+		k.SetDeploymentFactory(ctx, common.HexToAddress(factory.Address), common.HexToAddress(factory.Deployer))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -98,7 +102,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:            ethGenAccounts,
+		Params:              k.GetParams(ctx),
+		DeploymentFactories: k.GetDeploymentFactories(ctx),
 	}
 }
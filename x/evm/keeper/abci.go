@@ -16,14 +16,18 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, and emits the contract statistics of the block if they are enabled. The speculative
+// execution of the block, if still running, is stopped before the state is committed. The EVM
+// end block logic doesn't update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	k.CancelPreExecution()
+
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
+	k.EmitContractStats(infCtx)
 
 	return []abci.ValidatorUpdate{}
 }
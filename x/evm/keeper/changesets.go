@@ -0,0 +1,179 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+var _ baseapp.StreamingService = &ChangesetIndex{}
+
+// ChangesetIndex is a streaming service that indexes the addresses of the
+// accounts whose auth account, bank balances or EVM storage were written in
+// each committed block. The index is stored in a local database, outside of
+// the consensus state, under the block height followed by the address.
+type ChangesetIndex struct {
+	db dbm.DB
+
+	accountKey storetypes.StoreKey
+	bankKey    storetypes.StoreKey
+	evmKey     storetypes.StoreKey
+
+	listeners map[storetypes.StoreKey][]storetypes.WriteListener
+}
+
+// NewChangesetIndex creates a changeset index on the given database that
+// listens to the writes on the auth, bank and EVM stores.
+func NewChangesetIndex(db dbm.DB, accountKey, bankKey, evmKey storetypes.StoreKey) *ChangesetIndex {
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener, 3)
+	for _, key := range []storetypes.StoreKey{accountKey, bankKey, evmKey} {
+		listeners[key] = []storetypes.WriteListener{storetypes.NewMemoryListener(key)}
+	}
+
+	return &ChangesetIndex{
+		db:         db,
+		accountKey: accountKey,
+		bankKey:    bankKey,
+		evmKey:     evmKey,
+		listeners:  listeners,
+	}
+}
+
+// Listeners implements baseapp.StreamingService
+func (ci *ChangesetIndex) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return ci.listeners
+}
+
+// Stream implements baseapp.StreamingService. The index is written on commit.
+func (ci *ChangesetIndex) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close implements baseapp.StreamingService
+func (ci *ChangesetIndex) Close() error {
+	return ci.db.Close()
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener
+func (ci *ChangesetIndex) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener
+func (ci *ChangesetIndex) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener
+func (ci *ChangesetIndex) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener. It indexes the addresses
+// written in the committed block. The errors are logged instead of returned
+// so that the index never affects the consensus.
+func (ci *ChangesetIndex) ListenCommit(goCtx context.Context, _ abci.ResponseCommit) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	height := ctx.BlockHeight()
+
+	batch := ci.db.NewBatch()
+	defer batch.Close()
+
+	for key, listeners := range ci.listeners {
+		for _, listener := range listeners {
+			for _, pair := range listener.(*storetypes.MemoryListener).PopStateCache() {
+				addr, ok := ci.changedAddress(key, pair.Key)
+				if !ok {
+					continue
+				}
+				if err := batch.Set(changesetKey(height, addr), []byte{}); err != nil {
+					ctx.Logger().Error("failed to index the changed account", "height", height, "error", err)
+					return nil
+				}
+			}
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		ctx.Logger().Error("failed to write the changeset index", "height", height, "error", err)
+	}
+	return nil
+}
+
+// changedAddress returns the Ethereum address of the account a written key
+// belongs to, if any.
+func (ci *ChangesetIndex) changedAddress(storeKey storetypes.StoreKey, key []byte) (common.Address, bool) {
+	var addr []byte
+
+	switch {
+	case storeKey == ci.accountKey && bytes.HasPrefix(key, authtypes.AddressStoreKeyPrefix):
+		addr = key[len(authtypes.AddressStoreKeyPrefix):]
+	case storeKey == ci.bankKey && bytes.HasPrefix(key, banktypes.BalancesPrefix):
+		addr = lengthPrefixedAddress(key[len(banktypes.BalancesPrefix):])
+	case storeKey == ci.evmKey && bytes.HasPrefix(key, types.KeyPrefixStorage):
+		if len(key) >= len(types.KeyPrefixStorage)+common.AddressLength {
+			addr = key[len(types.KeyPrefixStorage) : len(types.KeyPrefixStorage)+common.AddressLength]
+		}
+	}
+
+	if len(addr) != common.AddressLength {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(addr), true
+}
+
+// TouchedAddresses walks the changesets of the blocks in the height range
+// (fromHeight, toHeight] and returns the set of the addresses written.
+func (ci *ChangesetIndex) TouchedAddresses(fromHeight, toHeight int64) (map[common.Address]struct{}, error) {
+	it, err := ci.db.Iterator(
+		sdk.Uint64ToBigEndian(uint64(fromHeight+1)),
+		sdk.Uint64ToBigEndian(uint64(toHeight+1)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate the changeset index: %w", err)
+	}
+	defer it.Close()
+
+	touched := make(map[common.Address]struct{})
+	for ; it.Valid(); it.Next() {
+		touched[common.BytesToAddress(it.Key()[8:])] = struct{}{}
+	}
+
+	return touched, it.Error()
+}
+
+// changesetKey returns the index key of an address written at a height.
+func changesetKey(height int64, addr common.Address) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), addr.Bytes()...)
+}
+
+// lengthPrefixedAddress returns the address of a key that starts with a
+// length prefixed address, or nil if the key is malformed.
+func lengthPrefixedAddress(key []byte) []byte {
+	if len(key) == 0 {
+		return nil
+	}
+
+	addrLen := int(key[0])
+	if len(key) < 1+addrLen || addrLen > address.MaxAddrLen {
+		return nil
+	}
+
+	return key[1 : 1+addrLen]
+}
@@ -0,0 +1,301 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// contractStatsTelemetryTop is the number of contracts with the highest gas
+// used over the window whose statistics are reported as telemetry gauges.
+const contractStatsTelemetryTop = 10
+
+// EnableContractStats enables the collection of the gas used, call and revert
+// counts of the contracts executed in each block. The statistics are emitted as
+// events at the end of the block and aggregated over the given number of blocks
+// for the ContractStats query and the telemetry. The statistics are local to the
+// node and don't affect the state.
+func (k *Keeper) EnableContractStats(window int) {
+	k.contractStats = newContractStatsWindow(window)
+}
+
+// GetContractStatsTransient returns the statistics of the contracts executed in
+// the current block, sorted by address.
+func (k Keeper) GetContractStatsTransient(ctx sdk.Context) []types.ContractStats {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientContractStats)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var stats []types.ContractStats
+	for ; iterator.Valid(); iterator.Next() {
+		var contractStats types.ContractStats
+		k.cdc.MustUnmarshal(iterator.Value(), &contractStats)
+		stats = append(stats, contractStats)
+	}
+
+	return stats
+}
+
+// addContractStatsTransient adds the statistics collected during a message
+// execution to the statistics of the current block.
+func (k Keeper) addContractStatsTransient(ctx sdk.Context, stats map[common.Address]*types.ContractStats) {
+	// the transient store accesses must not affect the gas consumption of the
+	// transaction
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientContractStats)
+
+	for address, executionStats := range stats {
+		contractStats := types.ContractStats{Address: address.Hex()}
+		if bz := store.Get(address.Bytes()); len(bz) > 0 {
+			k.cdc.MustUnmarshal(bz, &contractStats)
+		}
+
+		contractStats.GasUsed += executionStats.GasUsed
+		contractStats.Calls += executionStats.Calls
+		contractStats.Reverts += executionStats.Reverts
+		store.Set(address.Bytes(), k.cdc.MustMarshal(&contractStats))
+	}
+}
+
+// copyContractStatsTransient copies the contract statistics of the current
+// block from the cache context of a transaction that is not committed, so that
+// the executions of the reverted transactions are counted.
+func (k Keeper) copyContractStatsTransient(from, to sdk.Context) {
+	if k.contractStats == nil {
+		return
+	}
+
+	to = to.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store := prefix.NewStore(to.TransientStore(k.transientKey), types.KeyPrefixTransientContractStats)
+	for _, contractStats := range k.GetContractStatsTransient(from.WithGasMeter(sdk.NewInfiniteGasMeter())) {
+		contractStats := contractStats
+		store.Set(common.HexToAddress(contractStats.Address).Bytes(), k.cdc.MustMarshal(&contractStats))
+	}
+}
+
+// EmitContractStats emits the statistics of the contracts executed in the
+// current block as events, and adds them to the aggregated statistics of the
+// recent blocks. It's a no-op if the contract statistics are disabled.
+func (k Keeper) EmitContractStats(ctx sdk.Context) {
+	if k.contractStats == nil {
+		return
+	}
+
+	stats := k.GetContractStatsTransient(ctx)
+	for _, contractStats := range stats {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContractStats,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractStats.Address),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(contractStats.GasUsed, 10)),
+				sdk.NewAttribute(types.AttributeKeyCalls, strconv.FormatUint(contractStats.Calls, 10)),
+				sdk.NewAttribute(types.AttributeKeyReverts, strconv.FormatUint(contractStats.Reverts, 10)),
+			),
+		)
+	}
+
+	k.contractStats.add(ctx.BlockHeight(), stats)
+
+	aggregated, _, _ := k.contractStats.aggregate()
+	if len(aggregated) > contractStatsTelemetryTop {
+		aggregated = aggregated[:contractStatsTelemetryTop]
+	}
+	for _, contractStats := range aggregated {
+		labels := []metrics.Label{telemetry.NewLabel(types.AttributeKeyContractAddress, contractStats.Address)}
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "contract", "gas_used"}, float32(contractStats.GasUsed), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "contract", "calls"}, float32(contractStats.Calls), labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "contract", "reverts"}, float32(contractStats.Reverts), labels)
+	}
+}
+
+// blockContractStats are the contract statistics of a block.
+type blockContractStats struct {
+	height int64
+	stats  []types.ContractStats
+}
+
+// contractStatsWindow holds the contract statistics of the most recent blocks.
+type contractStatsWindow struct {
+	mu     sync.RWMutex
+	size   int
+	blocks []blockContractStats
+}
+
+// newContractStatsWindow creates a window of the given number of blocks.
+func newContractStatsWindow(size int) *contractStatsWindow {
+	if size < 1 {
+		size = 1
+	}
+	return &contractStatsWindow{size: size}
+}
+
+// add adds the statistics of a block, evicting the oldest block if the window
+// is full.
+func (w *contractStatsWindow) add(height int64, stats []types.ContractStats) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.blocks = append(w.blocks, blockContractStats{height: height, stats: stats})
+	if len(w.blocks) > w.size {
+		w.blocks = w.blocks[len(w.blocks)-w.size:]
+	}
+}
+
+// aggregate returns the statistics of the contracts summed over the blocks of
+// the window, sorted by gas used in descending order, along with the height
+// range of the window.
+func (w *contractStatsWindow) aggregate() (stats []types.ContractStats, fromHeight, toHeight int64) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if len(w.blocks) == 0 {
+		return nil, 0, 0
+	}
+
+	totals := make(map[string]*types.ContractStats)
+	for _, block := range w.blocks {
+		for _, contractStats := range block.stats {
+			total, ok := totals[contractStats.Address]
+			if !ok {
+				total = &types.ContractStats{Address: contractStats.Address}
+				totals[contractStats.Address] = total
+			}
+			total.GasUsed += contractStats.GasUsed
+			total.Calls += contractStats.Calls
+			total.Reverts += contractStats.Reverts
+		}
+	}
+
+	stats = make([]types.ContractStats, 0, len(totals))
+	for _, total := range totals {
+		stats = append(stats, *total)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].GasUsed != stats[j].GasUsed {
+			return stats[i].GasUsed > stats[j].GasUsed
+		}
+		return stats[i].Address < stats[j].Address
+	})
+
+	return stats, w.blocks[0].height, w.blocks[len(w.blocks)-1].height
+}
+
+var _ vm.EVMLogger = &contractStatsCollector{}
+
+// contractStatsCollector is a vm.EVMLogger that collects the gas used, call and
+// revert counts of the contracts executed by a message. The gas used by a call
+// frame is attributed to the code it executes, i.e. the callee of a
+// DELEGATECALL, excluding the gas used by its nested calls. The intrinsic gas
+// and the refunds are not attributed to any contract. The calls to accounts
+// without code are ignored. The calls to the wrapped tracer are forwarded as
+// they are.
+type contractStatsCollector struct {
+	vm.EVMLogger
+
+	env    *vm.EVM
+	frames []contractStatsFrame
+	stats  map[common.Address]*types.ContractStats
+}
+
+// contractStatsFrame is a call frame of the execution.
+type contractStatsFrame struct {
+	address common.Address
+	// contract is false if the callee has no code
+	contract bool
+	// nestedGasUsed is the gas used by the nested calls of the frame
+	nestedGasUsed uint64
+}
+
+// newContractStatsCollector wraps the tracer with a contract statistics collector.
+func newContractStatsCollector(tracer vm.EVMLogger) *contractStatsCollector {
+	return &contractStatsCollector{
+		EVMLogger: tracer,
+		stats:     make(map[common.Address]*types.ContractStats),
+	}
+}
+
+// CaptureStart implements vm.EVMLogger. It opens the frame of the top-level call.
+func (c *contractStatsCollector) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	c.env = env
+	c.enter(to, create)
+	c.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureEnd implements vm.EVMLogger. It closes the frame of the top-level call.
+func (c *contractStatsCollector) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {
+	c.exit(gasUsed, err)
+	c.EVMLogger.CaptureEnd(output, gasUsed, t, err)
+}
+
+// CaptureEnter implements vm.EVMLogger. It opens the frame of a nested call.
+func (c *contractStatsCollector) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	c.enter(to, typ == vm.CREATE || typ == vm.CREATE2)
+	c.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit implements vm.EVMLogger. It closes the frame of a nested call.
+func (c *contractStatsCollector) CaptureExit(output []byte, gasUsed uint64, err error) {
+	c.exit(gasUsed, err)
+	c.EVMLogger.CaptureExit(output, gasUsed, err)
+}
+
+// enter pushes the frame of a call to the given address.
+func (c *contractStatsCollector) enter(address common.Address, create bool) {
+	contract := create
+	if !contract && c.env != nil {
+		_, isPrecompile := c.env.Precompile(address)
+		contract = isPrecompile || c.env.StateDB.GetCodeSize(address) > 0
+	}
+
+	c.frames = append(c.frames, contractStatsFrame{address: address, contract: contract})
+}
+
+// exit pops the current frame and records the statistics of its callee.
+func (c *contractStatsCollector) exit(gasUsed uint64, err error) {
+	if len(c.frames) == 0 {
+		return
+	}
+
+	frame := c.frames[len(c.frames)-1]
+	c.frames = c.frames[:len(c.frames)-1]
+
+	if len(c.frames) > 0 {
+		c.frames[len(c.frames)-1].nestedGasUsed += gasUsed
+	}
+
+	if !frame.contract {
+		return
+	}
+
+	stats, ok := c.stats[frame.address]
+	if !ok {
+		stats = &types.ContractStats{Address: frame.address.Hex()}
+		c.stats[frame.address] = stats
+	}
+
+	if gasUsed > frame.nestedGasUsed {
+		stats.GasUsed += gasUsed - frame.nestedGasUsed
+	}
+	stats.Calls++
+	if err != nil {
+		stats.Reverts++
+	}
+}
+
+// Stats returns the statistics collected during the execution.
+func (c *contractStatsCollector) Stats() map[common.Address]*types.ContractStats {
+	return c.stats
+}
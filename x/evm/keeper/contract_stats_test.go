@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v16/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v16/testutil"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestContractStats() {
+	suite.SetupTest()
+
+	_, err := suite.queryClient.ContractStats(suite.ctx, &types.QueryContractStatsRequest{})
+	suite.Require().ErrorContains(err, "contract statistics are not enabled")
+
+	suite.app.EvmKeeper.EnableContractStats(2)
+
+	contract := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1_000_000))
+	suite.TransferERC20Token(suite.T(), contract, suite.address, utiltx.GenerateAddress(), big.NewInt(10))
+
+	// the transfer of a sender without tokens reverts
+	sender, key := utiltx.NewAddrKey()
+	coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1e18)))
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender.Bytes(), coins))
+	res := suite.deliverEthTxs(suite.ctx, []*types.MsgEthereumTx{
+		suite.erc20TransferTx(key, sender, 0, contract, utiltx.GenerateAddress()),
+	})
+	suite.Require().NotEmpty(res[0].VmError)
+
+	// the transfers to accounts without code are ignored
+	suite.deliverEthTxs(suite.ctx, []*types.MsgEthereumTx{
+		suite.signedTx(key, &types.EvmTxArgs{Nonce: 1, To: &suite.address, GasLimit: 21_000, Amount: big.NewInt(1)}, sender),
+	})
+
+	stats := suite.app.EvmKeeper.GetContractStatsTransient(suite.ctx)
+	suite.Require().Len(stats, 1)
+	suite.Require().Equal(contract.Hex(), stats[0].Address)
+	suite.Require().Equal(uint64(3), stats[0].Calls)
+	suite.Require().Equal(uint64(1), stats[0].Reverts)
+	suite.Require().NotZero(stats[0].GasUsed)
+
+	height := suite.ctx.BlockHeight()
+	em := sdk.NewEventManager()
+	suite.app.EvmKeeper.EndBlock(suite.ctx.WithEventManager(em), abci.RequestEndBlock{})
+	suite.Require().Len(em.Events(), 2)
+	suite.Require().Equal(types.EventTypeContractStats, em.Events()[1].Type)
+
+	queryRes, err := suite.queryClient.ContractStats(suite.ctx, &types.QueryContractStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(stats, queryRes.Stats)
+	suite.Require().Equal(height, queryRes.FromHeight)
+	suite.Require().Equal(height, queryRes.ToHeight)
+
+	// the statistics are aggregated over the window
+	for i := int64(1); i <= 2; i++ {
+		suite.app.EvmKeeper.EndBlock(suite.ctx.WithBlockHeight(height+i), abci.RequestEndBlock{})
+	}
+
+	queryRes, err = suite.queryClient.ContractStats(suite.ctx, &types.QueryContractStatsRequest{Address: contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.Stats, 1)
+	suite.Require().Equal(2*stats[0].GasUsed, queryRes.Stats[0].GasUsed)
+	suite.Require().Equal(2*stats[0].Calls, queryRes.Stats[0].Calls)
+	suite.Require().Equal(height+1, queryRes.FromHeight)
+	suite.Require().Equal(height+2, queryRes.ToHeight)
+
+	queryRes, err = suite.queryClient.ContractStats(suite.ctx, &types.QueryContractStatsRequest{Address: utiltx.GenerateAddress().Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(queryRes.Stats)
+
+	_, err = suite.queryClient.ContractStats(suite.ctx, &types.QueryContractStatsRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestContractStatsSpeculativeExecution() {
+	suite.SetupTest()
+	suite.app.EvmKeeper.EnableContractStats(1)
+
+	contract := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1_000_000))
+
+	senders := make([]common.Address, 3)
+	keys := make([]*ethsecp256k1.PrivKey, len(senders))
+	for i := range senders {
+		senders[i], keys[i] = utiltx.NewAddrKey()
+		coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1e18)))
+		suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, senders[i].Bytes(), coins))
+		suite.TransferERC20Token(suite.T(), contract, suite.address, senders[i], big.NewInt(1000))
+	}
+	suite.Commit()
+
+	msgs := make([]*types.MsgEthereumTx, len(senders))
+	txs := make([]*ethtypes.Transaction, len(senders))
+	for i := range senders {
+		msgs[i] = suite.erc20TransferTx(keys[i], senders[i], 0, contract, utiltx.GenerateAddress())
+		txs[i] = msgs[i].AsTransaction()
+	}
+
+	serialCtx, _ := suite.ctx.CacheContext()
+	suite.deliverEthTxs(serialCtx, msgs)
+
+	parallelCtx, _ := suite.ctx.CacheContext()
+	suite.app.EvmKeeper.PreExecuteBlock(parallelCtx, txs, 2)
+	suite.deliverEthTxs(parallelCtx, msgs)
+
+	stats := suite.app.EvmKeeper.GetContractStatsTransient(serialCtx)
+	suite.Require().Len(stats, 1)
+	suite.Require().Equal(uint64(len(senders)), stats[0].Calls)
+	suite.Require().Equal(stats, suite.app.EvmKeeper.GetContractStatsTransient(parallelCtx))
+}
@@ -0,0 +1,248 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// GetDeploymentFactory returns the allowlisted deployer of the given factory
+// contract. It returns false if the contract is not a factory.
+func (k Keeper) GetDeploymentFactory(ctx sdk.Context, factory common.Address) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeploymentFactory)
+	bz := store.Get(factory.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetDeploymentFactory stores the allowlisted deployer of a factory contract.
+func (k Keeper) SetDeploymentFactory(ctx sdk.Context, factory, deployer common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeploymentFactory)
+	store.Set(factory.Bytes(), deployer.Bytes())
+}
+
+// IterateDeploymentFactories iterates over all the factory contracts and performs a
+// callback function. The iteration stops if the callback returns true.
+func (k Keeper) IterateDeploymentFactories(ctx sdk.Context, cb func(factory, deployer common.Address) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeploymentFactory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(common.BytesToAddress(iterator.Key()), common.BytesToAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetDeploymentFactories returns all the factory contracts.
+func (k Keeper) GetDeploymentFactories(ctx sdk.Context) []types.DeploymentFactory {
+	factories := []types.DeploymentFactory{}
+	k.IterateDeploymentFactories(ctx, func(factory, deployer common.Address) bool {
+		factories = append(factories, types.DeploymentFactory{
+			Address:  factory.Hex(),
+			Deployer: deployer.Hex(),
+		})
+		return false
+	})
+	return factories
+}
+
+// CanDeploy returns true if the given address is allowed to deploy contracts
+// under the deployment policy. On the allowlisted factories mode, the contracts
+// deployed by an allowlisted account are allowed to deploy as long as their
+// deployer remains on the allowlist.
+func (k Keeper) CanDeploy(ctx sdk.Context, policy types.DeploymentPolicy, address common.Address) bool {
+	if policy.IsPermissionless() || policy.IsAllowlistedDeployer(address) {
+		return true
+	}
+
+	if !policy.AllowFactories() {
+		return false
+	}
+
+	deployer, found := k.GetDeploymentFactory(ctx, address)
+	return found && policy.IsAllowlistedDeployer(deployer)
+}
+
+var _ vm.EVMLogger = &deploymentGuard{}
+
+// deploymentGuard is a vm.EVMLogger that enforces the deployment policy on the
+// nested CREATE, CREATE2 and call operations performed during the EVM execution.
+// The calls to the wrapped tracer are forwarded as they are.
+//
+// NOTE: the EVM doesn't expose hooks to abort the execution, and its interpreter
+// never checks whether the execution was cancelled. So on the first violation,
+// the guard disables the stateful precompiles, whose changes aren't reverted with
+// the StateDB, and drains the gas of every frame that executes an opcode
+// afterwards, so that the frames fail with an out of gas error. The state
+// transition then reverts the StateDB changes once the execution returns.
+type deploymentGuard struct {
+	vm.EVMLogger
+
+	ctx    sdk.Context
+	keeper *Keeper
+	policy types.DeploymentPolicy
+
+	err error
+	// precompiles are the precompiled contracts of the EVM and stateful are the
+	// addresses of the ones that are disabled on a violation
+	precompiles map[common.Address]vm.PrecompiledContract
+	stateful    []common.Address
+	// factories are the contracts created by allowlisted deployers during the
+	// current execution
+	factories map[common.Address]common.Address
+}
+
+// newDeploymentGuard wraps the tracer with a guard for the deployment policy.
+func newDeploymentGuard(ctx sdk.Context, k *Keeper, policy types.DeploymentPolicy, tracer vm.EVMLogger) *deploymentGuard {
+	return &deploymentGuard{
+		EVMLogger: tracer,
+		ctx:       ctx,
+		keeper:    k,
+		policy:    policy,
+		factories: make(map[common.Address]common.Address),
+	}
+}
+
+// Err returns the deployment policy violation of the execution, if any.
+func (g *deploymentGuard) Err() error {
+	return g.err
+}
+
+// guardPrecompiles sets the precompiled contracts of the EVM, along with the
+// addresses of the stateful ones to disable on a violation. The denied callees
+// are disabled upfront, as the EVM resolves a precompile before entering it.
+func (g *deploymentGuard) guardPrecompiles(precompiles map[common.Address]vm.PrecompiledContract, stateful []common.Address) {
+	g.precompiles = precompiles
+	g.stateful = stateful
+
+	for address, precompile := range precompiles {
+		if g.policy.IsDeniedCallee(address) {
+			precompiles[address] = deniedPrecompile{
+				PrecompiledContract: precompile,
+				err:                 errorsmod.Wrapf(types.ErrCalleeDenied, "callee %s", address),
+			}
+		}
+	}
+}
+
+// CaptureStart implements vm.EVMLogger. It records the top-level creations of
+// the allowlisted deployers.
+func (g *deploymentGuard) CaptureStart(env *vm.EVM, from, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	if create {
+		g.recordFactory(from, to)
+	}
+	g.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureState implements vm.EVMLogger. It drains the gas of the frames that
+// keep executing after a violation, so that they fail.
+func (g *deploymentGuard) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if g.err != nil {
+		scope.Contract.Gas = 0
+	}
+	g.EVMLogger.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// CaptureEnter implements vm.EVMLogger. It checks the nested creations and calls
+// against the deployment policy.
+func (g *deploymentGuard) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	switch typ {
+	case vm.CREATE, vm.CREATE2:
+		if g.canDeploy(from) {
+			g.recordFactory(from, to)
+		} else {
+			g.fail(errorsmod.Wrapf(types.ErrDeploymentNotAllowed, "deployer %s is not allowed to deploy contracts", from))
+		}
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		if g.policy.IsDeniedCallee(to) {
+			g.fail(errorsmod.Wrapf(types.ErrCalleeDenied, "callee %s", to))
+		}
+	}
+	g.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// canDeploy checks the deployment permission, including the factories created
+// earlier in the same execution.
+func (g *deploymentGuard) canDeploy(from common.Address) bool {
+	if g.keeper.CanDeploy(g.ctx, g.policy, from) {
+		return true
+	}
+
+	deployer, found := g.factories[from]
+	return found && g.policy.AllowFactories() && g.policy.IsAllowlistedDeployer(deployer)
+}
+
+// recordFactory records the contracts created by allowlisted deployers.
+func (g *deploymentGuard) recordFactory(deployer, contract common.Address) {
+	if g.policy.IsAllowlistedDeployer(deployer) {
+		g.factories[contract] = deployer
+	}
+}
+
+// fail records the first violation and disables the stateful precompiles, so
+// that the rest of the execution can't perform any change outside of the StateDB.
+func (g *deploymentGuard) fail(err error) {
+	if g.err != nil {
+		return
+	}
+	g.err = err
+	for _, address := range g.stateful {
+		if precompile, ok := g.precompiles[address]; ok {
+			g.precompiles[address] = deniedPrecompile{PrecompiledContract: precompile, err: err}
+		}
+	}
+}
+
+var _ vm.PrecompiledContract = deniedPrecompile{}
+
+// deniedPrecompile is a precompiled contract that fails with the deployment
+// policy violation of the execution.
+type deniedPrecompile struct {
+	vm.PrecompiledContract
+	err error
+}
+
+// RequiredGas implements vm.PrecompiledContract.
+func (deniedPrecompile) RequiredGas([]byte) uint64 {
+	return 0
+}
+
+// Run implements vm.PrecompiledContract. It returns the deployment policy violation.
+func (p deniedPrecompile) Run(*vm.EVM, *vm.Contract, bool) ([]byte, error) {
+	return nil, p.err
+}
+
+// commitFactories persists the factories created during the execution. The
+// contracts whose creation failed have no code and are skipped.
+func (g *deploymentGuard) commitFactories(ctx sdk.Context) {
+	factories := make([]common.Address, 0, len(g.factories))
+	for factory := range g.factories {
+		factories = append(factories, factory)
+	}
+	// sort the factories to write them to the store in a deterministic order
+	sort.Slice(factories, func(i, j int) bool {
+		return bytes.Compare(factories[i].Bytes(), factories[j].Bytes()) < 0
+	})
+
+	for _, factory := range factories {
+		acct := g.keeper.GetAccountWithoutBalance(ctx, factory)
+		if acct == nil || !acct.IsContract() {
+			continue
+		}
+		g.keeper.SetDeploymentFactory(ctx, factory, g.factories[factory])
+	}
+}
@@ -0,0 +1,295 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
+	"github.com/evmos/evmos/v16/precompiles/staking"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// factoryInitCode is the init code of a contract that deploys an empty
+// contract from its constructor and returns a single STOP opcode as runtime code:
+// PUSH1 0 PUSH1 0 PUSH1 0 CREATE POP PUSH1 1 PUSH1 0 RETURN
+var factoryInitCode = hexutil.MustDecode("0x600060006000f05060016000f3")
+
+// callerCode returns the runtime code of a contract that calls the given address:
+// PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH20 <callee> GAS CALL STOP
+func callerCode(callee common.Address) []byte {
+	code := hexutil.MustDecode("0x60006000600060006000")
+	code = append(code, 0x73)
+	code = append(code, callee.Bytes()...)
+	return append(code, 0x5a, 0xf1, 0x00)
+}
+
+func (suite *KeeperTestSuite) TestDeploymentPolicy() {
+	var (
+		msg    core.Message
+		policy types.DeploymentPolicy
+	)
+
+	deniedCallee := utiltx.GenerateAddress()
+	caller := utiltx.GenerateAddress()
+	otherDeployer := utiltx.GenerateAddress()
+
+	newMessage := func(from common.Address, to *common.Address, data []byte) core.Message {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, from)
+		return ethtypes.NewMessage(from, to, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expErr     error
+		expVMErr   error
+		expFactory bool
+	}{
+		{
+			"pass - permissionless policy allows nested deployments",
+			func() {
+				policy = types.DefaultDeploymentPolicy()
+				msg = newMessage(suite.address, nil, factoryInitCode)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - sender not allowlisted",
+			func() {
+				policy = types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{otherDeployer.Hex()}, nil)
+				msg = newMessage(suite.address, nil, factoryInitCode)
+			},
+			types.ErrDeploymentNotAllowed,
+			nil,
+			false,
+		},
+		{
+			"fail - allowlisted EOAs mode rejects nested deployments",
+			func() {
+				policy = types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{suite.address.Hex()}, nil)
+				msg = newMessage(suite.address, nil, factoryInitCode)
+			},
+			nil,
+			types.ErrDeploymentNotAllowed,
+			false,
+		},
+		{
+			"pass - allowlisted factories mode allows nested deployments",
+			func() {
+				policy = types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES, []string{suite.address.Hex()}, nil)
+				msg = newMessage(suite.address, nil, factoryInitCode)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"fail - call to denied callee",
+			func() {
+				policy = types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_PERMISSIONLESS, nil, []string{deniedCallee.Hex()})
+				msg = newMessage(suite.address, &deniedCallee, nil)
+			},
+			types.ErrCalleeDenied,
+			nil,
+			false,
+		},
+		{
+			"fail - nested call to denied callee",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(caller, callerCode(deniedCallee))
+				suite.Require().NoError(vmdb.Commit())
+
+				policy = types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_PERMISSIONLESS, nil, []string{deniedCallee.Hex()})
+				msg = newMessage(suite.address, &caller, nil)
+			},
+			nil,
+			types.ErrCalleeDenied,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			tc.malleate()
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+			config.Params.DeploymentPolicy = policy
+
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+			contract := crypto.CreateAddress(msg.From(), msg.Nonce())
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			if tc.expVMErr != nil {
+				suite.Require().True(res.Failed())
+				suite.Require().Contains(res.VmError, tc.expVMErr.Error())
+				acct := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, contract)
+				suite.Require().True(acct == nil || !acct.IsContract())
+				return
+			}
+			suite.Require().False(res.Failed(), res.VmError)
+
+			deployer, found := suite.app.EvmKeeper.GetDeploymentFactory(suite.ctx, contract)
+			suite.Require().Equal(tc.expFactory, found)
+			if tc.expFactory {
+				suite.Require().Equal(suite.address, deployer)
+				suite.Require().True(suite.app.EvmKeeper.CanDeploy(suite.ctx, policy, contract))
+			}
+		})
+	}
+}
+
+// precompileCallerCode returns the code of a contract that calls the given
+// precompile with the input, after deploying an empty contract if create is set:
+// [PUSH1 0 PUSH1 0 PUSH1 0 CREATE POP] CODECOPY(0, <input offset>, <input length>)
+// CALL(GAS, <precompile>, 0, 0, <input length>, 0, 0) POP STOP <input>
+func precompileCallerCode(create bool, precompile common.Address, input []byte) []byte {
+	var code []byte
+	if create {
+		code = hexutil.MustDecode("0x600060006000f050")
+	}
+	size := []byte{byte(len(input) >> 8), byte(len(input))}
+	offset := len(code) + 45
+
+	code = append(code, 0x61)
+	code = append(code, size...)
+	code = append(code, 0x61, byte(offset>>8), byte(offset), 0x60, 0x00, 0x39)
+	code = append(code, 0x60, 0x00, 0x60, 0x00, 0x61)
+	code = append(code, size...)
+	code = append(code, 0x60, 0x00, 0x60, 0x00, 0x73)
+	code = append(code, precompile.Bytes()...)
+	code = append(code, 0x5a, 0xf1, 0x50, 0x00)
+	return append(code, input...)
+}
+
+func (suite *KeeperTestSuite) TestDeploymentPolicyPrecompiles() {
+	var (
+		msg    core.Message
+		policy types.DeploymentPolicy
+	)
+
+	stakingPrecompile := common.HexToAddress(staking.PrecompileAddress)
+	caller := utiltx.GenerateAddress()
+	grantee := utiltx.GenerateAddress()
+
+	stakingABI, err := staking.LoadABI()
+	suite.Require().NoError(err)
+	input, err := stakingABI.Pack(authorization.ApproveMethod, grantee, big.NewInt(1e18), []string{staking.DelegateMsg})
+	suite.Require().NoError(err)
+
+	newMessage := func(to *common.Address, data []byte) core.Message {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		return ethtypes.NewMessage(suite.address, to, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expVMErr error
+	}{
+		{
+			"pass - permissionless policy allows the precompile calls of a deployment",
+			func() {
+				policy = types.DefaultDeploymentPolicy()
+				msg = newMessage(nil, precompileCallerCode(true, stakingPrecompile, input))
+			},
+			nil,
+		},
+		{
+			"fail - blocked deployment calls a precompile",
+			func() {
+				policy = types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{suite.address.Hex()}, nil)
+				msg = newMessage(nil, precompileCallerCode(true, stakingPrecompile, input))
+			},
+			types.ErrDeploymentNotAllowed,
+		},
+		{
+			"fail - nested call to a denied precompile",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(caller, precompileCallerCode(false, stakingPrecompile, input))
+				suite.Require().NoError(vmdb.Commit())
+
+				policy = types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_PERMISSIONLESS, nil, []string{stakingPrecompile.Hex()})
+				msg = newMessage(&caller, nil)
+			},
+			types.ErrCalleeDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			tc.malleate()
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+			config.Params.DeploymentPolicy = policy
+
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+
+			grant, _ := suite.app.AuthzKeeper.GetAuthorization(suite.ctx, grantee.Bytes(), suite.address.Bytes(), staking.DelegateMsg)
+			if tc.expVMErr != nil {
+				suite.Require().True(res.Failed())
+				suite.Require().Contains(res.VmError, tc.expVMErr.Error())
+				suite.Require().Nil(grant, "expected the precompile changes to be reverted")
+				return
+			}
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().NotNil(grant, "expected the precompile to grant the authorization")
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCanDeploy() {
+	deployer := utiltx.GenerateAddress()
+	factory := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+
+	suite.SetupTest()
+	suite.app.EvmKeeper.SetDeploymentFactory(suite.ctx, factory, deployer)
+
+	testCases := []struct {
+		name    string
+		policy  types.DeploymentPolicy
+		address common.Address
+		expPass bool
+	}{
+		{"permissionless", types.DefaultDeploymentPolicy(), other, true},
+		{"allowlisted EOA", types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{deployer.Hex()}, nil), deployer, true},
+		{"factory on allowlisted EOAs mode", types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{deployer.Hex()}, nil), factory, false},
+		{"factory on allowlisted factories mode", types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES, []string{deployer.Hex()}, nil), factory, true},
+		{"factory of removed deployer", types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES, []string{other.Hex()}, nil), factory, false},
+		{"not allowlisted", types.NewDeploymentPolicy(types.DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES, []string{deployer.Hex()}, nil), other, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expPass, suite.app.EvmKeeper.CanDeploy(suite.ctx, tc.policy, tc.address))
+		})
+	}
+
+	suite.Require().Equal([]types.DeploymentFactory{{Address: factory.Hex(), Deployer: deployer.Hex()}}, suite.app.EvmKeeper.GetDeploymentFactories(suite.ctx))
+}
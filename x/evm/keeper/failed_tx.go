@@ -0,0 +1,186 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// PostTxFailed delegates the call to the failed tx hooks. If no hook has been
+// registered or the gas limit is zero, this function is a no-op. The hooks are
+// executed in a cache context with a gas meter of the given limit, which is only
+// committed if they succeed. Their errors, including running out of gas, are
+// logged and don't affect the outcome of the failed tx.
+func (k *Keeper) PostTxFailed(ctx sdk.Context, gasLimit uint64, msg core.Message, failure *types.TxFailure) {
+	if k.failedHooks == nil || gasLimit == 0 {
+		return
+	}
+
+	cacheCtx, commit := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return k.failedHooks.PostTxFailed(cacheCtx, msg, failure)
+	}()
+	if err != nil {
+		k.Logger(ctx).Error("tx failed hooks failed", "tx hash", failure.TxHash.Hex(), "error", err.Error())
+		return
+	}
+
+	commit()
+}
+
+// newTxFailure returns the context of the failed tx passed to the failed tx
+// hooks. The logs emitted during the EVM execution are used if they were
+// collected, otherwise the logs of the receipt are used.
+func newTxFailure(
+	res *types.MsgEthereumTxResponse,
+	receipt *ethtypes.Receipt,
+	txConfig statedb.TxConfig,
+	collector *logCollector,
+	postTxErr error,
+) *types.TxFailure {
+	failure := &types.TxFailure{
+		TxHash:  txConfig.TxHash,
+		GasUsed: res.GasUsed,
+		VmError: res.VmError,
+		Ret:     res.Ret,
+		Logs:    receipt.Logs,
+	}
+
+	switch {
+	case postTxErr != nil:
+		failure.RevertReason = postTxErr.Error()
+	case res.VmError == vm.ErrExecutionReverted.Error():
+		// the revert reason is empty if the data is a custom error
+		failure.RevertReason, _ = abi.UnpackRevert(res.Ret)
+	}
+
+	if collector != nil {
+		failure.Logs = collector.Logs()
+		for i, log := range failure.Logs {
+			log.TxHash = txConfig.TxHash
+			log.TxIndex = txConfig.TxIndex
+			log.BlockHash = txConfig.BlockHash
+			log.BlockNumber = receipt.BlockNumber.Uint64()
+			log.Index = txConfig.LogIndex + uint(i)
+		}
+	}
+
+	return failure
+}
+
+var _ vm.EVMLogger = &logCollector{}
+
+// logCollector is a vm.EVMLogger that collects the logs emitted during the EVM
+// execution, including the logs of the calls that are reverted afterwards. The
+// calls to the wrapped tracer are forwarded as they are.
+//
+// NOTE: the opcodes are traced before the memory expansion and execution, so
+// the data of a log is read on the next tracer call, once the LOG opcode has
+// been executed successfully.
+type logCollector struct {
+	vm.EVMLogger
+
+	logs []*ethtypes.Log
+	// pending is the log of the last traced LOG opcode
+	pending *pendingLog
+}
+
+// pendingLog is a log whose data hasn't been read yet.
+type pendingLog struct {
+	log    *ethtypes.Log
+	memory *vm.Memory
+	offset int64
+	size   int64
+}
+
+// newLogCollector wraps the tracer with a log collector.
+func newLogCollector(tracer vm.EVMLogger) *logCollector {
+	return &logCollector{EVMLogger: tracer}
+}
+
+// Logs returns the logs emitted during the execution.
+func (c *logCollector) Logs() []*ethtypes.Log {
+	c.flush()
+	return c.logs
+}
+
+// flush reads the data of the pending log and adds it to the collected logs.
+func (c *logCollector) flush() {
+	if c.pending == nil {
+		return
+	}
+
+	c.pending.log.Data = c.pending.memory.GetCopy(c.pending.offset, c.pending.size)
+	c.logs = append(c.logs, c.pending.log)
+	c.pending = nil
+}
+
+// CaptureState implements vm.EVMLogger. It records the LOG opcodes.
+func (c *logCollector) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	c.flush()
+
+	if err == nil && op >= vm.LOG0 && op <= vm.LOG4 {
+		stack := scope.Stack
+		offset, size := stack.Back(0), stack.Back(1)
+
+		topics := make([]common.Hash, int(op-vm.LOG0))
+		for i := range topics {
+			topics[i] = stack.Back(2 + i).Bytes32()
+		}
+
+		c.pending = &pendingLog{
+			log: &ethtypes.Log{
+				Address: scope.Contract.Address(),
+				Topics:  topics,
+			},
+			memory: scope.Memory,
+			offset: int64(offset.Uint64()),
+			size:   int64(size.Uint64()),
+		}
+	}
+
+	c.EVMLogger.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// CaptureFault implements vm.EVMLogger. It discards the log of a LOG opcode
+// that failed, e.g. in a static call.
+func (c *logCollector) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	c.pending = nil
+	c.EVMLogger.CaptureFault(pc, op, gas, cost, scope, depth, err)
+}
+
+// CaptureEnter implements vm.EVMLogger.
+func (c *logCollector) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	c.flush()
+	c.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit implements vm.EVMLogger.
+func (c *logCollector) CaptureExit(output []byte, gasUsed uint64, err error) {
+	c.flush()
+	c.EVMLogger.CaptureExit(output, gasUsed, err)
+}
+
+// CaptureEnd implements vm.EVMLogger.
+func (c *logCollector) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {
+	c.flush()
+	c.EVMLogger.CaptureEnd(output, gasUsed, t, err)
+}
@@ -90,6 +90,13 @@ func VerifyFee(
 		)
 	}
 
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		intrinsicGas, err = addAuthorizationsGas(intrinsicGas, len(setCodeTx.Authorizations))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
 		return nil, errorsmod.Wrapf(
@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -23,13 +24,30 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	intrinsicGas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		return addAuthorizationsGas(intrinsicGas, len(setCodeMsg.AuthorizationList))
+	}
+	return intrinsicGas, nil
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// addAuthorizationsGas adds the intrinsic gas of the EIP-7702 authorizations,
+// which are charged as creating new accounts.
+func addAuthorizationsGas(intrinsicGas uint64, authorizations int) (uint64, error) {
+	if uint64(authorizations) > (math.MaxUint64-intrinsicGas)/params.CallNewAccountGas {
+		return 0, core.ErrGasUintOverflow
+	}
+	return intrinsicGas + uint64(authorizations)*params.CallNewAccountGas, nil
+}
+
+// RefundGas transfers the leftover gas to the sender of the message, or to the fee granter that paid
+// the fees on its behalf, caped to half of the total gas consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// AnteHandler. The leftover gas of the fees paid in a fee token is refunded in the same fee token.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
@@ -41,10 +59,21 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	case 1:
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		if feeToken, found := k.GetFeeTokenTransient(ctx, msg.From(), msg.Nonce()); found {
+			refundedCoins = sdk.Coins{sdk.NewCoin(feeToken.Denom, feeToken.RefundAmount(sdkmath.NewIntFromBigInt(remaining)))}
+			if refundedCoins.IsZero() {
+				// no refund, the leftover gas is worth less than a unit of the fee token
+				return nil
+			}
+		}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to the fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		refundee := msg.From()
+		if payer, found := k.GetFeePayerTransient(ctx, msg.From(), msg.Nonce()); found {
+			refundee = payer
+		}
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundee.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -75,3 +104,17 @@ func GasToRefund(availableRefund, gasConsumed, refundQuotient uint64) uint64 {
 	}
 	return refund
 }
+
+// EffectivePriorityFee returns the priority fee per gas paid by the message on top
+// of the base fee. If the base fee is nil, the gas tip cap is returned.
+func EffectivePriorityFee(msg core.Message, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return new(big.Int).Set(msg.GasTipCap())
+	}
+
+	priorityFee := new(big.Int).Sub(msg.GasPrice(), baseFee)
+	if priorityFee.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return priorityFee
+}
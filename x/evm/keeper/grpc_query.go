@@ -19,7 +19,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -468,12 +470,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
 	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		msg, err := tx.AsMessage(signer, cfg.BaseFee)
 		if err != nil {
 			continue
 		}
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i)
 		// reset gas meter for each transaction
 		ctx = ctx.WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
@@ -484,8 +485,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	txConfig.TxHash = req.Msg.TxHash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
@@ -496,7 +496,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, req.Msg, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -565,10 +565,9 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -594,7 +593,26 @@ func (k *Keeper) traceTx(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	tx *types.MsgEthereumTx,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMessage(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMessage traces the execution of a core message, it returns a tuple:
+// (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMessage(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -606,10 +624,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -678,6 +692,68 @@ func (k *Keeper) traceTx(
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the latest state without committing it.
+// The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// pass false to not commit StateDB
+	result, _, err := k.traceMessage(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMessage
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -695,6 +771,163 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// DeploymentPolicy implements the Query/DeploymentPolicy gRPC method
+func (k Keeper) DeploymentPolicy(c context.Context, _ *types.QueryDeploymentPolicyRequest) (*types.QueryDeploymentPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDeploymentPolicyResponse{
+		DeploymentPolicy: k.GetParams(ctx).DeploymentPolicy,
+	}, nil
+}
+
+// DeployerPermission implements the Query/DeployerPermission gRPC method
+func (k Keeper) DeployerPermission(c context.Context, req *types.QueryDeployerPermissionRequest) (*types.QueryDeployerPermissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	policy := k.GetParams(ctx).DeploymentPolicy
+
+	res := &types.QueryDeployerPermissionResponse{
+		Allowed: k.CanDeploy(ctx, policy, address),
+	}
+	if deployer, found := k.GetDeploymentFactory(ctx, address); found {
+		res.FactoryDeployer = deployer.Hex()
+	}
+
+	return res, nil
+}
+
+// DeploymentFactories implements the Query/DeploymentFactories gRPC method
+func (k Keeper) DeploymentFactories(c context.Context, req *types.QueryDeploymentFactoriesRequest) (*types.QueryDeploymentFactoriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var factories []types.DeploymentFactory
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeploymentFactory)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		factories = append(factories, types.DeploymentFactory{
+			Address:  common.BytesToAddress(key).Hex(),
+			Deployer: common.BytesToAddress(value).Hex(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeploymentFactoriesResponse{
+		Factories:  factories,
+		Pagination: pageRes,
+	}, nil
+}
+
+// StateDiff implements the Query/StateDiff gRPC method
+func (k Keeper) StateDiff(c context.Context, req *types.QueryStateDiffRequest) (*types.QueryStateDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fromCtx, toCtx, err := k.HistoricalContexts(ctx, req.FromHeight, req.ToHeight)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryStateDiffResponse{
+		Diff: k.GetAccountStateDiff(fromCtx, toCtx, common.HexToAddress(req.Address)),
+	}, nil
+}
+
+// TouchedAccounts implements the Query/TouchedAccounts gRPC method
+func (k Keeper) TouchedAccounts(c context.Context, req *types.QueryTouchedAccountsRequest) (*types.QueryTouchedAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	touched, pageRes, err := k.GetTouchedAccounts(ctx, req.FromHeight, req.ToHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addresses := make([]string, len(touched))
+	for i, address := range touched {
+		addresses[i] = address.Hex()
+	}
+
+	return &types.QueryTouchedAccountsResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ContractStats implements the Query/ContractStats gRPC method
+func (k Keeper) ContractStats(_ context.Context, req *types.QueryContractStatsRequest) (*types.QueryContractStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address != "" {
+		if err := evmostypes.ValidateAddress(req.Address); err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				types.ErrZeroAddress.Error(),
+			)
+		}
+	}
+
+	if k.contractStats == nil {
+		return nil, status.Error(codes.Unavailable, "contract statistics are not enabled")
+	}
+
+	stats, fromHeight, toHeight := k.contractStats.aggregate()
+	if req.Address != "" {
+		address := common.HexToAddress(req.Address).Hex()
+		filtered := []types.ContractStats{}
+		for _, contractStats := range stats {
+			if contractStats.Address == address {
+				filtered = append(filtered, contractStats)
+				break
+			}
+		}
+		stats = filtered
+	}
+
+	if req.Limit > 0 && len(stats) > int(req.Limit) {
+		stats = stats[:req.Limit]
+	}
+
+	return &types.QueryContractStatsResponse{
+		Stats:      stats,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	}, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -25,10 +25,10 @@ import (
 const invalidAddress = "0x0000"
 
 // expGasConsumed is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee)
-const expGasConsumed = 7700
+const expGasConsumed = 8168
 
 // expGasConsumedWithFeeMkt is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) with enabled feemarket
-const expGasConsumedWithFeeMkt = 7694
+const expGasConsumedWithFeeMkt = 8162
 
 func (suite *KeeperTestSuite) TestQueryAccount() {
 	var (
@@ -937,7 +937,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			},
 			expPass:       true,
 			traceResponse: "{\"gas\":34828,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
-			expFinalGas:   29708, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
+			expFinalGas:   34730, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
 		},
 		{
 			msg: "invalid chain id",
@@ -1368,6 +1368,95 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var req *types.QueryTraceCallRequest
+
+	// callee runs TIMESTAMP POP PUSH1 1 PUSH1 0 SSTORE STOP
+	callee := utiltx.GenerateAddress()
+	calleeCode := hexutil.MustDecode("0x4250600160005500")
+	// caller marks a new phase with NUMBER and calls the callee with all the gas:
+	// NUMBER POP PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH20 callee GAS CALL STOP
+	caller := utiltx.GenerateAddress()
+	callerCode := append(hexutil.MustDecode("0x43506000600060006000600073"), callee.Bytes()...)
+	callerCode = append(callerCode, hexutil.MustDecode("0x5af100")...)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid args",
+			func() {
+				req = &types.QueryTraceCallRequest{Args: []byte("invalid args"), GasCap: config.DefaultGasCap}
+			},
+			false,
+		},
+		{
+			"fail - negative output limit",
+			func() {
+				args, err := json.Marshal(&types.TransactionArgs{To: &caller})
+				suite.Require().NoError(err)
+				req = &types.QueryTraceCallRequest{
+					Args:        args,
+					GasCap:      config.DefaultGasCap,
+					TraceConfig: &types.TraceConfig{Limit: -1},
+				}
+			},
+			false,
+		},
+		{
+			"pass - erc7562 tracer",
+			func() {
+				args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &caller})
+				suite.Require().NoError(err)
+				req = &types.QueryTraceCallRequest{
+					Args:        args,
+					GasCap:      config.DefaultGasCap,
+					TraceConfig: &types.TraceConfig{Tracer: types.TracerERC7562},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			vmdb := suite.StateDB()
+			vmdb.SetCode(caller, callerCode)
+			vmdb.SetCode(callee, calleeCode)
+			suite.Require().NoError(vmdb.Commit())
+
+			tc.malleate()
+
+			res, err := suite.queryClient.TraceCall(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var result types.ERC7562TracerResult
+			suite.Require().NoError(json.Unmarshal(res.Data, &result))
+			suite.Require().Empty(result.Error)
+			suite.Require().Len(result.Phases, 2)
+			suite.Require().Empty(result.Phases[0].Opcodes)
+
+			phase := result.Phases[1]
+			suite.Require().Equal(callee, phase.TopLevelTarget)
+			suite.Require().Equal(uint64(1), phase.Opcodes[vm.TIMESTAMP.String()])
+			suite.Require().Equal(uint64(1), phase.Opcodes[vm.SSTORE.String()])
+			suite.Require().Zero(phase.Opcodes[vm.GAS.String()])
+			suite.Require().Equal(len(calleeCode), phase.ContractSize[callee])
+			suite.Require().Equal(uint64(1), phase.Access[callee].Writes[common.Hash{}.Hex()])
+
+			// the call is not committed
+			suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, callee, common.Hash{}))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1435,6 +1524,18 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
+		{
+			"ContractStats method",
+			func() (interface{}, error) {
+				return k.ContractStats(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
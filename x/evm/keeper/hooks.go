@@ -10,7 +10,10 @@ import (
 	"github.com/evmos/evmos/v16/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks         = MultiEvmHooks{}
+	_ types.EvmFailedTxHooks = MultiEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -29,3 +32,39 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// PostTxFailed delegate the call to the underlying hooks that implement EvmFailedTxHooks
+func (mh MultiEvmHooks) PostTxFailed(ctx sdk.Context, msg core.Message, failure *types.TxFailure) error {
+	for i := range mh {
+		hook, ok := mh[i].(types.EvmFailedTxHooks)
+		if !ok {
+			continue
+		}
+		if err := hook.PostTxFailed(ctx, msg, failure); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// failedTxHooks returns the hooks that implement EvmFailedTxHooks, or nil if
+// there are none.
+func failedTxHooks(hooks types.EvmHooks) types.EvmFailedTxHooks {
+	switch hooks := hooks.(type) {
+	case MultiEvmHooks:
+		var failed MultiEvmHooks
+		for _, hook := range hooks {
+			if failedTxHooks(hook) != nil {
+				failed = append(failed, hook)
+			}
+		}
+		if len(failed) == 0 {
+			return nil
+		}
+		return failed
+	case types.EvmFailedTxHooks:
+		return hooks
+	default:
+		return nil
+	}
+}
@@ -4,11 +4,17 @@ import (
 	"errors"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/evmos/evmos/v16/testutil"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/evm/keeper"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	"github.com/evmos/evmos/v16/x/evm/types"
@@ -88,3 +94,163 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+// FailedTxRecordHook records the failed txs, and creates an account in the
+// failed tx hook context
+type FailedTxRecordHook struct {
+	PostTxErr error
+	Err       error
+	Panic     bool
+	Gas       uint64
+	Account   common.Address
+	Failures  []*types.TxFailure
+
+	accountKeeper types.AccountKeeper
+}
+
+func (dh *FailedTxRecordHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
+	return dh.PostTxErr
+}
+
+func (dh *FailedTxRecordHook) PostTxFailed(ctx sdk.Context, _ core.Message, failure *types.TxFailure) error {
+	dh.Failures = append(dh.Failures, failure)
+	dh.accountKeeper.SetAccount(ctx, dh.accountKeeper.NewAccountWithAddress(ctx, dh.Account.Bytes()))
+	ctx.GasMeter().ConsumeGas(dh.Gas, "failed tx hook")
+	if dh.Panic {
+		panic("post tx failed panic")
+	}
+	return dh.Err
+}
+
+// revertingInitCode returns an init code that emits a log with the first word
+// of the revert data and reverts with the given reason.
+func revertingInitCode(reason string) []byte {
+	revertData, err := abi.Arguments{{Type: abi.Type{T: abi.StringTy}}}.Pack(reason)
+	if err != nil {
+		panic(err)
+	}
+	revertData = append(crypto.Keccak256([]byte("Error(string)"))[:4], revertData...)
+
+	size := byte(len(revertData))
+	code := []byte{
+		byte(vm.PUSH1), size, byte(vm.PUSH1), 17, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.LOG0),
+		byte(vm.PUSH1), size, byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}
+	return append(code, revertData...)
+}
+
+func (suite *KeeperTestSuite) TestEvmFailedTxHooks() {
+	testCases := []struct {
+		msg        string
+		hook       *FailedTxRecordHook
+		revert     bool
+		expAccount bool
+		expFunc    func(hook *FailedTxRecordHook, res *types.MsgEthereumTxResponse)
+	}{
+		{
+			"reverted tx",
+			&FailedTxRecordHook{},
+			true,
+			true,
+			func(hook *FailedTxRecordHook, res *types.MsgEthereumTxResponse) {
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+				suite.Require().Len(hook.Failures, 1)
+				failure := hook.Failures[0]
+				suite.Require().Equal(res.Hash, failure.TxHash.Hex())
+				suite.Require().Equal(res.GasUsed, failure.GasUsed)
+				suite.Require().Equal(res.VmError, failure.VmError)
+				suite.Require().Equal(res.Ret, failure.Ret)
+				suite.Require().Equal("boom", failure.RevertReason)
+				// the logs emitted before the revert are passed to the hook
+				suite.Require().Len(failure.Logs, 1)
+				suite.Require().Equal(res.Ret[:32], failure.Logs[0].Data)
+			},
+		},
+		{
+			"post tx processing failure",
+			&FailedTxRecordHook{PostTxErr: errors.New("post tx processing failed")},
+			false,
+			true,
+			func(hook *FailedTxRecordHook, res *types.MsgEthereumTxResponse) {
+				suite.Require().Equal(types.ErrPostTxProcessing.Error(), res.VmError)
+				suite.Require().Empty(res.Logs)
+				suite.Require().Len(hook.Failures, 1)
+				failure := hook.Failures[0]
+				suite.Require().Contains(failure.RevertReason, "post tx processing failed")
+				// the logs of the execution are passed to the hook
+				suite.Require().Len(failure.Logs, 1)
+				suite.Require().Equal(res.Hash, failure.Logs[0].TxHash.Hex())
+				suite.Require().Len(failure.Logs[0].Topics, 3)
+				suite.Require().Equal(common.LeftPadBytes(big.NewInt(10).Bytes(), 32), failure.Logs[0].Data)
+			},
+		},
+		{
+			"successful tx",
+			&FailedTxRecordHook{},
+			false,
+			false,
+			func(hook *FailedTxRecordHook, res *types.MsgEthereumTxResponse) {
+				suite.Require().Empty(res.VmError)
+				suite.Require().Empty(hook.Failures)
+			},
+		},
+		{
+			"hook error is discarded",
+			&FailedTxRecordHook{Err: errors.New("post tx failed")},
+			true,
+			false,
+			func(hook *FailedTxRecordHook, res *types.MsgEthereumTxResponse) {
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+				suite.Require().Len(hook.Failures, 1)
+			},
+		},
+		{
+			"hook out of gas is recovered",
+			&FailedTxRecordHook{Gas: types.DefaultFailedTxHooksGasLimit},
+			true,
+			false,
+			func(hook *FailedTxRecordHook, res *types.MsgEthereumTxResponse) {
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+				suite.Require().Len(hook.Failures, 1)
+			},
+		},
+		{
+			"hook panic is recovered",
+			&FailedTxRecordHook{Panic: true},
+			true,
+			false,
+			func(hook *FailedTxRecordHook, res *types.MsgEthereumTxResponse) {
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+				suite.Require().Len(hook.Failures, 1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			contract := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1_000_000))
+
+			sender, key := utiltx.NewAddrKey()
+			coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1e18)))
+			suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender.Bytes(), coins))
+			suite.TransferERC20Token(suite.T(), contract, suite.address, sender, big.NewInt(100))
+
+			tc.hook.Account = utiltx.GenerateAddress()
+			tc.hook.accountKeeper = suite.app.AccountKeeper
+			suite.app.EvmKeeper = suite.app.EvmKeeper.CleanHooks()
+			suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(&LogRecordHook{}, tc.hook))
+
+			msg := suite.erc20TransferTx(key, sender, 0, contract, utiltx.GenerateAddress())
+			if tc.revert {
+				msg = suite.signedTx(key, &types.EvmTxArgs{GasLimit: 100_000, Input: revertingInitCode("boom")}, sender)
+			}
+			res := suite.deliverEthTxs(suite.ctx, []*types.MsgEthereumTx{msg})[0]
+
+			tc.expFunc(tc.hook, res)
+			// the writes of the hook are only committed if it succeeds
+			suite.Require().Equal(tc.expAccount, suite.app.AccountKeeper.HasAccount(suite.ctx, tc.hook.Account.Bytes()))
+		})
+	}
+}
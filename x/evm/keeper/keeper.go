@@ -22,6 +22,7 @@ import (
 	evmostypes "github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	"github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // Keeper grants access to the EVM module state and implements the go-ethereum StateDB interface.
@@ -57,6 +58,8 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+	// EVM Hooks for failed txs, derived from the hooks
+	failedHooks types.EvmFailedTxHooks
 	// Legacy subspace
 	ss paramstypes.Subspace
 
@@ -64,6 +67,24 @@ type Keeper struct {
 	// Some these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+	// dynamicPrecompiles instantiate the active precompiles that are not in the
+	// available precompiles map from the state, when they are called.
+	dynamicPrecompiles []types.DynamicPrecompileKeeper
+
+	// historicalStore is used to read the state committed at previous heights
+	historicalStore sdk.MultiStore
+
+	// changesets indexes the accounts changed in each block. The accounts
+	// touched between two heights can't be queried if it's nil.
+	changesets *ChangesetIndex
+
+	// speculative holds the results of the speculative execution of the last
+	// block proposal
+	speculative *speculativeCache
+
+	// contractStats holds the contract statistics of the recent blocks. The
+	// statistics are not collected if it's nil.
+	contractStats *contractStatsWindow
 }
 
 // NewKeeper generates new evm module keeper
@@ -100,6 +121,7 @@ func NewKeeper(
 		transientKey:    transientKey,
 		tracer:          tracer,
 		ss:              ss,
+		speculative:     &speculativeCache{},
 	}
 }
 
@@ -192,6 +214,46 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetFeePayerTransient sets the account that paid the fees of the transaction
+// with the given sender and nonce on behalf of the sender.
+func (k Keeper) SetFeePayerTransient(ctx sdk.Context, sender common.Address, nonce uint64, payer common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(types.FeePayerKey(sender, nonce), payer.Bytes())
+}
+
+// GetFeePayerTransient returns the account that paid the fees of the transaction
+// with the given sender and nonce, if they were not paid by the sender.
+func (k Keeper) GetFeePayerTransient(ctx sdk.Context, sender common.Address, nonce uint64) (common.Address, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(types.FeePayerKey(sender, nonce))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// SetFeeTokenTransient sets the fee token in which the fees of the transaction with
+// the given sender and nonce were paid.
+func (k Keeper) SetFeeTokenTransient(ctx sdk.Context, sender common.Address, nonce uint64, feeToken feemarkettypes.FeeToken) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeToken)
+	store.Set(types.FeePayerKey(sender, nonce), k.cdc.MustMarshal(&feeToken))
+}
+
+// GetFeeTokenTransient returns the fee token in which the fees of the transaction with
+// the given sender and nonce were paid, if they were not paid in the EVM denomination.
+func (k Keeper) GetFeeTokenTransient(ctx sdk.Context, sender common.Address, nonce uint64) (feemarkettypes.FeeToken, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeToken)
+	bz := store.Get(types.FeePayerKey(sender, nonce))
+	if len(bz) == 0 {
+		return feemarkettypes.FeeToken{}, false
+	}
+
+	var feeToken feemarkettypes.FeeToken
+	k.cdc.MustUnmarshal(bz, &feeToken)
+	return feeToken, true
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -242,6 +304,7 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	}
 
 	k.hooks = eh
+	k.failedHooks = failedTxHooks(eh)
 	return k
 }
 
@@ -249,6 +312,7 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 // NOTE: Should only be used for testing purposes
 func (k *Keeper) CleanHooks() *Keeper {
 	k.hooks = nil
+	k.failedHooks = nil
 	return k
 }
 
@@ -36,7 +36,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	txIndex := k.GetTxIndexTransient(ctx)
 
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", msg.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -44,7 +44,14 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, tx)
+	var response *types.MsgEthereumTxResponse
+	var err error
+	// go-ethereum doesn't support the set code transactions
+	if setCodeTx, ok := msg.Data.GetCachedValue().(*types.SetCodeTx); ok {
+		response, err = k.ApplySetCodeTransaction(ctx, setCodeTx)
+	} else {
+		response, err = k.ApplyTransaction(ctx, tx)
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
@@ -124,7 +131,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", tx.Type())),
+			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", msg.TxType())),
 		),
 	})
 
@@ -0,0 +1,936 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"errors"
+	"math/big"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmostypes "github.com/evmos/evmos/v16/types"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// parallelRounds is the maximum number of rounds in which the conflicting
+// transactions are re-executed in parallel. The conflicts remaining after the
+// last round are re-executed sequentially.
+const parallelRounds = 4
+
+// errSpeculationUnsupported is returned by the stateful precompiled contracts
+// during the speculative execution.
+var errSpeculationUnsupported = errors.New("state access not supported by the speculative execution")
+
+// SpeculativeResult is the outcome of the speculative execution of an Ethereum
+// transaction. It can be used in place of the execution of the transaction as
+// long as the state it read is unchanged when the transaction is delivered.
+type SpeculativeResult struct {
+	// Response is the response of the message execution. The logs are indexed
+	// as if the transaction was the first one of the block.
+	Response *types.MsgEthereumTxResponse
+	// Incarnation is the number of times the transaction was executed.
+	Incarnation int
+
+	// reads are the values read by the EVM, after the fee deduction and the
+	// nonce increment of the sender
+	reads map[mvKey]mvValue
+	// blockHashes are the block hashes read by the EVM
+	blockHashes map[uint64]common.Hash
+	// ops are the writes of the StateDB commit, in order
+	ops []stateOp
+	// contractStats are the contract statistics collected during the execution
+	contractStats map[common.Address]*types.ContractStats
+}
+
+// ExecuteParallel speculatively executes the given Ethereum transactions on top
+// of the state of the context, as if they were delivered in order in the block
+// of the context. The transactions are executed in parallel by the given number
+// of workers on isolated StateDBs backed by a multi-version memory. The read set
+// of each execution is then validated in order against the writes of the
+// preceding transactions, and the conflicting transactions are re-executed until
+// every result matches the serial execution.
+//
+// The state of the context is left untouched. The fee deduction, nonce increment
+// and gas refund of the sender are simulated. The result of a transaction is nil
+// if it cannot be executed speculatively, e.g. because it calls a stateful
+// precompiled contract or it would be rejected by the ante handler.
+func (k *Keeper) ExecuteParallel(ctx sdk.Context, txs []*ethtypes.Transaction, workers int) ([]*SpeculativeResult, error) {
+	return k.executeParallel(ctx, txs, workers, nil)
+}
+
+// executeParallel implements ExecuteParallel. The execution is aborted once the
+// canceled flag is set, if any.
+func (k *Keeper) executeParallel(
+	ctx sdk.Context,
+	txs []*ethtypes.Transaction,
+	workers int,
+	canceled *atomic.Bool,
+) ([]*SpeculativeResult, error) {
+	if k.eip155ChainID == nil {
+		return nil, errors.New("chain id is not set")
+	}
+
+	if k.tracer != "" {
+		return nil, errors.New("speculative execution is not supported with a tracer")
+	}
+
+	// the failed tx hooks need the logs collected during the execution
+	if k.failedHooks != nil {
+		return nil, errors.New("speculative execution is not supported with failed tx hooks")
+	}
+
+	cfg, err := k.speculativeEVMConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Params.DeploymentPolicy.IsRestricted() {
+		return nil, errors.New("speculative execution is not supported with a restricted deployment policy")
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	e := &parallelExecutor{
+		k:        k,
+		ctx:      ctx,
+		cfg:      cfg,
+		signer:   ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight())),
+		workers:  workers,
+		canceled: canceled,
+		mv:       newMVMemory(),
+		txs:      make([]*speculativeTx, len(txs)),
+	}
+	for i, tx := range txs {
+		e.txs[i] = &speculativeTx{tx: tx}
+	}
+
+	e.run()
+	if e.isCanceled() {
+		return nil, errors.New("speculative execution canceled")
+	}
+
+	results := make([]*SpeculativeResult, len(txs))
+	for i, stx := range e.txs {
+		results[i] = stx.result
+	}
+	return results, nil
+}
+
+// PreExecuteBlock speculatively executes in the background the Ethereum
+// transactions of the block of the context and keeps the results until the
+// block is delivered. It is meant to be called on the block proposals, with a
+// context on the state the block is executed on that is not written anymore.
+// It returns immediately, and the returned channel is closed once the execution
+// is done. The results replace the execution of the transactions on DeliverTx
+// as long as they are available and the state they read is unchanged. A
+// previous execution still running is canceled.
+func (k *Keeper) PreExecuteBlock(ctx sdk.Context, txs []*ethtypes.Transaction, workers int) <-chan struct{} {
+	run := k.speculative.start()
+
+	// the execution must not share the gas meter and events of the caller
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+
+	go func() {
+		defer close(run.done)
+
+		// the speculative execution must not affect the node
+		defer func() {
+			if r := recover(); r != nil {
+				k.Logger(ctx).Error("speculative execution panicked", "panic", r)
+			}
+		}()
+
+		batch, err := k.preExecuteBlock(ctx, txs, workers, &run.canceled)
+		if err != nil {
+			k.Logger(ctx).Debug("skipped speculative execution", "error", err)
+			return
+		}
+
+		k.speculative.finish(run, batch)
+	}()
+
+	return run.done
+}
+
+// CancelPreExecution cancels the speculative execution running in the
+// background, if any, and waits until it stops. The results already available
+// are kept.
+func (k *Keeper) CancelPreExecution() {
+	k.speculative.cancel()
+}
+
+// preExecuteBlock runs the speculative execution of the block and returns its
+// results.
+func (k *Keeper) preExecuteBlock(
+	ctx sdk.Context,
+	txs []*ethtypes.Transaction,
+	workers int,
+	canceled *atomic.Bool,
+) (*speculativeBatch, error) {
+	results, err := k.executeParallel(ctx, txs, workers, canceled)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := k.speculativeEVMConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &speculativeBatch{
+		blockHash: common.BytesToHash(ctx.HeaderHash()),
+		env:       k.newSpeculativeEnv(ctx, cfg),
+		results:   make(map[common.Hash]*SpeculativeResult, len(results)),
+	}
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		if _, found := batch.results[txs[i].Hash()]; !found {
+			batch.results[txs[i].Hash()] = result
+		}
+	}
+
+	return batch, nil
+}
+
+// speculativeEVMConfig returns the EVM configuration of the block of the context.
+// The base fee is the one set by the fee market at the beginning of the block.
+func (k *Keeper) speculativeEVMConfig(ctx sdk.Context) (*statedb.EVMConfig, error) {
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	if cfg.BaseFee != nil {
+		if baseFee := k.feeMarketKeeper.CalculateBaseFee(ctx); baseFee != nil {
+			cfg.BaseFee = baseFee
+		}
+	}
+
+	return cfg, nil
+}
+
+// applySpeculativeResult applies the writes of the speculative execution of the
+// transaction and returns its response, in place of the message execution. It
+// returns false if there is no result for the transaction or if the state read
+// by the speculative execution changed, in which case the message must be
+// executed.
+//
+// The message is always executed when the failed tx hooks or a tracer observe
+// the execution, as the speculative results don't keep the logs of the reverted
+// calls and the traces. Otherwise, the hooks would receive different inputs
+// depending on whether the node runs the speculative execution.
+func (k *Keeper) applySpeculativeResult(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, bool) {
+	if k.observesExecution() {
+		return nil, false
+	}
+
+	result := k.speculative.pop(ctx, txConfig.TxHash, func() speculativeEnv {
+		return k.newSpeculativeEnv(ctx, cfg)
+	})
+	if result == nil {
+		return nil, false
+	}
+
+	for key, value := range result.reads {
+		if !k.readMVValue(ctx, key).equal(value) {
+			return nil, false
+		}
+	}
+
+	getHash := k.GetHashFn(ctx)
+	for height, hash := range result.blockHashes {
+		if getHash(height) != hash {
+			return nil, false
+		}
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	for _, op := range result.ops {
+		if err := op.apply(cacheCtx, k); err != nil {
+			return nil, false
+		}
+	}
+	commit()
+
+	if result.contractStats != nil {
+		k.addContractStatsTransient(ctx, result.contractStats)
+	}
+
+	res := *result.Response
+	res.Hash = txConfig.TxHash.Hex()
+	res.Logs = make([]*types.Log, len(result.Response.Logs))
+	for i, log := range result.Response.Logs {
+		indexed := *log
+		indexed.TxHash = txConfig.TxHash.String()
+		indexed.TxIndex = uint64(txConfig.TxIndex)
+		indexed.Index = uint64(txConfig.LogIndex) + uint64(i)
+		indexed.BlockHash = txConfig.BlockHash.String()
+		res.Logs[i] = &indexed
+	}
+	if result.Response.Logs == nil {
+		res.Logs = nil
+	}
+
+	return &res, true
+}
+
+// observesExecution returns true if the failed tx hooks or a tracer other than
+// the no-op tracer observe the execution of the messages.
+func (k *Keeper) observesExecution() bool {
+	switch k.tracer {
+	case types.TracerAccessList, types.TracerJSON, types.TracerMarkdown, types.TracerStruct:
+		return true
+	default:
+		return k.failedHooks != nil
+	}
+}
+
+// readMVValue reads the value of the key from the state of the context.
+func (k *Keeper) readMVValue(ctx sdk.Context, key mvKey) mvValue {
+	if key.storage {
+		return mvValue{state: k.GetState(ctx, key.address, key.slot)}
+	}
+	return mvValue{account: k.GetAccount(ctx, key.address)}
+}
+
+// speculativeEnv are the block and module settings the speculative execution
+// depends on.
+type speculativeEnv struct {
+	height           int64
+	time             int64
+	gasLimit         uint64
+	coinbase         common.Address
+	baseFee          string
+	params           string
+	minGasMultiplier string
+}
+
+// newSpeculativeEnv returns the settings of the context.
+func (k *Keeper) newSpeculativeEnv(ctx sdk.Context, cfg *statedb.EVMConfig) speculativeEnv {
+	env := speculativeEnv{
+		height:           ctx.BlockHeight(),
+		time:             ctx.BlockHeader().Time.Unix(),
+		gasLimit:         evmostypes.BlockGasLimit(ctx),
+		coinbase:         cfg.CoinBase,
+		params:           string(k.cdc.MustMarshal(&cfg.Params)),
+		minGasMultiplier: k.feeMarketKeeper.GetParams(ctx).MinGasMultiplier.String(),
+	}
+	if cfg.BaseFee != nil {
+		env.baseFee = cfg.BaseFee.String()
+	}
+	return env
+}
+
+// speculativeCache holds the results of the speculative execution of the last
+// block proposal.
+type speculativeCache struct {
+	mu    sync.Mutex
+	batch *speculativeBatch
+	// run is the speculative execution running in the background, if any
+	run *speculativeRun
+}
+
+// speculativeRun is a speculative execution running in the background.
+type speculativeRun struct {
+	canceled atomic.Bool
+	// done is closed once the execution stops
+	done chan struct{}
+}
+
+// speculativeBatch are the speculative results of a block.
+type speculativeBatch struct {
+	blockHash common.Hash
+	env       speculativeEnv
+	// checked is true once the settings of the delivered block are compared
+	// to the ones of the speculative execution
+	checked bool
+	results map[common.Hash]*SpeculativeResult
+}
+
+// start discards the results, cancels the running execution, if any, and
+// returns the new one.
+func (c *speculativeCache) start() *speculativeRun {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.run != nil {
+		c.run.canceled.Store(true)
+	}
+
+	c.batch = nil
+	c.run = &speculativeRun{done: make(chan struct{})}
+	return c.run
+}
+
+// finish sets the results of the execution, unless it was replaced by a newer
+// one.
+func (c *speculativeCache) finish(run *speculativeRun, batch *speculativeBatch) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.run != run || run.canceled.Load() {
+		return
+	}
+
+	c.batch = batch
+	c.run = nil
+}
+
+// cancel cancels the running execution, if any, and waits until it stops.
+func (c *speculativeCache) cancel() {
+	c.mu.Lock()
+	run := c.run
+	c.run = nil
+	c.mu.Unlock()
+
+	if run != nil {
+		run.canceled.Store(true)
+		<-run.done
+	}
+}
+
+// pop removes and returns the result of the transaction if the block of the
+// context is the one that was executed speculatively. All the results are
+// discarded if the block settings differ.
+func (c *speculativeCache) pop(ctx sdk.Context, txHash common.Hash, env func() speculativeEnv) *SpeculativeResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	batch := c.batch
+	if batch == nil || batch.blockHash != common.BytesToHash(ctx.HeaderHash()) {
+		return nil
+	}
+
+	result, found := batch.results[txHash]
+	if !found {
+		return nil
+	}
+	delete(batch.results, txHash)
+
+	if !batch.checked {
+		batch.checked = true
+		if batch.env != env() {
+			c.batch = nil
+			return nil
+		}
+	}
+
+	return result
+}
+
+// mvKey is a key of the multi-version memory, either an account or a storage
+// slot of an account.
+type mvKey struct {
+	address common.Address
+	slot    common.Hash
+	storage bool
+}
+
+// accountKey returns the key of the account.
+func accountKey(address common.Address) mvKey {
+	return mvKey{address: address}
+}
+
+// storageKey returns the key of the storage slot.
+func storageKey(address common.Address, slot common.Hash) mvKey {
+	return mvKey{address: address, slot: slot, storage: true}
+}
+
+// mvValue is the value of a key of the multi-version memory. The account is nil
+// if it does not exist.
+type mvValue struct {
+	account *statedb.Account
+	state   common.Hash
+}
+
+// equal returns true if both values are the same.
+func (v mvValue) equal(other mvValue) bool {
+	return v.state == other.state && equalAccounts(v.account, other.account)
+}
+
+// mvMemory is the multi-version memory of the speculative execution. It holds
+// for each key the values written by the transactions of the block. It is only
+// written between the execution rounds, so the executions of a round are
+// isolated from each other and deterministic.
+type mvMemory struct {
+	// writes are the values written to each key, by transaction index
+	writes map[mvKey]map[int]mvValue
+	// keys are the keys written by each transaction
+	keys map[int][]mvKey
+	// codes are the contract codes by code hash
+	codes map[common.Hash][]byte
+}
+
+// newMVMemory creates an empty multi-version memory.
+func newMVMemory() *mvMemory {
+	return &mvMemory{
+		writes: make(map[mvKey]map[int]mvValue),
+		keys:   make(map[int][]mvKey),
+		codes:  make(map[common.Hash][]byte),
+	}
+}
+
+// read returns the value of the key written by the closest transaction
+// preceding the given transaction. It returns false if none of them wrote the
+// key.
+func (m *mvMemory) read(key mvKey, txIndex int) (mvValue, bool) {
+	writer := -1
+	var value mvValue
+	for index, v := range m.writes[key] {
+		if index < txIndex && index > writer {
+			writer, value = index, v
+		}
+	}
+	return value, writer >= 0
+}
+
+// record replaces the writes of the transaction.
+func (m *mvMemory) record(txIndex int, writes map[mvKey]mvValue, codes map[common.Hash][]byte) {
+	for _, key := range m.keys[txIndex] {
+		delete(m.writes[key], txIndex)
+	}
+
+	keys := make([]mvKey, 0, len(writes))
+	for key, value := range writes {
+		if m.writes[key] == nil {
+			m.writes[key] = make(map[int]mvValue)
+		}
+		m.writes[key][txIndex] = value
+		keys = append(keys, key)
+	}
+	m.keys[txIndex] = keys
+
+	for hash, code := range codes {
+		m.codes[hash] = code
+	}
+}
+
+// speculativeTx is the state of the speculative execution of a transaction.
+type speculativeTx struct {
+	tx  *ethtypes.Transaction
+	msg core.Message
+	// err is the error of the message conversion, if any
+	err error
+
+	incarnation int
+	// reads are the values read by the last execution, before the fee
+	// deduction of the sender
+	reads  map[mvKey]mvValue
+	writes map[mvKey]mvValue
+	codes  map[common.Hash][]byte
+	// result is nil if the transaction cannot be executed speculatively
+	result *SpeculativeResult
+}
+
+// parallelExecutor runs the speculative execution of the transactions of a
+// block.
+type parallelExecutor struct {
+	k       *Keeper
+	ctx     sdk.Context
+	cfg     *statedb.EVMConfig
+	signer  ethtypes.Signer
+	workers int
+	// canceled aborts the execution once set, if not nil
+	canceled *atomic.Bool
+	mv       *mvMemory
+	txs      []*speculativeTx
+	// base are the values read from the state of the context during the
+	// validation
+	base map[mvKey]mvValue
+}
+
+// run executes all the transactions in parallel, then validates them in order.
+// The valid prefix of the block is final. The conflicting transactions after it
+// are re-executed in parallel against the updated memory, and the remaining
+// conflicts after the last round are re-executed in order.
+func (e *parallelExecutor) run() {
+	e.base = make(map[mvKey]mvValue)
+
+	pending := make([]int, len(e.txs))
+	for i := range e.txs {
+		pending[i] = i
+	}
+	e.executeRound(pending)
+
+	frontier := 0
+	for round := 1; frontier < len(e.txs); round++ {
+		if e.isCanceled() {
+			return
+		}
+
+		for frontier < len(e.txs) && e.validate(frontier) {
+			frontier++
+		}
+
+		if frontier == len(e.txs) {
+			return
+		}
+
+		if round >= parallelRounds {
+			break
+		}
+
+		pending = pending[:0]
+		for i := frontier; i < len(e.txs); i++ {
+			if !e.validate(i) {
+				pending = append(pending, i)
+			}
+		}
+		e.executeRound(pending)
+	}
+
+	// the preceding transactions are final, so the re-execution is valid
+	ctx := e.workerContext()
+	for ; frontier < len(e.txs) && !e.isCanceled(); frontier++ {
+		if !e.validate(frontier) {
+			e.execute(ctx, frontier)
+			e.publish(frontier)
+		}
+	}
+}
+
+// executeRound executes the given transactions in parallel and publishes their
+// writes once all of them are executed.
+func (e *parallelExecutor) executeRound(indexes []int) {
+	queue := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < e.workers && w < len(indexes); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := e.workerContext()
+			for i := range queue {
+				if !e.isCanceled() {
+					e.execute(ctx, i)
+				}
+			}
+		}()
+	}
+
+	for _, i := range indexes {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	for _, i := range indexes {
+		e.publish(i)
+	}
+}
+
+// isCanceled returns true if the execution was canceled.
+func (e *parallelExecutor) isCanceled() bool {
+	return e.canceled != nil && e.canceled.Load()
+}
+
+// workerContext returns a branch of the context with its own gas meter and event
+// manager, so the workers do not share any mutable state.
+func (e *parallelExecutor) workerContext() sdk.Context {
+	ctx := e.ctx.WithMultiStore(e.ctx.MultiStore().CacheMultiStore())
+	return ctx.
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// publish records the writes of the last execution of the transaction in the
+// multi-version memory.
+func (e *parallelExecutor) publish(i int) {
+	stx := e.txs[i]
+	e.mv.record(i, stx.writes, stx.codes)
+}
+
+// validate returns true if the values read by the last execution of the
+// transaction are the ones written by the preceding transactions.
+func (e *parallelExecutor) validate(i int) bool {
+	for key, value := range e.txs[i].reads {
+		current, found := e.mv.read(key, i)
+		if !found {
+			current = e.readBase(key)
+		}
+		if !current.equal(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// readBase reads the value of the key from the state of the context.
+func (e *parallelExecutor) readBase(key mvKey) mvValue {
+	value, found := e.base[key]
+	if !found {
+		value = e.k.readMVValue(e.ctx, key)
+		e.base[key] = value
+	}
+	return value
+}
+
+// execute runs the transaction on the given worker context. The fee deduction
+// and the nonce increment of the ante handler are applied to the sender before
+// the message execution, and the leftover gas is refunded after it.
+func (e *parallelExecutor) execute(ctx sdk.Context, i int) {
+	stx := e.txs[i]
+	stx.incarnation++
+	stx.result = nil
+
+	view := newSpeculativeView(e.k, e.mv, i)
+	defer func() {
+		stx.reads = view.reads
+		stx.writes = view.writes
+		stx.codes = view.codes
+		if stx.result == nil {
+			// the transaction has no effect on the following ones
+			stx.writes, stx.codes = nil, nil
+		}
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			stx.result = nil
+		}
+	}()
+
+	if stx.msg == nil && stx.err == nil {
+		stx.msg, stx.err = stx.tx.AsMessage(e.signer, e.cfg.BaseFee)
+	}
+	if stx.err != nil {
+		return
+	}
+	msg := stx.msg
+
+	sender := view.read(ctx, accountKey(msg.From())).account
+	if sender == nil || sender.Nonce != msg.Nonce() {
+		return
+	}
+
+	fee := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(msg.Gas()))
+	if sender.Balance.Cmp(new(big.Int).Add(fee, msg.Value())) < 0 {
+		return
+	}
+
+	ante := *sender
+	ante.Nonce++
+	ante.Balance = new(big.Int).Sub(sender.Balance, fee)
+	view.writes[accountKey(msg.From())] = mvValue{account: &ante}
+
+	txConfig := statedb.NewTxConfig(common.BytesToHash(ctx.HeaderHash()), stx.tx.Hash(), 0, 0)
+	res, err := e.k.applyMessageWithConfig(ctx, msg, nil, true, e.cfg, txConfig, view)
+	if err != nil || view.unsupported {
+		return
+	}
+
+	// refund the leftover gas to the sender
+	refund := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(msg.Gas()-res.GasUsed))
+	refunded := *view.writes[accountKey(msg.From())].account
+	refunded.Balance = new(big.Int).Add(refunded.Balance, refund)
+	view.writes[accountKey(msg.From())] = mvValue{account: &refunded}
+
+	reads := make(map[mvKey]mvValue, len(view.reads))
+	for key, value := range view.reads {
+		reads[key] = value
+	}
+	reads[accountKey(msg.From())] = mvValue{account: &ante}
+
+	stx.result = &SpeculativeResult{
+		Response:      res,
+		Incarnation:   stx.incarnation,
+		reads:         reads,
+		blockHashes:   view.blockHashes,
+		ops:           view.ops,
+		contractStats: view.contractStats,
+	}
+}
+
+var _ statedb.Keeper = &speculativeView{}
+
+// speculativeView is the statedb.Keeper of a speculative execution. It reads the
+// values written by the preceding transactions from the multi-version memory,
+// or the state of the context otherwise, and keeps track of the values read.
+// The writes are kept in memory.
+type speculativeView struct {
+	k       *Keeper
+	mv      *mvMemory
+	txIndex int
+
+	reads       map[mvKey]mvValue
+	writes      map[mvKey]mvValue
+	codes       map[common.Hash][]byte
+	ops         []stateOp
+	blockHashes map[uint64]common.Hash
+	// unsupported is true if the execution accessed the state in a way that
+	// cannot be tracked
+	unsupported bool
+	// contractStats are the contract statistics collected during the execution
+	contractStats map[common.Address]*types.ContractStats
+}
+
+// newSpeculativeView creates the view of the given transaction.
+func newSpeculativeView(k *Keeper, mv *mvMemory, txIndex int) *speculativeView {
+	return &speculativeView{
+		k:           k,
+		mv:          mv,
+		txIndex:     txIndex,
+		reads:       make(map[mvKey]mvValue),
+		writes:      make(map[mvKey]mvValue),
+		codes:       make(map[common.Hash][]byte),
+		blockHashes: make(map[uint64]common.Hash),
+	}
+}
+
+// read returns the value of the key and records it on the first read.
+func (v *speculativeView) read(ctx sdk.Context, key mvKey) mvValue {
+	if value, found := v.writes[key]; found {
+		return value
+	}
+	if value, found := v.reads[key]; found {
+		return value
+	}
+
+	value, found := v.mv.read(key, v.txIndex)
+	if !found {
+		value = v.k.readMVValue(ctx, key)
+	}
+	v.reads[key] = value
+	return value
+}
+
+// GetAccount implements statedb.Keeper.
+func (v *speculativeView) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	account := v.read(ctx, accountKey(addr)).account
+	if account == nil {
+		return nil
+	}
+	acct := *account
+	acct.Balance = new(big.Int).Set(account.Balance)
+	return &acct
+}
+
+// GetState implements statedb.Keeper.
+func (v *speculativeView) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return v.read(ctx, storageKey(addr, key)).state
+}
+
+// GetCode implements statedb.Keeper. The code is content addressed, so it does
+// not need to be tracked.
+func (v *speculativeView) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
+	if code, found := v.codes[codeHash]; found {
+		return code
+	}
+	if code, found := v.mv.codes[codeHash]; found {
+		return code
+	}
+	return v.k.GetCode(ctx, codeHash)
+}
+
+// ForEachStorage implements statedb.Keeper. The storage iteration is not
+// supported.
+func (v *speculativeView) ForEachStorage(sdk.Context, common.Address, func(key, value common.Hash) bool) {
+	v.unsupported = true
+}
+
+// SetAccount implements statedb.Keeper.
+func (v *speculativeView) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	acct := account
+	acct.Balance = new(big.Int).Set(account.Balance)
+	v.writes[accountKey(addr)] = mvValue{account: &acct}
+	v.ops = append(v.ops, stateOp{address: addr, account: &acct})
+	return nil
+}
+
+// SetState implements statedb.Keeper.
+func (v *speculativeView) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	v.writes[storageKey(addr, key)] = mvValue{state: common.BytesToHash(value)}
+	v.ops = append(v.ops, stateOp{address: addr, key: key, value: value})
+}
+
+// SetCode implements statedb.Keeper.
+func (v *speculativeView) SetCode(_ sdk.Context, codeHash, code []byte) {
+	v.codes[common.BytesToHash(codeHash)] = code
+	v.ops = append(v.ops, stateOp{codeHash: codeHash, code: code})
+}
+
+// DeleteAccount implements statedb.Keeper. The account deletion is not supported
+// as it clears the whole storage of the account.
+func (v *speculativeView) DeleteAccount(sdk.Context, common.Address) error {
+	v.unsupported = true
+	return errSpeculationUnsupported
+}
+
+// getHashFn wraps the block hash lookup to record the hashes read.
+func (v *speculativeView) getHashFn(getHash vm.GetHashFunc) vm.GetHashFunc {
+	return func(height uint64) common.Hash {
+		hash := getHash(height)
+		v.blockHashes[height] = hash
+		return hash
+	}
+}
+
+// disablePrecompiles replaces the given precompiled contracts, which access the
+// state outside of the StateDB, with contracts that abort the execution.
+func (v *speculativeView) disablePrecompiles(precompiles map[common.Address]vm.PrecompiledContract, addresses []common.Address) {
+	for _, address := range addresses {
+		if precompile, ok := precompiles[address]; ok {
+			precompiles[address] = unsupportedPrecompile{PrecompiledContract: precompile, view: v}
+		}
+	}
+}
+
+var _ vm.PrecompiledContract = unsupportedPrecompile{}
+
+// unsupportedPrecompile is a precompiled contract that cannot be executed
+// speculatively.
+type unsupportedPrecompile struct {
+	vm.PrecompiledContract
+	view *speculativeView
+}
+
+// RequiredGas implements vm.PrecompiledContract.
+func (unsupportedPrecompile) RequiredGas([]byte) uint64 {
+	return 0
+}
+
+// Run implements vm.PrecompiledContract. It marks the execution as unsupported.
+func (p unsupportedPrecompile) Run(*vm.EVM, *vm.Contract, bool) ([]byte, error) {
+	p.view.unsupported = true
+	return nil, errSpeculationUnsupported
+}
+
+// stateOp is a write of the StateDB commit: a code, an account or a storage
+// slot.
+type stateOp struct {
+	address  common.Address
+	account  *statedb.Account
+	codeHash []byte
+	code     []byte
+	key      common.Hash
+	value    []byte
+}
+
+// apply performs the write on the keeper.
+func (op stateOp) apply(ctx sdk.Context, k statedb.Keeper) error {
+	switch {
+	case op.codeHash != nil:
+		k.SetCode(ctx, op.codeHash, op.code)
+	case op.account != nil:
+		return k.SetAccount(ctx, op.address, *op.account)
+	default:
+		k.SetState(ctx, op.address, op.key, op.value)
+	}
+	return nil
+}
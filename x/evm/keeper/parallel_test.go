@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v16/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v16/testutil"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestExecuteParallel() {
+	suite.SetupTest()
+
+	contract := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1_000_000))
+
+	senders := make([]common.Address, 8)
+	keys := make([]*ethsecp256k1.PrivKey, len(senders))
+	for i := range senders {
+		senders[i], keys[i] = utiltx.NewAddrKey()
+		coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1e18)))
+		suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, senders[i].Bytes(), coins))
+
+		msg := suite.TransferERC20Token(suite.T(), contract, suite.address, senders[i], big.NewInt(1000))
+		res, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+	}
+	suite.Commit()
+
+	recipient := utiltx.GenerateAddress()
+	var msgs []*types.MsgEthereumTx
+	// independent transfers
+	for i := 0; i < 4; i++ {
+		msgs = append(msgs, suite.erc20TransferTx(keys[i], senders[i], 0, contract, utiltx.GenerateAddress()))
+	}
+	// transfers to the same recipient
+	msgs = append(msgs,
+		suite.erc20TransferTx(keys[4], senders[4], 0, contract, recipient),
+		suite.erc20TransferTx(keys[5], senders[5], 0, contract, recipient),
+	)
+	// consecutive transactions of the same sender
+	msgs = append(msgs,
+		suite.erc20TransferTx(keys[6], senders[6], 0, contract, utiltx.GenerateAddress()),
+		suite.erc20TransferTx(keys[6], senders[6], 1, contract, utiltx.GenerateAddress()),
+	)
+	// stateful precompile call
+	staking := common.HexToAddress(types.AvailableEVMExtensions[2])
+	msgs = append(msgs, suite.signedTx(keys[7], &types.EvmTxArgs{
+		Nonce:    0,
+		To:       &staking,
+		GasLimit: 100_000,
+		Input:    []byte{1, 2, 3, 4},
+	}, senders[7]))
+
+	txs := make([]*ethtypes.Transaction, len(msgs))
+	for i, msg := range msgs {
+		txs[i] = msg.AsTransaction()
+	}
+
+	serialCtx, _ := suite.ctx.CacheContext()
+	serial := suite.deliverEthTxs(serialCtx, msgs)
+
+	speculativeCtx, _ := suite.ctx.CacheContext()
+	results, err := suite.app.EvmKeeper.ExecuteParallel(speculativeCtx, txs, 4)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, len(txs))
+
+	for i, result := range results {
+		if i == len(results)-1 {
+			suite.Require().Nil(result, "stateful precompile call")
+			continue
+		}
+
+		suite.Require().NotNil(result, "tx %d", i)
+		suite.Require().Equal(serial[i].Ret, result.Response.Ret, "tx %d", i)
+		suite.Require().Equal(serial[i].GasUsed, result.Response.GasUsed, "tx %d", i)
+		suite.Require().Equal(serial[i].VmError, result.Response.VmError, "tx %d", i)
+		suite.Require().Len(result.Response.Logs, len(serial[i].Logs), "tx %d", i)
+		for j, log := range result.Response.Logs {
+			suite.Require().Equal(serial[i].Logs[j].Topics, log.Topics)
+			suite.Require().Equal(serial[i].Logs[j].Data, log.Data)
+		}
+
+		switch i {
+		case 5, 7:
+			suite.Require().Greater(result.Incarnation, 1, "conflicting tx %d", i)
+		default:
+			suite.Require().Equal(1, result.Incarnation, "tx %d", i)
+		}
+	}
+
+	// the delivery with the speculative results matches the serial execution
+	parallelCtx, _ := suite.ctx.CacheContext()
+	<-suite.app.EvmKeeper.PreExecuteBlock(parallelCtx, txs, 4)
+	parallel := suite.deliverEthTxs(parallelCtx, msgs)
+	suite.Require().Equal(serial, parallel)
+
+	evmStore := suite.app.GetKey(types.StoreKey)
+	suite.Require().Equal(storeContents(serialCtx, evmStore), storeContents(parallelCtx, evmStore))
+	for _, address := range append(senders, recipient) {
+		suite.Require().Equal(
+			suite.app.EvmKeeper.GetAccount(serialCtx, address),
+			suite.app.EvmKeeper.GetAccount(parallelCtx, address),
+		)
+	}
+
+	// the results are not used once the state they read changed
+	changedCtx, _ := suite.ctx.CacheContext()
+	<-suite.app.EvmKeeper.PreExecuteBlock(changedCtx, txs, 4)
+	suite.Require().NoError(testutil.FundAccount(changedCtx, suite.app.BankKeeper, senders[0].Bytes(),
+		sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1)))))
+	changed := suite.deliverEthTxs(changedCtx, msgs)
+	suite.Require().Equal(serial, changed)
+
+	// the delivery does not wait for a running speculative execution, which
+	// is stopped at the end of the block
+	proposalCtx, _ := suite.ctx.CacheContext()
+	pendingCtx, _ := suite.ctx.CacheContext()
+	done := suite.app.EvmKeeper.PreExecuteBlock(proposalCtx, txs, 4)
+	pending := suite.deliverEthTxs(pendingCtx, msgs)
+	suite.app.EvmKeeper.CancelPreExecution()
+	<-done
+	suite.Require().Equal(serial, pending)
+}
+
+func (suite *KeeperTestSuite) TestSpeculativeResultWithFailedTxHooks() {
+	suite.SetupTest()
+
+	sender, key := utiltx.NewAddrKey()
+	coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1e18)))
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender.Bytes(), coins))
+	suite.Commit()
+
+	// the failed tx emits a log before reverting
+	msgs := []*types.MsgEthereumTx{
+		suite.signedTx(key, &types.EvmTxArgs{GasLimit: 100_000, Input: revertingInitCode("boom")}, sender),
+	}
+	txs := []*ethtypes.Transaction{msgs[0].AsTransaction()}
+	hooks := suite.app.EvmKeeper.CleanHooks()
+
+	serialHook := &FailedTxRecordHook{Account: utiltx.GenerateAddress(), accountKeeper: suite.app.AccountKeeper}
+	serialCtx, _ := suite.ctx.CacheContext()
+	hooks.SetHooks(serialHook)
+	suite.deliverEthTxs(serialCtx, msgs)
+	suite.Require().Len(serialHook.Failures, 1)
+	suite.Require().Len(serialHook.Failures[0].Logs, 1)
+
+	// the speculative result of the tx is available, but not used once the
+	// failed tx hooks are set
+	speculativeHook := &FailedTxRecordHook{Account: utiltx.GenerateAddress(), accountKeeper: suite.app.AccountKeeper}
+	speculativeCtx, _ := suite.ctx.CacheContext()
+	hooks.CleanHooks()
+	<-suite.app.EvmKeeper.PreExecuteBlock(speculativeCtx, txs, 4)
+	hooks.SetHooks(speculativeHook)
+	suite.deliverEthTxs(speculativeCtx, msgs)
+
+	suite.Require().Equal(serialHook.Failures, speculativeHook.Failures)
+}
+
+// erc20TransferTx returns a signed transfer of the test ERC20 token.
+func (suite *KeeperTestSuite) erc20TransferTx(
+	priv *ethsecp256k1.PrivKey,
+	from common.Address,
+	nonce uint64,
+	contract, to common.Address,
+) *types.MsgEthereumTx {
+	input, err := types.ERC20Contract.ABI.Pack("transfer", to, big.NewInt(10))
+	suite.Require().NoError(err)
+
+	return suite.signedTx(priv, &types.EvmTxArgs{
+		Nonce:    nonce,
+		To:       &contract,
+		GasLimit: 100_000,
+		Input:    input,
+	}, from)
+}
+
+// signedTx returns the transaction signed by the given key.
+func (suite *KeeperTestSuite) signedTx(priv *ethsecp256k1.PrivKey, args *types.EvmTxArgs, from common.Address) *types.MsgEthereumTx {
+	args.ChainID = suite.app.EvmKeeper.ChainID()
+	args.GasPrice = big.NewInt(1)
+
+	msg := types.NewTx(args)
+	msg.From = from.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(args.ChainID), utiltx.NewSigner(priv)))
+	return msg
+}
+
+// deliverEthTxs deducts the fees and increments the nonce of the sender, as the
+// ante handler does, and executes each transaction in order.
+func (suite *KeeperTestSuite) deliverEthTxs(ctx sdk.Context, msgs []*types.MsgEthereumTx) []*types.MsgEthereumTxResponse {
+	responses := make([]*types.MsgEthereumTxResponse, len(msgs))
+	for i, msg := range msgs {
+		tx := msg.AsTransaction()
+		from := common.HexToAddress(msg.From)
+
+		fee := new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas()))
+		fees := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewIntFromBigInt(fee)))
+		suite.Require().NoError(suite.app.EvmKeeper.DeductTxCostsFromUserBalance(ctx, fees, from))
+
+		acc := suite.app.AccountKeeper.GetAccount(ctx, from.Bytes())
+		suite.Require().NoError(acc.SetSequence(acc.GetSequence() + 1))
+		suite.app.AccountKeeper.SetAccount(ctx, acc)
+
+		res, err := suite.app.EvmKeeper.EthereumTx(ctx, msg)
+		suite.Require().NoError(err)
+		responses[i] = res
+	}
+	return responses
+}
+
+// storeContents returns all the entries of the store.
+func storeContents(ctx sdk.Context, key storetypes.StoreKey) map[string]string {
+	contents := make(map[string]string)
+	iterator := ctx.KVStore(key).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contents[string(iterator.Key())] = string(iterator.Value())
+	}
+	return contents
+}
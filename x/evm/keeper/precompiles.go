@@ -16,22 +16,28 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"golang.org/x/exp/maps"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	authzprecompile "github.com/evmos/evmos/v16/precompiles/authz"
 	bankprecompile "github.com/evmos/evmos/v16/precompiles/bank"
 	distprecompile "github.com/evmos/evmos/v16/precompiles/distribution"
 	erc20precompile "github.com/evmos/evmos/v16/precompiles/erc20"
+	erc20moduleprecompile "github.com/evmos/evmos/v16/precompiles/erc20module"
 	ics20precompile "github.com/evmos/evmos/v16/precompiles/ics20"
 	osmosisoutpost "github.com/evmos/evmos/v16/precompiles/outposts/osmosis"
 	strideoutpost "github.com/evmos/evmos/v16/precompiles/outposts/stride"
 	"github.com/evmos/evmos/v16/precompiles/p256"
+	slashingprecompile "github.com/evmos/evmos/v16/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v16/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v16/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
+	"github.com/evmos/evmos/v16/x/evm/types"
 	transferkeeper "github.com/evmos/evmos/v16/x/ibc/transfer/keeper"
 	vestingkeeper "github.com/evmos/evmos/v16/x/vesting/keeper"
 )
@@ -40,8 +46,10 @@ import (
 // NOTE: this should only be used during initialization of the Keeper.
 func AvailablePrecompiles(
 	chainID string,
+	cdc codec.Codec,
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
+	slashingKeeper slashingkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
 	erc20Keeper erc20Keeper.Keeper,
 	vestingKeeper vestingkeeper.Keeper,
@@ -85,6 +93,21 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
+	authzPrecompile, err := authzprecompile.NewPrecompile(cdc, authzKeeper, stakingKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	slashingPrecompile, err := slashingprecompile.NewPrecompile(slashingKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
+	}
+
+	erc20ModulePrecompile, err := erc20moduleprecompile.NewPrecompile(erc20Keeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate erc20 module precompile: %w", err))
+	}
+
 	var WEVMOSAddress common.Address
 	if utils.IsMainnet(chainID) {
 		WEVMOSAddress = common.HexToAddress(erc20precompile.WEVMOSContractMainnet)
@@ -119,6 +142,9 @@ func AvailablePrecompiles(
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
+	// NOTE: the p256 precompile is also available at its RIP-7212 address, which
+	// must be used when the BLS12-381 precompiles are enabled
+	precompiles[common.HexToAddress(p256.RIP7212PrecompileAddress)] = p256Precompile
 
 	// Stateful precompiles
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
@@ -126,6 +152,9 @@ func AvailablePrecompiles(
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[erc20ModulePrecompile.Address()] = erc20ModulePrecompile
 
 	// Outposts
 	precompiles[strideOutpost.Address()] = strideOutpost
@@ -148,6 +177,13 @@ func (k *Keeper) WithPrecompiles(precompiles map[common.Address]vm.PrecompiledCo
 	return k
 }
 
+// WithDynamicPrecompiles sets the keepers that instantiate the active precompiled
+// contracts that are not available in the Keeper from their state.
+func (k *Keeper) WithDynamicPrecompiles(keepers ...types.DynamicPrecompileKeeper) *Keeper {
+	k.dynamicPrecompiles = keepers
+	return k
+}
+
 // Precompiles returns the subset of the available precompiled contracts that
 // are active given the current parameters.
 func (k Keeper) Precompiles(
@@ -167,6 +203,130 @@ func (k Keeper) Precompiles(
 	return activePrecompileMap
 }
 
+// activePrecompiles returns the active precompiled contracts. The addresses that
+// are not available precompiles are served by the dynamic precompile keepers. Their
+// precompiles are only instantiated from the state when they are called, so that
+// the number of dynamic precompiles doesn't affect the cost of the other calls.
+func (k Keeper) activePrecompiles(
+	ctx sdk.Context,
+	activePrecompiles ...common.Address,
+) map[common.Address]vm.PrecompiledContract {
+	if len(k.dynamicPrecompiles) == 0 {
+		return k.Precompiles(activePrecompiles...)
+	}
+
+	activePrecompileMap := make(map[common.Address]vm.PrecompiledContract, len(activePrecompiles))
+	for _, address := range activePrecompiles {
+		if precompile, ok := k.precompiles[address]; ok {
+			activePrecompileMap[address] = precompile
+			continue
+		}
+
+		address := address
+		activePrecompileMap[address] = &dynamicPrecompile{
+			address: address,
+			resolve: func() (vm.PrecompiledContract, bool) {
+				return k.getDynamicPrecompile(ctx, address)
+			},
+		}
+	}
+
+	return activePrecompileMap
+}
+
+// getDynamicPrecompile returns the precompiled contract instantiated by the
+// dynamic precompile keepers at the given address, if any.
+func (k Keeper) getDynamicPrecompile(ctx sdk.Context, address common.Address) (vm.PrecompiledContract, bool) {
+	for _, keeper := range k.dynamicPrecompiles {
+		if precompile, found := keeper.GetDynamicPrecompile(ctx, address); found {
+			return precompile, true
+		}
+	}
+	return nil, false
+}
+
+var (
+	_ vm.PrecompiledContract         = &dynamicPrecompile{}
+	_ types.GasSchedulablePrecompile = &dynamicPrecompile{}
+)
+
+// dynamicPrecompile is an active precompiled contract that is resolved from the
+// state on its first use. The calls fail if it can't be resolved.
+type dynamicPrecompile struct {
+	address  common.Address
+	resolve  func() (vm.PrecompiledContract, bool)
+	schedule *types.PrecompileGasSchedule
+
+	resolved   bool
+	precompile vm.PrecompiledContract
+}
+
+// Address implements vm.PrecompiledContract.
+func (p *dynamicPrecompile) Address() common.Address {
+	return p.address
+}
+
+// RequiredGas implements vm.PrecompiledContract.
+func (p *dynamicPrecompile) RequiredGas(input []byte) uint64 {
+	precompile := p.get()
+	if precompile == nil {
+		return 0
+	}
+	return precompile.RequiredGas(input)
+}
+
+// Run implements vm.PrecompiledContract.
+func (p *dynamicPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	precompile := p.get()
+	if precompile == nil {
+		return nil, fmt.Errorf("precompiled contract not found: %s", p.address)
+	}
+	return precompile.Run(evm, contract, readonly)
+}
+
+// WithGasSchedule implements types.GasSchedulablePrecompile. The schedule is
+// applied to the precompile once it's resolved.
+func (p *dynamicPrecompile) WithGasSchedule(schedule types.PrecompileGasSchedule) vm.PrecompiledContract {
+	scheduled := *p
+	scheduled.schedule = &schedule
+	return &scheduled
+}
+
+// get resolves the precompiled contract on the first call.
+func (p *dynamicPrecompile) get() vm.PrecompiledContract {
+	if p.resolved {
+		return p.precompile
+	}
+	p.resolved = true
+
+	precompile, found := p.resolve()
+	if !found {
+		return nil
+	}
+
+	if scheduled, ok := precompile.(types.GasSchedulablePrecompile); ok && p.schedule != nil {
+		precompile = scheduled.WithGasSchedule(*p.schedule)
+	}
+	p.precompile = precompile
+	return precompile
+}
+
+// applyGasSchedules replaces the precompiles that have a gas schedule defined in the
+// EVM parameters with a copy that charges gas according to the schedule.
+func applyGasSchedules(
+	precompiles map[common.Address]vm.PrecompiledContract,
+	schedules []types.PrecompileGasSchedule,
+) {
+	for _, schedule := range schedules {
+		address := common.HexToAddress(schedule.Address)
+		precompile, ok := precompiles[address].(types.GasSchedulablePrecompile)
+		if !ok {
+			continue
+		}
+		precompiles[address] = precompile.WithGasSchedule(schedule)
+	}
+}
+
 // AddEVMExtensions adds the given precompiles to the list of active precompiles in the EVM parameters
 // and to the available precompiles map in the Keeper. This function returns an error if
 // the precompiles are invalid or duplicated.
@@ -6,6 +6,7 @@ package keeper_test
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/p256"
 	stakingprecompile "github.com/evmos/evmos/v16/precompiles/staking"
 	"github.com/evmos/evmos/v16/x/evm/types"
 )
@@ -21,6 +22,11 @@ func (suite *KeeperTestSuite) TestIsAvailablePrecompile() {
 			address:      common.HexToAddress(stakingprecompile.PrecompileAddress),
 			expAvailable: true,
 		},
+		{
+			name:         "pass - p256 precompile at its RIP-7212 address",
+			address:      common.HexToAddress(p256.RIP7212PrecompileAddress),
+			expAvailable: true,
+		},
 		{
 			name:         "fail - unavailable precompile",
 			address:      common.HexToAddress("0x0000000000000000000000000000000000099999"),
@@ -0,0 +1,126 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	evmostypes "github.com/evmos/evmos/v16/types"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// applyAuthorizations sets the code of the authorities of the EIP-7702
+// authorizations to the delegation designators of the authorized addresses and
+// increments their nonces. The invalid authorizations are skipped.
+func (k *Keeper) applyAuthorizations(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	chainID *big.Int,
+	authorizations []types.SetCodeAuthorization,
+) {
+	for i, auth := range authorizations {
+		authority, err := k.validateAuthorization(ctx, stateDB, chainID, auth)
+		if err != nil {
+			k.Logger(ctx).Debug("skipping invalid authorization", "index", i, "error", err.Error())
+			continue
+		}
+
+		// the authorities that already exist only pay the base cost of the authorizations
+		if stateDB.Exist(authority) {
+			stateDB.AddRefund(params.CallNewAccountGas - types.SetCodeAuthorizationBaseGas)
+		}
+
+		stateDB.SetNonce(authority, auth.Nonce+1)
+		if auth.GetAddress() == (common.Address{}) {
+			// the delegation to the zero address clears the code of the authority
+			stateDB.SetCode(authority, nil)
+		} else {
+			stateDB.SetCode(authority, types.AddressToDelegation(auth.GetAddress()))
+		}
+	}
+}
+
+// validateAuthorization returns the authority of the authorization if it can be
+// applied to the state.
+func (k *Keeper) validateAuthorization(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	chainID *big.Int,
+	auth types.SetCodeAuthorization,
+) (common.Address, error) {
+	if authChainID := auth.ChainID.BigInt(); authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+		return common.Address{}, errors.New("invalid chain ID")
+	}
+
+	if auth.Nonce == math.MaxUint64 {
+		return common.Address{}, errors.New("nonce overflow")
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// the authority is accessed even if the authorization is not applied
+	stateDB.AddAddressToAccessList(authority)
+
+	if code := stateDB.GetCode(authority); len(code) > 0 {
+		if _, ok := types.ParseDelegation(code); !ok {
+			return common.Address{}, errors.New("authority is not EOA")
+		}
+	}
+
+	if stateDB.GetNonce(authority) != auth.Nonce {
+		return common.Address{}, errors.New("invalid nonce")
+	}
+
+	// the code hash is only stored on Ethereum accounts
+	if acct := k.accountKeeper.GetAccount(ctx, authority.Bytes()); acct != nil {
+		if _, ok := acct.(evmostypes.EthAccountI); !ok {
+			return common.Address{}, errors.New("authority is not an Ethereum account")
+		}
+	}
+
+	return authority, nil
+}
+
+// delegationInterpreter wraps the EVM interpreter to resolve the EIP-7702
+// delegation designators of the called accounts. The code of the address the
+// designator delegates to is executed in place of the designator, and the
+// access to that address is charged to the call. The designators stay the code
+// of the accounts for the EXTCODESIZE, EXTCODECOPY and EXTCODEHASH opcodes.
+type delegationInterpreter struct {
+	vm.Interpreter
+	stateDB vm.StateDB
+}
+
+// Run implements vm.Interpreter
+func (i delegationInterpreter) Run(contract *vm.Contract, input []byte, static bool) ([]byte, error) {
+	delegate, ok := types.ParseDelegation(contract.Code)
+	// the init code of the contract creations is never resolved, since it's
+	// not the code of the account
+	if !ok || contract.CodeAddr == nil || i.stateDB.GetCodeHash(*contract.CodeAddr) != contract.CodeHash {
+		return i.Interpreter.Run(contract, input, static)
+	}
+
+	cost := params.WarmStorageReadCostEIP2929
+	if !i.stateDB.AddressInAccessList(delegate) {
+		i.stateDB.AddAddressToAccessList(delegate)
+		cost = params.ColdAccountAccessCostEIP2929
+	}
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	contract.SetCallCode(contract.CodeAddr, i.stateDB.GetCodeHash(delegate), i.stateDB.GetCode(delegate))
+	return i.Interpreter.Run(contract, input, static)
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// storeCode is the runtime code of a contract that stores 1 on the slot 0:
+// PUSH1 1 PUSH1 0 SSTORE STOP
+var storeCode = hexutil.MustDecode("0x600160005500")
+
+func (suite *KeeperTestSuite) TestApplySetCodeAuthorizations() {
+	var auths []types.SetCodeAuthorization
+
+	delegate := utiltx.GenerateAddress()
+	authority, authorityKey := utiltx.NewAddrKey()
+	authorityECDSA, err := authorityKey.ToECDSA()
+	suite.Require().NoError(err)
+
+	signAuth := func(chainID int64, address common.Address, nonce uint64) types.SetCodeAuthorization {
+		auth := types.SetCodeAuthorization{
+			ChainID: sdkmath.NewInt(chainID),
+			Address: address.Hex(),
+			Nonce:   nonce,
+		}
+		sig, err := crypto.Sign(auth.SigHash().Bytes(), authorityECDSA)
+		suite.Require().NoError(err)
+		auth.SetSignatureValues(new(big.Int).SetBytes(sig[64:]), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+		return auth
+	}
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expDelegation bool
+		expNonce      uint64
+	}{
+		{
+			"pass - delegate to contract",
+			func() {
+				auths = []types.SetCodeAuthorization{signAuth(9000, delegate, 0)}
+			},
+			true,
+			1,
+		},
+		{
+			"pass - authorization valid on all chains",
+			func() {
+				auths = []types.SetCodeAuthorization{signAuth(0, delegate, 0)}
+			},
+			true,
+			1,
+		},
+		{
+			"pass - clear delegation",
+			func() {
+				auths = []types.SetCodeAuthorization{
+					signAuth(9000, delegate, 0),
+					signAuth(9000, common.Address{}, 1),
+				}
+			},
+			false,
+			2,
+		},
+		{
+			"skip - invalid nonce",
+			func() {
+				auths = []types.SetCodeAuthorization{signAuth(9000, delegate, 5)}
+			},
+			false,
+			0,
+		},
+		{
+			"skip - invalid chain ID",
+			func() {
+				auths = []types.SetCodeAuthorization{signAuth(9001, delegate, 0)}
+			},
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			vmdb := suite.StateDB()
+			vmdb.SetCode(delegate, storeCode)
+			suite.Require().NoError(vmdb.Commit())
+
+			tc.malleate()
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := types.SetCodeMessage{
+				Message:           ethtypes.NewMessage(suite.address, &authority, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true),
+				AuthorizationList: auths,
+			}
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+
+			suite.Require().Equal(tc.expNonce, suite.app.EvmKeeper.GetNonce(suite.ctx, authority))
+
+			acct := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, authority)
+			slot := suite.app.EvmKeeper.GetState(suite.ctx, authority, common.Hash{})
+			if !tc.expDelegation {
+				suite.Require().True(acct == nil || !acct.IsContract())
+				suite.Require().Equal(common.Hash{}, slot)
+				return
+			}
+
+			suite.Require().Equal(crypto.Keccak256(types.AddressToDelegation(delegate)), acct.CodeHash)
+			// the delegated code is executed on the storage of the authority
+			suite.Require().Equal(common.BigToHash(big.NewInt(1)), slot)
+			suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, delegate, common.Hash{}))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplySetCodeTransactionDisabled() {
+	suite.SetupTest()
+
+	to := utiltx.GenerateAddress()
+	tx := &types.SetCodeTx{
+		To: to.Hex(),
+		Authorizations: []types.SetCodeAuthorization{
+			{ChainID: sdkmath.NewInt(9000), Address: to.Hex()},
+		},
+	}
+
+	_, err := suite.app.EvmKeeper.ApplySetCodeTransaction(suite.ctx, tx)
+	suite.Require().ErrorIs(err, types.ErrSetCodeTxDisabled)
+}
+
+func (suite *KeeperTestSuite) TestCallDelegatedAccount() {
+	suite.SetupTest()
+
+	delegate := utiltx.GenerateAddress()
+	authority := utiltx.GenerateAddress()
+	inspector := utiltx.GenerateAddress()
+	designator := types.AddressToDelegation(delegate)
+
+	// PUSH20 authority EXTCODESIZE PUSH1 0 SSTORE PUSH20 authority EXTCODEHASH PUSH1 1 SSTORE STOP
+	inspectorCode := append([]byte{0x73}, authority.Bytes()...)
+	inspectorCode = append(inspectorCode, 0x3b, 0x60, 0x00, 0x55, 0x73)
+	inspectorCode = append(inspectorCode, authority.Bytes()...)
+	inspectorCode = append(inspectorCode, 0x3f, 0x60, 0x01, 0x55, 0x00)
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(delegate, storeCode)
+	vmdb.SetCode(authority, designator)
+	vmdb.SetCode(inspector, inspectorCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+	config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+	suite.Require().NoError(err)
+	txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+	// the gas limit is low enough for the gas used not to be raised by the
+	// minimum gas multiplier
+	call := func(to common.Address) *types.MsgEthereumTxResponse {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := ethtypes.NewMessage(suite.address, &to, nonce, big.NewInt(0), 80_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+		res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res
+	}
+
+	// the delegated code is executed on the storage of the authority, and the
+	// cold access to the delegate is charged
+	delegateRes := call(delegate)
+	authorityRes := call(authority)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, authority, common.Hash{}))
+	suite.Require().Equal(delegateRes.GasUsed+params.ColdAccountAccessCostEIP2929, authorityRes.GasUsed)
+
+	// EXTCODESIZE and EXTCODEHASH return the designator of the authority
+	call(inspector)
+	suite.Require().Equal(
+		common.BigToHash(big.NewInt(int64(len(designator)))),
+		suite.app.EvmKeeper.GetState(suite.ctx, inspector, common.Hash{}),
+	)
+	suite.Require().Equal(
+		crypto.Keccak256Hash(designator),
+		suite.app.EvmKeeper.GetState(suite.ctx, inspector, common.BigToHash(big.NewInt(1))),
+	)
+}
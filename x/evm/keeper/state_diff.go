@@ -0,0 +1,199 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// SetHistoricalStore sets the multistore used to read the state committed at
+// previous heights, e.g. the application CommitMultiStore or the versiondb
+// query multistore.
+func (k *Keeper) SetHistoricalStore(ms sdk.MultiStore) {
+	k.historicalStore = ms
+}
+
+// SetChangesetIndex sets the index of the accounts changed in each block, used
+// to query the accounts touched between two heights.
+func (k *Keeper) SetChangesetIndex(index *ChangesetIndex) {
+	k.changesets = index
+}
+
+// HistoricalContexts returns the contexts on the state committed at the two
+// given heights. The heights must not be greater than the height of the given
+// context.
+func (k Keeper) HistoricalContexts(ctx sdk.Context, fromHeight, toHeight int64) (sdk.Context, sdk.Context, error) {
+	if err := validateHeightRange(ctx, fromHeight, toHeight); err != nil {
+		return ctx, ctx, err
+	}
+
+	if k.historicalStore == nil {
+		return ctx, ctx, errors.New("historical state is not available")
+	}
+
+	fromCtx, err := k.contextAtHeight(ctx, fromHeight)
+	if err != nil {
+		return ctx, ctx, err
+	}
+
+	toCtx, err := k.contextAtHeight(ctx, toHeight)
+	if err != nil {
+		return ctx, ctx, err
+	}
+
+	return fromCtx, toCtx, nil
+}
+
+// validateHeightRange returns an error if the height range is invalid or ends
+// after the height of the given context.
+func validateHeightRange(ctx sdk.Context, fromHeight, toHeight int64) error {
+	if fromHeight < 1 || toHeight < fromHeight {
+		return fmt.Errorf("invalid height range [%d, %d]", fromHeight, toHeight)
+	}
+
+	if toHeight > ctx.BlockHeight() {
+		return fmt.Errorf("height %d is greater than the current height %d", toHeight, ctx.BlockHeight())
+	}
+
+	return nil
+}
+
+// contextAtHeight returns a context on the state committed at the given height.
+func (k Keeper) contextAtHeight(ctx sdk.Context, height int64) (sdk.Context, error) {
+	ms, err := k.historicalStore.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to load state at height %d: %w", height, err)
+	}
+
+	return ctx.WithMultiStore(ms).WithBlockHeight(height), nil
+}
+
+// GetAccountStateDiff returns the changes of the balance, nonce, code hash and
+// storage of an account between the states of the two contexts.
+func (k *Keeper) GetAccountStateDiff(fromCtx, toCtx sdk.Context, address common.Address) types.AccountStateDiff {
+	diff := types.AccountStateDiff{
+		Address: address.Hex(),
+		Storage: []types.StorageDiff{},
+	}
+
+	if acct := k.GetAccount(fromCtx, address); acct != nil {
+		diff.BalanceBefore = acct.Balance.String()
+		diff.NonceBefore = acct.Nonce
+		diff.CodeHashBefore = common.BytesToHash(acct.CodeHash).Hex()
+	}
+
+	if acct := k.GetAccount(toCtx, address); acct != nil {
+		diff.BalanceAfter = acct.Balance.String()
+		diff.NonceAfter = acct.Nonce
+		diff.CodeHashAfter = common.BytesToHash(acct.CodeHash).Hex()
+	}
+
+	k.diffStorage(fromCtx, toCtx, address.Bytes(), func(key, before, after []byte) {
+		diff.Storage = append(diff.Storage, types.StorageDiff{
+			Key:    common.BytesToHash(key[common.AddressLength:]).Hex(),
+			Before: common.BytesToHash(before).Hex(),
+			After:  common.BytesToHash(after).Hex(),
+		})
+	})
+
+	return diff
+}
+
+// GetTouchedAccounts returns the addresses of the accounts whose auth account,
+// balances or storage were written in the blocks after fromHeight up to
+// toHeight, sorted and paginated. Only the changesets of the blocks in the range
+// are walked, from the changeset index.
+func (k *Keeper) GetTouchedAccounts(
+	ctx sdk.Context,
+	fromHeight, toHeight int64,
+	pageReq *query.PageRequest,
+) ([]common.Address, *query.PageResponse, error) {
+	if err := validateHeightRange(ctx, fromHeight, toHeight); err != nil {
+		return nil, nil, err
+	}
+
+	if k.changesets == nil {
+		return nil, nil, errors.New("the changeset index is not enabled")
+	}
+
+	touched, err := k.changesets.TouchedAddresses(fromHeight, toHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// paginate over the sorted addresses
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for address := range touched {
+		store.Set(address.Bytes(), []byte{})
+	}
+
+	var addresses []common.Address
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		addresses = append(addresses, common.BytesToAddress(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return addresses, pageRes, nil
+}
+
+// diffStorage walks the storage entries on both states in key order and calls
+// the callback for every changed entry. The walk is restricted to the keys with
+// the given prefix, relative to the storage prefix, if any. The keys passed to
+// the callback are the address followed by the storage key, and the missing
+// values are nil.
+func (k *Keeper) diffStorage(fromCtx, toCtx sdk.Context, keyPrefix []byte, cb func(key, before, after []byte)) {
+	var end []byte
+	if len(keyPrefix) > 0 {
+		end = storetypes.PrefixEndBytes(keyPrefix)
+	}
+
+	fromIt := prefix.NewStore(fromCtx.KVStore(k.storeKey), types.KeyPrefixStorage).Iterator(keyPrefix, end)
+	defer fromIt.Close()
+	toIt := prefix.NewStore(toCtx.KVStore(k.storeKey), types.KeyPrefixStorage).Iterator(keyPrefix, end)
+	defer toIt.Close()
+
+	for fromIt.Valid() || toIt.Valid() {
+		switch {
+		case !toIt.Valid() || (fromIt.Valid() && bytes.Compare(fromIt.Key(), toIt.Key()) < 0):
+			cb(fromIt.Key(), fromIt.Value(), nil)
+			fromIt.Next()
+		case !fromIt.Valid() || bytes.Compare(fromIt.Key(), toIt.Key()) > 0:
+			cb(toIt.Key(), nil, toIt.Value())
+			toIt.Next()
+		default:
+			if !bytes.Equal(fromIt.Value(), toIt.Value()) {
+				cb(fromIt.Key(), fromIt.Value(), toIt.Value())
+			}
+			fromIt.Next()
+			toIt.Next()
+		}
+	}
+}
+
+// equalAccounts returns true if both accounts have the same nonce, balance and
+// code hash.
+func equalAccounts(a, b *statedb.Account) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Nonce == b.Nonce &&
+		a.Balance.Cmp(b.Balance) == 0 &&
+		bytes.Equal(a.CodeHash, b.CodeHash)
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/keeper"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestStateDiff() {
+	suite.SetupTest()
+
+	changesets := keeper.NewChangesetIndex(
+		dbm.NewMemDB(),
+		suite.app.GetKey(authtypes.StoreKey),
+		suite.app.GetKey(banktypes.StoreKey),
+		suite.app.GetKey(types.StoreKey),
+	)
+	suite.app.SetStreamingService(changesets)
+	suite.Commit()
+
+	contract := utiltx.GenerateAddress()
+	recipient := utiltx.GenerateAddress()
+	unchanged := utiltx.GenerateAddress()
+
+	updatedKey := common.BytesToHash([]byte("updated"))
+	removedKey := common.BytesToHash([]byte("removed"))
+	createdKey := common.BytesToHash([]byte("created"))
+	value := common.BytesToHash([]byte("value"))
+	newValue := common.BytesToHash([]byte("new value"))
+
+	vmdb := suite.StateDB()
+	vmdb.SetState(contract, updatedKey, value)
+	vmdb.SetState(contract, removedKey, value)
+	vmdb.SetNonce(unchanged, 1)
+	suite.Require().NoError(vmdb.Commit())
+	suite.Commit()
+	fromHeight := suite.app.LastBlockHeight()
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	vmdb = suite.StateDB()
+	vmdb.SetState(contract, updatedKey, newValue)
+	vmdb.SetState(contract, removedKey, common.Hash{})
+	vmdb.SetState(contract, createdKey, value)
+	vmdb.AddBalance(recipient, big.NewInt(100))
+	vmdb.SetNonce(suite.address, nonce+1)
+	suite.Require().NoError(vmdb.Commit())
+	suite.Commit()
+	toHeight := suite.app.LastBlockHeight()
+
+	res, err := suite.queryClient.StateDiff(suite.ctx, &types.QueryStateDiffRequest{
+		Address:    contract.Hex(),
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(contract.Hex(), res.Diff.Address)
+	suite.Require().ElementsMatch([]types.StorageDiff{
+		{Key: updatedKey.Hex(), Before: value.Hex(), After: newValue.Hex()},
+		{Key: removedKey.Hex(), Before: value.Hex(), After: common.Hash{}.Hex()},
+		{Key: createdKey.Hex(), Before: common.Hash{}.Hex(), After: value.Hex()},
+	}, res.Diff.Storage)
+
+	res, err = suite.queryClient.StateDiff(suite.ctx, &types.QueryStateDiffRequest{
+		Address:    recipient.Hex(),
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Diff.BalanceBefore)
+	suite.Require().Equal("100", res.Diff.BalanceAfter)
+	suite.Require().Empty(res.Diff.Storage)
+
+	res, err = suite.queryClient.StateDiff(suite.ctx, &types.QueryStateDiffRequest{
+		Address:    suite.address.Hex(),
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(nonce, res.Diff.NonceBefore)
+	suite.Require().Equal(nonce+1, res.Diff.NonceAfter)
+	suite.Require().Equal(res.Diff.CodeHashBefore, res.Diff.CodeHashAfter)
+
+	_, err = suite.queryClient.TouchedAccounts(suite.ctx, &types.QueryTouchedAccountsRequest{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	suite.Require().Error(err, "the changeset index is not enabled")
+
+	suite.app.EvmKeeper.SetChangesetIndex(changesets)
+	defer suite.app.EvmKeeper.SetChangesetIndex(nil)
+
+	touched, err := suite.queryClient.TouchedAccounts(suite.ctx, &types.QueryTouchedAccountsRequest{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Contains(touched.Addresses, contract.Hex())
+	suite.Require().Contains(touched.Addresses, recipient.Hex())
+	suite.Require().Contains(touched.Addresses, suite.address.Hex())
+	suite.Require().NotContains(touched.Addresses, unchanged.Hex())
+
+	// the addresses are sorted by their bytes, not by their checksummed hex
+	lowercased := make([]string, len(touched.Addresses))
+	for i, address := range touched.Addresses {
+		lowercased[i] = strings.ToLower(address)
+	}
+	suite.Require().IsIncreasing(lowercased)
+
+	// paginate the touched accounts
+	var paginated []string
+	pageReq := &query.PageRequest{Limit: 1}
+	for {
+		res, err := suite.queryClient.TouchedAccounts(suite.ctx, &types.QueryTouchedAccountsRequest{
+			FromHeight: fromHeight,
+			ToHeight:   toHeight,
+			Pagination: pageReq,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Addresses, 1)
+		paginated = append(paginated, res.Addresses...)
+
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+	suite.Require().Equal(touched.Addresses, paginated)
+
+	_, err = suite.queryClient.StateDiff(suite.ctx, &types.QueryStateDiffRequest{
+		Address:    contract.Hex(),
+		FromHeight: toHeight,
+		ToHeight:   fromHeight,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.TouchedAccounts(suite.ctx, &types.QueryTouchedAccountsRequest{
+		FromHeight: fromHeight,
+		ToHeight:   suite.ctx.BlockHeight() + 1,
+	})
+	suite.Require().Error(err)
+}
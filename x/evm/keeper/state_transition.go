@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/evmos/evmos/v16/precompiles/bls12381"
 	evmostypes "github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	"github.com/evmos/evmos/v16/x/evm/types"
@@ -59,7 +60,9 @@ func (k *Keeper) NewEVM(
 		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	evm := vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	evm.WithInterpreter(delegationInterpreter{Interpreter: evm.Interpreter(), stateDB: stateDB})
+	return evm
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -136,16 +139,10 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
-	var (
-		bloom        *big.Int
-		bloomReceipt ethtypes.Bloom
-	)
-
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
-	txConfig := k.TxConfig(ctx, tx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
@@ -154,8 +151,50 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	return k.applyTransaction(ctx, cfg, msg, tx.Hash(), tx.Type())
+}
+
+// ApplySetCodeTransaction runs and attempts to perform a state transition with the given EIP-7702 set code
+// transaction, the same way as ApplyTransaction. The transaction is rejected before the prague fork.
+func (k *Keeper) ApplySetCodeTransaction(ctx sdk.Context, tx *types.SetCodeTx) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	if !cfg.Params.ChainConfig.IsPrague(big.NewInt(ctx.BlockHeight())) {
+		return nil, errorsmod.Wrapf(types.ErrSetCodeTxDisabled, "prague fork not active at height %d", ctx.BlockHeight())
+	}
+
+	from, err := tx.Sender(k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to retrieve the sender of the set code transaction")
+	}
+
+	return k.applyTransaction(ctx, cfg, tx.AsMessage(from, cfg.BaseFee), tx.Hash(), types.SetCodeTxType)
+}
+
+// applyTransaction applies the message of the transaction with the given hash and type.
+func (k *Keeper) applyTransaction(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	msg core.Message,
+	txHash common.Hash,
+	txType uint8,
+) (*types.MsgEthereumTxResponse, error) {
+	var (
+		bloom        *big.Int
+		bloomReceipt ethtypes.Bloom
+		err          error
+	)
+
+	txConfig := k.TxConfig(ctx, txHash)
+
 	// snapshot to contain the tx processing and post processing in same scope
-	var commit func()
+	var (
+		commit    func()
+		committed bool
+	)
 	tmpCtx := ctx
 	if k.hooks != nil {
 		// Create a cache context to revert state when tx hooks fails,
@@ -165,8 +204,22 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	// collect the logs emitted during the execution for the failed tx hooks
+	var (
+		tracer    vm.EVMLogger
+		collector *logCollector
+	)
+	if k.failedHooks != nil {
+		collector = newLogCollector(k.Tracer(tmpCtx, msg, cfg.ChainConfig))
+		tracer = collector
+	}
+
+	// use the result of the speculative execution of the block, if it is still
+	// valid, otherwise pass true to commit the StateDB
+	res, ok := k.applySpeculativeResult(tmpCtx, cfg, txConfig)
+	if !ok {
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
+	}
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -198,7 +251,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	receipt := &ethtypes.Receipt{
-		Type:              tx.Type(),
+		Type:              txType,
 		PostState:         nil, // TODO: intermediate state root
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
@@ -211,25 +264,36 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		TransactionIndex:  txConfig.TxIndex,
 	}
 
+	var postTxErr error
 	if !res.Failed() {
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
 		// Only call hooks if tx executed successfully.
-		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
+		if postTxErr = k.PostTxProcessing(tmpCtx, msg, receipt); postTxErr != nil {
 			// If hooks return error, revert the whole tx.
 			res.VmError = types.ErrPostTxProcessing.Error()
-			k.Logger(ctx).Error("tx post processing failed", "error", err)
+			k.Logger(ctx).Error("tx post processing failed", "error", postTxErr)
 
 			// If the tx failed in post processing hooks, we should clear the logs
 			res.Logs = nil
 		} else if commit != nil {
 			// PostTxProcessing is successful, commit the tmpCtx
 			commit()
+			committed = true
 			// Since the post-processing can alter the log, we need to update the result
 			res.Logs = types.NewLogsFromEth(receipt.Logs)
 			ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
 		}
 	}
 
+	// the contract statistics of the reverted transactions are kept
+	if commit != nil && !committed {
+		k.copyContractStatsTransient(tmpCtx, ctx)
+	}
+
+	if res.Failed() && k.failedHooks != nil {
+		k.PostTxFailed(ctx, cfg.Params.FailedTxHooksGasLimit, msg, newTxFailure(res, receipt, txConfig, collector, postTxErr))
+	}
+
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
 	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
@@ -248,6 +312,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	// record the priority fee for the fee history of the block
+	k.feeMarketKeeper.AddTransientPriorityFee(ctx, res.GasUsed, EffectivePriorityFee(msg, cfg.BaseFee))
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
@@ -308,6 +375,21 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	commit bool,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	return k.applyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig, nil)
+}
+
+// applyMessageWithConfig implements ApplyMessageWithConfig. If a speculative view
+// is given, the StateDB is backed by the view instead of the keeper and the
+// precompiled contracts that access the state outside of the StateDB abort the
+// execution.
+func (k *Keeper) applyMessageWithConfig(ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	view *speculativeView,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
@@ -321,16 +403,51 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
-	stateDB := statedb.New(ctx, k, txConfig)
+	// return error if the sender or the callee are not allowed by the deployment policy
+	policy := cfg.Params.DeploymentPolicy
+	if err := policy.ValidateTopLevel(msg.From(), msg.To()); err != nil {
+		return nil, errorsmod.Wrap(err, "deployment policy")
+	}
+
+	// the nested creations and calls are checked against the deployment policy
+	// during the EVM execution
+	var guard *deploymentGuard
+	if policy.IsRestricted() {
+		if tracer == nil {
+			tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
+		}
+		guard = newDeploymentGuard(ctx, k, policy, tracer)
+		tracer = guard
+	}
+
+	// the contract statistics are only collected for the committed executions
+	var statsCollector *contractStatsCollector
+	if k.contractStats != nil && commit {
+		if tracer == nil {
+			tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
+		}
+		statsCollector = newContractStatsCollector(tracer)
+		tracer = statsCollector
+	}
+
+	var stateKeeper statedb.Keeper = k
+	if view != nil {
+		stateKeeper = view
+	}
+
+	stateDB := statedb.New(ctx, stateKeeper, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	if view != nil {
+		evm.Context.GetHash = view.getHashFn(evm.Context.GetHash)
+	}
 
 	// set the custom precompiles to the EVM (if any)
-	if cfg.Params.HasCustomPrecompiles() {
+	if cfg.Params.HasCustomPrecompiles() || cfg.Params.EnableBLS12381Precompiles {
 		customPrecompiles := cfg.Params.GetActivePrecompilesAddrs()
 
-		activePrecompiles := make([]common.Address, len(vm.PrecompiledAddressesBerlin)+len(customPrecompiles))
-		copy(activePrecompiles[:len(vm.PrecompiledAddressesBerlin)], vm.PrecompiledAddressesBerlin)
-		copy(activePrecompiles[len(vm.PrecompiledAddressesBerlin):], customPrecompiles)
+		activePrecompiles := make([]common.Address, 0, len(vm.PrecompiledAddressesBerlin)+len(customPrecompiles))
+		activePrecompiles = append(activePrecompiles, vm.PrecompiledAddressesBerlin...)
+		activePrecompiles = append(activePrecompiles, customPrecompiles...)
 
 		// Check if the transaction is sent to an inactive precompile
 		//
@@ -347,7 +464,24 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		// NOTE: this only adds active precompiles to the EVM.
 		// This means that evm.Precompile(addr) will return false for inactive precompiles
 		// even though this is actually a reserved address.
-		precompileMap := k.Precompiles(activePrecompiles...)
+		precompileMap := k.activePrecompiles(ctx, activePrecompiles...)
+		applyGasSchedules(precompileMap, cfg.Params.PrecompileGasSchedules)
+
+		// NOTE: the BLS12-381 precompiles are opted in through governance. Their addresses
+		// don't overlap with the custom precompiles, as checked by the params validation.
+		if cfg.Params.EnableBLS12381Precompiles {
+			for _, blsPrecompile := range bls12381.Precompiles() {
+				precompileMap[blsPrecompile.Address()] = blsPrecompile
+				activePrecompiles = append(activePrecompiles, blsPrecompile.Address())
+			}
+		}
+
+		if view != nil {
+			view.disablePrecompiles(precompileMap, customPrecompiles)
+		}
+		if guard != nil {
+			guard.guardPrecompiles(precompileMap, customPrecompiles)
+		}
 		evm.WithPrecompiles(precompileMap, activePrecompiles)
 	}
 
@@ -385,6 +519,13 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
+	// the EIP-7702 authorizations are applied even if the execution fails
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		k.applyAuthorizations(ctx, stateDB, cfg.ChainConfig.ChainID, setCodeMsg.AuthorizationList)
+	}
+
+	snapshot := stateDB.Snapshot()
+
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
@@ -396,6 +537,15 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
 	}
 
+	// revert the state changes if the execution violated the deployment policy
+	if guard != nil && guard.Err() != nil {
+		stateDB.RevertToSnapshot(snapshot)
+		if contractCreation {
+			stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
+		}
+		ret, vmErr = nil, guard.Err()
+	}
+
 	refundQuotient := params.RefundQuotient
 
 	// After EIP-3529: refunds are capped to gasUsed / 5
@@ -426,6 +576,18 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
+
+		if guard != nil && guard.Err() == nil {
+			guard.commitFactories(ctx)
+		}
+
+		if statsCollector != nil {
+			if view != nil {
+				view.contractStats = statsCollector.Stats()
+			} else {
+				k.addContractStatsTransient(ctx, statsCollector.Stats())
+			}
+		}
 	}
 
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
@@ -12,15 +12,19 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/precompiles/p256"
+	"github.com/evmos/evmos/v16/testutil"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/evm/keeper"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	"github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestGetHashFn() {
@@ -459,6 +463,172 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestRefundGasToFeePayer() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	defer func() { suite.mintFeeCollector = false }()
+
+	keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	m, err := newNativeMessage(
+		suite.StateDB().GetNonce(suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		ethCfg,
+		suite.signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	payer := utiltx.GenerateAddress()
+	suite.app.EvmKeeper.SetFeePayerTransient(suite.ctx, suite.address, m.Nonce(), payer)
+
+	found, ok := suite.app.EvmKeeper.GetFeePayerTransient(suite.ctx, suite.address, m.Nonce())
+	suite.Require().True(ok)
+	suite.Require().Equal(payer, found)
+
+	_, ok = suite.app.EvmKeeper.GetFeePayerTransient(suite.ctx, suite.address, m.Nonce()+1)
+	suite.Require().False(ok)
+
+	senderBalance := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+
+	leftoverGas := uint64(1000)
+	err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, leftoverGas, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	expRefund := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), m.GasPrice())
+	suite.Require().Equal(1, expRefund.Sign())
+	suite.Require().Equal(expRefund, suite.app.EvmKeeper.GetBalance(suite.ctx, payer))
+	suite.Require().Equal(senderBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address))
+}
+
+func (suite *KeeperTestSuite) TestRefundGasInFeeToken() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	defer func() { suite.mintFeeCollector = false }()
+
+	keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	m, err := newNativeMessage(
+		suite.StateDB().GetNonce(suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		ethCfg,
+		suite.signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	// one unit of the EVM denomination is worth half a unit of the fee token
+	feeToken := feemarkettypes.NewFeeToken("uatom", sdkmath.LegacyNewDecWithPrec(5, 1))
+	suite.app.EvmKeeper.SetFeeTokenTransient(suite.ctx, suite.address, m.Nonce(), feeToken)
+
+	found, ok := suite.app.EvmKeeper.GetFeeTokenTransient(suite.ctx, suite.address, m.Nonce())
+	suite.Require().True(ok)
+	suite.Require().Equal(feeToken, found)
+
+	_, ok = suite.app.EvmKeeper.GetFeeTokenTransient(suite.ctx, suite.address, m.Nonce()+1)
+	suite.Require().False(ok)
+
+	// fund the fee collector with the fee token paid in the ante handler
+	leftoverGas := uint64(1000)
+	expRefund := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), m.GasPrice())
+	paid := sdk.NewCoins(sdk.NewCoin(feeToken.Denom, sdkmath.NewIntFromBigInt(expRefund)))
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, paid)
+	suite.Require().NoError(err)
+
+	senderBalance := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+
+	err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, leftoverGas, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	// the leftover gas is refunded in the fee token and the EVM balance is untouched
+	refund := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), feeToken.Denom)
+	suite.Require().Equal(sdkmath.NewIntFromBigInt(expRefund).QuoRaw(2), refund.Amount)
+	suite.Require().Equal(senderBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address))
+}
+
+func (suite *KeeperTestSuite) TestEffectivePriorityFee() {
+	to := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name           string
+		gasPrice       *big.Int
+		gasFeeCap      *big.Int
+		gasTipCap      *big.Int
+		baseFee        *big.Int
+		expPriorityFee *big.Int
+	}{
+		{
+			"no base fee - gas tip cap",
+			big.NewInt(10),
+			big.NewInt(10),
+			big.NewInt(10),
+			nil,
+			big.NewInt(10),
+		},
+		{
+			"base fee - gas price above the base fee",
+			big.NewInt(12),
+			big.NewInt(20),
+			big.NewInt(2),
+			big.NewInt(10),
+			big.NewInt(2),
+		},
+		{
+			"base fee - gas price capped by the gas fee cap",
+			big.NewInt(11),
+			big.NewInt(11),
+			big.NewInt(5),
+			big.NewInt(10),
+			big.NewInt(1),
+		},
+		{
+			"base fee - gas price below the base fee",
+			big.NewInt(5),
+			big.NewInt(5),
+			big.NewInt(5),
+			big.NewInt(10),
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			msg := ethtypes.NewMessage(suite.address, &to, 0, big.NewInt(0), params.TxGas, tc.gasPrice, tc.gasFeeCap, tc.gasTipCap, nil, nil, false)
+			suite.Require().Equal(tc.expPriorityFee, keeper.EffectivePriorityFee(msg, tc.baseFee))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyTransactionRecordsPriorityFee() {
+	suite.SetupTest()
+
+	sender, key := utiltx.NewAddrKey()
+	coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(1e18)))
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender.Bytes(), coins))
+
+	res := suite.deliverEthTxs(suite.ctx, []*types.MsgEthereumTx{
+		suite.signedTx(key, &types.EvmTxArgs{To: &suite.address, GasLimit: params.TxGas, Amount: big.NewInt(1)}, sender),
+	})
+	suite.Require().Empty(res[0].VmError)
+
+	// the fee market is disabled, so the whole gas price is the priority fee
+	fees := suite.app.FeeMarketKeeper.GetTransientPriorityFees(suite.ctx)
+	suite.Require().NotEmpty(fees)
+	suite.Require().Equal(feemarkettypes.TxPriorityFee{GasUsed: params.TxGas, PriorityFee: big.NewInt(1)}, fees[len(fees)-1])
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
@@ -605,6 +775,26 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 			},
 			false,
 		},
+		{
+			"messsage applied ok with the BLS12-381 precompiles enabled",
+			func() {
+				config.Params.EnableBLS12381Precompiles = true
+				config.Params.ActivePrecompiles = []string{p256.RIP7212PrecompileAddress}
+				msg, err = newNativeMessage(
+					vmdb.GetNonce(suite.address),
+					suite.ctx.BlockHeight(),
+					suite.address,
+					chainCfg,
+					suite.signer,
+					signer,
+					ethtypes.AccessListTxType,
+					nil,
+					nil,
+				)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
 		{
 			"call contract tx with config param EnableCall = false",
 			func() {
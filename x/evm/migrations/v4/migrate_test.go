@@ -85,5 +85,6 @@ func TestMigrate(t *testing.T) {
 	require.Equal(t, legacySubspace.ps.EnableCreate, params.EnableCreate)
 	require.Equal(t, legacySubspace.ps.AllowUnprotectedTxs, params.AllowUnprotectedTxs)
 	require.Equal(t, legacySubspace.ps.ExtraEIPs, params.ExtraEIPs.EIPs)
-	require.EqualValues(t, legacySubspace.ps.ChainConfig, params.V4ChainConfig)
+	// NOTE: the forks added after v4 are not part of the legacy chain config
+	require.Equal(t, cdc.MustMarshal(&legacySubspace.ps.ChainConfig), cdc.MustMarshal(&params.V4ChainConfig))
 }
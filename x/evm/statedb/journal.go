@@ -86,6 +86,20 @@ func (j *journal) Revert(statedb *StateDB, snapshot int) {
 	j.entries = j.entries[:snapshot]
 }
 
+// discardStateChanges replaces the entries that modify the state objects with
+// no-op entries and drops the dirty tracking. The entries are replaced instead of
+// removed so that the journal indexes of the revisions remain valid.
+func (j *journal) discardStateChanges() {
+	for i, entry := range j.entries {
+		switch entry.(type) {
+		case createObjectChange, resetObjectChange, suicideChange,
+			balanceChange, nonceChange, storageChange, codeChange:
+			j.entries[i] = flushedChange{}
+		}
+	}
+	j.dirties = make(map[common.Address]int)
+}
+
 // length returns the current number of entries in the journal.
 func (j *journal) length() int {
 	return len(j.entries)
@@ -129,6 +143,9 @@ type (
 	}
 	addLogChange struct{}
 
+	// Change to a state object that has been flushed to the keeper.
+	flushedChange struct{}
+
 	// Changes to the access list
 	accessListAddAccountChange struct {
 		address *common.Address
@@ -215,6 +232,12 @@ func (ch addLogChange) Dirtied() *common.Address {
 	return nil
 }
 
+func (ch flushedChange) Revert(*StateDB) {}
+
+func (ch flushedChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch accessListAddAccountChange) Revert(s *StateDB) {
 	/*
 		One important invariant here, is that whenever a (addr, slot) is added, if the
@@ -142,6 +142,9 @@ func (s *StateDB) GetNonce(addr common.Address) uint64 {
 }
 
 // GetCode returns the code of account, nil if not exists.
+//
+// NOTE: the EIP-7702 delegation designators are returned as is. They are only
+// resolved when the accounts are called, see the EVM interpreter of the keeper.
 func (s *StateDB) GetCode(addr common.Address) []byte {
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
@@ -468,3 +471,19 @@ func (s *StateDB) Commit() error {
 	}
 	return nil
 }
+
+// Flush writes the dirty states to keeper and discards the live state objects,
+// so that the subsequent reads load the state from the keeper. Unlike Commit, the
+// StateDB can still be used afterwards, which allows the precompiles to modify the
+// EVM state through the keeper (e.g. with a nested EVM call) without the next commit
+// overwriting these changes with stale values.
+//
+// NOTE: the state changes flushed can no longer be reverted.
+func (s *StateDB) Flush() error {
+	if err := s.Commit(); err != nil {
+		return err
+	}
+	s.stateObjects = make(map[common.Address]*stateObject)
+	s.journal.discardStateChanges()
+	return nil
+}
@@ -536,6 +536,34 @@ func (suite *StateDBTestSuite) TestRefund() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestFlush() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	value2 := common.BigToHash(big.NewInt(3))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	revision := db.Snapshot()
+	db.SetState(address, key, value1)
+	db.AddRefund(10)
+	suite.Require().NoError(db.Flush())
+	suite.Require().Equal(value1, keeper.GetState(sdk.Context{}, address, key))
+
+	// the changes made through the keeper are visible to the StateDB
+	// and are not overwritten by the next commit
+	keeper.SetState(sdk.Context{}, address, key, value2.Bytes())
+	suite.Require().Equal(value2, db.GetState(address, key))
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(value2, keeper.GetState(sdk.Context{}, address, key))
+
+	// the flushed state changes are not reverted, unlike the other changes
+	db.RevertToSnapshot(revision)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(value2, db.GetState(address, key))
+	suite.Require().Equal(value2, keeper.GetState(sdk.Context{}, address, key))
+	suite.Require().Zero(db.GetRefund())
+}
+
 func (suite *StateDBTestSuite) TestIterateStorage() {
 	key1 := common.BigToHash(big.NewInt(1))
 	value1 := common.BigToHash(big.NewInt(2))
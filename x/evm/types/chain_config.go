@@ -152,13 +152,32 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.CancunBlock); err != nil {
 		return errorsmod.Wrap(err, "CancunBlock")
 	}
+	if err := validateBlock(cc.PragueBlock); err != nil {
+		return errorsmod.Wrap(err, "PragueBlock")
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
 	}
+	// NOTE: the go-ethereum config doesn't define the prague fork
+	if cc.PragueBlock != nil {
+		cancunBlock := getBlockValue(cc.CancunBlock)
+		if cancunBlock == nil || cancunBlock.Cmp(cc.PragueBlock.BigInt()) > 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidChainConfig, "invalid config fork order: prague block %s enabled before cancun block %s", cc.PragueBlock, cc.CancunBlock,
+			)
+		}
+	}
 	return nil
 }
 
+// IsPrague returns whether the prague fork, which enables the EIP-7702 set code
+// transactions, is active at the given block height.
+func (cc ChainConfig) IsPrague(height *big.Int) bool {
+	pragueBlock := getBlockValue(cc.PragueBlock)
+	return pragueBlock != nil && pragueBlock.Cmp(height) <= 0
+}
+
 func validateHash(hex string) error {
 	if hex != "" && strings.TrimSpace(hex) == "" {
 		return errorsmod.Wrap(ErrInvalidChainConfig, "hash cannot be blank")
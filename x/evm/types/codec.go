@@ -49,6 +49,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		"ethermint.evm.v1.TxData",
 		(*TxData)(nil),
 		&DynamicFeeTx{},
+		&SetCodeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
 	)
@@ -0,0 +1,134 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/types"
+)
+
+// DefaultDeploymentPolicy returns the permissionless deployment policy without
+// any denied callees.
+func DefaultDeploymentPolicy() DeploymentPolicy {
+	return DeploymentPolicy{
+		Mode: DEPLOYMENT_MODE_PERMISSIONLESS,
+	}
+}
+
+// NewDeploymentPolicy creates a new DeploymentPolicy instance
+func NewDeploymentPolicy(mode DeploymentMode, deployerAllowlist, deniedCallees []string) DeploymentPolicy {
+	return DeploymentPolicy{
+		Mode:              mode,
+		DeployerAllowlist: deployerAllowlist,
+		DeniedCallees:     deniedCallees,
+	}
+}
+
+// Validate checks that the deployment mode is known and that the allowlisted
+// deployers and the denied callees are valid and unique hex addresses.
+func (dp DeploymentPolicy) Validate() error {
+	if _, ok := DeploymentMode_name[int32(dp.Mode)]; !ok {
+		return fmt.Errorf("invalid deployment mode %d", dp.Mode)
+	}
+
+	if err := validateAddressList(dp.DeployerAllowlist); err != nil {
+		return fmt.Errorf("invalid deployer allowlist: %w", err)
+	}
+
+	if err := validateAddressList(dp.DeniedCallees); err != nil {
+		return fmt.Errorf("invalid denied callees: %w", err)
+	}
+
+	return nil
+}
+
+// IsPermissionless returns true if any account is allowed to deploy contracts.
+func (dp DeploymentPolicy) IsPermissionless() bool {
+	return dp.Mode == DEPLOYMENT_MODE_PERMISSIONLESS
+}
+
+// IsRestricted returns true if the policy restricts the contract deployments or
+// the contract calls, i.e. if the EVM execution needs to be inspected.
+func (dp DeploymentPolicy) IsRestricted() bool {
+	return !dp.IsPermissionless() || len(dp.DeniedCallees) > 0
+}
+
+// AllowFactories returns true if the contracts deployed by the allowlisted
+// deployers are allowed to deploy contracts.
+func (dp DeploymentPolicy) AllowFactories() bool {
+	return dp.Mode == DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES
+}
+
+// IsAllowlistedDeployer returns true if the address is on the deployer allowlist.
+func (dp DeploymentPolicy) IsAllowlistedDeployer(address common.Address) bool {
+	return containsAddress(dp.DeployerAllowlist, address)
+}
+
+// IsDeniedCallee returns true if the address is on the denied callees list.
+func (dp DeploymentPolicy) IsDeniedCallee(address common.Address) bool {
+	return containsAddress(dp.DeniedCallees, address)
+}
+
+// ValidateTopLevel checks that an Ethereum transaction from the given sender is
+// allowed by the policy. A nil recipient corresponds to a contract creation.
+// NOTE: the nested deployments performed by factory contracts are checked
+// during the EVM execution.
+func (dp DeploymentPolicy) ValidateTopLevel(from common.Address, to *common.Address) error {
+	if to == nil {
+		if !dp.IsPermissionless() && !dp.IsAllowlistedDeployer(from) {
+			return errorsmod.Wrapf(ErrDeploymentNotAllowed, "deployer %s is not allowlisted", from)
+		}
+		return nil
+	}
+
+	if dp.IsDeniedCallee(*to) {
+		return errorsmod.Wrapf(ErrCalleeDenied, "callee %s", to)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the DeploymentFactory fields.
+func (df DeploymentFactory) Validate() error {
+	if err := types.ValidateAddress(df.Address); err != nil {
+		return fmt.Errorf("invalid factory address: %w", err)
+	}
+
+	if err := types.ValidateAddress(df.Deployer); err != nil {
+		return fmt.Errorf("invalid factory deployer: %w", err)
+	}
+
+	return nil
+}
+
+// validateAddressList checks that the list contains valid and unique hex addresses.
+func validateAddressList(addresses []string) error {
+	seen := make(map[common.Address]struct{})
+	for _, address := range addresses {
+		if err := types.ValidateAddress(address); err != nil {
+			return err
+		}
+
+		addr := common.HexToAddress(address)
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate address %s", address)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// containsAddress returns true if the hex address list contains the address.
+func containsAddress(addresses []string, address common.Address) bool {
+	for _, addr := range addresses {
+		if common.HexToAddress(addr) == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,269 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// TracerERC7562 is the name of the native tracer that collects the data
+// required to validate ERC-4337 user operations against the ERC-7562 rules.
+const TracerERC7562 = "erc7562Tracer"
+
+// maxKeccakInputSize is the maximum size of the KECCAK256 preimages recorded
+// by the ERC-7562 tracer. Storage slots associated with an address are derived
+// from short preimages (mapping keys), so longer inputs are not relevant.
+const maxKeccakInputSize = 128
+
+func init() {
+	tracers.RegisterLookup(false, func(name string, _ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+		if name != TracerERC7562 {
+			return nil, errors.New("no tracer found")
+		}
+		return NewERC7562Tracer(), nil
+	})
+}
+
+// ERC7562StorageAccess defines the storage slots read and written by a
+// contract during a validation phase, along with the access count.
+type ERC7562StorageAccess struct {
+	Reads  map[string]uint64 `json:"reads"`
+	Writes map[string]uint64 `json:"writes"`
+}
+
+// ERC7562Phase holds the data collected between two NUMBER opcodes executed by
+// the EntryPoint (i.e. the top level call). The EntryPoint uses these markers
+// to separate the factory, account and paymaster validation phases. A new
+// phase is also started when the EntryPoint calls a different top level target.
+type ERC7562Phase struct {
+	// TopLevelTarget is the first address called by the EntryPoint in the phase
+	TopLevelTarget common.Address `json:"topLevelTarget"`
+	// TopLevelMethod is the method selector of the first call of the phase
+	TopLevelMethod hexutil.Bytes `json:"topLevelMethod"`
+	// Opcodes counts the opcodes executed below the EntryPoint
+	Opcodes map[string]uint64 `json:"opcodes"`
+	// Access holds the storage accessed by each contract
+	Access map[common.Address]*ERC7562StorageAccess `json:"access"`
+	// ContractSize holds the code size of each called address
+	ContractSize map[common.Address]int `json:"contractSize"`
+	// EntryPointCalls holds the method selectors of the calls made back to the
+	// EntryPoint
+	EntryPointCalls []hexutil.Bytes `json:"entryPointCalls"`
+	// OOG is true if any call of the phase ran out of gas
+	OOG bool `json:"oog"`
+}
+
+// ERC7562TracerResult is the result of the ERC-7562 tracer.
+type ERC7562TracerResult struct {
+	Phases []*ERC7562Phase `json:"phases"`
+	Keccak []hexutil.Bytes `json:"keccak"`
+	Output hexutil.Bytes   `json:"output"`
+	Error  string          `json:"error,omitempty"`
+}
+
+var _ tracers.Tracer = &ERC7562Tracer{}
+
+// ERC7562Tracer is a native tracer that collects the opcodes, storage accesses
+// and calls performed during the simulation of an ERC-4337 user operation
+// validation. It does not enforce any rule: the collected data is evaluated by
+// the bundler.
+type ERC7562Tracer struct {
+	env        *vm.EVM
+	entryPoint common.Address
+	result     ERC7562TracerResult
+	callDepth  int
+	lastOpGas  bool
+	interrupt  uint32 // Atomic flag to signal execution interruption
+	reason     error  // Textual reason for the interruption
+}
+
+// NewERC7562Tracer creates a new ERC-7562 tracer.
+func NewERC7562Tracer() *ERC7562Tracer {
+	t := &ERC7562Tracer{}
+	t.newPhase()
+	return t
+}
+
+// newPhase starts a new validation phase.
+func (t *ERC7562Tracer) newPhase() {
+	t.result.Phases = append(t.result.Phases, &ERC7562Phase{
+		Opcodes:      make(map[string]uint64),
+		Access:       make(map[common.Address]*ERC7562StorageAccess),
+		ContractSize: make(map[common.Address]int),
+	})
+}
+
+// currentPhase returns the validation phase being traced.
+func (t *ERC7562Tracer) currentPhase() *ERC7562Phase {
+	return t.result.Phases[len(t.result.Phases)-1]
+}
+
+// storageAccess returns the storage access record of the given contract on
+// the current phase.
+func (t *ERC7562Tracer) storageAccess(address common.Address) *ERC7562StorageAccess {
+	phase := t.currentPhase()
+	access, ok := phase.Access[address]
+	if !ok {
+		access = &ERC7562StorageAccess{
+			Reads:  make(map[string]uint64),
+			Writes: make(map[string]uint64),
+		}
+		phase.Access[address] = access
+	}
+	return access
+}
+
+// CaptureStart implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.entryPoint = to
+	t.callDepth = 1
+}
+
+// CaptureState implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+
+	// the EntryPoint marks the start of a new phase with the NUMBER opcode
+	if depth == 1 {
+		if op == vm.NUMBER {
+			t.newPhase()
+		}
+		return
+	}
+
+	phase := t.currentPhase()
+	if gas < cost {
+		phase.OOG = true
+	}
+
+	// GAS is only allowed when it is immediately followed by a call
+	if t.lastOpGas {
+		switch op {
+		case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		default:
+			phase.Opcodes[vm.GAS.String()]++
+		}
+	}
+	t.lastOpGas = op == vm.GAS
+	if op != vm.GAS {
+		phase.Opcodes[op.String()]++
+	}
+
+	stack := scope.Stack
+	switch op {
+	case vm.SLOAD:
+		slot := common.Hash(stack.Back(0).Bytes32())
+		t.storageAccess(scope.Contract.Address()).Reads[slot.Hex()]++
+	case vm.SSTORE:
+		slot := common.Hash(stack.Back(0).Bytes32())
+		t.storageAccess(scope.Contract.Address()).Writes[slot.Hex()]++
+	case vm.KECCAK256:
+		offset, size := stack.Back(0), stack.Back(1)
+		if size.IsUint64() && size.Uint64() <= maxKeccakInputSize && offset.IsUint64() {
+			input := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
+			t.result.Keccak = append(t.result.Keccak, input)
+		}
+	}
+}
+
+// CaptureFault implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) {
+	t.result.Output = common.CopyBytes(output)
+	if err != nil {
+		t.result.Error = err.Error()
+	}
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+
+	if t.callDepth == 1 {
+		// a call of the EntryPoint to a different entity also starts a new phase
+		if target := t.currentPhase().TopLevelTarget; target != (common.Address{}) && target != to {
+			t.newPhase()
+		}
+		if phase := t.currentPhase(); phase.TopLevelTarget == (common.Address{}) {
+			phase.TopLevelTarget = to
+			if len(input) >= 4 {
+				phase.TopLevelMethod = common.CopyBytes(input[:4])
+			}
+		}
+	}
+	t.callDepth++
+
+	phase := t.currentPhase()
+
+	if to == t.entryPoint {
+		selector := hexutil.Bytes{}
+		if len(input) >= 4 {
+			selector = common.CopyBytes(input[:4])
+		}
+		phase.EntryPointCalls = append(phase.EntryPointCalls, selector)
+	}
+
+	if _, ok := phase.ContractSize[to]; !ok && t.env != nil {
+		phase.ContractSize[to] = t.env.StateDB.GetCodeSize(to)
+	}
+}
+
+// CaptureExit implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.callDepth--
+}
+
+// CaptureTxStart implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureTxStart(gasLimit uint64) {}
+
+// CaptureTxEnd implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *ERC7562Tracer) CaptureTxEnd(restGas uint64) {}
+
+// GetResult implements tracers.Tracer interface
+func (t *ERC7562Tracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.result)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop implements tracers.Tracer interface
+func (t *ERC7562Tracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
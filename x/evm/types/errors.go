@@ -30,6 +30,10 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrInactivePrecompile
+	codeErrDeploymentNotAllowed
+	codeErrCalleeDenied
+	codeErrInvalidSetCodeTx
+	codeErrSetCodeTxDisabled
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -82,6 +86,18 @@ var (
 
 	// ErrInactivePrecompile returns an error if a call is made to an inactive precompile
 	ErrInactivePrecompile = errorsmod.Register(ModuleName, codeErrInactivePrecompile, "precompile not enabled")
+
+	// ErrDeploymentNotAllowed returns an error if the deployer is not allowed by the deployment policy
+	ErrDeploymentNotAllowed = errorsmod.Register(ModuleName, codeErrDeploymentNotAllowed, "contract deployment not allowed")
+
+	// ErrCalleeDenied returns an error if a call is made to a contract denied by the deployment policy
+	ErrCalleeDenied = errorsmod.Register(ModuleName, codeErrCalleeDenied, "contract call denied")
+
+	// ErrInvalidSetCodeTx returns an error if an EIP-7702 set code transaction or one of its authorizations is invalid
+	ErrInvalidSetCodeTx = errorsmod.Register(ModuleName, codeErrInvalidSetCodeTx, "invalid set code transaction")
+
+	// ErrSetCodeTxDisabled returns an error if a set code transaction is sent before the prague fork
+	ErrSetCodeTxDisabled = errorsmod.Register(ModuleName, codeErrSetCodeTxDisabled, "set code transactions are not enabled")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
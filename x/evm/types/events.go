@@ -8,6 +8,8 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 
+	EventTypeContractStats = "contract_stats"
+
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeKeyTxHash          = "txHash"
@@ -20,6 +22,9 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyGasUsed          = "gasUsed"
+	AttributeKeyCalls            = "calls"
+	AttributeKeyReverts          = "reverts"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
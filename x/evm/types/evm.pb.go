@@ -24,6 +24,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DeploymentMode defines who is allowed to deploy contracts on the EVM.
+type DeploymentMode int32
+
+const (
+	// DEPLOYMENT_MODE_PERMISSIONLESS allows any account to deploy contracts
+	DEPLOYMENT_MODE_PERMISSIONLESS DeploymentMode = 0
+	// DEPLOYMENT_MODE_ALLOWLISTED_EOAS only allows the accounts of the deployer
+	// allowlist to deploy contracts
+	DEPLOYMENT_MODE_ALLOWLISTED_EOAS DeploymentMode = 1
+	// DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES allows the accounts of the deployer
+	// allowlist and the contracts deployed directly by them (factories) to deploy
+	// contracts
+	DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES DeploymentMode = 2
+)
+
+var DeploymentMode_name = map[int32]string{
+	0: "DEPLOYMENT_MODE_PERMISSIONLESS",
+	1: "DEPLOYMENT_MODE_ALLOWLISTED_EOAS",
+	2: "DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES",
+}
+
+var DeploymentMode_value = map[string]int32{
+	"DEPLOYMENT_MODE_PERMISSIONLESS":        0,
+	"DEPLOYMENT_MODE_ALLOWLISTED_EOAS":      1,
+	"DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES": 2,
+}
+
+func (x DeploymentMode) String() string {
+	return proto.EnumName(DeploymentMode_name, int32(x))
+}
+
+func (DeploymentMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// evm_denom represents the token denomination used to run the EVM state
@@ -45,6 +80,19 @@ type Params struct {
 	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty"`
 	// evm_channels is the list of channel identifiers from EVM compatible chains
 	EVMChannels []string `protobuf:"bytes,8,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// precompile_gas_schedules defines the gas costs overrides for the methods of the
+	// stateful precompiled contracts
+	PrecompileGasSchedules []PrecompileGasSchedule `protobuf:"bytes,9,rep,name=precompile_gas_schedules,json=precompileGasSchedules,proto3" json:"precompile_gas_schedules"`
+	// enable_bls12381_precompiles toggles the EIP-2537 BLS12-381 curve operation
+	// precompiled contracts at the addresses 0x0b to 0x11. The p256 precompile must
+	// be activated at its RIP-7212 address 0x100 instead of 0x0b to enable them.
+	EnableBLS12381Precompiles bool `protobuf:"varint,10,opt,name=enable_bls12381_precompiles,json=enableBls12381Precompiles,proto3" json:"enable_bls12381_precompiles,omitempty"`
+	// deployment_policy defines which accounts are allowed to deploy contracts and
+	// which contracts cannot be called
+	DeploymentPolicy DeploymentPolicy `protobuf:"bytes,11,opt,name=deployment_policy,json=deploymentPolicy,proto3" json:"deployment_policy"`
+	// failed_tx_hooks_gas_limit defines the gas limit of the hooks executed on the
+	// failed Ethereum transactions. The hooks are skipped if it is zero.
+	FailedTxHooksGasLimit uint64 `protobuf:"varint,12,opt,name=failed_tx_hooks_gas_limit,json=failedTxHooksGasLimit,proto3" json:"failed_tx_hooks_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -136,6 +184,357 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetPrecompileGasSchedules() []PrecompileGasSchedule {
+	if m != nil {
+		return m.PrecompileGasSchedules
+	}
+	return nil
+}
+
+func (m *Params) GetEnableBLS12381Precompiles() bool {
+	if m != nil {
+		return m.EnableBLS12381Precompiles
+	}
+	return false
+}
+
+func (m *Params) GetDeploymentPolicy() DeploymentPolicy {
+	if m != nil {
+		return m.DeploymentPolicy
+	}
+	return DeploymentPolicy{}
+}
+
+func (m *Params) GetFailedTxHooksGasLimit() uint64 {
+	if m != nil {
+		return m.FailedTxHooksGasLimit
+	}
+	return 0
+}
+
+// DeploymentPolicy defines the permissioned contract deployment policy of the EVM.
+// It applies to both top-level contract creations and to the nested CREATE and
+// CREATE2 operations executed by contracts.
+type DeploymentPolicy struct {
+	// mode defines who is allowed to deploy contracts
+	Mode DeploymentMode `protobuf:"varint,1,opt,name=mode,proto3,enum=ethermint.evm.v1.DeploymentMode" json:"mode,omitempty"`
+	// deployer_allowlist is the list of hex addresses allowed to deploy contracts
+	// when the mode is not permissionless
+	DeployerAllowlist []string `protobuf:"bytes,2,rep,name=deployer_allowlist,json=deployerAllowlist,proto3" json:"deployer_allowlist,omitempty"`
+	// denied_callees is the list of hex addresses of the contracts that cannot be
+	// called, regardless of the deployment mode
+	DeniedCallees []string `protobuf:"bytes,3,rep,name=denied_callees,json=deniedCallees,proto3" json:"denied_callees,omitempty"`
+}
+
+func (m *DeploymentPolicy) Reset()         { *m = DeploymentPolicy{} }
+func (m *DeploymentPolicy) String() string { return proto.CompactTextString(m) }
+func (*DeploymentPolicy) ProtoMessage()    {}
+func (*DeploymentPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *DeploymentPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentPolicy.Merge(m, src)
+}
+func (m *DeploymentPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentPolicy proto.InternalMessageInfo
+
+func (m *DeploymentPolicy) GetMode() DeploymentMode {
+	if m != nil {
+		return m.Mode
+	}
+	return DEPLOYMENT_MODE_PERMISSIONLESS
+}
+
+func (m *DeploymentPolicy) GetDeployerAllowlist() []string {
+	if m != nil {
+		return m.DeployerAllowlist
+	}
+	return nil
+}
+
+func (m *DeploymentPolicy) GetDeniedCallees() []string {
+	if m != nil {
+		return m.DeniedCallees
+	}
+	return nil
+}
+
+// DeploymentFactory defines a contract that was deployed by an allowlisted
+// deployer and is allowed to deploy contracts on its behalf.
+type DeploymentFactory struct {
+	// address is the hex address of the factory contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// deployer is the hex address of the allowlisted account that deployed the factory
+	Deployer string `protobuf:"bytes,2,opt,name=deployer,proto3" json:"deployer,omitempty"`
+}
+
+func (m *DeploymentFactory) Reset()         { *m = DeploymentFactory{} }
+func (m *DeploymentFactory) String() string { return proto.CompactTextString(m) }
+func (*DeploymentFactory) ProtoMessage()    {}
+func (*DeploymentFactory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *DeploymentFactory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentFactory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentFactory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentFactory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentFactory.Merge(m, src)
+}
+func (m *DeploymentFactory) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentFactory) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentFactory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentFactory proto.InternalMessageInfo
+
+func (m *DeploymentFactory) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DeploymentFactory) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+// ContractStats defines the execution statistics of a contract. The gas used
+// only accounts for the execution of the contract code, excluding the gas
+// used by the contracts it calls.
+type ContractStats struct {
+	// address is the hex address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// gas_used is the gas used by the execution of the contract code
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// calls is the number of calls to the contract
+	Calls uint64 `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	// reverts is the number of calls to the contract that reverted or failed
+	Reverts uint64 `protobuf:"varint,4,opt,name=reverts,proto3" json:"reverts,omitempty"`
+}
+
+func (m *ContractStats) Reset()         { *m = ContractStats{} }
+func (m *ContractStats) String() string { return proto.CompactTextString(m) }
+func (*ContractStats) ProtoMessage()    {}
+func (*ContractStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *ContractStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStats.Merge(m, src)
+}
+func (m *ContractStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStats proto.InternalMessageInfo
+
+func (m *ContractStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractStats) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ContractStats) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+func (m *ContractStats) GetReverts() uint64 {
+	if m != nil {
+		return m.Reverts
+	}
+	return 0
+}
+
+// PrecompileGasSchedule defines the gas costs of the methods of a precompiled contract.
+// The costs override the default gas configuration of the precompile.
+type PrecompileGasSchedule struct {
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// methods defines the gas costs of the precompile methods
+	Methods []MethodGasCost `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods"`
+}
+
+func (m *PrecompileGasSchedule) Reset()         { *m = PrecompileGasSchedule{} }
+func (m *PrecompileGasSchedule) String() string { return proto.CompactTextString(m) }
+func (*PrecompileGasSchedule) ProtoMessage()    {}
+func (*PrecompileGasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+}
+func (m *PrecompileGasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileGasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileGasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileGasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileGasSchedule.Merge(m, src)
+}
+func (m *PrecompileGasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileGasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileGasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileGasSchedule proto.InternalMessageInfo
+
+func (m *PrecompileGasSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileGasSchedule) GetMethods() []MethodGasCost {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+// MethodGasCost defines the gas costs of a single precompile method.
+type MethodGasCost struct {
+	// method is the ABI name of the precompile method
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// base_gas is the flat gas cost charged on every call to the method
+	BaseGas uint64 `protobuf:"varint,2,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	// gas_per_byte is the gas cost charged for every byte of the method arguments
+	GasPerByte uint64 `protobuf:"varint,3,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty"`
+	// storage_gas_multiplier scales the KV store gas costs (reads, writes,
+	// iterations and deletions) incurred during the method execution. A zero
+	// value leaves the default KV store gas costs unchanged.
+	StorageGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=storage_gas_multiplier,json=storageGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"storage_gas_multiplier"`
+}
+
+func (m *MethodGasCost) Reset()         { *m = MethodGasCost{} }
+func (m *MethodGasCost) String() string { return proto.CompactTextString(m) }
+func (*MethodGasCost) ProtoMessage()    {}
+func (*MethodGasCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+}
+func (m *MethodGasCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MethodGasCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MethodGasCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MethodGasCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodGasCost.Merge(m, src)
+}
+func (m *MethodGasCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *MethodGasCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodGasCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodGasCost proto.InternalMessageInfo
+
+func (m *MethodGasCost) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MethodGasCost) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+func (m *MethodGasCost) GetGasPerByte() uint64 {
+	if m != nil {
+		return m.GasPerByte
+	}
+	return 0
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -178,13 +577,16 @@ type ChainConfig struct {
 	ShanghaiBlock *cosmossdk_io_math.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
 	// cancun_block switch block (nil = no fork, 0 = already on cancun)
 	CancunBlock *cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=cosmossdk.io/math.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	// prague_block switch block (nil = no fork, 0 = already on prague), enables the
+	// EIP-7702 set code transactions
+	PragueBlock *cosmossdk_io_math.Int `protobuf:"bytes,24,opt,name=prague_block,json=pragueBlock,proto3,customtype=cosmossdk.io/math.Int" json:"prague_block,omitempty" yaml:"prague_block"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +641,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +698,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +774,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +889,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +930,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +990,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{12}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +1097,13 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 }
 
 func init() {
+	proto.RegisterEnum("ethermint.evm.v1.DeploymentMode", DeploymentMode_name, DeploymentMode_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*DeploymentPolicy)(nil), "ethermint.evm.v1.DeploymentPolicy")
+	proto.RegisterType((*DeploymentFactory)(nil), "ethermint.evm.v1.DeploymentFactory")
+	proto.RegisterType((*ContractStats)(nil), "ethermint.evm.v1.ContractStats")
+	proto.RegisterType((*PrecompileGasSchedule)(nil), "ethermint.evm.v1.PrecompileGasSchedule")
+	proto.RegisterType((*MethodGasCost)(nil), "ethermint.evm.v1.MethodGasCost")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -708,111 +1116,143 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xcd, 0x4f, 0x23, 0xc9,
-	0x15, 0x07, 0x6c, 0xa0, 0x5d, 0x36, 0x76, 0x53, 0x18, 0xd6, 0x3b, 0xa3, 0xd0, 0xa4, 0x0f, 0x11,
-	0x91, 0x76, 0x61, 0x60, 0xc2, 0xee, 0x68, 0x57, 0xf9, 0x98, 0x9e, 0x61, 0x13, 0xc8, 0xec, 0x06,
-	0x15, 0x6c, 0xa2, 0x44, 0x89, 0x5a, 0xe5, 0xee, 0xda, 0x76, 0x2f, 0xdd, 0x5d, 0x56, 0x55, 0xb5,
-	0xc7, 0xce, 0x5f, 0x10, 0x29, 0x97, 0xfc, 0x09, 0xfb, 0xe7, 0xac, 0x72, 0x9a, 0x63, 0x94, 0x43,
-	0x2b, 0x62, 0x6e, 0x28, 0x27, 0xee, 0x91, 0xa2, 0xfa, 0x70, 0xfb, 0x03, 0x42, 0x7c, 0x81, 0xfa,
-	0xbd, 0x8f, 0xdf, 0xab, 0xf7, 0xea, 0xb5, 0x5f, 0x15, 0x78, 0x42, 0x44, 0x8f, 0xb0, 0x34, 0xce,
-	0xc4, 0x21, 0x19, 0xa4, 0x87, 0x83, 0x23, 0xf9, 0xef, 0xa0, 0xcf, 0xa8, 0xa0, 0xd0, 0x2e, 0x75,
-	0x07, 0x52, 0x38, 0x38, 0x7a, 0xd2, 0x8e, 0x68, 0x44, 0x95, 0xf2, 0x50, 0xae, 0xb4, 0x9d, 0xfb,
-	0xef, 0x0a, 0x58, 0xbb, 0xc0, 0x0c, 0xa7, 0x1c, 0x1e, 0x81, 0x1a, 0x19, 0xa4, 0x7e, 0x48, 0x32,
-	0x9a, 0x76, 0x96, 0xf7, 0x96, 0xf7, 0x6b, 0x5e, 0xfb, 0xae, 0x70, 0xec, 0x11, 0x4e, 0x93, 0xcf,
-	0xdc, 0x52, 0xe5, 0x22, 0x8b, 0x0c, 0xd2, 0xd7, 0x72, 0x09, 0x7f, 0x0a, 0x36, 0x48, 0x86, 0xbb,
-	0x09, 0xf1, 0x03, 0x46, 0xb0, 0x20, 0x9d, 0x95, 0xbd, 0xe5, 0x7d, 0xcb, 0xeb, 0xdc, 0x15, 0x4e,
-	0xdb, 0xb8, 0x4d, 0xab, 0x5d, 0xd4, 0xd0, 0xf8, 0x95, 0x82, 0xf0, 0x53, 0x50, 0x1f, 0xeb, 0x71,
-	0x92, 0x74, 0x2a, 0xca, 0x79, 0xe7, 0xae, 0x70, 0xe0, 0xac, 0x33, 0x4e, 0x12, 0x17, 0x01, 0xe3,
-	0x8a, 0x93, 0x04, 0xbe, 0x04, 0x80, 0x0c, 0x05, 0xc3, 0x3e, 0x89, 0xfb, 0xbc, 0x53, 0xdd, 0xab,
-	0xec, 0x57, 0x3c, 0xf7, 0xa6, 0x70, 0x6a, 0xa7, 0x52, 0x7a, 0x7a, 0x76, 0xc1, 0xef, 0x0a, 0x67,
-	0xd3, 0x90, 0x94, 0x86, 0x2e, 0xaa, 0x29, 0x70, 0x1a, 0xf7, 0x39, 0xfc, 0x13, 0x68, 0x04, 0x3d,
-	0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe2, 0xa8, 0xb3, 0xba, 0xb7, 0xbc, 0x5f, 0x3f, 0xfe, 0xc1,
-	0xc1, 0x7c, 0xdd, 0x0e, 0x5e, 0x49, 0xab, 0x57, 0xca, 0xc8, 0x7b, 0xfa, 0x7d, 0xe1, 0x2c, 0xdd,
-	0x15, 0xce, 0x96, 0xa6, 0x9e, 0x26, 0x70, 0x51, 0x3d, 0x98, 0x58, 0xc2, 0x63, 0xb0, 0x8d, 0x93,
-	0x84, 0xbe, 0xf5, 0xf3, 0x4c, 0x16, 0x9a, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf2, 0xce, 0x9a, 0x4c,
-	0x12, 0x6d, 0x29, 0xe5, 0xd7, 0x13, 0xdd, 0xd5, 0x90, 0xc3, 0x8f, 0x01, 0xc4, 0x81, 0x88, 0x07,
-	0xc4, 0xef, 0x33, 0x12, 0xd0, 0xb4, 0x1f, 0x27, 0x84, 0x77, 0xd6, 0xf7, 0x2a, 0xfb, 0x35, 0xb4,
-	0xa9, 0x35, 0x17, 0x13, 0x05, 0x3c, 0x06, 0x0d, 0x79, 0x28, 0x41, 0x0f, 0x67, 0x19, 0x49, 0x78,
-	0xc7, 0x92, 0x86, 0x5e, 0xeb, 0xa6, 0x70, 0xea, 0xa7, 0xbf, 0xfd, 0xf2, 0x95, 0x11, 0xa3, 0x3a,
-	0x19, 0xa4, 0x63, 0xe0, 0xfe, 0xa7, 0x09, 0xea, 0x53, 0x09, 0xc1, 0x3f, 0x82, 0x56, 0x8f, 0xa6,
-	0x84, 0x0b, 0x82, 0x43, 0xbf, 0x9b, 0xd0, 0xe0, 0xda, 0x9c, 0xfc, 0xf3, 0x7f, 0x16, 0xce, 0x76,
-	0x40, 0x79, 0x4a, 0x39, 0x0f, 0xaf, 0x0f, 0x62, 0x7a, 0x98, 0x62, 0xd1, 0x3b, 0x38, 0xcb, 0xc4,
-	0x5d, 0xe1, 0xec, 0xe8, 0xf4, 0xe7, 0x3c, 0x5d, 0xd4, 0x2c, 0x25, 0x9e, 0x14, 0xc0, 0x1e, 0x68,
-	0x86, 0x98, 0xfa, 0xdf, 0x50, 0x76, 0x6d, 0xc8, 0x57, 0x14, 0xb9, 0xf7, 0x3f, 0xc9, 0x6f, 0x0a,
-	0xa7, 0xf1, 0xfa, 0xe5, 0x6f, 0xbe, 0xa0, 0xec, 0x5a, 0x51, 0xdc, 0x15, 0xce, 0xb6, 0x0e, 0x36,
-	0x4b, 0xe4, 0xa2, 0x46, 0x88, 0x69, 0x69, 0x06, 0x7f, 0x07, 0xec, 0xd2, 0x80, 0xe7, 0xfd, 0x3e,
-	0x65, 0xc2, 0xb4, 0xd3, 0xc7, 0x37, 0x85, 0xd3, 0x34, 0x94, 0x97, 0x5a, 0x73, 0x57, 0x38, 0x1f,
-	0xcc, 0x91, 0x1a, 0x1f, 0x17, 0x35, 0x0d, 0xad, 0x31, 0x85, 0x5d, 0xd0, 0x20, 0x71, 0xff, 0xe8,
-	0xe4, 0x99, 0x49, 0xa0, 0xaa, 0x12, 0xf8, 0xf9, 0x63, 0x09, 0xd4, 0x4f, 0xcf, 0x2e, 0x8e, 0x4e,
-	0x9e, 0x8d, 0xf7, 0x6f, 0x7a, 0x65, 0x9a, 0xc5, 0x45, 0x75, 0x0d, 0xf5, 0xe6, 0xcf, 0x80, 0x81,
-	0x7e, 0x0f, 0xf3, 0x9e, 0xea, 0xc4, 0x9a, 0xb7, 0x7f, 0x53, 0x38, 0x40, 0x33, 0xfd, 0x0a, 0xf3,
-	0xde, 0xa4, 0xea, 0xdd, 0xd1, 0x9f, 0x71, 0x26, 0xe2, 0x3c, 0x1d, 0x73, 0x01, 0xed, 0x2c, 0xad,
-	0xca, 0xed, 0x9e, 0x98, 0xed, 0xae, 0x2d, 0xba, 0xdd, 0x93, 0x87, 0xb6, 0x7b, 0x32, 0xbb, 0x5d,
-	0x6d, 0x53, 0xc6, 0x78, 0x61, 0x62, 0xac, 0x2f, 0x1a, 0xe3, 0xc5, 0x43, 0x31, 0x5e, 0xcc, 0xc6,
-	0xd0, 0x36, 0xb2, 0x2f, 0xe7, 0xf2, 0xec, 0x58, 0x0b, 0xf7, 0xe5, 0xbd, 0x0a, 0x35, 0x4b, 0x89,
-	0x66, 0xbf, 0x06, 0xed, 0x80, 0x66, 0x5c, 0x48, 0x59, 0x46, 0xfb, 0x09, 0x31, 0x21, 0x6a, 0x2a,
-	0xc4, 0x8b, 0xc7, 0x42, 0x3c, 0x35, 0x5f, 0xfe, 0x03, 0xee, 0x2e, 0xda, 0x9a, 0x15, 0xeb, 0x60,
-	0x3e, 0xb0, 0xfb, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x4c, 0x20, 0xa0, 0x02, 0xfd, 0xe4, 0xb1,
-	0x40, 0xa6, 0x43, 0xe7, 0x5d, 0x5d, 0xd4, 0x9a, 0x88, 0x74, 0x80, 0xdf, 0x83, 0x66, 0x2c, 0xa3,
-	0x76, 0xf3, 0xc4, 0xd0, 0xd7, 0x15, 0xfd, 0xf1, 0x63, 0xf4, 0xe6, 0xab, 0x9a, 0x75, 0x74, 0xd1,
-	0xc6, 0x58, 0xa0, 0xa9, 0x43, 0x00, 0xd3, 0x3c, 0x66, 0x7e, 0x94, 0xe0, 0x20, 0x26, 0xcc, 0xd0,
-	0x37, 0x14, 0xfd, 0x27, 0x8f, 0xd1, 0x7f, 0xa8, 0xe9, 0xef, 0x3b, 0xbb, 0xc8, 0x96, 0xc2, 0x5f,
-	0x6a, 0x99, 0x8e, 0x72, 0x09, 0x1a, 0x5d, 0xc2, 0x92, 0x38, 0x33, 0xfc, 0x1b, 0x8a, 0xff, 0xd9,
-	0x63, 0xfc, 0xa6, 0x83, 0xa6, 0xdd, 0x5c, 0x54, 0xd7, 0xb0, 0x24, 0x4d, 0x68, 0x16, 0xd2, 0x31,
-	0xe9, 0xe6, 0xc2, 0xa4, 0xd3, 0x6e, 0x2e, 0xaa, 0x6b, 0xa8, 0x49, 0x23, 0xb0, 0x85, 0x19, 0xa3,
-	0x6f, 0xe7, 0x0a, 0x02, 0x15, 0xf7, 0xa7, 0x8f, 0x71, 0x3f, 0xd1, 0xdc, 0x0f, 0x78, 0xbb, 0x68,
-	0x53, 0x49, 0x67, 0x4a, 0x12, 0x02, 0x18, 0x31, 0x3c, 0x9a, 0x8b, 0xd3, 0x5e, 0xb8, 0xf0, 0xf7,
-	0x9d, 0x5d, 0x64, 0x4b, 0xe1, 0x4c, 0x94, 0x6f, 0x41, 0x3b, 0x25, 0x2c, 0x22, 0x7e, 0x46, 0x04,
-	0xef, 0x27, 0xb1, 0x30, 0x71, 0xb6, 0x17, 0xfe, 0x0e, 0x1e, 0x72, 0x77, 0x11, 0x54, 0xe2, 0xaf,
-	0x8c, 0xb4, 0xec, 0x52, 0xde, 0xc3, 0x59, 0xd4, 0xc3, 0xb1, 0x89, 0xb2, 0xb3, 0x70, 0x97, 0xce,
-	0x3a, 0xba, 0x68, 0x63, 0x2c, 0x28, 0x8f, 0x3a, 0xc0, 0x59, 0x90, 0x8f, 0x8f, 0xfa, 0x83, 0x85,
-	0x8f, 0x7a, 0xda, 0x4d, 0x0e, 0x70, 0x05, 0x15, 0xe9, 0x79, 0xd5, 0x6a, 0xda, 0xad, 0xf3, 0xaa,
-	0xd5, 0xb2, 0xed, 0xf3, 0xaa, 0x65, 0xdb, 0x9b, 0xe7, 0x55, 0x6b, 0xcb, 0x6e, 0xa3, 0x8d, 0x11,
-	0x4d, 0xa8, 0x3f, 0x78, 0xae, 0x9d, 0x50, 0x9d, 0xbc, 0xc5, 0xdc, 0xfc, 0xd0, 0xa0, 0x66, 0x80,
-	0x05, 0x4e, 0x46, 0xdc, 0x14, 0x02, 0xd9, 0xba, 0x3c, 0x53, 0x63, 0xeb, 0x10, 0xac, 0x5e, 0x0a,
-	0x79, 0xf5, 0xb1, 0x41, 0xe5, 0x9a, 0x8c, 0xf4, 0xb0, 0x45, 0x72, 0x09, 0xdb, 0x60, 0x75, 0x80,
-	0x93, 0x5c, 0xdf, 0xa1, 0x6a, 0x48, 0x03, 0xf7, 0x02, 0xb4, 0xae, 0x18, 0xce, 0xb8, 0x1c, 0xff,
-	0x34, 0x7b, 0x43, 0x23, 0x0e, 0x21, 0xa8, 0xaa, 0x39, 0xa1, 0x7d, 0xd5, 0x1a, 0xfe, 0x18, 0x54,
-	0x13, 0x1a, 0xf1, 0xce, 0xca, 0x5e, 0x65, 0xbf, 0x7e, 0xbc, 0x7d, 0xff, 0x16, 0xf3, 0x86, 0x46,
-	0x48, 0x99, 0xb8, 0x7f, 0x5f, 0x01, 0x95, 0x37, 0x34, 0x82, 0x1d, 0xb0, 0x8e, 0xc3, 0x90, 0x11,
-	0xce, 0x0d, 0xd3, 0x18, 0xc2, 0x1d, 0xb0, 0x26, 0x68, 0x3f, 0x0e, 0x34, 0x5d, 0x0d, 0x19, 0x24,
-	0x03, 0x87, 0x58, 0x60, 0x35, 0x58, 0x1b, 0x48, 0xad, 0xe5, 0x25, 0x44, 0x65, 0xe6, 0x67, 0x79,
-	0xda, 0x25, 0x4c, 0xcd, 0xc7, 0xaa, 0xd7, 0xba, 0x2d, 0x9c, 0xba, 0x92, 0x7f, 0xa5, 0xc4, 0x68,
-	0x1a, 0xc0, 0x8f, 0xc0, 0xba, 0x18, 0x4e, 0xcf, 0xba, 0xad, 0xdb, 0xc2, 0x69, 0x89, 0x49, 0x9a,
-	0x72, 0x94, 0xa1, 0x35, 0x31, 0x54, 0x23, 0xed, 0x10, 0x58, 0x62, 0xe8, 0xc7, 0x59, 0x48, 0x86,
-	0x6a, 0x9c, 0x55, 0xbd, 0xf6, 0x6d, 0xe1, 0xd8, 0x53, 0xe6, 0x67, 0x52, 0x87, 0xd6, 0xc5, 0x50,
-	0x2d, 0xe0, 0x47, 0x00, 0xe8, 0x2d, 0xa9, 0x08, 0x7a, 0x3a, 0x6d, 0xdc, 0x16, 0x4e, 0x4d, 0x49,
-	0x15, 0xf7, 0x64, 0x09, 0x5d, 0xb0, 0xaa, 0xb9, 0x2d, 0xc5, 0xdd, 0xb8, 0x2d, 0x1c, 0x2b, 0xa1,
-	0x91, 0xe6, 0xd4, 0x2a, 0x59, 0x2a, 0x46, 0x52, 0x3a, 0x20, 0xa1, 0x1a, 0x11, 0x16, 0x1a, 0x43,
-	0xf7, 0xaf, 0x2b, 0xc0, 0xba, 0x1a, 0x22, 0xc2, 0xf3, 0x44, 0xc0, 0x2f, 0x80, 0x1d, 0xd0, 0x4c,
-	0x30, 0x1c, 0x08, 0x7f, 0xa6, 0xb4, 0xde, 0xd3, 0xc9, 0x0f, 0xfa, 0xbc, 0x85, 0x8b, 0x5a, 0x63,
-	0xd1, 0x4b, 0x53, 0xff, 0x36, 0x58, 0xed, 0x26, 0x94, 0xa6, 0xaa, 0x13, 0x1a, 0x48, 0x03, 0x88,
-	0x54, 0xd5, 0xd4, 0x29, 0x57, 0xd4, 0x5d, 0xf5, 0x87, 0xf7, 0x4f, 0x79, 0xae, 0x55, 0xbc, 0x1d,
-	0x73, 0x5f, 0x6d, 0xea, 0xd8, 0xc6, 0xdf, 0x95, 0xb5, 0x55, 0xad, 0x64, 0x83, 0x0a, 0x23, 0x42,
-	0x1d, 0x5a, 0x03, 0xc9, 0x25, 0x7c, 0x02, 0x2c, 0x46, 0x06, 0x84, 0x09, 0x12, 0xaa, 0xc3, 0xb1,
-	0x50, 0x89, 0xe1, 0x87, 0xc0, 0x8a, 0x30, 0xf7, 0x73, 0x4e, 0x42, 0x7d, 0x12, 0x68, 0x3d, 0xc2,
-	0xfc, 0x6b, 0x4e, 0xc2, 0xcf, 0xaa, 0x7f, 0xf9, 0xce, 0x59, 0x72, 0x31, 0xa8, 0xbf, 0x0c, 0x02,
-	0xc2, 0xf9, 0x55, 0xde, 0x4f, 0xc8, 0x23, 0x1d, 0x76, 0x0c, 0x1a, 0x5c, 0x50, 0x86, 0x23, 0xe2,
-	0x5f, 0x93, 0x91, 0xe9, 0x33, 0xdd, 0x35, 0x46, 0xfe, 0x6b, 0x32, 0xe2, 0x68, 0x1a, 0x98, 0x10,
-	0xdf, 0x55, 0x41, 0xfd, 0x8a, 0xe1, 0x80, 0x98, 0x0b, 0xac, 0xec, 0x55, 0x09, 0x99, 0x09, 0x61,
-	0x90, 0x8c, 0x2d, 0xe2, 0x94, 0xd0, 0x5c, 0x98, 0xef, 0x69, 0x0c, 0xa5, 0x07, 0x23, 0x64, 0x48,
-	0x02, 0x55, 0xc6, 0x2a, 0x32, 0x08, 0x9e, 0x80, 0x8d, 0x30, 0xe6, 0xea, 0xc1, 0xc1, 0x05, 0x0e,
-	0xae, 0x75, 0xfa, 0x9e, 0x7d, 0x5b, 0x38, 0x0d, 0xa3, 0xb8, 0x94, 0x72, 0x34, 0x83, 0xe0, 0xe7,
-	0xa0, 0x35, 0x71, 0x53, 0xbb, 0xd5, 0x57, 0x7c, 0x0f, 0xde, 0x16, 0x4e, 0xb3, 0x34, 0x55, 0x1a,
-	0x34, 0x87, 0xe5, 0x49, 0x87, 0xa4, 0x9b, 0x47, 0xaa, 0xf9, 0x2c, 0xa4, 0x81, 0x94, 0x26, 0x71,
-	0x1a, 0x0b, 0xd5, 0x6c, 0xab, 0x48, 0x03, 0xf8, 0x39, 0xa8, 0xd1, 0x01, 0x61, 0x2c, 0x0e, 0x09,
-	0x57, 0x17, 0x88, 0xff, 0xf7, 0x5a, 0x41, 0x13, 0x7b, 0x99, 0x9c, 0x79, 0x4c, 0xa5, 0x24, 0xa5,
-	0x6c, 0xa4, 0xae, 0x08, 0x26, 0x39, 0xad, 0xf8, 0x52, 0xc9, 0xd1, 0x0c, 0x82, 0x1e, 0x80, 0xc6,
-	0x8d, 0x11, 0x91, 0xb3, 0xcc, 0x57, 0xdf, 0x7f, 0x43, 0xf9, 0xaa, 0xaf, 0x50, 0x6b, 0x91, 0x52,
-	0xbe, 0xc6, 0x02, 0xa3, 0x7b, 0x12, 0xf8, 0x33, 0x00, 0xf5, 0x99, 0xf8, 0xdf, 0x72, 0x5a, 0x3e,
-	0xb7, 0xf4, 0x8c, 0x57, 0xf1, 0xb5, 0xd6, 0xec, 0xd9, 0xd6, 0xe8, 0x9c, 0x53, 0x93, 0xc5, 0x79,
-	0xd5, 0xaa, 0xda, 0xab, 0xe7, 0x55, 0x6b, 0xdd, 0xb6, 0xca, 0xfa, 0x99, 0x2c, 0xd0, 0xd6, 0x18,
-	0x4f, 0x6d, 0xcf, 0xfb, 0xc5, 0xf7, 0x37, 0xbb, 0xcb, 0xef, 0x6e, 0x76, 0x97, 0xff, 0x75, 0xb3,
-	0xbb, 0xfc, 0xb7, 0xf7, 0xbb, 0x4b, 0xef, 0xde, 0xef, 0x2e, 0xfd, 0xe3, 0xfd, 0xee, 0xd2, 0x1f,
-	0x7e, 0x14, 0xc5, 0xa2, 0x97, 0x77, 0x0f, 0x02, 0x9a, 0xca, 0xa7, 0x32, 0xe5, 0xe6, 0xef, 0xe0,
-	0xe8, 0x93, 0xc3, 0xa1, 0x7a, 0x43, 0x8b, 0x51, 0x9f, 0xf0, 0xee, 0x9a, 0x7a, 0x1b, 0x3f, 0xff,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x0c, 0x86, 0x61, 0x61, 0x0f, 0x00, 0x00,
+	// 2167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdd, 0x4e, 0x24, 0xc7,
+	0xf5, 0x67, 0x96, 0x01, 0x66, 0x6a, 0x86, 0xa1, 0x29, 0x3e, 0x3c, 0xbb, 0x2b, 0xd3, 0xfc, 0xfb,
+	0x1f, 0x27, 0x38, 0xb2, 0x61, 0x61, 0x8d, 0x8d, 0x6c, 0x25, 0x0e, 0x0d, 0xb3, 0x18, 0x02, 0x0b,
+	0xaa, 0x61, 0x63, 0x6d, 0x94, 0xa8, 0x55, 0xd3, 0x5d, 0xdb, 0xd3, 0xa6, 0xbb, 0x6b, 0xd4, 0x55,
+	0x33, 0x3b, 0x93, 0x27, 0xb0, 0xe4, 0x9b, 0x3c, 0x41, 0x64, 0x29, 0x0f, 0x92, 0xab, 0x48, 0x56,
+	0xae, 0xac, 0x5c, 0x45, 0xbe, 0x68, 0x45, 0xec, 0x1d, 0x97, 0x3c, 0x41, 0x54, 0x1f, 0x3d, 0x5f,
+	0x90, 0x09, 0x37, 0x50, 0xbf, 0xf3, 0xf1, 0x3b, 0x55, 0xa7, 0x4e, 0x75, 0x9d, 0x1a, 0xf0, 0x84,
+	0xf0, 0x26, 0x49, 0xa2, 0x20, 0xe6, 0x5b, 0xa4, 0x13, 0x6d, 0x75, 0xb6, 0xc5, 0xbf, 0xcd, 0x56,
+	0x42, 0x39, 0x85, 0x46, 0x5f, 0xb7, 0x29, 0x84, 0x9d, 0xed, 0x27, 0xcb, 0x3e, 0xf5, 0xa9, 0x54,
+	0x6e, 0x89, 0x91, 0xb2, 0xb3, 0xfe, 0x3e, 0x0b, 0x66, 0x2f, 0x70, 0x82, 0x23, 0x06, 0xb7, 0x41,
+	0x91, 0x74, 0x22, 0xc7, 0x23, 0x31, 0x8d, 0xaa, 0xb9, 0xf5, 0xdc, 0x46, 0xd1, 0x5e, 0xbe, 0x4d,
+	0x4d, 0xa3, 0x87, 0xa3, 0xf0, 0x73, 0xab, 0xaf, 0xb2, 0x50, 0x81, 0x74, 0xa2, 0x43, 0x31, 0x84,
+	0xbf, 0x02, 0xf3, 0x24, 0xc6, 0x8d, 0x90, 0x38, 0x6e, 0x42, 0x30, 0x27, 0xd5, 0x47, 0xeb, 0xb9,
+	0x8d, 0x82, 0x5d, 0xbd, 0x4d, 0xcd, 0x65, 0xed, 0x36, 0xac, 0xb6, 0x50, 0x59, 0xe1, 0x03, 0x09,
+	0xe1, 0x67, 0xa0, 0x94, 0xe9, 0x71, 0x18, 0x56, 0xa7, 0xa5, 0xf3, 0xea, 0x6d, 0x6a, 0xc2, 0x51,
+	0x67, 0x1c, 0x86, 0x16, 0x02, 0xda, 0x15, 0x87, 0x21, 0xdc, 0x07, 0x80, 0x74, 0x79, 0x82, 0x1d,
+	0x12, 0xb4, 0x58, 0x35, 0xbf, 0x3e, 0xbd, 0x31, 0x6d, 0x5b, 0xd7, 0xa9, 0x59, 0xac, 0x09, 0x69,
+	0xed, 0xf8, 0x82, 0xdd, 0xa6, 0xe6, 0xa2, 0x26, 0xe9, 0x1b, 0x5a, 0xa8, 0x28, 0x41, 0x2d, 0x68,
+	0x31, 0xf8, 0x47, 0x50, 0x76, 0x9b, 0x38, 0x88, 0x1d, 0x97, 0xc6, 0x6f, 0x02, 0xbf, 0x3a, 0xb3,
+	0x9e, 0xdb, 0x28, 0xed, 0xbc, 0xbf, 0x39, 0x9e, 0xb7, 0xcd, 0x03, 0x61, 0x75, 0x20, 0x8d, 0xec,
+	0xa7, 0x3f, 0xa4, 0xe6, 0xd4, 0x6d, 0x6a, 0x2e, 0x29, 0xea, 0x61, 0x02, 0x0b, 0x95, 0xdc, 0x81,
+	0x25, 0xdc, 0x01, 0x2b, 0x38, 0x0c, 0xe9, 0x5b, 0xa7, 0x1d, 0x8b, 0x44, 0x13, 0x97, 0x13, 0xcf,
+	0xe1, 0x5d, 0x56, 0x9d, 0x15, 0x8b, 0x44, 0x4b, 0x52, 0xf9, 0x6a, 0xa0, 0xbb, 0xec, 0x32, 0xf8,
+	0x31, 0x80, 0xd8, 0xe5, 0x41, 0x87, 0x38, 0xad, 0x84, 0xb8, 0x34, 0x6a, 0x05, 0x21, 0x61, 0xd5,
+	0xb9, 0xf5, 0xe9, 0x8d, 0x22, 0x5a, 0x54, 0x9a, 0x8b, 0x81, 0x02, 0xee, 0x80, 0xb2, 0xd8, 0x14,
+	0xb7, 0x89, 0xe3, 0x98, 0x84, 0xac, 0x5a, 0x10, 0x86, 0xf6, 0xc2, 0x75, 0x6a, 0x96, 0x6a, 0xbf,
+	0x3b, 0x3b, 0xd0, 0x62, 0x54, 0x22, 0x9d, 0x28, 0x03, 0xd0, 0x07, 0xd5, 0x01, 0xb7, 0xe3, 0x63,
+	0xe6, 0x30, 0xb7, 0x49, 0xbc, 0xb6, 0x08, 0x54, 0x5c, 0x9f, 0xde, 0x28, 0xed, 0xfc, 0xe2, 0x6e,
+	0x06, 0x06, 0x41, 0x8f, 0x30, 0xab, 0x6b, 0x7b, 0x3b, 0x2f, 0x72, 0x81, 0x56, 0x5b, 0xf7, 0x29,
+	0x45, 0x7a, 0x9f, 0xea, 0xdd, 0x6b, 0x84, 0x6c, 0x7b, 0xe7, 0xf9, 0xde, 0xf6, 0xc8, 0xa2, 0x80,
+	0xdc, 0xea, 0xf7, 0xaf, 0x53, 0xf3, 0x71, 0x4d, 0x9a, 0xd9, 0xa7, 0x75, 0x69, 0x35, 0xb4, 0x40,
+	0xf4, 0x58, 0x31, 0xd8, 0x21, 0x1b, 0x57, 0xc1, 0x57, 0x60, 0xd1, 0x23, 0xad, 0x90, 0xf6, 0x22,
+	0x12, 0x73, 0xa7, 0x45, 0xc3, 0xc0, 0xed, 0x55, 0x4b, 0x72, 0x0b, 0xad, 0xbb, 0x0b, 0x38, 0xec,
+	0x9b, 0x5e, 0x48, 0x4b, 0x3d, 0x77, 0xc3, 0x1b, 0x93, 0xc3, 0x3d, 0xf0, 0xf8, 0x0d, 0x0e, 0x42,
+	0xb9, 0x55, 0x4e, 0x93, 0xd2, 0x2b, 0x26, 0x73, 0x14, 0x06, 0x51, 0xc0, 0xab, 0xe5, 0xf5, 0xdc,
+	0x46, 0x1e, 0xad, 0x28, 0x83, 0xcb, 0xee, 0x57, 0x42, 0x7d, 0x84, 0xd9, 0xa9, 0x50, 0x5a, 0x7f,
+	0xc9, 0x01, 0x63, 0x3c, 0x0c, 0xfc, 0x04, 0xe4, 0x23, 0xea, 0x11, 0x79, 0x98, 0x2a, 0x3b, 0xeb,
+	0x93, 0x26, 0x76, 0x46, 0x3d, 0x82, 0xa4, 0xb5, 0x28, 0x03, 0x35, 0x31, 0x92, 0x38, 0xb2, 0x4c,
+	0xc2, 0x80, 0xf1, 0xea, 0x23, 0x55, 0x06, 0x99, 0x66, 0x3f, 0x53, 0xc0, 0x0f, 0x40, 0xc5, 0x23,
+	0x71, 0x40, 0x3c, 0x79, 0x4e, 0x08, 0x61, 0xd5, 0x69, 0x69, 0x3a, 0xaf, 0xa4, 0x07, 0x4a, 0x68,
+	0x1d, 0x83, 0xc5, 0x41, 0xb4, 0x17, 0xd8, 0xe5, 0x34, 0xe9, 0xc1, 0x2a, 0x98, 0xc3, 0x9e, 0x97,
+	0x10, 0xc6, 0xd4, 0x81, 0x47, 0x19, 0x84, 0x4f, 0x40, 0x21, 0x0b, 0x25, 0x0f, 0x75, 0x11, 0xf5,
+	0xb1, 0xc5, 0xc1, 0xfc, 0x01, 0x8d, 0x79, 0x82, 0x5d, 0x5e, 0xe7, 0x98, 0xb3, 0x09, 0x34, 0x8f,
+	0x41, 0x41, 0x24, 0xb0, 0xcd, 0x88, 0x27, 0x69, 0xf2, 0x68, 0xce, 0xc7, 0xec, 0x15, 0x23, 0x1e,
+	0x5c, 0x06, 0x33, 0x62, 0xc2, 0x4c, 0x1e, 0xfb, 0x3c, 0x52, 0x40, 0x50, 0x25, 0xa4, 0x43, 0x12,
+	0x2e, 0x8e, 0xb5, 0xb4, 0xd7, 0xd0, 0x4a, 0xc0, 0xca, 0xbd, 0x85, 0x38, 0x21, 0xfa, 0x97, 0x60,
+	0x2e, 0x22, 0xbc, 0x49, 0x3d, 0x26, 0xd3, 0x57, 0xda, 0x31, 0xef, 0x6e, 0xc1, 0x99, 0x34, 0x38,
+	0xc2, 0xec, 0x80, 0x32, 0xae, 0x0b, 0x23, 0xf3, 0xb2, 0xfe, 0x96, 0x03, 0xf3, 0x23, 0x06, 0x70,
+	0x15, 0xcc, 0x2a, 0xa5, 0x8e, 0xa5, 0x91, 0x58, 0x68, 0x03, 0x33, 0x79, 0xa4, 0xb2, 0x85, 0x0a,
+	0x7c, 0x84, 0x19, 0x5c, 0x07, 0x65, 0x91, 0x83, 0x16, 0x49, 0x9c, 0x46, 0x8f, 0x13, 0xbd, 0x5e,
+	0xe0, 0x63, 0x76, 0x41, 0x12, 0xbb, 0xc7, 0x09, 0x7c, 0x0d, 0x56, 0x19, 0xa7, 0x09, 0xf6, 0xd5,
+	0x91, 0x8c, 0xda, 0x21, 0x0f, 0x5a, 0x61, 0x40, 0x12, 0x99, 0x83, 0xa2, 0xfd, 0xff, 0x62, 0x56,
+	0x3f, 0xa5, 0xe6, 0x53, 0x97, 0xb2, 0x88, 0x32, 0xe6, 0x5d, 0x6d, 0x06, 0x74, 0x2b, 0xc2, 0xbc,
+	0xb9, 0x79, 0x4a, 0x7c, 0xec, 0xf6, 0x0e, 0x89, 0x8b, 0x96, 0x35, 0xc5, 0x11, 0x66, 0x67, 0x7d,
+	0x02, 0xeb, 0x9f, 0x0b, 0xa0, 0x34, 0xf4, 0x05, 0x83, 0x7f, 0x00, 0x0b, 0x4d, 0x1a, 0x11, 0xc6,
+	0x09, 0xf6, 0x9c, 0x46, 0x48, 0xdd, 0x2b, 0xfd, 0xa9, 0x7f, 0xfe, 0x53, 0x6a, 0xae, 0xdc, 0xe5,
+	0x3f, 0x8e, 0xf9, 0x6d, 0x6a, 0xae, 0xaa, 0xef, 0xdd, 0x98, 0xa7, 0x85, 0x2a, 0x7d, 0x89, 0x2d,
+	0x04, 0xb0, 0x09, 0x2a, 0x1e, 0xa6, 0xce, 0x1b, 0x9a, 0x5c, 0x69, 0x72, 0x59, 0x3b, 0xb6, 0xfd,
+	0x5f, 0xc9, 0xaf, 0x53, 0xb3, 0x7c, 0xb8, 0x7f, 0xfe, 0x82, 0x26, 0x57, 0x92, 0xe2, 0x36, 0x35,
+	0x57, 0x54, 0xb0, 0x51, 0x22, 0x0b, 0x95, 0x3d, 0x4c, 0xfb, 0x66, 0xf0, 0x6b, 0x60, 0xf4, 0x0d,
+	0x58, 0xbb, 0xd5, 0xa2, 0x09, 0xd7, 0xf7, 0xc7, 0xc7, 0xd7, 0xa9, 0x59, 0xd1, 0x94, 0x75, 0xa5,
+	0xb9, 0x4d, 0xcd, 0xf7, 0xc6, 0x48, 0xb5, 0x8f, 0x85, 0x2a, 0x9a, 0x56, 0x9b, 0xc2, 0x06, 0x28,
+	0x93, 0xa0, 0xb5, 0xbd, 0xfb, 0x4c, 0x2f, 0x40, 0xed, 0xc0, 0x97, 0x93, 0x16, 0x50, 0xaa, 0x1d,
+	0x5f, 0x6c, 0xef, 0x3e, 0xcb, 0xe6, 0xaf, 0x2f, 0x87, 0x61, 0x16, 0x0b, 0x95, 0x14, 0x54, 0x93,
+	0x3f, 0x06, 0x1a, 0x3a, 0x4d, 0xcc, 0x9a, 0xf2, 0xea, 0x29, 0xda, 0x1b, 0xd7, 0xa9, 0x09, 0x14,
+	0xd3, 0x57, 0x98, 0x35, 0x07, 0x59, 0x6f, 0xf4, 0xfe, 0x84, 0x63, 0x1e, 0xb4, 0xa3, 0x8c, 0x0b,
+	0x28, 0x67, 0x61, 0xd5, 0x9f, 0xee, 0xae, 0x9e, 0xee, 0xec, 0x43, 0xa7, 0xbb, 0x7b, 0xdf, 0x74,
+	0x77, 0x47, 0xa7, 0xab, 0x6c, 0xfa, 0x31, 0xf6, 0x74, 0x8c, 0xb9, 0x87, 0xc6, 0xd8, 0xbb, 0x2f,
+	0xc6, 0xde, 0x68, 0x0c, 0x65, 0x23, 0xea, 0x72, 0x6c, 0x9d, 0xd5, 0xc2, 0x83, 0xeb, 0xf2, 0x4e,
+	0x86, 0x2a, 0x7d, 0x89, 0x62, 0xbf, 0x02, 0xcb, 0x2e, 0x8d, 0x19, 0x17, 0xb2, 0x98, 0xb6, 0x42,
+	0xa2, 0x43, 0x14, 0x65, 0x88, 0xbd, 0x49, 0x21, 0x9e, 0xea, 0xab, 0xfe, 0x1e, 0x77, 0x0b, 0x2d,
+	0x8d, 0x8a, 0x55, 0x30, 0x07, 0x18, 0x2d, 0xc2, 0x49, 0xc2, 0x1a, 0xed, 0xc4, 0xd7, 0x81, 0x80,
+	0x0c, 0xf4, 0xc9, 0xa4, 0x40, 0xba, 0x42, 0xc7, 0x5d, 0x2d, 0xb4, 0x30, 0x10, 0xa9, 0x00, 0xaf,
+	0x41, 0x25, 0x10, 0x51, 0x1b, 0xed, 0x50, 0xd3, 0x97, 0x24, 0xfd, 0xce, 0x24, 0x7a, 0x7d, 0xaa,
+	0x46, 0x1d, 0x2d, 0x34, 0x9f, 0x09, 0x14, 0xb5, 0x07, 0x60, 0xd4, 0x0e, 0x12, 0xc7, 0x0f, 0xb1,
+	0x1b, 0x88, 0x0f, 0x96, 0xa4, 0x2f, 0x4b, 0xfa, 0x4f, 0x27, 0xd1, 0x3f, 0x56, 0xf4, 0x77, 0x9d,
+	0x2d, 0x64, 0x08, 0xe1, 0x91, 0x92, 0xa9, 0x28, 0x75, 0x50, 0x6e, 0x90, 0x24, 0x0c, 0x62, 0xcd,
+	0x3f, 0x2f, 0xf9, 0x9f, 0x4d, 0xe2, 0xd7, 0x15, 0x34, 0xec, 0x66, 0xa1, 0x92, 0x82, 0x7d, 0xd2,
+	0x90, 0xc6, 0x1e, 0xcd, 0x48, 0x17, 0x1f, 0x4c, 0x3a, 0xec, 0x66, 0xa1, 0x92, 0x82, 0x8a, 0xd4,
+	0x07, 0x4b, 0x38, 0x49, 0xe8, 0xdb, 0xb1, 0x84, 0x40, 0xc9, 0xfd, 0xd9, 0x24, 0xee, 0x27, 0x8a,
+	0xfb, 0x1e, 0x6f, 0x0b, 0x2d, 0x4a, 0xe9, 0x48, 0x4a, 0x3c, 0x00, 0xfd, 0x04, 0xf7, 0xc6, 0xe2,
+	0x2c, 0x3f, 0x38, 0xf1, 0x77, 0x9d, 0x2d, 0x64, 0x08, 0xe1, 0x48, 0x94, 0x6f, 0xc0, 0x72, 0x44,
+	0x12, 0x9f, 0x38, 0x31, 0xe1, 0xac, 0x15, 0x06, 0x5c, 0xc7, 0x59, 0x79, 0xf0, 0x39, 0xb8, 0xcf,
+	0xdd, 0x42, 0x50, 0x8a, 0x5f, 0x6a, 0x69, 0xbf, 0x4a, 0x59, 0x13, 0xc7, 0x7e, 0x13, 0x07, 0x3a,
+	0xca, 0xea, 0x83, 0xab, 0x74, 0xd4, 0xd1, 0x42, 0xf3, 0x99, 0xa0, 0xbf, 0xd5, 0x2e, 0x8e, 0xdd,
+	0x76, 0xb6, 0xd5, 0xef, 0x3d, 0x78, 0xab, 0x87, 0xdd, 0x44, 0xc7, 0x2e, 0x61, 0x9f, 0xb4, 0x95,
+	0x60, 0xbf, 0x9d, 0x7d, 0x1b, 0xaa, 0x0f, 0x26, 0x1d, 0x76, 0xb3, 0x50, 0x49, 0x41, 0x49, 0x7a,
+	0x92, 0x2f, 0x54, 0x8c, 0x85, 0x93, 0x7c, 0x61, 0xc1, 0x30, 0x4e, 0xf2, 0x05, 0xc3, 0x58, 0x3c,
+	0xc9, 0x17, 0x96, 0x8c, 0x65, 0x34, 0xdf, 0xa3, 0x21, 0x75, 0x3a, 0xcf, 0x95, 0x13, 0x2a, 0x91,
+	0xb7, 0x98, 0xe9, 0xaf, 0x17, 0xaa, 0xb8, 0x98, 0xe3, 0xb0, 0xc7, 0x74, 0x76, 0x91, 0xa1, 0x72,
+	0x3e, 0x74, 0x17, 0x6e, 0x81, 0x19, 0xd1, 0x78, 0x11, 0x68, 0x80, 0xe9, 0x2b, 0xd2, 0xd3, 0xad,
+	0x88, 0x18, 0x8a, 0xae, 0xaa, 0x83, 0xc3, 0x36, 0xd1, 0x4d, 0x9b, 0x02, 0xd6, 0x05, 0x58, 0xb8,
+	0x4c, 0x70, 0xcc, 0xc4, 0x23, 0x82, 0xc6, 0xa7, 0xd4, 0x67, 0x10, 0x82, 0xbc, 0xbc, 0x7c, 0x94,
+	0xaf, 0x1c, 0xc3, 0x0f, 0x41, 0x3e, 0xa4, 0x7e, 0xd6, 0x2c, 0xad, 0xdc, 0x6d, 0x96, 0x4e, 0xa9,
+	0x8f, 0xa4, 0x89, 0xf5, 0x8f, 0x47, 0x60, 0xfa, 0x94, 0xfa, 0x13, 0x9a, 0xaf, 0x55, 0x30, 0xcb,
+	0x69, 0x2b, 0x70, 0x99, 0x6e, 0x5d, 0x35, 0x12, 0x81, 0x3d, 0xcc, 0xb1, 0xbc, 0xad, 0xcb, 0x48,
+	0x8e, 0xc5, 0x53, 0x46, 0xae, 0xcc, 0x89, 0xdb, 0x51, 0x43, 0xb7, 0x3d, 0x79, 0x7b, 0xe1, 0x26,
+	0x35, 0x4b, 0x52, 0xfe, 0x52, 0x8a, 0xd1, 0x30, 0x80, 0x1f, 0x81, 0x39, 0xd1, 0xa4, 0x0f, 0x2e,
+	0xd0, 0xa5, 0x9b, 0xd4, 0x5c, 0xe0, 0x83, 0x65, 0x8a, 0xfb, 0x11, 0xcd, 0xf2, 0xae, 0xf8, 0x0f,
+	0xb7, 0x40, 0x81, 0x77, 0x9d, 0x20, 0xf6, 0x48, 0x57, 0xde, 0x91, 0x79, 0x7b, 0xf9, 0x26, 0x35,
+	0x8d, 0x21, 0xf3, 0x63, 0xa1, 0x43, 0x73, 0xbc, 0x2b, 0x07, 0xf0, 0x23, 0x00, 0xd4, 0x94, 0x64,
+	0x04, 0x75, 0xe5, 0xcd, 0xdf, 0xa4, 0x66, 0x51, 0x4a, 0x25, 0xf7, 0x60, 0x08, 0x2d, 0x30, 0xa3,
+	0xb8, 0x0b, 0x92, 0xbb, 0x7c, 0x93, 0x9a, 0x85, 0x90, 0xfa, 0x8a, 0x53, 0xa9, 0x54, 0x6b, 0x1b,
+	0xd1, 0x0e, 0xf1, 0xe4, 0xbd, 0x53, 0x40, 0x19, 0xb4, 0xbe, 0x7b, 0x04, 0x0a, 0x97, 0x5d, 0x44,
+	0x58, 0x3b, 0xe4, 0xf0, 0x05, 0x30, 0x5c, 0xdd, 0x5d, 0x3b, 0x23, 0xa9, 0xb5, 0x9f, 0x0e, 0x6e,
+	0x89, 0x71, 0x0b, 0x0b, 0x2d, 0x64, 0xa2, 0x7d, 0x9d, 0xff, 0x65, 0x30, 0xd3, 0x08, 0x29, 0x8d,
+	0x64, 0x25, 0x94, 0x91, 0x02, 0x10, 0xc9, 0xac, 0xc9, 0x5d, 0x9e, 0x96, 0xcf, 0xa5, 0xff, 0xbb,
+	0xbb, 0xcb, 0x63, 0xa5, 0x62, 0xaf, 0xea, 0x57, 0x6f, 0x45, 0xc5, 0xd6, 0xfe, 0x96, 0xc8, 0xad,
+	0x2c, 0x25, 0x03, 0x4c, 0x27, 0x84, 0xcb, 0x4d, 0x2b, 0x23, 0x31, 0x14, 0xaf, 0x07, 0xd5, 0xb6,
+	0x13, 0x4f, 0x6e, 0x4e, 0x01, 0xf5, 0xf1, 0xc8, 0x93, 0x60, 0x76, 0xe4, 0x49, 0xf0, 0x79, 0xfe,
+	0xdb, 0xef, 0xcd, 0x29, 0x0b, 0x83, 0xd2, 0xbe, 0xeb, 0x12, 0xc6, 0x2e, 0xdb, 0xad, 0x89, 0xed,
+	0xfd, 0x0e, 0x28, 0x67, 0x6d, 0xf3, 0x15, 0xe9, 0xe9, 0x3a, 0x53, 0x55, 0xa3, 0xe5, 0xbf, 0x25,
+	0x3d, 0x86, 0x86, 0x81, 0x0e, 0xf1, 0x7d, 0x1e, 0x94, 0x2e, 0x13, 0xec, 0x12, 0xdd, 0x15, 0x8b,
+	0x5a, 0x15, 0x30, 0xc9, 0xba, 0x7a, 0x85, 0x44, 0x6c, 0x1e, 0x44, 0x84, 0xb6, 0xb9, 0x3e, 0x4f,
+	0x19, 0x14, 0x1e, 0x09, 0x21, 0x5d, 0xe2, 0xea, 0x76, 0x5e, 0x23, 0xb8, 0x0b, 0xe6, 0xbd, 0x80,
+	0xc9, 0x87, 0x2f, 0xe3, 0xd8, 0xbd, 0x52, 0xcb, 0xb7, 0x8d, 0x9b, 0xd4, 0x2c, 0x6b, 0x45, 0x5d,
+	0xc8, 0xd1, 0x08, 0x82, 0x5f, 0x80, 0x85, 0x81, 0x9b, 0x9c, 0xad, 0xfa, 0xa1, 0xc0, 0x86, 0x37,
+	0xa9, 0x59, 0xe9, 0x9b, 0x4a, 0x0d, 0x1a, 0xc3, 0x62, 0xa7, 0x3d, 0xd2, 0x68, 0xfb, 0xb2, 0xf8,
+	0x0a, 0x48, 0x01, 0x21, 0x55, 0xef, 0x56, 0x51, 0x6c, 0x33, 0x48, 0x01, 0xf8, 0x05, 0x28, 0xd2,
+	0x0e, 0x49, 0x92, 0xc0, 0xd3, 0xaf, 0xf0, 0xff, 0xf5, 0x9b, 0x07, 0x1a, 0xd8, 0x8b, 0xc5, 0xe9,
+	0x47, 0x7d, 0x44, 0x22, 0x9a, 0xa8, 0x17, 0xb7, 0x5e, 0x9c, 0x52, 0x9c, 0x49, 0x39, 0x1a, 0x41,
+	0xd0, 0x06, 0x50, 0xbb, 0x25, 0x84, 0xb7, 0x93, 0xd8, 0x91, 0xe7, 0xbf, 0x2c, 0x7d, 0xe5, 0x29,
+	0x54, 0x5a, 0x24, 0x95, 0x87, 0x98, 0x63, 0x74, 0x47, 0x02, 0x7f, 0x0d, 0xa0, 0xda, 0x13, 0xe7,
+	0x1b, 0x46, 0xfb, 0x3f, 0xda, 0xa8, 0xc6, 0x41, 0xc6, 0x57, 0x5a, 0x3d, 0x67, 0x43, 0xa1, 0x13,
+	0x46, 0xf5, 0x2a, 0x4e, 0xf2, 0x85, 0xbc, 0x31, 0x73, 0x92, 0x2f, 0xcc, 0x19, 0x85, 0x7e, 0xfe,
+	0xf4, 0x2a, 0xd0, 0x52, 0x86, 0x87, 0xa6, 0xf7, 0xcb, 0xef, 0x72, 0xa0, 0x32, 0xfa, 0x3c, 0x87,
+	0x16, 0x58, 0x3b, 0xac, 0x5d, 0x9c, 0x9e, 0xbf, 0x3e, 0xab, 0xbd, 0xbc, 0x74, 0xce, 0xce, 0x0f,
+	0x6b, 0xce, 0x45, 0x0d, 0x9d, 0x1d, 0xd7, 0xeb, 0xc7, 0xe7, 0x2f, 0x4f, 0x6b, 0xf5, 0xba, 0x31,
+	0x05, 0x7f, 0x06, 0xd6, 0xc7, 0x6d, 0xf6, 0x4f, 0x4f, 0xcf, 0xbf, 0x3e, 0x3d, 0xae, 0x5f, 0xd6,
+	0x0e, 0x9d, 0xda, 0xf9, 0x7e, 0xdd, 0xc8, 0xc1, 0x0f, 0xc1, 0x07, 0x93, 0xac, 0x5e, 0xec, 0x1f,
+	0x5c, 0x9e, 0xa3, 0xe3, 0x5a, 0xdd, 0x78, 0xf4, 0x24, 0xff, 0xed, 0x5f, 0xd7, 0xa6, 0xec, 0xdf,
+	0xfc, 0x70, 0xbd, 0x96, 0xfb, 0xf1, 0x7a, 0x2d, 0xf7, 0xef, 0xeb, 0xb5, 0xdc, 0x9f, 0xdf, 0xad,
+	0x4d, 0xfd, 0xf8, 0x6e, 0x6d, 0xea, 0x5f, 0xef, 0xd6, 0xa6, 0x7e, 0xff, 0x73, 0x3f, 0xe0, 0xcd,
+	0x76, 0x63, 0xd3, 0xa5, 0x91, 0xf8, 0xf9, 0x8f, 0x32, 0xfd, 0xb7, 0xb3, 0xfd, 0xe9, 0x56, 0x57,
+	0x8c, 0xb7, 0x78, 0xaf, 0x45, 0x58, 0x63, 0x56, 0xfe, 0xde, 0xf7, 0xfc, 0x3f, 0x03, 0x00, 0x61,
+	0x69, 0x15, 0xec, 0x35, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -835,6 +1275,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FailedTxHooksGasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.FailedTxHooksGasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.DeploymentPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.EnableBLS12381Precompiles {
+		i--
+		if m.EnableBLS12381Precompiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PrecompileGasSchedules) > 0 {
+		for iNdEx := len(m.PrecompileGasSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrecompileGasSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.EVMChannels) > 0 {
 		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EVMChannels[iNdEx])
@@ -874,21 +1353,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x2a
 	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -922,7 +1401,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
+func (m *DeploymentPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -932,16 +1411,252 @@ func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeploymentPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeploymentPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeniedCallees) > 0 {
+		for iNdEx := len(m.DeniedCallees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedCallees[iNdEx])
+			copy(dAtA[i:], m.DeniedCallees[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DeniedCallees[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeployerAllowlist) > 0 {
+		for iNdEx := len(m.DeployerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeployerAllowlist[iNdEx])
+			copy(dAtA[i:], m.DeployerAllowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DeployerAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeploymentFactory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentFactory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentFactory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reverts != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Reverts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Calls != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileGasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileGasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileGasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MethodGasCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MethodGasCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MethodGasCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StorageGasMultiplier.Size()
+		i -= size
+		if _, err := m.StorageGasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.GasPerByte != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasPerByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PragueBlock != nil {
+		{
+			size := m.PragueBlock.Size()
+			i -= size
+			if _, err := m.PragueBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.CancunBlock != nil {
 		{
 			size := m.CancunBlock.Size()
@@ -1619,63 +2334,180 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.PrecompileGasSchedules) > 0 {
+		for _, e := range m.PrecompileGasSchedules {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.EnableBLS12381Precompiles {
+		n += 2
+	}
+	l = m.DeploymentPolicy.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.FailedTxHooksGasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.FailedTxHooksGasLimit))
+	}
 	return n
 }
 
-func (m *ChainConfig) Size() (n int) {
+func (m *DeploymentPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.HomesteadBlock != nil {
-		l = m.HomesteadBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if m.Mode != 0 {
+		n += 1 + sovEvm(uint64(m.Mode))
 	}
-	if m.DAOForkBlock != nil {
-		l = m.DAOForkBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.DeployerAllowlist) > 0 {
+		for _, s := range m.DeployerAllowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
 	}
-	if m.DAOForkSupport {
-		n += 2
+	if len(m.DeniedCallees) > 0 {
+		for _, s := range m.DeniedCallees {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
 	}
-	if m.EIP150Block != nil {
-		l = m.EIP150Block.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *DeploymentFactory) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.EIP150Hash)
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	if m.EIP155Block != nil {
-		l = m.EIP155Block.Size()
+	l = len(m.Deployer)
+	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	if m.EIP158Block != nil {
-		l = m.EIP158Block.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *ContractStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.ByzantiumBlock != nil {
-		l = m.ByzantiumBlock.Size()
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	if m.ConstantinopleBlock != nil {
-		l = m.ConstantinopleBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.GasUsed))
 	}
-	if m.PetersburgBlock != nil {
-		l = m.PetersburgBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if m.Calls != 0 {
+		n += 1 + sovEvm(uint64(m.Calls))
 	}
-	if m.IstanbulBlock != nil {
-		l = m.IstanbulBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if m.Reverts != 0 {
+		n += 1 + sovEvm(uint64(m.Reverts))
 	}
-	if m.MuirGlacierBlock != nil {
-		l = m.MuirGlacierBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *PrecompileGasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.BerlinBlock != nil {
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, e := range m.Methods {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MethodGasCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.BaseGas != 0 {
+		n += 1 + sovEvm(uint64(m.BaseGas))
+	}
+	if m.GasPerByte != 0 {
+		n += 1 + sovEvm(uint64(m.GasPerByte))
+	}
+	l = m.StorageGasMultiplier.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *ChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HomesteadBlock != nil {
+		l = m.HomesteadBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DAOForkBlock != nil {
+		l = m.DAOForkBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DAOForkSupport {
+		n += 2
+	}
+	if m.EIP150Block != nil {
+		l = m.EIP150Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.EIP150Hash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EIP155Block != nil {
+		l = m.EIP155Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EIP158Block != nil {
+		l = m.EIP158Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ByzantiumBlock != nil {
+		l = m.ByzantiumBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ConstantinopleBlock != nil {
+		l = m.ConstantinopleBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.PetersburgBlock != nil {
+		l = m.PetersburgBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.IstanbulBlock != nil {
+		l = m.IstanbulBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.MuirGlacierBlock != nil {
+		l = m.MuirGlacierBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.BerlinBlock != nil {
 		l = m.BerlinBlock.Size()
 		n += 1 + l + sovEvm(uint64(l))
 	}
@@ -1703,6 +2535,10 @@ func (m *ChainConfig) Size() (n int) {
 		l = m.CancunBlock.Size()
 		n += 2 + l + sovEvm(uint64(l))
 	}
+	if m.PragueBlock != nil {
+		l = m.PragueBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -1876,16 +2712,823 @@ func (m *TraceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovEvm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvm(x uint64) (n int) {
+	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCreate = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCall = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtraEIPs = append(m.ExtraEIPs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
+					m.ExtraEIPs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileGasSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileGasSchedules = append(m.PrecompileGasSchedules, PrecompileGasSchedule{})
+			if err := m.PrecompileGasSchedules[len(m.PrecompileGasSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableBLS12381Precompiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableBLS12381Precompiles = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeploymentPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTxHooksGasLimit", wireType)
+			}
+			m.FailedTxHooksGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedTxHooksGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploymentPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= DeploymentMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAllowlist = append(m.DeployerAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedCallees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedCallees = append(m.DeniedCallees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploymentFactory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentFactory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentFactory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverts", wireType)
+			}
+			m.Reverts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reverts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvm(x uint64) (n int) {
-	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PrecompileGasSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,15 +3551,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrecompileGasSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrecompileGasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1944,13 +3587,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -1960,113 +3603,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableCreate = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
 			}
-			m.EnableCall = bool(v != 0)
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ExtraEIPs = append(m.ExtraEIPs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvm
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvm
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
-					m.ExtraEIPs = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtraEIPs = append(m.ExtraEIPs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			m.Methods = append(m.Methods, MethodGasCost{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MethodGasCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MethodGasCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MethodGasCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2076,30 +3687,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
 			}
-			var v int
+			m.BaseGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2109,17 +3719,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BaseGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowUnprotectedTxs = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerByte", wireType)
 			}
-			var stringLen uint64
+			m.GasPerByte = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2129,27 +3738,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasPerByte |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EVMChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGasMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2177,7 +3773,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
+			if err := m.StorageGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2893,6 +4491,42 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PragueBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.PragueBlock = &v
+			if err := m.PragueBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -47,5 +47,16 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenFactories := make(map[string]bool)
+	for _, factory := range gs.DeploymentFactories {
+		if seenFactories[factory.Address] {
+			return fmt.Errorf("duplicated deployment factory %s", factory.Address)
+		}
+		if err := factory.Validate(); err != nil {
+			return fmt.Errorf("invalid deployment factory %s: %w", factory.Address, err)
+		}
+		seenFactories[factory.Address] = true
+	}
+
 	return gs.Params.Validate()
 }
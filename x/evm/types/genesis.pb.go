@@ -29,6 +29,9 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// deployment_factories defines the contracts that are allowed to deploy
+	// contracts on behalf of the allowlisted deployers
+	DeploymentFactories []DeploymentFactory `protobuf:"bytes,3,rep,name=deployment_factories,json=deploymentFactories,proto3" json:"deployment_factories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetDeploymentFactories() []DeploymentFactory {
+	if m != nil {
+		return m.DeploymentFactories
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +162,29 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x18, 0xe7, 0x6c, 0xd3, 0xda, 0xab, 0x51, 0x73, 0x31, 0x91, 0x30, 0x5c, 0x49, 0x07, 0xc3, 0x74,
-	0x84, 0x9a, 0x74, 0x56, 0x16, 0x57, 0x43, 0x37, 0xb7, 0x2b, 0x7c, 0xa1, 0x0c, 0x70, 0x84, 0xbb,
-	0x12, 0x5d, 0x1d, 0x9d, 0x7c, 0x0e, 0x9f, 0xa4, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x22, 0x86, 0x83,
-	0x36, 0x51, 0x96, 0xcb, 0x77, 0xf7, 0xfb, 0xf7, 0xdd, 0x0f, 0x53, 0x50, 0x1b, 0x28, 0xd2, 0x24,
-	0x53, 0x2e, 0x94, 0xa9, 0x5b, 0x7a, 0x6e, 0x0c, 0x19, 0xc8, 0x44, 0xb2, 0xbc, 0x10, 0x4a, 0x90,
-	0xcb, 0x23, 0xce, 0xa0, 0x4c, 0x59, 0xe9, 0x59, 0x56, 0x4f, 0xd1, 0x00, 0x9a, 0x6d, 0x5d, 0xc5,
-	0x22, 0x16, 0x7a, 0x74, 0x9b, 0xa9, 0x7d, 0x9d, 0xbf, 0x21, 0x7c, 0xf6, 0xd0, 0xba, 0xae, 0x14,
-	0x57, 0x40, 0x7c, 0x7c, 0xca, 0xc3, 0x50, 0x6c, 0x33, 0x25, 0x4d, 0x64, 0x0f, 0x9c, 0xe9, 0xc2,
-	0x66, 0xff, 0x73, 0x58, 0xa7, 0xb8, 0x6f, 0x89, 0xfe, 0x70, 0xf7, 0x35, 0x33, 0x82, 0xa3, 0x8e,
-	0x2c, 0xf1, 0x28, 0xe7, 0x05, 0x4f, 0xa5, 0x79, 0x62, 0x23, 0x67, 0xba, 0x30, 0xfb, 0x0e, 0x8f,
-	0x1a, 0xef, 0x94, 0x1d, 0x7b, 0xfe, 0x8a, 0xf0, 0xf9, 0x5f, 0x6b, 0x62, 0xe2, 0x31, 0x8f, 0xa2,
-	0x02, 0x64, 0xb3, 0x0d, 0x72, 0x26, 0xc1, 0xe1, 0x4a, 0x08, 0x1e, 0x86, 0x22, 0x02, 0x1d, 0x31,
-	0x09, 0xf4, 0x4c, 0x7c, 0x3c, 0x96, 0x4a, 0x14, 0x3c, 0x06, 0x73, 0xa0, 0x77, 0xbf, 0xee, 0x27,
-	0xeb, 0x6f, 0xfa, 0x17, 0x4d, 0xf0, 0xc7, 0xf7, 0x6c, 0xbc, 0x6a, 0xf9, 0xc1, 0x41, 0xe8, 0xdf,
-	0xed, 0x2a, 0x8a, 0xf6, 0x15, 0x45, 0x3f, 0x15, 0x45, 0xef, 0x35, 0x35, 0xf6, 0x35, 0x35, 0x3e,
-	0x6b, 0x6a, 0x3c, 0xdd, 0xc4, 0x89, 0xda, 0x6c, 0xd7, 0x2c, 0x14, 0x69, 0xd3, 0xab, 0x90, 0xdd,
-	0x59, 0x7a, 0x4b, 0xf7, 0x59, 0x17, 0xae, 0x5e, 0x72, 0x90, 0xeb, 0x91, 0xae, 0xf6, 0xf6, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x34, 0x4b, 0x2d, 0x51, 0xc0, 0x01, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0x4f, 0x4b, 0xfb, 0x30,
+	0x18, 0x6e, 0x7e, 0x1b, 0xdb, 0x6f, 0x99, 0xa8, 0xc4, 0x81, 0x65, 0x87, 0x6c, 0x4c, 0x90, 0x9d,
+	0x5a, 0x36, 0x61, 0x67, 0x2d, 0xa2, 0x57, 0xe9, 0x6e, 0x22, 0x48, 0xd6, 0xbe, 0x76, 0x05, 0xdb,
+	0x94, 0x26, 0x2b, 0xee, 0xea, 0x27, 0xf0, 0x73, 0xf8, 0x49, 0x76, 0xdc, 0xd1, 0x93, 0xca, 0x76,
+	0xf1, 0x63, 0x48, 0xd3, 0xac, 0xe0, 0x7a, 0x29, 0x4f, 0xf3, 0xfc, 0xc9, 0xf3, 0xe6, 0xc5, 0x14,
+	0xe4, 0x1c, 0xd2, 0x28, 0x8c, 0xa5, 0x0d, 0x59, 0x64, 0x67, 0x23, 0x3b, 0x80, 0x18, 0x44, 0x28,
+	0xac, 0x24, 0xe5, 0x92, 0x93, 0xe3, 0x92, 0xb7, 0x20, 0x8b, 0xac, 0x6c, 0xd4, 0xed, 0x56, 0x1c,
+	0x39, 0xa1, 0xd4, 0xdd, 0x4e, 0xc0, 0x03, 0xae, 0xa0, 0x9d, 0xa3, 0xe2, 0x74, 0xf0, 0x83, 0xf0,
+	0xc1, 0x6d, 0x91, 0x3a, 0x95, 0x4c, 0x02, 0x71, 0xf0, 0x7f, 0xe6, 0x79, 0x7c, 0x11, 0x4b, 0x61,
+	0xa2, 0x7e, 0x6d, 0xd8, 0x1e, 0xf7, 0xad, 0xfd, 0x7b, 0x2c, 0xed, 0xb8, 0x2a, 0x84, 0x4e, 0x7d,
+	0xf5, 0xd9, 0x33, 0xdc, 0xd2, 0x47, 0x26, 0xb8, 0x91, 0xb0, 0x94, 0x45, 0xc2, 0xfc, 0xd7, 0x47,
+	0xc3, 0xf6, 0xd8, 0xac, 0x26, 0xdc, 0x29, 0x5e, 0x3b, 0xb5, 0x9a, 0x3c, 0xe0, 0x8e, 0x0f, 0xc9,
+	0x33, 0x5f, 0x46, 0x10, 0xcb, 0xc7, 0x27, 0xe6, 0x49, 0x9e, 0x86, 0x20, 0xcc, 0x9a, 0xea, 0x71,
+	0x56, 0x4d, 0xb9, 0x2e, 0xd5, 0x37, 0x4a, 0xbc, 0xd4, 0x81, 0x27, 0xfe, 0x1e, 0x11, 0x82, 0x18,
+	0xbc, 0x22, 0x7c, 0xf8, 0xb7, 0x38, 0x31, 0x71, 0x93, 0xf9, 0x7e, 0x0a, 0x22, 0x9f, 0x15, 0x0d,
+	0x5b, 0xee, 0xee, 0x97, 0x10, 0x5c, 0xf7, 0xb8, 0x0f, 0x6a, 0x80, 0x96, 0xab, 0x30, 0x71, 0x70,
+	0x53, 0x48, 0x9e, 0xb2, 0x00, 0x74, 0xa3, 0xd3, 0x6a, 0x23, 0xf5, 0x88, 0xce, 0x51, 0xde, 0xe2,
+	0xfd, 0xab, 0xd7, 0x9c, 0x16, 0x7a, 0x77, 0x67, 0x74, 0x2e, 0x57, 0x1b, 0x8a, 0xd6, 0x1b, 0x8a,
+	0xbe, 0x37, 0x14, 0xbd, 0x6d, 0xa9, 0xb1, 0xde, 0x52, 0xe3, 0x63, 0x4b, 0x8d, 0xfb, 0xf3, 0x20,
+	0x94, 0xf3, 0xc5, 0xcc, 0xf2, 0x78, 0x94, 0x6f, 0x8d, 0x0b, 0xfd, 0xcd, 0x46, 0x13, 0xfb, 0x25,
+	0xc7, 0xb6, 0x5c, 0x26, 0x20, 0x66, 0x0d, 0xb5, 0xb8, 0x8b, 0xdf, 0x01, 0x00, 0x1b, 0xf4, 0x9e,
+	0x36, 0x1e, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +207,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeploymentFactories) > 0 {
+		for iNdEx := len(m.DeploymentFactories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeploymentFactories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +324,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DeploymentFactories) > 0 {
+		for _, e := range m.DeploymentFactories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +458,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentFactories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentFactories = append(m.DeploymentFactories, DeploymentFactory{})
+			if err := m.DeploymentFactories[len(m.DeploymentFactories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
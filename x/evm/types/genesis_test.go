@@ -211,6 +211,37 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid deployment factories",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DeploymentFactories: []DeploymentFactory{
+					{Address: suite.address, Deployer: suite.address},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicated deployment factory",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DeploymentFactories: []DeploymentFactory{
+					{Address: suite.address, Deployer: suite.address},
+					{Address: suite.address, Deployer: suite.address},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid deployment factory deployer",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DeploymentFactories: []DeploymentFactory{
+					{Address: suite.address, Deployer: "invalid"},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -11,8 +11,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
@@ -50,6 +52,26 @@ type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	CalculateBaseFee(ctx sdk.Context) *big.Int
+	AddTransientPriorityFee(ctx sdk.Context, gasUsed uint64, priorityFee *big.Int)
+}
+
+// GasSchedulablePrecompile defines the interface of the precompiled contracts whose
+// method gas costs can be overridden through the PrecompileGasSchedules parameter.
+type GasSchedulablePrecompile interface {
+	vm.PrecompiledContract
+	// WithGasSchedule returns a copy of the precompiled contract that charges
+	// gas according to the given schedule.
+	WithGasSchedule(schedule PrecompileGasSchedule) vm.PrecompiledContract
+}
+
+// DynamicPrecompileKeeper defines the expected interface of the keepers that
+// instantiate precompiled contracts from their state, e.g. the ERC-20 precompiles
+// of the token pairs. The dynamic precompiles are only called if their address is
+// active in the EVM parameters.
+type DynamicPrecompileKeeper interface {
+	// GetDynamicPrecompile returns the precompiled contract at the given address,
+	// or false if the keeper doesn't serve it.
+	GetDynamicPrecompile(ctx sdk.Context, address common.Address) (vm.PrecompiledContract, bool)
 }
 
 // Event Hooks
@@ -61,6 +83,35 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmFailedTxHooks event hooks for failed evm txs. The hooks registered through
+// the EvmHooks that also implement this interface are called.
+type EvmFailedTxHooks interface {
+	// Called after a tx failed, either in the EVM execution or in the PostTxProcessing hooks.
+	// It's executed in a cached context with a gas meter limited by the FailedTxHooksGasLimit
+	// parameter that is discarded if it returns an error, so that it cannot alter the outcome
+	// of the failed tx.
+	PostTxFailed(ctx sdk.Context, msg core.Message, failure *TxFailure) error
+}
+
+// TxFailure defines the context of a failed evm tx.
+type TxFailure struct {
+	// TxHash is the hash of the failed tx
+	TxHash common.Hash
+	// GasUsed is the gas used by the failed tx
+	GasUsed uint64
+	// VmError is the error of the EVM execution or of the PostTxProcessing hooks
+	VmError string
+	// RevertReason is the decoded revert reason of the EVM execution, or the error
+	// returned by the PostTxProcessing hooks
+	RevertReason string
+	// Ret is the data returned by the EVM execution, i.e. the ABI encoded revert
+	// reason or custom error
+	Ret []byte
+	// Logs are the logs emitted during the EVM execution, including the logs of
+	// the reverted calls
+	Logs []*ethtypes.Log
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
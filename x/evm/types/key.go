@@ -3,6 +3,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -28,6 +29,7 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixDeploymentFactory
 )
 
 // prefix bytes for the EVM transient store
@@ -36,6 +38,9 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
+	prefixTransientContractStats
+	prefixTransientFeeToken
 )
 
 // KVStore key prefixes
@@ -43,14 +48,20 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixDeploymentFactory = []byte{prefixDeploymentFactory}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
+
+	KeyPrefixTransientContractStats = []byte{prefixTransientContractStats}
+	KeyPrefixTransientFeeToken      = []byte{prefixTransientFeeToken}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -58,6 +69,12 @@ func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
 
+// FeePayerKey defines the transient key under which the fee payer, or the fee
+// token, of the transaction with the given sender and nonce is stored.
+func FeePayerKey(sender common.Address, nonce uint64) []byte {
+	return append(sender.Bytes(), sdk.Uint64ToBigEndian(nonce)...)
+}
+
 // StateKey defines the full key under which an account state is stored.
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
@@ -77,6 +77,22 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:        cid,
+			Amount:         amt,
+			To:             toAddr,
+			GasTipCap:      &gtc,
+			GasFeeCap:      &gfc,
+			Nonce:          tx.Nonce,
+			GasLimit:       tx.GasLimit,
+			Data:           tx.Input,
+			Accesses:       NewAccessList(tx.Accesses),
+			Authorizations: tx.AuthorizationList,
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -120,7 +136,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
@@ -141,6 +157,18 @@ func (msg *MsgEthereumTx) FromEthereumTx(tx *ethtypes.Transaction) error {
 	return nil
 }
 
+// FromSetCodeTx populates the message fields from the given set code transaction
+func (msg *MsgEthereumTx) FromSetCodeTx(tx *SetCodeTx) error {
+	anyTxData, err := PackTxData(tx)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = anyTxData
+	msg.Hash = tx.Hash().Hex()
+	return nil
+}
+
 // Route returns the route value of an MsgEthereumTx.
 func (msg MsgEthereumTx) Route() string { return RouterKey }
 
@@ -183,7 +211,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.TxHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -237,6 +265,31 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	// go-ethereum doesn't support the set code transactions
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		if setCodeTx.GetChainID().Cmp(ethSigner.ChainID()) != 0 {
+			return fmt.Errorf("%w: have %s want %s", ethtypes.ErrInvalidChainId, setCodeTx.GetChainID(), ethSigner.ChainID())
+		}
+
+		sig, _, err := keyringSigner.SignByAddress(from, setCodeTx.SigHash().Bytes())
+		if err != nil {
+			return err
+		}
+
+		v, r, s, err := decodeSignature(sig)
+		if err != nil {
+			return err
+		}
+		setCodeTx.SetSignatureValues(nil, v, r, s)
+
+		return msg.FromSetCodeTx(setCodeTx)
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -300,15 +353,59 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
+// TxHash returns the hash of the Ethereum transaction.
+func (msg MsgEthereumTx) TxHash() common.Hash {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Hash{}
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.Hash()
+	}
+	return ethtypes.NewTx(txData.AsEthereumData()).Hash()
+}
+
+// TxType returns the type of the Ethereum transaction.
+func (msg MsgEthereumTx) TxType() uint8 {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return 0
+	}
+	return txData.TxType()
+}
+
 // AsMessage creates an Ethereum core.Message from the msg fields
 func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		from, err := setCodeTx.Sender(signer.ChainID())
+		if err != nil {
+			return nil, err
+		}
+		return setCodeTx.AsMessage(from, baseFee), nil
+	}
 	return msg.AsTransaction().AsMessage(signer, baseFee)
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
-	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(msg.AsTransaction())
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var from common.Address
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		from, err = setCodeTx.Sender(chainID)
+	} else {
+		signer := ethtypes.LatestSignerForChainID(chainID)
+		from, err = signer.Sender(msg.AsTransaction())
+	}
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -324,6 +421,14 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		setCodeTx, err := NewSetCodeTxFromBinary(b)
+		if err != nil {
+			return err
+		}
+		return msg.FromSetCodeTx(setCodeTx)
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/precompiles/bls12381"
 	"github.com/evmos/evmos/v16/precompiles/p256"
 	"github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/utils"
@@ -31,6 +33,10 @@ var (
 	DefaultEnableCreate = true
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+	// DefaultEnableBLS12381Precompiles disables the BLS12-381 precompiles (i.e false)
+	DefaultEnableBLS12381Precompiles = false
+	// DefaultFailedTxHooksGasLimit defines the default gas limit of the failed tx hooks
+	DefaultFailedTxHooksGasLimit uint64 = 1_000_000
 	// AvailableEVMExtensions defines the default active precompiles
 	AvailableEVMExtensions = []string{
 		p256.PrecompileAddress,                       // P256 precompile
@@ -40,6 +46,9 @@ var (
 		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
 		"0x0000000000000000000000000000000000000804", // Bank precompile
+		"0x0000000000000000000000000000000000000805", // Authz precompile
+		"0x0000000000000000000000000000000000000806", // Slashing precompile
+		"0x0000000000000000000000000000000000000807", // ERC20 module precompile
 		"0x0000000000000000000000000000000000000900", // Stride outpost
 		"0x0000000000000000000000000000000000000901", // Osmosis outpost
 	}
@@ -53,6 +62,17 @@ var (
 	}
 )
 
+const (
+	// MaxPrecompileBaseGas is the maximum flat gas cost of a precompile method in the gas schedules
+	MaxPrecompileBaseGas uint64 = 10_000_000
+	// MaxPrecompileGasPerByte is the maximum per byte gas cost of a precompile method in the gas schedules
+	MaxPrecompileGasPerByte uint64 = 10_000
+)
+
+// MaxPrecompileStorageGasMultiplier is the maximum storage gas multiplier of a precompile method
+// in the gas schedules
+var MaxPrecompileStorageGasMultiplier = sdkmath.LegacyNewDec(100)
+
 // NewParams creates a new Params instance
 func NewParams(
 	evmDenom string,
@@ -90,6 +110,10 @@ func DefaultParams() Params {
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
 		ActivePrecompiles:   AvailableEVMExtensions,
 		EVMChannels:         DefaultEVMChannels,
+
+		EnableBLS12381Precompiles: DefaultEnableBLS12381Precompiles,
+		DeploymentPolicy:          DefaultDeploymentPolicy(),
+		FailedTxHooksGasLimit:     DefaultFailedTxHooksGasLimit,
 	}
 }
 
@@ -133,6 +157,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBool(p.EnableBLS12381Precompiles); err != nil {
+		return err
+	}
+
 	if err := validateChainConfig(p.ChainConfig); err != nil {
 		return err
 	}
@@ -141,6 +169,20 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.EnableBLS12381Precompiles {
+		if err := validateBLS12381Precompiles(p.ActivePrecompiles); err != nil {
+			return err
+		}
+	}
+
+	if err := validatePrecompileGasSchedules(p.PrecompileGasSchedules); err != nil {
+		return err
+	}
+
+	if err := p.DeploymentPolicy.Validate(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -168,6 +210,17 @@ func (p Params) GetActivePrecompilesAddrs() []common.Address {
 	return precompiles
 }
 
+// GetPrecompileGasSchedule returns the gas schedule of the precompile with the given
+// hex address and a boolean indicating if it was found.
+func (p Params) GetPrecompileGasSchedule(address common.Address) (PrecompileGasSchedule, bool) {
+	for _, schedule := range p.PrecompileGasSchedules {
+		if common.HexToAddress(schedule.Address) == address {
+			return schedule, true
+		}
+	}
+	return PrecompileGasSchedule{}, false
+}
+
 // IsEVMChannel returns true if the channel provided is in the list of
 // EVM channels
 func (p Params) IsEVMChannel(channel string) bool {
@@ -262,6 +315,97 @@ func ValidatePrecompiles(i interface{}) error {
 	return nil
 }
 
+// validateBLS12381Precompiles checks that the active precompiles don't use the addresses
+// of the BLS12-381 precompiles. In particular, the p256 precompile needs to be activated at
+// its RIP-7212 address instead.
+func validateBLS12381Precompiles(activePrecompiles []string) error {
+	blsAddresses := bls12381.PrecompileAddresses()
+	for _, precompile := range activePrecompiles {
+		address := common.HexToAddress(precompile)
+		if !slices.Contains(blsAddresses, address) {
+			continue
+		}
+
+		if address == common.HexToAddress(p256.PrecompileAddress) {
+			return fmt.Errorf(
+				"the p256 precompile must be moved to %s to enable the BLS12-381 precompiles",
+				p256.RIP7212PrecompileAddress,
+			)
+		}
+		return fmt.Errorf("precompile %s overlaps with the BLS12-381 precompiles", precompile)
+	}
+	return nil
+}
+
+// validatePrecompileGasSchedules checks that the precompile gas schedules have valid
+// and unique addresses and that the method gas costs are valid.
+func validatePrecompileGasSchedules(i interface{}) error {
+	schedules, ok := i.([]PrecompileGasSchedule)
+	if !ok {
+		return fmt.Errorf("invalid precompile gas schedule slice type: %T", i)
+	}
+
+	seenPrecompiles := make(map[common.Address]struct{})
+	for _, schedule := range schedules {
+		if err := types.ValidateAddress(schedule.Address); err != nil {
+			return fmt.Errorf("invalid precompile gas schedule address %s", schedule.Address)
+		}
+
+		address := common.HexToAddress(schedule.Address)
+		if _, ok := seenPrecompiles[address]; ok {
+			return fmt.Errorf("duplicate precompile gas schedule %s", schedule.Address)
+		}
+		seenPrecompiles[address] = struct{}{}
+
+		if err := schedule.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid gas schedule for precompile %s", schedule.Address)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the method names of the gas schedule are not empty nor duplicated
+// and that the method gas costs and storage gas multipliers are within their bounds.
+func (gs PrecompileGasSchedule) Validate() error {
+	seenMethods := make(map[string]struct{})
+	for _, cost := range gs.Methods {
+		if strings.TrimSpace(cost.Method) == "" {
+			return fmt.Errorf("method name cannot be blank")
+		}
+
+		if _, ok := seenMethods[cost.Method]; ok {
+			return fmt.Errorf("duplicate method %s", cost.Method)
+		}
+		seenMethods[cost.Method] = struct{}{}
+
+		if cost.BaseGas > MaxPrecompileBaseGas {
+			return fmt.Errorf("base gas of method %s cannot be higher than %d: %d", cost.Method, MaxPrecompileBaseGas, cost.BaseGas)
+		}
+
+		if cost.GasPerByte > MaxPrecompileGasPerByte {
+			return fmt.Errorf("gas per byte of method %s cannot be higher than %d: %d", cost.Method, MaxPrecompileGasPerByte, cost.GasPerByte)
+		}
+
+		if cost.StorageGasMultiplier.IsNil() {
+			continue
+		}
+
+		if cost.StorageGasMultiplier.IsNegative() {
+			return fmt.Errorf("storage gas multiplier of method %s cannot be negative: %s", cost.Method, cost.StorageGasMultiplier)
+		}
+
+		if cost.StorageGasMultiplier.GT(MaxPrecompileStorageGasMultiplier) {
+			return fmt.Errorf(
+				"storage gas multiplier of method %s cannot be higher than %s: %s",
+				cost.Method, MaxPrecompileStorageGasMultiplier, cost.StorageGasMultiplier,
+			)
+		}
+	}
+
+	return nil
+}
+
 // IsLondon returns if london hardfork is enabled.
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
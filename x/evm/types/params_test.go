@@ -3,7 +3,10 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/precompiles/p256"
 
 	"github.com/stretchr/testify/require"
 )
@@ -59,6 +62,177 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid precompile gas schedules",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{
+						Address: "0x0000000000000000000000000000000000000800",
+						Methods: []MethodGasCost{
+							{Method: "delegate", BaseGas: 10_000, GasPerByte: 10, StorageGasMultiplier: math.LegacyNewDec(2)},
+							{Method: "undelegate", BaseGas: 10_000},
+						},
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid precompile gas schedule address",
+			params: Params{
+				EvmDenom:               DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{{Address: "invalid"}},
+			},
+			errContains: "invalid precompile gas schedule address",
+		},
+		{
+			name: "duplicate precompile gas schedule",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{Address: "0x0000000000000000000000000000000000000800"},
+					{Address: "0x0000000000000000000000000000000000000800"},
+				},
+			},
+			errContains: "duplicate precompile gas schedule",
+		},
+		{
+			name: "blank precompile gas schedule method",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{
+						Address: "0x0000000000000000000000000000000000000800",
+						Methods: []MethodGasCost{{Method: " "}},
+					},
+				},
+			},
+			errContains: "method name cannot be blank",
+		},
+		{
+			name: "duplicate precompile gas schedule method",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{
+						Address: "0x0000000000000000000000000000000000000800",
+						Methods: []MethodGasCost{{Method: "delegate"}, {Method: "delegate"}},
+					},
+				},
+			},
+			errContains: "duplicate method delegate",
+		},
+		{
+			name: "negative storage gas multiplier",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{
+						Address: "0x0000000000000000000000000000000000000800",
+						Methods: []MethodGasCost{{Method: "delegate", StorageGasMultiplier: math.LegacyNewDec(-1)}},
+					},
+				},
+			},
+			errContains: "cannot be negative",
+		},
+		{
+			name: "BLS12-381 precompiles with the p256 precompile at its RIP-7212 address",
+			params: Params{
+				EvmDenom:                  DefaultEVMDenom,
+				ActivePrecompiles:         []string{p256.RIP7212PrecompileAddress},
+				EnableBLS12381Precompiles: true,
+			},
+			expPass: true,
+		},
+		{
+			name: "BLS12-381 precompiles with the p256 precompile at its legacy address",
+			params: Params{
+				EvmDenom:                  DefaultEVMDenom,
+				ActivePrecompiles:         []string{p256.PrecompileAddress},
+				EnableBLS12381Precompiles: true,
+			},
+			errContains: "the p256 precompile must be moved",
+		},
+		{
+			name: "precompile base gas too high",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{
+						Address: "0x0000000000000000000000000000000000000800",
+						Methods: []MethodGasCost{{Method: "delegate", BaseGas: MaxPrecompileBaseGas + 1}},
+					},
+				},
+			},
+			errContains: "base gas of method delegate cannot be higher",
+		},
+		{
+			name: "precompile gas per byte too high",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{
+						Address: "0x0000000000000000000000000000000000000800",
+						Methods: []MethodGasCost{{Method: "delegate", GasPerByte: MaxPrecompileGasPerByte + 1}},
+					},
+				},
+			},
+			errContains: "gas per byte of method delegate cannot be higher",
+		},
+		{
+			name: "storage gas multiplier too high",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				PrecompileGasSchedules: []PrecompileGasSchedule{
+					{
+						Address: "0x0000000000000000000000000000000000000800",
+						Methods: []MethodGasCost{{Method: "delegate", StorageGasMultiplier: math.LegacyNewDec(101)}},
+					},
+				},
+			},
+			errContains: "storage gas multiplier of method delegate cannot be higher",
+		},
+		{
+			name: "valid deployment policy",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				DeploymentPolicy: NewDeploymentPolicy(
+					DEPLOYMENT_MODE_ALLOWLISTED_FACTORIES,
+					[]string{"0x1000000000000000000000000000000000000000"},
+					[]string{"0x2000000000000000000000000000000000000000"},
+				),
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid deployment mode",
+			params: Params{
+				EvmDenom:         DefaultEVMDenom,
+				DeploymentPolicy: DeploymentPolicy{Mode: DeploymentMode(10)},
+			},
+			errContains: "invalid deployment mode 10",
+		},
+		{
+			name: "invalid allowlisted deployer",
+			params: Params{
+				EvmDenom:         DefaultEVMDenom,
+				DeploymentPolicy: NewDeploymentPolicy(DEPLOYMENT_MODE_ALLOWLISTED_EOAS, []string{"invalid"}, nil),
+			},
+			errContains: "invalid deployer allowlist",
+		},
+		{
+			name: "duplicate denied callee",
+			params: Params{
+				EvmDenom: DefaultEVMDenom,
+				DeploymentPolicy: NewDeploymentPolicy(
+					DEPLOYMENT_MODE_PERMISSIONLESS,
+					nil,
+					[]string{"0x2000000000000000000000000000000000000000", "0x2000000000000000000000000000000000000000"},
+				),
+			},
+			errContains: "duplicate address",
+		},
 	}
 
 	for _, tc := range testCases {
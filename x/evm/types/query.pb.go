@@ -1226,6 +1226,134 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,2,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,3,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,4,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{22}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{23}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1235,7 +1363,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1274,7 +1402,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1303,1357 +1431,1798 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
-	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
-	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "ethermint.evm.v1.QueryCosmosAccountRequest")
-	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "ethermint.evm.v1.QueryCosmosAccountResponse")
-	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "ethermint.evm.v1.QueryValidatorAccountRequest")
-	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "ethermint.evm.v1.QueryValidatorAccountResponse")
-	proto.RegisterType((*QueryBalanceRequest)(nil), "ethermint.evm.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "ethermint.evm.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryStorageRequest)(nil), "ethermint.evm.v1.QueryStorageRequest")
-	proto.RegisterType((*QueryStorageResponse)(nil), "ethermint.evm.v1.QueryStorageResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "ethermint.evm.v1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "ethermint.evm.v1.QueryCodeResponse")
-	proto.RegisterType((*QueryTxLogsRequest)(nil), "ethermint.evm.v1.QueryTxLogsRequest")
-	proto.RegisterType((*QueryTxLogsResponse)(nil), "ethermint.evm.v1.QueryTxLogsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
-	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
-	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
-	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
-	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
-	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
-	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
-	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
-	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
+// QueryDeploymentPolicyRequest defines the request type for querying the
+// contract deployment policy.
+type QueryDeploymentPolicyRequest struct {
 }
 
-func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
-
-var fileDescriptor_e15a877459347994 = []byte{
-	// 1467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0xf0, 0x77, 0x08, 0xe0, 0x2c, 0x89, 0x1d, 0xf6, 0xdb,
-	0xc4, 0x86, 0xc2, 0x2e, 0x49, 0xab, 0x48, 0xed, 0xa5, 0x60, 0x0b, 0x28, 0x05, 0x2a, 0xea, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0x5b, 0xf1, 0xee, 0x98, 0x9d, 0xb1, 0xe5, 0x80, 0x38,
-	0x14, 0xa1, 0xfe, 0x50, 0x2f, 0x48, 0xbd, 0xf5, 0xc4, 0xbd, 0xb7, 0x5e, 0x7a, 0xec, 0x95, 0x23,
-	0x52, 0x2f, 0x55, 0x0f, 0xb4, 0x22, 0x3d, 0xf4, 0x6f, 0xe8, 0xa9, 0x9a, 0x1f, 0x6b, 0xef, 0xda,
-	0xde, 0x38, 0x54, 0xf4, 0xd6, 0xd3, 0xee, 0xcc, 0xbc, 0x79, 0x9f, 0xcf, 0xbc, 0xf7, 0x66, 0xde,
-	0x07, 0xac, 0x62, 0xd6, 0xc4, 0xbe, 0xdb, 0xf2, 0x98, 0x85, 0x7b, 0xae, 0xd5, 0xdb, 0xb2, 0xee,
-	0x75, 0xb1, 0xbf, 0x6f, 0x76, 0x7c, 0xc2, 0x08, 0xcc, 0x0e, 0x56, 0x4d, 0xdc, 0x73, 0xcd, 0xde,
-	0x96, 0x7e, 0xde, 0x26, 0xd4, 0x25, 0xd4, 0xaa, 0x23, 0x8a, 0xa5, 0xa9, 0xd5, 0xdb, 0xaa, 0x63,
-	0x86, 0xb6, 0xac, 0x0e, 0x72, 0x5a, 0x1e, 0x62, 0x2d, 0xe2, 0xc9, 0xdd, 0xba, 0x3e, 0xe6, 0x9b,
-	0x3b, 0x91, 0x6b, 0x2b, 0x63, 0x6b, 0xac, 0xaf, 0x96, 0x96, 0x1d, 0xe2, 0x10, 0xf1, 0x6b, 0xf1,
-	0x3f, 0x35, 0xbb, 0xea, 0x10, 0xe2, 0xb4, 0xb1, 0x85, 0x3a, 0x2d, 0x0b, 0x79, 0x1e, 0x61, 0x02,
-	0x89, 0xaa, 0xd5, 0x82, 0x5a, 0x15, 0xa3, 0x7a, 0xf7, 0xae, 0xc5, 0x5a, 0x2e, 0xa6, 0x0c, 0xb9,
-	0x1d, 0x69, 0x60, 0xbc, 0x03, 0x4e, 0x7c, 0xc4, 0xd9, 0x5e, 0xb1, 0x6d, 0xd2, 0xf5, 0x58, 0x15,
-	0xdf, 0xeb, 0x62, 0xca, 0x60, 0x0e, 0xa4, 0x50, 0xa3, 0xe1, 0x63, 0x4a, 0x73, 0xda, 0xba, 0x56,
-	0x5a, 0xa8, 0x06, 0xc3, 0x77, 0xd3, 0x5f, 0x3d, 0x2d, 0xcc, 0xfc, 0xf9, 0xb4, 0x30, 0x63, 0xd8,
-	0x60, 0x39, 0xba, 0x95, 0x76, 0x88, 0x47, 0x31, 0xdf, 0x5b, 0x47, 0x6d, 0xe4, 0xd9, 0x38, 0xd8,
-	0xab, 0x86, 0xf0, 0x0c, 0x58, 0xb0, 0x49, 0x03, 0xd7, 0x9a, 0x88, 0x36, 0x73, 0xb3, 0x62, 0x2d,
-	0xcd, 0x27, 0xde, 0x47, 0xb4, 0x09, 0x97, 0xc1, 0x9c, 0x47, 0xf8, 0xa6, 0xc4, 0xba, 0x56, 0x4a,
-	0x56, 0xe5, 0xc0, 0x78, 0x0f, 0xac, 0x08, 0x90, 0x8a, 0x08, 0xef, 0x3f, 0x60, 0xf9, 0x85, 0x06,
-	0xf4, 0x49, 0x1e, 0x14, 0xd9, 0x0d, 0x70, 0x4c, 0x66, 0xae, 0x16, 0xf5, 0xb4, 0x24, 0x67, 0xaf,
-	0xc8, 0x49, 0xa8, 0x83, 0x34, 0xe5, 0xa0, 0x9c, 0xdf, 0xac, 0xe0, 0x37, 0x18, 0x73, 0x17, 0x48,
-	0x7a, 0xad, 0x79, 0x5d, 0xb7, 0x8e, 0x7d, 0x75, 0x82, 0x25, 0x35, 0xfb, 0xa1, 0x98, 0x34, 0x6e,
-	0x82, 0x55, 0xc1, 0xe3, 0x13, 0xd4, 0x6e, 0x35, 0x10, 0x23, 0xfe, 0xc8, 0x61, 0xce, 0x82, 0x45,
-	0x9b, 0x78, 0xa3, 0x3c, 0x32, 0x7c, 0xee, 0xca, 0xd8, 0xa9, 0xbe, 0xd1, 0xc0, 0x5a, 0x8c, 0x37,
-	0x75, 0xb0, 0x22, 0x38, 0x1e, 0xb0, 0x8a, 0x7a, 0x0c, 0xc8, 0xbe, 0xc6, 0xa3, 0x05, 0x45, 0x54,
-	0x96, 0x79, 0x7e, 0x95, 0xf4, 0x5c, 0x52, 0x45, 0x34, 0xd8, 0x3a, 0xad, 0x88, 0x8c, 0x9b, 0x0a,
-	0xec, 0x63, 0x46, 0x7c, 0xe4, 0x4c, 0x07, 0x83, 0x59, 0x90, 0xd8, 0xc3, 0xfb, 0xaa, 0xde, 0xf8,
-	0x6f, 0x08, 0xfe, 0x82, 0x82, 0x1f, 0x38, 0x53, 0xf0, 0xcb, 0x60, 0xae, 0x87, 0xda, 0xdd, 0x00,
-	0x5c, 0x0e, 0x8c, 0x1d, 0x90, 0x55, 0xa5, 0xd4, 0x78, 0xa5, 0x43, 0x16, 0xc1, 0xff, 0x42, 0xfb,
-	0x14, 0x04, 0x04, 0x49, 0x5e, 0xfb, 0x62, 0xd7, 0x62, 0x55, 0xfc, 0x1b, 0xf7, 0x01, 0x14, 0x86,
-	0xbb, 0xfd, 0x5b, 0xc4, 0xa1, 0x01, 0x04, 0x04, 0x49, 0x71, 0x63, 0xa4, 0x7f, 0xf1, 0x0f, 0xaf,
-	0x01, 0x30, 0x7c, 0x57, 0xc4, 0xd9, 0x32, 0xdb, 0x9b, 0xa6, 0x2c, 0x5a, 0x93, 0x3f, 0x42, 0xa6,
-	0x7c, 0xaf, 0xd4, 0x23, 0x64, 0xde, 0x19, 0x86, 0xaa, 0x1a, 0xda, 0x19, 0x22, 0xf9, 0xb5, 0xa6,
-	0x02, 0x1b, 0x80, 0x2b, 0x9e, 0xe7, 0x40, 0xb2, 0x4d, 0x1c, 0x7e, 0xba, 0x44, 0x29, 0xb3, 0x7d,
-	0xd2, 0x1c, 0x7d, 0xfa, 0xcc, 0x5b, 0xc4, 0xa9, 0x0a, 0x13, 0x78, 0x7d, 0x02, 0xa9, 0xe2, 0x54,
-	0x52, 0x12, 0x27, 0xcc, 0xca, 0x58, 0x56, 0x71, 0xb8, 0x83, 0x7c, 0xe4, 0x06, 0x71, 0x30, 0x6e,
-	0x2b, 0x82, 0xc1, 0xac, 0x22, 0xb8, 0x03, 0xe6, 0x3b, 0x62, 0x46, 0x04, 0x28, 0xb3, 0x9d, 0x1b,
-	0xa7, 0x28, 0x77, 0x94, 0x93, 0xcf, 0x5e, 0x14, 0x66, 0xaa, 0xca, 0xda, 0xf8, 0x51, 0x03, 0xc7,
-	0xae, 0xb2, 0x66, 0x05, 0xb5, 0xdb, 0xa1, 0x48, 0x23, 0xdf, 0xa1, 0x41, 0x4e, 0xf8, 0x3f, 0x3c,
-	0x0d, 0x52, 0x0e, 0xa2, 0x35, 0x1b, 0x75, 0xd4, 0xf5, 0x98, 0x77, 0x10, 0xad, 0xa0, 0x0e, 0xfc,
-	0x0c, 0x64, 0x3b, 0x3e, 0xe9, 0x10, 0x8a, 0xfd, 0xc1, 0x15, 0xe3, 0xd7, 0x63, 0xb1, 0xbc, 0xfd,
-	0xd7, 0x8b, 0x82, 0xe9, 0xb4, 0x58, 0xb3, 0x5b, 0x37, 0x6d, 0xe2, 0x5a, 0xaa, 0x37, 0xc8, 0xcf,
-	0x45, 0xda, 0xd8, 0xb3, 0xd8, 0x7e, 0x07, 0x53, 0xb3, 0x32, 0xbc, 0xdb, 0xd5, 0xe3, 0x81, 0xaf,
-	0xe0, 0x5e, 0xae, 0x80, 0xb4, 0xdd, 0x44, 0x2d, 0xaf, 0xd6, 0x6a, 0xe4, 0x92, 0xeb, 0x5a, 0x29,
-	0x51, 0x4d, 0x89, 0xf1, 0x8d, 0x86, 0x51, 0x04, 0x27, 0xae, 0x52, 0xd6, 0x72, 0x11, 0xc3, 0xd7,
-	0xd1, 0x30, 0x10, 0x59, 0x90, 0x70, 0x90, 0x24, 0x9f, 0xac, 0xf2, 0x5f, 0xe3, 0x71, 0x32, 0xc8,
-	0xa9, 0x8f, 0x6c, 0xbc, 0xdb, 0x0f, 0xce, 0xb9, 0x05, 0x12, 0x2e, 0x75, 0x54, 0xbc, 0x0a, 0xe3,
-	0xf1, 0xba, 0x4d, 0x9d, 0xab, 0x7c, 0x0e, 0x77, 0xdd, 0xdd, 0x7e, 0x95, 0xdb, 0xc2, 0xcb, 0x60,
-	0x91, 0x71, 0x27, 0x35, 0x9b, 0x78, 0x77, 0x5b, 0x8e, 0x38, 0x69, 0x66, 0x7b, 0x6d, 0x7c, 0xaf,
-	0x80, 0xaa, 0x08, 0xa3, 0x6a, 0x86, 0x0d, 0x07, 0xb0, 0x02, 0x16, 0x3b, 0x3e, 0x6e, 0x60, 0x1b,
-	0x53, 0x4a, 0x7c, 0x9a, 0x4b, 0x8a, 0x82, 0x9a, 0x8a, 0x1e, 0xd9, 0xc4, 0x5f, 0xc9, 0x7a, 0x9b,
-	0xd8, 0x7b, 0xc1, 0x7b, 0x34, 0x27, 0x22, 0x93, 0x11, 0x73, 0xf2, 0x35, 0x82, 0x6b, 0x00, 0x48,
-	0x13, 0x71, 0x69, 0xe6, 0xc5, 0xa5, 0x59, 0x10, 0x33, 0xa2, 0xcf, 0x54, 0x82, 0x65, 0xde, 0x0a,
-	0x73, 0x29, 0x71, 0x0c, 0xdd, 0x94, 0x7d, 0xd2, 0x0c, 0xfa, 0xa4, 0xb9, 0x1b, 0xf4, 0xc9, 0x72,
-	0x9a, 0x17, 0xcd, 0x93, 0xdf, 0x0a, 0x9a, 0x72, 0xc2, 0x57, 0x26, 0xe6, 0x3e, 0xfd, 0xef, 0xe4,
-	0x7e, 0x21, 0x92, 0x7b, 0x68, 0x80, 0x25, 0x49, 0xdf, 0x45, 0xfd, 0x1a, 0x4f, 0x37, 0x08, 0x45,
-	0xe0, 0x36, 0xea, 0x5f, 0x47, 0xf4, 0x83, 0x64, 0x7a, 0x36, 0x9b, 0xa8, 0xa6, 0x59, 0xbf, 0xd6,
-	0xf2, 0x1a, 0xb8, 0x6f, 0x9c, 0x57, 0xaf, 0xdc, 0xa0, 0x0a, 0x86, 0x4f, 0x50, 0x03, 0x31, 0x14,
-	0x94, 0x3b, 0xff, 0x37, 0x7e, 0x48, 0x80, 0x53, 0x43, 0xe3, 0x32, 0xf7, 0x1a, 0xaa, 0x1a, 0xd6,
-	0x0f, 0x1e, 0x82, 0xe9, 0x55, 0xc3, 0xfa, 0xf4, 0x35, 0x54, 0xcd, 0x7f, 0x09, 0x9f, 0x9e, 0x70,
-	0xe3, 0x22, 0x38, 0x3d, 0x96, 0xb3, 0x43, 0x72, 0x7c, 0x72, 0xd0, 0xaf, 0x29, 0xbe, 0x86, 0x83,
-	0xbe, 0x60, 0xdc, 0x1a, 0xf4, 0x62, 0x35, 0xad, 0x5c, 0xbc, 0x0d, 0xd2, 0xfc, 0xf1, 0xae, 0xdd,
-	0xc5, 0xaa, 0x1f, 0x96, 0x57, 0x7e, 0x7d, 0x51, 0x38, 0x29, 0x4f, 0x48, 0x1b, 0x7b, 0x66, 0x8b,
-	0x58, 0x2e, 0x62, 0x4d, 0xf3, 0x86, 0xc7, 0x78, 0x9f, 0x16, 0xbb, 0xb7, 0x7f, 0x5a, 0x04, 0x73,
-	0xc2, 0x1d, 0xfc, 0x5c, 0x03, 0x29, 0x25, 0x4f, 0xe0, 0xc6, 0x78, 0xea, 0x27, 0xe8, 0x4f, 0x7d,
-	0x73, 0x9a, 0x99, 0xa4, 0x66, 0x14, 0x1f, 0xfd, 0xfc, 0xc7, 0xb7, 0xb3, 0x67, 0x61, 0x81, 0xab,
-	0x65, 0x42, 0x03, 0xcd, 0xac, 0xe4, 0x89, 0xf5, 0x40, 0xa5, 0xea, 0x21, 0xfc, 0x4e, 0x03, 0x4b,
-	0x11, 0x05, 0x08, 0xdf, 0x8c, 0x81, 0x98, 0xa4, 0x34, 0xf5, 0x0b, 0x47, 0x33, 0x56, 0xac, 0x4c,
-	0xc1, 0xaa, 0x04, 0x37, 0xa3, 0xac, 0x02, 0xa1, 0x39, 0x46, 0xee, 0x7b, 0x0d, 0x64, 0x47, 0x85,
-	0x1c, 0x34, 0x63, 0x20, 0x63, 0xf4, 0xa3, 0x6e, 0x1d, 0xd9, 0x5e, 0xb1, 0xdc, 0x11, 0x2c, 0x2f,
-	0x41, 0x33, 0xca, 0xb2, 0x17, 0xd8, 0x0f, 0x89, 0x86, 0x75, 0xe9, 0x43, 0xf8, 0x48, 0x03, 0x29,
-	0x25, 0xd7, 0x62, 0xd3, 0x19, 0x55, 0x82, 0xb1, 0xe9, 0x1c, 0x51, 0x7d, 0x46, 0x49, 0x50, 0x32,
-	0xe0, 0x7a, 0x94, 0x92, 0x92, 0x7e, 0x34, 0x14, 0xb2, 0x2f, 0x35, 0x90, 0x52, 0xa2, 0x2d, 0x96,
-	0x44, 0x54, 0x21, 0xc6, 0x92, 0x18, 0xd1, 0x7e, 0xc6, 0x45, 0x41, 0xa2, 0x08, 0x37, 0xa2, 0x24,
-	0xa8, 0x34, 0x1b, 0x72, 0xb0, 0x1e, 0xec, 0xe1, 0xfd, 0x87, 0xb0, 0x07, 0x92, 0x5c, 0xd7, 0x41,
-	0x23, 0xb6, 0x44, 0x06, 0x62, 0x51, 0xff, 0xff, 0xa1, 0x36, 0x0a, 0x7f, 0x43, 0xe0, 0x17, 0xe0,
-	0xda, 0x68, 0xf5, 0x34, 0x22, 0x11, 0xa0, 0x60, 0x5e, 0xca, 0x1a, 0xf8, 0x46, 0x8c, 0xd7, 0x88,
-	0x7a, 0xd2, 0x37, 0xa6, 0x58, 0x29, 0xf4, 0x55, 0x81, 0x7e, 0x0a, 0x2e, 0x47, 0xd1, 0xa5, 0x66,
-	0x82, 0x0c, 0xa4, 0x94, 0x64, 0x82, 0xeb, 0xe3, 0xfe, 0xa2, 0x6a, 0x4a, 0x2f, 0x4e, 0x6b, 0x11,
-	0x01, 0x66, 0x5e, 0x60, 0xe6, 0xe0, 0xa9, 0x28, 0x26, 0x66, 0xcd, 0x9a, 0xcd, 0xa1, 0xee, 0x83,
-	0x4c, 0x48, 0xef, 0x1c, 0x01, 0x79, 0xc2, 0x59, 0x27, 0x08, 0x26, 0xc3, 0x10, 0xb8, 0xab, 0x50,
-	0x1f, 0xc1, 0x55, 0xa6, 0xfc, 0xb5, 0x85, 0x7d, 0x90, 0x52, 0x6d, 0x33, 0xb6, 0xce, 0xa2, 0xe2,
-	0x2a, 0xb6, 0xce, 0x46, 0xba, 0x6f, 0xdc, 0xa9, 0x65, 0xbf, 0x64, 0x7d, 0xf8, 0x58, 0x03, 0x60,
-	0xf8, 0xa0, 0xc3, 0xd2, 0x61, 0x6e, 0xc3, 0x7d, 0x5a, 0x3f, 0x77, 0x04, 0x4b, 0xc5, 0xe1, 0xac,
-	0xe0, 0x70, 0x06, 0xae, 0x4c, 0xe2, 0x20, 0x3a, 0x0c, 0x0f, 0x80, 0x6a, 0x08, 0x87, 0xdc, 0xf6,
-	0x70, 0x1f, 0x39, 0xe4, 0xb6, 0x47, 0xfa, 0x4a, 0x5c, 0x00, 0x82, 0x5e, 0x53, 0xbe, 0xfc, 0xec,
-	0x65, 0x5e, 0x7b, 0xfe, 0x32, 0xaf, 0xfd, 0xfe, 0x32, 0xaf, 0x3d, 0x39, 0xc8, 0xcf, 0x3c, 0x3f,
-	0xc8, 0xcf, 0xfc, 0x72, 0x90, 0x9f, 0xf9, 0x74, 0x33, 0xd4, 0x6f, 0x07, 0x7b, 0x09, 0xb5, 0x7a,
-	0x5b, 0x3b, 0x56, 0x5f, 0xf8, 0x11, 0x3d, 0xb7, 0x3e, 0x2f, 0xda, 0xfb, 0x5b, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xc6, 0xc7, 0x39, 0x16, 0xce, 0x11, 0x00, 0x00,
+func (m *QueryDeploymentPolicyRequest) Reset()         { *m = QueryDeploymentPolicyRequest{} }
+func (m *QueryDeploymentPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeploymentPolicyRequest) ProtoMessage()    {}
+func (*QueryDeploymentPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{26}
+}
+func (m *QueryDeploymentPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeploymentPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeploymentPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeploymentPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeploymentPolicyRequest.Merge(m, src)
+}
+func (m *QueryDeploymentPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeploymentPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeploymentPolicyRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDeploymentPolicyRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Account queries an Ethereum account.
-	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
-	// CosmosAccount queries an Ethereum account's Cosmos Address.
-	CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error)
-	// ValidatorAccount queries an Ethereum account's from a validator consensus
-	// Address.
-	ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error)
-	// Balance queries the balance of a the EVM denomination for a single
-	// EthAccount.
-	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
-	// Storage queries the balance of all coins for a single account.
-	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
-	// Code queries the balance of all coins for a single account.
-	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
-	// Params queries the parameters of x/evm module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// EthCall implements the `eth_call` rpc api
-	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
-	// EstimateGas implements the `eth_estimateGas` rpc api
-	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
-	// TraceTx implements the `debug_traceTransaction` rpc api
-	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
-	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
-	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block,
-	// it's similar to feemarket module's method, but also checks london hardfork status.
-	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+// QueryDeploymentPolicyResponse defines the response type for querying the
+// contract deployment policy.
+type QueryDeploymentPolicyResponse struct {
+	// deployment_policy is the current contract deployment policy
+	DeploymentPolicy DeploymentPolicy `protobuf:"bytes,1,opt,name=deployment_policy,json=deploymentPolicy,proto3" json:"deployment_policy"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDeploymentPolicyResponse) Reset()         { *m = QueryDeploymentPolicyResponse{} }
+func (m *QueryDeploymentPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeploymentPolicyResponse) ProtoMessage()    {}
+func (*QueryDeploymentPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{27}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDeploymentPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
-	out := new(QueryAccountResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Account", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeploymentPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeploymentPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeploymentPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeploymentPolicyResponse.Merge(m, src)
+}
+func (m *QueryDeploymentPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeploymentPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeploymentPolicyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error) {
-	out := new(QueryCosmosAccountResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CosmosAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeploymentPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryDeploymentPolicyResponse) GetDeploymentPolicy() DeploymentPolicy {
+	if m != nil {
+		return m.DeploymentPolicy
 	}
-	return out, nil
+	return DeploymentPolicy{}
 }
 
-func (c *queryClient) ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error) {
-	out := new(QueryValidatorAccountResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ValidatorAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDeployerPermissionRequest defines the request type for querying if an
+// account is allowed to deploy contracts.
+type QueryDeployerPermissionRequest struct {
+	// address is the ethereum hex address to query the permission for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
-	out := new(QueryBalanceResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Balance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeployerPermissionRequest) Reset()         { *m = QueryDeployerPermissionRequest{} }
+func (m *QueryDeployerPermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeployerPermissionRequest) ProtoMessage()    {}
+func (*QueryDeployerPermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{28}
+}
+func (m *QueryDeployerPermissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeployerPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeployerPermissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeployerPermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeployerPermissionRequest.Merge(m, src)
+}
+func (m *QueryDeployerPermissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeployerPermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeployerPermissionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error) {
-	out := new(QueryStorageResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Storage", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeployerPermissionRequest proto.InternalMessageInfo
+
+func (m *QueryDeployerPermissionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
-	out := new(QueryCodeResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Code", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDeployerPermissionResponse defines the response type for querying if an
+// account is allowed to deploy contracts.
+type QueryDeployerPermissionResponse struct {
+	// allowed is true if the account is allowed to deploy contracts
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// factory_deployer is the hex address of the allowlisted deployer of the
+	// account if the account is a factory contract
+	FactoryDeployer string `protobuf:"bytes,2,opt,name=factory_deployer,json=factoryDeployer,proto3" json:"factory_deployer,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDeployerPermissionResponse) Reset()         { *m = QueryDeployerPermissionResponse{} }
+func (m *QueryDeployerPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeployerPermissionResponse) ProtoMessage()    {}
+func (*QueryDeployerPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{29}
 }
-
-func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
-	out := new(MsgEthereumTxResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EthCall", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeployerPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeployerPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeployerPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeployerPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeployerPermissionResponse.Merge(m, src)
+}
+func (m *QueryDeployerPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeployerPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeployerPermissionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
-	out := new(EstimateGasResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EstimateGas", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeployerPermissionResponse proto.InternalMessageInfo
+
+func (m *QueryDeployerPermissionResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
-	out := new(QueryTraceTxResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeployerPermissionResponse) GetFactoryDeployer() string {
+	if m != nil {
+		return m.FactoryDeployer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error) {
-	out := new(QueryTraceBlockResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDeploymentFactoriesRequest defines the request type for querying the
+// factory contracts.
+type QueryDeploymentFactoriesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
-	out := new(QueryBaseFeeResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeploymentFactoriesRequest) Reset()         { *m = QueryDeploymentFactoriesRequest{} }
+func (m *QueryDeploymentFactoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeploymentFactoriesRequest) ProtoMessage()    {}
+func (*QueryDeploymentFactoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{30}
+}
+func (m *QueryDeploymentFactoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeploymentFactoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeploymentFactoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeploymentFactoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeploymentFactoriesRequest.Merge(m, src)
+}
+func (m *QueryDeploymentFactoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeploymentFactoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeploymentFactoriesRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Account queries an Ethereum account.
-	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
-	// CosmosAccount queries an Ethereum account's Cosmos Address.
-	CosmosAccount(context.Context, *QueryCosmosAccountRequest) (*QueryCosmosAccountResponse, error)
-	// ValidatorAccount queries an Ethereum account's from a validator consensus
-	// Address.
-	ValidatorAccount(context.Context, *QueryValidatorAccountRequest) (*QueryValidatorAccountResponse, error)
-	// Balance queries the balance of a the EVM denomination for a single
-	// EthAccount.
-	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
-	// Storage queries the balance of all coins for a single account.
-	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
-	// Code queries the balance of all coins for a single account.
-	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
-	// Params queries the parameters of x/evm module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// EthCall implements the `eth_call` rpc api
-	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
-	// EstimateGas implements the `eth_estimateGas` rpc api
-	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
-	// TraceTx implements the `debug_traceTransaction` rpc api
-	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
-	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
-	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block,
-	// it's similar to feemarket module's method, but also checks london hardfork status.
-	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+var xxx_messageInfo_QueryDeploymentFactoriesRequest proto.InternalMessageInfo
+
+func (m *QueryDeploymentFactoriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryDeploymentFactoriesResponse defines the response type for querying the
+// factory contracts.
+type QueryDeploymentFactoriesResponse struct {
+	// factories are the factory contracts deployed by the allowlisted deployers
+	Factories []DeploymentFactory `protobuf:"bytes,1,rep,name=factories,proto3" json:"factories"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*QueryAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+func (m *QueryDeploymentFactoriesResponse) Reset()         { *m = QueryDeploymentFactoriesResponse{} }
+func (m *QueryDeploymentFactoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeploymentFactoriesResponse) ProtoMessage()    {}
+func (*QueryDeploymentFactoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{31}
 }
-func (*UnimplementedQueryServer) CosmosAccount(ctx context.Context, req *QueryCosmosAccountRequest) (*QueryCosmosAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CosmosAccount not implemented")
+func (m *QueryDeploymentFactoriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ValidatorAccount(ctx context.Context, req *QueryValidatorAccountRequest) (*QueryValidatorAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccount not implemented")
+func (m *QueryDeploymentFactoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeploymentFactoriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+func (m *QueryDeploymentFactoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeploymentFactoriesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
+func (m *QueryDeploymentFactoriesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+func (m *QueryDeploymentFactoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeploymentFactoriesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+var xxx_messageInfo_QueryDeploymentFactoriesResponse proto.InternalMessageInfo
+
+func (m *QueryDeploymentFactoriesResponse) GetFactories() []DeploymentFactory {
+	if m != nil {
+		return m.Factories
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallRequest) (*MsgEthereumTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
+
+func (m *QueryDeploymentFactoriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+
+// QueryStateDiffRequest defines the request type for querying the state changes
+// of an account between two heights.
+type QueryStateDiffRequest struct {
+	// address is the ethereum hex address to query the state changes for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// from_height is the height of the original state.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the height of the modified state.
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
 }
-func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
+
+func (m *QueryStateDiffRequest) Reset()         { *m = QueryStateDiffRequest{} }
+func (m *QueryStateDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStateDiffRequest) ProtoMessage()    {}
+func (*QueryStateDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{32}
 }
-func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
+func (m *QueryStateDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+func (m *QueryStateDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryStateDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateDiffRequest.Merge(m, src)
+}
+func (m *QueryStateDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateDiffRequest.DiscardUnknown(m)
 }
 
-func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Account(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/Account",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+var xxx_messageInfo_QueryStateDiffRequest proto.InternalMessageInfo
+
+func (m *QueryStateDiffRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CosmosAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCosmosAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CosmosAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/CosmosAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CosmosAccount(ctx, req.(*QueryCosmosAccountRequest))
+func (m *QueryStateDiffRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ValidatorAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/ValidatorAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorAccount(ctx, req.(*QueryValidatorAccountRequest))
+func (m *QueryStateDiffRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Balance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/Balance",
+// QueryStateDiffResponse defines the response type for querying the state
+// changes of an account between two heights.
+type QueryStateDiffResponse struct {
+	// diff is the state changes of the account
+	Diff AccountStateDiff `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff"`
+}
+
+func (m *QueryStateDiffResponse) Reset()         { *m = QueryStateDiffResponse{} }
+func (m *QueryStateDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateDiffResponse) ProtoMessage()    {}
+func (*QueryStateDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{33}
+}
+func (m *QueryStateDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+}
+func (m *QueryStateDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateDiffResponse.Merge(m, src)
+}
+func (m *QueryStateDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStateDiffResponse proto.InternalMessageInfo
+
+func (m *QueryStateDiffResponse) GetDiff() AccountStateDiff {
+	if m != nil {
+		return m.Diff
 	}
-	return interceptor(ctx, in, info, handler)
+	return AccountStateDiff{}
 }
 
-func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Storage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/Storage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Storage(ctx, req.(*QueryStorageRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryTouchedAccountsRequest defines the request type for querying the
+// accounts changed between two heights.
+type QueryTouchedAccountsRequest struct {
+	// from_height is the height of the original state.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the height of the modified state.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Code(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/Code",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Code(ctx, req.(*QueryCodeRequest))
+func (m *QueryTouchedAccountsRequest) Reset()         { *m = QueryTouchedAccountsRequest{} }
+func (m *QueryTouchedAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTouchedAccountsRequest) ProtoMessage()    {}
+func (*QueryTouchedAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{34}
+}
+func (m *QueryTouchedAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTouchedAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTouchedAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTouchedAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTouchedAccountsRequest.Merge(m, src)
+}
+func (m *QueryTouchedAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTouchedAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTouchedAccountsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTouchedAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryTouchedAccountsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *QueryTouchedAccountsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/Params",
+	return 0
+}
+
+func (m *QueryTouchedAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QueryTouchedAccountsResponse defines the response type for querying the
+// accounts changed between two heights.
+type QueryTouchedAccountsResponse struct {
+	// addresses are the sorted ethereum hex addresses of the changed accounts
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTouchedAccountsResponse) Reset()         { *m = QueryTouchedAccountsResponse{} }
+func (m *QueryTouchedAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTouchedAccountsResponse) ProtoMessage()    {}
+func (*QueryTouchedAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{35}
+}
+func (m *QueryTouchedAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTouchedAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTouchedAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTouchedAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTouchedAccountsResponse.Merge(m, src)
+}
+func (m *QueryTouchedAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTouchedAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTouchedAccountsResponse.DiscardUnknown(m)
 }
 
-func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EthCallRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTouchedAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryTouchedAccountsResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EthCall(ctx, in)
+	return nil
+}
+
+func (m *QueryTouchedAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/EthCall",
+	return nil
+}
+
+// AccountStateDiff defines the changes of the EVM state of an account between
+// two heights. The fields of a missing account are empty.
+type AccountStateDiff struct {
+	// address is the ethereum hex address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance_before is the EVM denomination balance at the original height
+	BalanceBefore string `protobuf:"bytes,2,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	// balance_after is the EVM denomination balance at the modified height
+	BalanceAfter string `protobuf:"bytes,3,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	// nonce_before is the nonce at the original height
+	NonceBefore uint64 `protobuf:"varint,4,opt,name=nonce_before,json=nonceBefore,proto3" json:"nonce_before,omitempty"`
+	// nonce_after is the nonce at the modified height
+	NonceAfter uint64 `protobuf:"varint,5,opt,name=nonce_after,json=nonceAfter,proto3" json:"nonce_after,omitempty"`
+	// code_hash_before is the hex code hash at the original height
+	CodeHashBefore string `protobuf:"bytes,6,opt,name=code_hash_before,json=codeHashBefore,proto3" json:"code_hash_before,omitempty"`
+	// code_hash_after is the hex code hash at the modified height
+	CodeHashAfter string `protobuf:"bytes,7,opt,name=code_hash_after,json=codeHashAfter,proto3" json:"code_hash_after,omitempty"`
+	// storage are the changed storage slots, sorted by key
+	Storage []StorageDiff `protobuf:"bytes,8,rep,name=storage,proto3" json:"storage"`
+}
+
+func (m *AccountStateDiff) Reset()         { *m = AccountStateDiff{} }
+func (m *AccountStateDiff) String() string { return proto.CompactTextString(m) }
+func (*AccountStateDiff) ProtoMessage()    {}
+func (*AccountStateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{36}
+}
+func (m *AccountStateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountStateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountStateDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EthCall(ctx, req.(*EthCallRequest))
+}
+func (m *AccountStateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountStateDiff.Merge(m, src)
+}
+func (m *AccountStateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountStateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountStateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountStateDiff proto.InternalMessageInfo
+
+func (m *AccountStateDiff) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EthCallRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AccountStateDiff) GetBalanceBefore() string {
+	if m != nil {
+		return m.BalanceBefore
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateGas(ctx, in)
+	return ""
+}
+
+func (m *AccountStateDiff) GetBalanceAfter() string {
+	if m != nil {
+		return m.BalanceAfter
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/EstimateGas",
+	return ""
+}
+
+func (m *AccountStateDiff) GetNonceBefore() uint64 {
+	if m != nil {
+		return m.NonceBefore
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateGas(ctx, req.(*EthCallRequest))
+	return 0
+}
+
+func (m *AccountStateDiff) GetNonceAfter() uint64 {
+	if m != nil {
+		return m.NonceAfter
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTraceTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AccountStateDiff) GetCodeHashBefore() string {
+	if m != nil {
+		return m.CodeHashBefore
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TraceTx(ctx, in)
+	return ""
+}
+
+func (m *AccountStateDiff) GetCodeHashAfter() string {
+	if m != nil {
+		return m.CodeHashAfter
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/TraceTx",
+	return ""
+}
+
+func (m *AccountStateDiff) GetStorage() []StorageDiff {
+	if m != nil {
+		return m.Storage
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TraceTx(ctx, req.(*QueryTraceTxRequest))
+	return nil
+}
+
+// StorageDiff defines the change of a storage slot between two heights.
+type StorageDiff struct {
+	// key is the hex storage key
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// before is the hex value at the original height
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	// after is the hex value at the modified height
+	After string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (m *StorageDiff) Reset()         { *m = StorageDiff{} }
+func (m *StorageDiff) String() string { return proto.CompactTextString(m) }
+func (*StorageDiff) ProtoMessage()    {}
+func (*StorageDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{37}
+}
+func (m *StorageDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *StorageDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDiff.Merge(m, src)
+}
+func (m *StorageDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDiff.DiscardUnknown(m)
 }
 
-func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTraceBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_StorageDiff proto.InternalMessageInfo
+
+func (m *StorageDiff) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TraceBlock(ctx, in)
+	return ""
+}
+
+func (m *StorageDiff) GetBefore() string {
+	if m != nil {
+		return m.Before
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/TraceBlock",
+	return ""
+}
+
+func (m *StorageDiff) GetAfter() string {
+	if m != nil {
+		return m.After
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TraceBlock(ctx, req.(*QueryTraceBlockRequest))
+	return ""
+}
+
+// QueryContractStatsRequest defines the request type for querying the
+// aggregated contract statistics.
+type QueryContractStatsRequest struct {
+	// address is the optional ethereum hex address of the contract to query the
+	// statistics for. The statistics of all the contracts are returned if empty.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// limit is the maximum number of contracts to return, sorted by gas used.
+	// All the contracts are returned if zero.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryContractStatsRequest) Reset()         { *m = QueryContractStatsRequest{} }
+func (m *QueryContractStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStatsRequest) ProtoMessage()    {}
+func (*QueryContractStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{38}
+}
+func (m *QueryContractStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryContractStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStatsRequest.Merge(m, src)
+}
+func (m *QueryContractStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStatsRequest.DiscardUnknown(m)
 }
 
-func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractStatsRequest proto.InternalMessageInfo
+
+func (m *QueryContractStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BaseFee(ctx, in)
+	return ""
+}
+
+func (m *QueryContractStatsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethermint.evm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Account",
-			Handler:    _Query_Account_Handler,
-		},
-		{
-			MethodName: "CosmosAccount",
-			Handler:    _Query_CosmosAccount_Handler,
-		},
-		{
-			MethodName: "ValidatorAccount",
-			Handler:    _Query_ValidatorAccount_Handler,
-		},
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
-			MethodName: "Storage",
-			Handler:    _Query_Storage_Handler,
-		},
-		{
-			MethodName: "Code",
-			Handler:    _Query_Code_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EthCall",
-			Handler:    _Query_EthCall_Handler,
-		},
-		{
-			MethodName: "EstimateGas",
-			Handler:    _Query_EstimateGas_Handler,
-		},
-		{
-			MethodName: "TraceTx",
-			Handler:    _Query_TraceTx_Handler,
-		},
-		{
-			MethodName: "TraceBlock",
-			Handler:    _Query_TraceBlock_Handler,
-		},
-		{
-			MethodName: "BaseFee",
-			Handler:    _Query_BaseFee_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ethermint/evm/v1/query.proto",
+// QueryContractStatsResponse defines the response type for querying the
+// aggregated contract statistics.
+type QueryContractStatsResponse struct {
+	// stats are the contract statistics, sorted by gas used in descending order
+	Stats []ContractStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	// from_height is the first height of the aggregated blocks
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height of the aggregated blocks
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
 }
 
-func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractStatsResponse) Reset()         { *m = QueryContractStatsResponse{} }
+func (m *QueryContractStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStatsResponse) ProtoMessage()    {}
+func (*QueryContractStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{39}
+}
+func (m *QueryContractStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryContractStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStatsResponse.Merge(m, src)
+}
+func (m *QueryContractStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryContractStatsResponse proto.InternalMessageInfo
+
+func (m *QueryContractStatsResponse) GetStats() []ContractStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
 }
 
-func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryContractStatsResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractStatsResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
+	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
+	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "ethermint.evm.v1.QueryCosmosAccountRequest")
+	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "ethermint.evm.v1.QueryCosmosAccountResponse")
+	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "ethermint.evm.v1.QueryValidatorAccountRequest")
+	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "ethermint.evm.v1.QueryValidatorAccountResponse")
+	proto.RegisterType((*QueryBalanceRequest)(nil), "ethermint.evm.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "ethermint.evm.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryStorageRequest)(nil), "ethermint.evm.v1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "ethermint.evm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "ethermint.evm.v1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "ethermint.evm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryTxLogsRequest)(nil), "ethermint.evm.v1.QueryTxLogsRequest")
+	proto.RegisterType((*QueryTxLogsResponse)(nil), "ethermint.evm.v1.QueryTxLogsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
+	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
+	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
+	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
+	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryDeploymentPolicyRequest)(nil), "ethermint.evm.v1.QueryDeploymentPolicyRequest")
+	proto.RegisterType((*QueryDeploymentPolicyResponse)(nil), "ethermint.evm.v1.QueryDeploymentPolicyResponse")
+	proto.RegisterType((*QueryDeployerPermissionRequest)(nil), "ethermint.evm.v1.QueryDeployerPermissionRequest")
+	proto.RegisterType((*QueryDeployerPermissionResponse)(nil), "ethermint.evm.v1.QueryDeployerPermissionResponse")
+	proto.RegisterType((*QueryDeploymentFactoriesRequest)(nil), "ethermint.evm.v1.QueryDeploymentFactoriesRequest")
+	proto.RegisterType((*QueryDeploymentFactoriesResponse)(nil), "ethermint.evm.v1.QueryDeploymentFactoriesResponse")
+	proto.RegisterType((*QueryStateDiffRequest)(nil), "ethermint.evm.v1.QueryStateDiffRequest")
+	proto.RegisterType((*QueryStateDiffResponse)(nil), "ethermint.evm.v1.QueryStateDiffResponse")
+	proto.RegisterType((*QueryTouchedAccountsRequest)(nil), "ethermint.evm.v1.QueryTouchedAccountsRequest")
+	proto.RegisterType((*QueryTouchedAccountsResponse)(nil), "ethermint.evm.v1.QueryTouchedAccountsResponse")
+	proto.RegisterType((*AccountStateDiff)(nil), "ethermint.evm.v1.AccountStateDiff")
+	proto.RegisterType((*StorageDiff)(nil), "ethermint.evm.v1.StorageDiff")
+	proto.RegisterType((*QueryContractStatsRequest)(nil), "ethermint.evm.v1.QueryContractStatsRequest")
+	proto.RegisterType((*QueryContractStatsResponse)(nil), "ethermint.evm.v1.QueryContractStatsResponse")
 }
 
-func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Balance) > 0 {
-		i -= len(m.Balance)
-		copy(dAtA[i:], m.Balance)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
+
+var fileDescriptor_e15a877459347994 = []byte{
+	// 2175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x48, 0x3e, 0x4a, 0x16, 0x33, 0xa2, 0x14, 0x6a, 0x2d, 0x91, 0xf2, 0xda,
+	0x92, 0x68, 0xc7, 0xde, 0xb5, 0x94, 0xd6, 0x40, 0x93, 0x16, 0x8d, 0xa5, 0xd8, 0x4e, 0x6a, 0xbb,
+	0x70, 0x19, 0x37, 0x87, 0x02, 0x05, 0x31, 0x22, 0x87, 0xe4, 0x42, 0xe4, 0x0e, 0xc3, 0x59, 0xb1,
+	0x54, 0x02, 0x03, 0x49, 0x90, 0x7e, 0x5f, 0x8c, 0xf6, 0x50, 0xa0, 0xe8, 0x21, 0x40, 0x8f, 0x3d,
+	0x14, 0x28, 0x8a, 0xf6, 0x5f, 0xc8, 0xa5, 0x40, 0x80, 0x5e, 0x8a, 0x1e, 0xdc, 0xc2, 0xee, 0xa1,
+	0x7f, 0x43, 0x4f, 0xc5, 0x7c, 0x2c, 0xf7, 0x83, 0x1f, 0x2b, 0xb9, 0xf6, 0xad, 0x27, 0x72, 0xdf,
+	0xbc, 0x8f, 0xdf, 0xbc, 0xf7, 0xe6, 0xcd, 0xbc, 0x07, 0xeb, 0xc4, 0x6d, 0x91, 0x5e, 0xc7, 0x76,
+	0x5c, 0x8b, 0xf4, 0x3b, 0x56, 0x7f, 0xd7, 0xfa, 0xe0, 0x98, 0xf4, 0x4e, 0xcc, 0x6e, 0x8f, 0xba,
+	0x14, 0xe5, 0x86, 0xab, 0x26, 0xe9, 0x77, 0xcc, 0xfe, 0xae, 0x7e, 0xa5, 0x46, 0x59, 0x87, 0x32,
+	0xeb, 0x10, 0x33, 0x22, 0x59, 0xad, 0xfe, 0xee, 0x21, 0x71, 0xf1, 0xae, 0xd5, 0xc5, 0x4d, 0xdb,
+	0xc1, 0xae, 0x4d, 0x1d, 0x29, 0xad, 0xeb, 0x23, 0xba, 0xb9, 0x12, 0xb9, 0xb6, 0x36, 0xb2, 0xe6,
+	0x0e, 0xd4, 0x52, 0xbe, 0x49, 0x9b, 0x54, 0xfc, 0xb5, 0xf8, 0x3f, 0x45, 0x5d, 0x6f, 0x52, 0xda,
+	0x6c, 0x13, 0x0b, 0x77, 0x6d, 0x0b, 0x3b, 0x0e, 0x75, 0x85, 0x25, 0xa6, 0x56, 0x4b, 0x6a, 0x55,
+	0x7c, 0x1d, 0x1e, 0x37, 0x2c, 0xd7, 0xee, 0x10, 0xe6, 0xe2, 0x4e, 0x57, 0x32, 0x18, 0x5f, 0x83,
+	0xe5, 0xef, 0x70, 0xb4, 0x37, 0x6b, 0x35, 0x7a, 0xec, 0xb8, 0x15, 0xf2, 0xc1, 0x31, 0x61, 0x2e,
+	0x2a, 0x40, 0x0a, 0xd7, 0xeb, 0x3d, 0xc2, 0x58, 0x41, 0xdb, 0xd4, 0xca, 0x99, 0x8a, 0xf7, 0xf9,
+	0x46, 0xfa, 0x27, 0x9f, 0x97, 0x66, 0xfe, 0xfd, 0x79, 0x69, 0xc6, 0xa8, 0x41, 0x3e, 0x2c, 0xca,
+	0xba, 0xd4, 0x61, 0x84, 0xcb, 0x1e, 0xe2, 0x36, 0x76, 0x6a, 0xc4, 0x93, 0x55, 0x9f, 0xe8, 0x3c,
+	0x64, 0x6a, 0xb4, 0x4e, 0xaa, 0x2d, 0xcc, 0x5a, 0x85, 0x59, 0xb1, 0x96, 0xe6, 0x84, 0x77, 0x30,
+	0x6b, 0xa1, 0x3c, 0xcc, 0x39, 0x94, 0x0b, 0x25, 0x36, 0xb5, 0x72, 0xb2, 0x22, 0x3f, 0x8c, 0x6f,
+	0xc2, 0x9a, 0x30, 0x72, 0x20, 0xdc, 0xfb, 0x1c, 0x28, 0x7f, 0xa4, 0x81, 0x3e, 0x4e, 0x83, 0x02,
+	0xbb, 0x05, 0xe7, 0x64, 0xe4, 0xaa, 0x61, 0x4d, 0x8b, 0x92, 0x7a, 0x53, 0x12, 0x91, 0x0e, 0x69,
+	0xc6, 0x8d, 0x72, 0x7c, 0xb3, 0x02, 0xdf, 0xf0, 0x9b, 0xab, 0xc0, 0x52, 0x6b, 0xd5, 0x39, 0xee,
+	0x1c, 0x92, 0x9e, 0xda, 0xc1, 0xa2, 0xa2, 0x7e, 0x5b, 0x10, 0x8d, 0xbb, 0xb0, 0x2e, 0x70, 0xbc,
+	0x8f, 0xdb, 0x76, 0x1d, 0xbb, 0xb4, 0x17, 0xd9, 0xcc, 0x05, 0x58, 0xa8, 0x51, 0x27, 0x8a, 0x23,
+	0xcb, 0x69, 0x37, 0x47, 0x76, 0xf5, 0x73, 0x0d, 0x36, 0x26, 0x68, 0x53, 0x1b, 0xdb, 0x81, 0x25,
+	0x0f, 0x55, 0x58, 0xa3, 0x07, 0xf6, 0x05, 0x6e, 0xcd, 0x4b, 0xa2, 0x7d, 0x19, 0xe7, 0xb3, 0x84,
+	0xe7, 0x3a, 0xe4, 0xc3, 0xa2, 0x71, 0x49, 0x64, 0xdc, 0x55, 0xc6, 0xde, 0x73, 0x69, 0x0f, 0x37,
+	0xe3, 0x8d, 0xa1, 0x1c, 0x24, 0x8e, 0xc8, 0x89, 0xca, 0x37, 0xfe, 0x37, 0x60, 0xfe, 0x2a, 0xe4,
+	0xc3, 0xca, 0x94, 0xf9, 0x3c, 0xcc, 0xf5, 0x71, 0xfb, 0xd8, 0x33, 0x2e, 0x3f, 0x8c, 0x1b, 0x90,
+	0x53, 0xa9, 0x54, 0x3f, 0xd3, 0x26, 0x77, 0xe0, 0x95, 0x80, 0x9c, 0x32, 0x81, 0x20, 0xc9, 0x73,
+	0x5f, 0x48, 0x2d, 0x54, 0xc4, 0x7f, 0xe3, 0x43, 0x40, 0x82, 0xf1, 0xe1, 0xe0, 0x1e, 0x6d, 0x32,
+	0xcf, 0x04, 0x82, 0xa4, 0x38, 0x31, 0x52, 0xbf, 0xf8, 0x8f, 0x6e, 0x03, 0xf8, 0x75, 0x45, 0xec,
+	0x2d, 0xbb, 0xb7, 0x6d, 0xca, 0xa4, 0x35, 0x79, 0x11, 0x32, 0x65, 0xbd, 0x52, 0x45, 0xc8, 0x7c,
+	0xe0, 0xbb, 0xaa, 0x12, 0x90, 0x0c, 0x80, 0xfc, 0xa9, 0x06, 0xcb, 0x21, 0xe3, 0x0a, 0xe7, 0x65,
+	0x48, 0xb6, 0x69, 0x93, 0xef, 0x2e, 0x51, 0xce, 0xee, 0xad, 0x98, 0xd1, 0xd2, 0x67, 0xde, 0xa3,
+	0xcd, 0x8a, 0x60, 0x41, 0x77, 0xc6, 0x80, 0xda, 0x89, 0x05, 0x25, 0xed, 0x04, 0x51, 0x19, 0x79,
+	0xe5, 0x87, 0x07, 0xb8, 0x87, 0x3b, 0x9e, 0x1f, 0x8c, 0xfb, 0xb0, 0x1c, 0xa2, 0x2a, 0x80, 0x37,
+	0x60, 0xbe, 0x2b, 0x28, 0xc2, 0x41, 0xd9, 0xbd, 0xc2, 0x28, 0x44, 0x29, 0xb1, 0x9f, 0xfc, 0xe2,
+	0x49, 0x69, 0xa6, 0xa2, 0xb8, 0x8d, 0x3f, 0x6b, 0x70, 0xee, 0x96, 0xdb, 0x3a, 0xc0, 0xed, 0x76,
+	0xc0, 0xd3, 0xb8, 0xd7, 0x64, 0x5e, 0x4c, 0xf8, 0x7f, 0xf4, 0x2a, 0xa4, 0x9a, 0x98, 0x55, 0x6b,
+	0xb8, 0xab, 0x8e, 0xc7, 0x7c, 0x13, 0xb3, 0x03, 0xdc, 0x45, 0xdf, 0x87, 0x5c, 0xb7, 0x47, 0xbb,
+	0x94, 0x91, 0xde, 0xf0, 0x88, 0xf1, 0xe3, 0xb1, 0xb0, 0xbf, 0xf7, 0x9f, 0x27, 0x25, 0xb3, 0x69,
+	0xbb, 0xad, 0xe3, 0x43, 0xb3, 0x46, 0x3b, 0x96, 0xba, 0x1b, 0xe4, 0xcf, 0x35, 0x56, 0x3f, 0xb2,
+	0xdc, 0x93, 0x2e, 0x61, 0xe6, 0x81, 0x7f, 0xb6, 0x2b, 0x4b, 0x9e, 0x2e, 0xef, 0x5c, 0xae, 0x41,
+	0xba, 0xd6, 0xc2, 0xb6, 0x53, 0xb5, 0xeb, 0x85, 0xe4, 0xa6, 0x56, 0x4e, 0x54, 0x52, 0xe2, 0xfb,
+	0xdd, 0xba, 0xb1, 0x03, 0xcb, 0xb7, 0x98, 0x6b, 0x77, 0xb0, 0x4b, 0xee, 0x60, 0xdf, 0x11, 0x39,
+	0x48, 0x34, 0xb1, 0x04, 0x9f, 0xac, 0xf0, 0xbf, 0xc6, 0x67, 0x49, 0x2f, 0xa6, 0x3d, 0x5c, 0x23,
+	0x0f, 0x07, 0xde, 0x3e, 0x77, 0x21, 0xd1, 0x61, 0x4d, 0xe5, 0xaf, 0xd2, 0xa8, 0xbf, 0xee, 0xb3,
+	0xe6, 0x2d, 0x4e, 0x23, 0xc7, 0x9d, 0x87, 0x83, 0x0a, 0xe7, 0x45, 0x6f, 0xc1, 0x82, 0xcb, 0x95,
+	0x54, 0x6b, 0xd4, 0x69, 0xd8, 0x4d, 0xb1, 0xd3, 0xec, 0xde, 0xc6, 0xa8, 0xac, 0x30, 0x75, 0x20,
+	0x98, 0x2a, 0x59, 0xd7, 0xff, 0x40, 0x07, 0xb0, 0xd0, 0xed, 0x91, 0x3a, 0xa9, 0x11, 0xc6, 0x68,
+	0x8f, 0x15, 0x92, 0x9b, 0x89, 0xd3, 0x58, 0x0f, 0x09, 0xf1, 0x2a, 0x79, 0xd8, 0xa6, 0xb5, 0x23,
+	0xaf, 0x1e, 0xcd, 0x09, 0xcf, 0x64, 0x05, 0x4d, 0x56, 0x23, 0xb4, 0x01, 0x20, 0x59, 0xc4, 0xa1,
+	0x99, 0x17, 0x87, 0x26, 0x23, 0x28, 0xe2, 0x9e, 0x39, 0xf0, 0x96, 0xf9, 0x55, 0x58, 0x48, 0x89,
+	0x6d, 0xe8, 0xa6, 0xbc, 0x27, 0x4d, 0xef, 0x9e, 0x34, 0x1f, 0x7a, 0xf7, 0xe4, 0x7e, 0x9a, 0x27,
+	0xcd, 0xe3, 0x7f, 0x94, 0x34, 0xa5, 0x84, 0xaf, 0x8c, 0x8d, 0x7d, 0xfa, 0xe5, 0xc4, 0x3e, 0x13,
+	0x8a, 0x3d, 0x32, 0x60, 0x51, 0xc2, 0xef, 0xe0, 0x41, 0x95, 0x87, 0x1b, 0x02, 0x1e, 0xb8, 0x8f,
+	0x07, 0x77, 0x30, 0xfb, 0x56, 0x32, 0x3d, 0x9b, 0x4b, 0x54, 0xd2, 0xee, 0xa0, 0x6a, 0x3b, 0x75,
+	0x32, 0x30, 0xae, 0xa8, 0x2a, 0x37, 0xcc, 0x02, 0xbf, 0x04, 0xd5, 0xb1, 0x8b, 0xbd, 0x74, 0xe7,
+	0xff, 0x8d, 0x3f, 0x24, 0x60, 0xd5, 0x67, 0xde, 0xe7, 0x5a, 0x03, 0x59, 0xe3, 0x0e, 0xbc, 0x42,
+	0x10, 0x9f, 0x35, 0xee, 0x80, 0xbd, 0x80, 0xac, 0xf9, 0x7f, 0xc0, 0xe3, 0x03, 0x6e, 0x5c, 0x83,
+	0x57, 0x47, 0x62, 0x36, 0x25, 0xc6, 0x1f, 0xcf, 0xc2, 0x8a, 0xcf, 0x1f, 0x57, 0x00, 0xa3, 0x31,
+	0x9c, 0x3d, 0x73, 0x0c, 0x03, 0x25, 0x34, 0x11, 0x5b, 0x42, 0x93, 0x2f, 0xc7, 0xab, 0x73, 0xe1,
+	0x12, 0x7a, 0x15, 0x56, 0xa3, 0x1e, 0x98, 0xe2, 0xb0, 0x95, 0xe1, 0x03, 0x87, 0x91, 0xdb, 0xc4,
+	0xbb, 0x48, 0x8d, 0x7b, 0x90, 0x0f, 0x93, 0x95, 0x8a, 0xaf, 0x40, 0x9a, 0xdf, 0x76, 0xd5, 0x06,
+	0x51, 0x0f, 0x88, 0xfd, 0xb5, 0xbf, 0x3f, 0x29, 0xad, 0x48, 0xf0, 0xac, 0x7e, 0x64, 0xda, 0xd4,
+	0xea, 0x60, 0xb7, 0x65, 0xbe, 0xeb, 0xb8, 0xfc, 0x61, 0x23, 0xa4, 0x8d, 0xa2, 0x7a, 0x20, 0xbe,
+	0x4d, 0xba, 0x6d, 0x7a, 0xd2, 0x21, 0x8e, 0xfb, 0x80, 0xb6, 0xed, 0xda, 0x89, 0x67, 0xad, 0x0f,
+	0x1b, 0x13, 0xd6, 0x95, 0xd9, 0xef, 0xc2, 0x2b, 0xf5, 0xe1, 0x5a, 0xb5, 0x2b, 0x16, 0x55, 0x8d,
+	0x37, 0x46, 0xa3, 0x15, 0x55, 0xa3, 0x6e, 0xc7, 0x5c, 0x3d, 0x42, 0x37, 0xde, 0x80, 0x62, 0xc0,
+	0x2e, 0xe9, 0x3d, 0xe0, 0x7a, 0x18, 0xb3, 0xa9, 0x13, 0xfb, 0x06, 0x32, 0x1a, 0x50, 0x9a, 0x28,
+	0xeb, 0xbf, 0xf4, 0x70, 0xbb, 0x4d, 0x7f, 0x40, 0xea, 0x42, 0x38, 0x5d, 0xf1, 0x3e, 0xd1, 0x65,
+	0xc8, 0x35, 0x70, 0xcd, 0xa5, 0xbd, 0x93, 0x6a, 0x5d, 0xc9, 0xab, 0x57, 0xdc, 0x92, 0xa2, 0x7b,
+	0x6a, 0x0d, 0x3b, 0x64, 0x87, 0x83, 0xbf, 0x2d, 0x38, 0x6c, 0x32, 0x7c, 0x45, 0x85, 0x5f, 0x4c,
+	0xda, 0xf3, 0xbe, 0x98, 0x8c, 0x3f, 0x6a, 0xb0, 0x39, 0xd9, 0x96, 0xda, 0xd4, 0x1d, 0xc8, 0x34,
+	0x3c, 0xa2, 0x2a, 0x98, 0x17, 0xa7, 0x85, 0x40, 0x6a, 0xf0, 0x62, 0xe0, 0xcb, 0xbe, 0xb8, 0x27,
+	0x15, 0x55, 0x47, 0xfe, 0x3d, 0x17, 0xbb, 0xe4, 0x6d, 0xbb, 0xd1, 0x88, 0x7f, 0x38, 0x97, 0x20,
+	0xdb, 0xe8, 0xd1, 0x4e, 0xb5, 0x45, 0xec, 0x66, 0xcb, 0x15, 0xc6, 0x13, 0x15, 0xe0, 0xa4, 0x77,
+	0x04, 0x85, 0xf7, 0x73, 0x2e, 0xf5, 0x96, 0x13, 0x62, 0x39, 0xed, 0x52, 0xb9, 0x68, 0xbc, 0x0f,
+	0xab, 0x51, 0x83, 0xca, 0x39, 0x5f, 0x87, 0x64, 0xdd, 0x6e, 0x34, 0x26, 0xa7, 0xa6, 0xea, 0x65,
+	0x86, 0x92, 0xca, 0x2d, 0x42, 0xca, 0xf8, 0xad, 0x06, 0xe7, 0xe5, 0xd1, 0xa5, 0xc7, 0xb5, 0x16,
+	0xa9, 0x2b, 0xe6, 0x61, 0x9c, 0x23, 0xa8, 0xb5, 0xe9, 0xa8, 0x67, 0xc3, 0xa8, 0x23, 0x59, 0x92,
+	0x78, 0xee, 0x2c, 0xf9, 0xa1, 0x06, 0xeb, 0xe3, 0x51, 0x2a, 0x27, 0xac, 0x43, 0x46, 0xf9, 0x59,
+	0x65, 0x48, 0xa6, 0xe2, 0x13, 0x5e, 0x5c, 0xd8, 0xff, 0x32, 0x0b, 0xb9, 0xa8, 0x3b, 0xa7, 0x84,
+	0x7c, 0x0b, 0xce, 0xa9, 0x3e, 0xab, 0x7a, 0x48, 0x1a, 0xb4, 0x47, 0xd4, 0x81, 0x5b, 0x54, 0xd4,
+	0x7d, 0x41, 0x44, 0x17, 0xc1, 0x23, 0x54, 0x71, 0xc3, 0x55, 0x6d, 0x61, 0xa6, 0xb2, 0xa0, 0x88,
+	0x37, 0x39, 0x8d, 0xdf, 0xdc, 0x0e, 0x0d, 0x68, 0x4a, 0x8a, 0xd2, 0x9f, 0x75, 0xa8, 0xaf, 0xa7,
+	0x04, 0xf2, 0x53, 0x69, 0x99, 0x13, 0x1c, 0xe0, 0xd0, 0xa1, 0x8e, 0x32, 0xe4, 0x86, 0x13, 0x03,
+	0x4f, 0x8f, 0xbc, 0xe0, 0xcf, 0x79, 0x83, 0x03, 0xa5, 0x6a, 0x1b, 0x96, 0x7c, 0x4e, 0xa9, 0x2e,
+	0xe5, 0x75, 0xf2, 0x92, 0x51, 0x6a, 0xfc, 0x06, 0xa4, 0x98, 0x6c, 0xf6, 0x0a, 0xe9, 0xcd, 0xc4,
+	0xf8, 0x8b, 0x4c, 0x75, 0x83, 0x81, 0xd4, 0xf3, 0x64, 0x8c, 0xfb, 0x90, 0x0d, 0xac, 0x7a, 0xbd,
+	0xa5, 0x36, 0xec, 0x2d, 0xd1, 0x2a, 0xcc, 0x87, 0x3c, 0xa7, 0xbe, 0x78, 0x47, 0x19, 0x74, 0x95,
+	0xfc, 0x30, 0xee, 0x0e, 0xc7, 0x1b, 0x0e, 0xbf, 0x30, 0x45, 0x8c, 0x58, 0xfc, 0xc9, 0xcc, 0xc3,
+	0x5c, 0xdb, 0xee, 0xd8, 0x32, 0x7d, 0x17, 0x2b, 0xf2, 0xc3, 0xf8, 0x95, 0x3f, 0xea, 0x08, 0x69,
+	0x53, 0x19, 0xf7, 0x26, 0xcc, 0x31, 0x4e, 0x98, 0xfc, 0x80, 0x0b, 0xc9, 0xa9, 0x9d, 0x4b, 0x99,
+	0xff, 0xad, 0x16, 0xec, 0x7d, 0xb2, 0x02, 0x73, 0x02, 0x19, 0xfa, 0x44, 0x83, 0x94, 0xca, 0x47,
+	0xb4, 0x35, 0x8a, 0x60, 0xcc, 0x2c, 0x4a, 0xdf, 0x8e, 0x63, 0x93, 0xfb, 0x33, 0x76, 0x3e, 0xfd,
+	0xeb, 0xbf, 0x7e, 0x39, 0x7b, 0x01, 0x95, 0xf8, 0xe4, 0x8c, 0x32, 0x6f, 0x7e, 0xa6, 0x46, 0x15,
+	0xd6, 0x47, 0xca, 0x79, 0x8f, 0xd0, 0xaf, 0x35, 0x58, 0x0c, 0x4d, 0x83, 0xd0, 0x6b, 0x13, 0x4c,
+	0x8c, 0x9b, 0x3a, 0xe9, 0x57, 0x4f, 0xc7, 0xac, 0x50, 0x99, 0x02, 0x55, 0x19, 0x6d, 0x87, 0x51,
+	0x79, 0x43, 0xa7, 0x11, 0x70, 0xbf, 0xd3, 0x20, 0x17, 0x1d, 0xea, 0x20, 0x73, 0x82, 0xc9, 0x09,
+	0xb3, 0x24, 0xdd, 0x3a, 0x35, 0xbf, 0x42, 0x79, 0x43, 0xa0, 0xbc, 0x8e, 0xcc, 0x30, 0xca, 0xbe,
+	0xc7, 0xef, 0x03, 0x0d, 0xce, 0xa8, 0x1e, 0xa1, 0x4f, 0x35, 0x48, 0xa9, 0xd1, 0xcd, 0xc4, 0x70,
+	0x86, 0xa7, 0x42, 0xfa, 0x76, 0x1c, 0x9b, 0x82, 0x54, 0x16, 0x90, 0x0c, 0xb4, 0x19, 0x86, 0xa4,
+	0x4a, 0x0c, 0x0b, 0xb8, 0xec, 0xc7, 0x1a, 0xa4, 0xd4, 0xa1, 0x9c, 0x08, 0x22, 0x3c, 0x2d, 0xd2,
+	0xb7, 0xe3, 0xd8, 0x14, 0x88, 0x6b, 0x02, 0xc4, 0x0e, 0xda, 0x0a, 0x83, 0x50, 0xd5, 0xc0, 0xc7,
+	0x60, 0x7d, 0x74, 0x44, 0x4e, 0x1e, 0xa1, 0x3e, 0x24, 0xf9, 0x8c, 0x07, 0x19, 0x13, 0x53, 0x64,
+	0x38, 0x38, 0xd2, 0x2f, 0x4e, 0xe5, 0x51, 0xf6, 0xb7, 0x84, 0xfd, 0x12, 0xda, 0x88, 0x66, 0x4f,
+	0x3d, 0xe4, 0x01, 0x06, 0xf3, 0x72, 0xc4, 0x81, 0x2e, 0x4d, 0xd0, 0x1a, 0x9a, 0xa4, 0xe8, 0x5b,
+	0x31, 0x5c, 0xca, 0xfa, 0xba, 0xb0, 0xbe, 0x8a, 0xf2, 0x61, 0xeb, 0x72, 0x7e, 0x82, 0x5c, 0x48,
+	0xa9, 0xf1, 0x09, 0xda, 0x1c, 0xd5, 0x17, 0x9e, 0xac, 0xe8, 0x3b, 0x71, 0xed, 0xa2, 0x67, 0xb3,
+	0x28, 0x6c, 0x16, 0xd0, 0x6a, 0xd8, 0x26, 0x71, 0x5b, 0xd5, 0x1a, 0x37, 0xf5, 0x21, 0x64, 0x03,
+	0xb3, 0x8f, 0x53, 0x58, 0x1e, 0xb3, 0xd7, 0x31, 0xc3, 0x13, 0xc3, 0x10, 0x76, 0xd7, 0x91, 0x1e,
+	0xb1, 0xab, 0x58, 0x79, 0xe7, 0x85, 0x06, 0x90, 0x52, 0x2d, 0xf4, 0xc4, 0x3c, 0x0b, 0x0f, 0x5a,
+	0xf4, 0xed, 0x38, 0xb6, 0xe9, 0xbb, 0x96, 0x7d, 0x97, 0x3b, 0x40, 0x9f, 0x69, 0x00, 0x7e, 0x73,
+	0x87, 0xca, 0xd3, 0xd4, 0x06, 0x7b, 0x76, 0xfd, 0xf2, 0x29, 0x38, 0x15, 0x86, 0x0b, 0x02, 0xc3,
+	0x79, 0xb4, 0x36, 0x0e, 0x83, 0xe8, 0x36, 0xd1, 0xc7, 0x1a, 0x64, 0x86, 0x1d, 0x13, 0xda, 0x99,
+	0xa6, 0x3b, 0x18, 0x82, 0x72, 0x3c, 0xa3, 0xc2, 0xb0, 0x29, 0x30, 0xe8, 0xa8, 0x30, 0x0e, 0x83,
+	0x88, 0xff, 0x80, 0x17, 0x1c, 0xd1, 0x30, 0x4d, 0x29, 0x38, 0xc1, 0x2e, 0x4d, 0xdf, 0x8e, 0x63,
+	0x9b, 0x1e, 0x03, 0xaf, 0x93, 0x43, 0xbf, 0xd1, 0x20, 0x17, 0x6d, 0x9a, 0x26, 0x56, 0xe6, 0x09,
+	0x4d, 0x9c, 0x6e, 0x9d, 0x9a, 0x7f, 0xfa, 0xad, 0x36, 0xd2, 0xe8, 0xa1, 0x3f, 0x69, 0x80, 0x46,
+	0xdb, 0x2c, 0x74, 0x7d, 0xaa, 0xc1, 0x31, 0xdd, 0x9c, 0xbe, 0x7b, 0x06, 0x09, 0x05, 0xf2, 0x4d,
+	0x01, 0xf2, 0xab, 0xe8, 0xf5, 0x18, 0x90, 0x96, 0xd7, 0xc7, 0x05, 0x8b, 0xd7, 0xef, 0x35, 0x58,
+	0x1e, 0xd3, 0x4b, 0xa1, 0xdd, 0x58, 0x57, 0x45, 0x7b, 0x3c, 0x7d, 0xef, 0x2c, 0x22, 0x0a, 0xfb,
+	0xae, 0xc0, 0xfe, 0x1a, 0xba, 0x1c, 0x87, 0xdd, 0x6f, 0xca, 0x7e, 0xa6, 0x41, 0xc6, 0x7f, 0x4d,
+	0xef, 0x4c, 0xbc, 0x4b, 0xc2, 0x9d, 0x96, 0x5e, 0x8e, 0x67, 0x54, 0x98, 0xae, 0x08, 0x4c, 0x97,
+	0x90, 0x11, 0xbd, 0x76, 0x78, 0x25, 0xe2, 0x5d, 0x50, 0xc0, 0x7d, 0xbf, 0xd0, 0x60, 0x29, 0xd2,
+	0x64, 0xa0, 0x6b, 0x93, 0x0e, 0xdc, 0xd8, 0x96, 0x49, 0x37, 0x4f, 0xcb, 0x3e, 0xfd, 0x94, 0xfa,
+	0xf0, 0xd0, 0x63, 0xf1, 0xc4, 0x0a, 0xbc, 0x26, 0xa7, 0x3c, 0xb1, 0x46, 0x5f, 0xbe, 0xfa, 0xd5,
+	0xd3, 0x31, 0x2b, 0x38, 0x97, 0x04, 0x9c, 0x22, 0x5a, 0x8f, 0x5e, 0x92, 0x92, 0xb9, 0xca, 0xc4,
+	0x73, 0xf6, 0xad, 0x2f, 0x9e, 0x16, 0xb5, 0x2f, 0x9f, 0x16, 0xb5, 0x7f, 0x3e, 0x2d, 0x6a, 0x8f,
+	0x9f, 0x15, 0x67, 0xbe, 0x7c, 0x56, 0x9c, 0xf9, 0xdb, 0xb3, 0xe2, 0xcc, 0xf7, 0xb6, 0x03, 0x73,
+	0xa6, 0xa1, 0x06, 0xca, 0xac, 0xfe, 0xee, 0x0d, 0x6b, 0x20, 0xb4, 0x89, 0x59, 0xd3, 0xe1, 0xbc,
+	0x18, 0x16, 0xbe, 0xfe, 0xdf, 0x01, 0x00, 0x7f, 0x73, 0x79, 0xf9, 0x1c, 0x1e, 0x00, 0x00,
 }
 
-func (m *QueryCosmosAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Account queries an Ethereum account.
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
+	// CosmosAccount queries an Ethereum account's Cosmos Address.
+	CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error)
+	// ValidatorAccount queries an Ethereum account's from a validator consensus
+	// Address.
+	ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error)
+	// Balance queries the balance of a the EVM denomination for a single
+	// EthAccount.
+	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
+	// Storage queries the balance of all coins for a single account.
+	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// Code queries the balance of all coins for a single account.
+	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// Params queries the parameters of x/evm module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EthCall implements the `eth_call` rpc api
+	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// EstimateGas implements the `eth_estimateGas` rpc api
+	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// TraceTx implements the `debug_traceTransaction` rpc api
+	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
+	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
+	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// BaseFee queries the base fee of the parent block of the current block,
+	// it's similar to feemarket module's method, but also checks london hardfork status.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// DeploymentPolicy queries the contract deployment policy of the EVM.
+	DeploymentPolicy(ctx context.Context, in *QueryDeploymentPolicyRequest, opts ...grpc.CallOption) (*QueryDeploymentPolicyResponse, error)
+	// DeployerPermission queries if an account is allowed to deploy contracts
+	// under the current deployment policy.
+	DeployerPermission(ctx context.Context, in *QueryDeployerPermissionRequest, opts ...grpc.CallOption) (*QueryDeployerPermissionResponse, error)
+	// DeploymentFactories queries the factory contracts deployed by the
+	// allowlisted deployers.
+	DeploymentFactories(ctx context.Context, in *QueryDeploymentFactoriesRequest, opts ...grpc.CallOption) (*QueryDeploymentFactoriesResponse, error)
+	// StateDiff queries the changes of the balance, nonce, code and storage of an
+	// Ethereum account between two historical heights.
+	StateDiff(ctx context.Context, in *QueryStateDiffRequest, opts ...grpc.CallOption) (*QueryStateDiffResponse, error)
+	// TouchedAccounts queries the Ethereum accounts whose account, balances or
+	// storage were written between two historical heights, from the changeset
+	// index of the node.
+	TouchedAccounts(ctx context.Context, in *QueryTouchedAccountsRequest, opts ...grpc.CallOption) (*QueryTouchedAccountsResponse, error)
+	// ContractStats queries the gas used, call and revert counts of the contracts
+	// aggregated over the recent blocks executed by the node.
+	ContractStats(ctx context.Context, in *QueryContractStatsRequest, opts ...grpc.CallOption) (*QueryContractStatsResponse, error)
 }
 
-func (m *QueryCosmosAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryCosmosAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCosmosAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error) {
+	out := new(QueryCosmosAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CosmosAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCosmosAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryCosmosAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AccountNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CosmosAddress) > 0 {
-		i -= len(m.CosmosAddress)
-		copy(dAtA[i:], m.CosmosAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error) {
+	out := new(QueryValidatorAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ValidatorAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValidatorAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
+	out := new(QueryBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Balance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryValidatorAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryValidatorAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsAddress) > 0 {
-		i -= len(m.ConsAddress)
-		copy(dAtA[i:], m.ConsAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error) {
+	out := new(QueryStorageResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Storage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValidatorAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
+	out := new(QueryCodeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Code", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryValidatorAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryValidatorAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AccountNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.AccountAddress) > 0 {
-		i -= len(m.AccountAddress)
-		copy(dAtA[i:], m.AccountAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
+	out := new(MsgEthereumTxResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EthCall", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
+	out := new(QueryTraceTxResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balance) > 0 {
-		i -= len(m.Balance)
-		copy(dAtA[i:], m.Balance)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error) {
+	out := new(QueryTraceBlockResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStorageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DeploymentPolicy(ctx context.Context, in *QueryDeploymentPolicyRequest, opts ...grpc.CallOption) (*QueryDeploymentPolicyResponse, error) {
+	out := new(QueryDeploymentPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/DeploymentPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DeployerPermission(ctx context.Context, in *QueryDeployerPermissionRequest, opts ...grpc.CallOption) (*QueryDeployerPermissionResponse, error) {
+	out := new(QueryDeployerPermissionResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/DeployerPermission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStorageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DeploymentFactories(ctx context.Context, in *QueryDeploymentFactoriesRequest, opts ...grpc.CallOption) (*QueryDeploymentFactoriesResponse, error) {
+	out := new(QueryDeploymentFactoriesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/DeploymentFactories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) StateDiff(ctx context.Context, in *QueryStateDiffRequest, opts ...grpc.CallOption) (*QueryStateDiffResponse, error) {
+	out := new(QueryStateDiffResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/StateDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TouchedAccounts(ctx context.Context, in *QueryTouchedAccountsRequest, opts ...grpc.CallOption) (*QueryTouchedAccountsResponse, error) {
+	out := new(QueryTouchedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TouchedAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractStats(ctx context.Context, in *QueryContractStatsRequest, opts ...grpc.CallOption) (*QueryContractStatsResponse, error) {
+	out := new(QueryContractStatsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ContractStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Account queries an Ethereum account.
+	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
+	// CosmosAccount queries an Ethereum account's Cosmos Address.
+	CosmosAccount(context.Context, *QueryCosmosAccountRequest) (*QueryCosmosAccountResponse, error)
+	// ValidatorAccount queries an Ethereum account's from a validator consensus
+	// Address.
+	ValidatorAccount(context.Context, *QueryValidatorAccountRequest) (*QueryValidatorAccountResponse, error)
+	// Balance queries the balance of a the EVM denomination for a single
+	// EthAccount.
+	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// Storage queries the balance of all coins for a single account.
+	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// Code queries the balance of all coins for a single account.
+	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// Params queries the parameters of x/evm module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EthCall implements the `eth_call` rpc api
+	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
+	// EstimateGas implements the `eth_estimateGas` rpc api
+	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// TraceTx implements the `debug_traceTransaction` rpc api
+	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
+	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
+	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
+	// BaseFee queries the base fee of the parent block of the current block,
+	// it's similar to feemarket module's method, but also checks london hardfork status.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// DeploymentPolicy queries the contract deployment policy of the EVM.
+	DeploymentPolicy(context.Context, *QueryDeploymentPolicyRequest) (*QueryDeploymentPolicyResponse, error)
+	// DeployerPermission queries if an account is allowed to deploy contracts
+	// under the current deployment policy.
+	DeployerPermission(context.Context, *QueryDeployerPermissionRequest) (*QueryDeployerPermissionResponse, error)
+	// DeploymentFactories queries the factory contracts deployed by the
+	// allowlisted deployers.
+	DeploymentFactories(context.Context, *QueryDeploymentFactoriesRequest) (*QueryDeploymentFactoriesResponse, error)
+	// StateDiff queries the changes of the balance, nonce, code and storage of an
+	// Ethereum account between two historical heights.
+	StateDiff(context.Context, *QueryStateDiffRequest) (*QueryStateDiffResponse, error)
+	// TouchedAccounts queries the Ethereum accounts whose account, balances or
+	// storage were written between two historical heights, from the changeset
+	// index of the node.
+	TouchedAccounts(context.Context, *QueryTouchedAccountsRequest) (*QueryTouchedAccountsResponse, error)
+	// ContractStats queries the gas used, call and revert counts of the contracts
+	// aggregated over the recent blocks executed by the node.
+	ContractStats(context.Context, *QueryContractStatsRequest) (*QueryContractStatsResponse, error)
 }
 
-func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
 }
-
-func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) CosmosAccount(ctx context.Context, req *QueryCosmosAccountRequest) (*QueryCosmosAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosmosAccount not implemented")
 }
-
-func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ValidatorAccount(ctx context.Context, req *QueryValidatorAccountRequest) (*QueryValidatorAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccount not implemented")
+}
+func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
+}
+func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallRequest) (*MsgEthereumTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
+}
+func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
+}
+func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
+}
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) DeploymentPolicy(ctx context.Context, req *QueryDeploymentPolicyRequest) (*QueryDeploymentPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentPolicy not implemented")
+}
+func (*UnimplementedQueryServer) DeployerPermission(ctx context.Context, req *QueryDeployerPermissionRequest) (*QueryDeployerPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployerPermission not implemented")
+}
+func (*UnimplementedQueryServer) DeploymentFactories(ctx context.Context, req *QueryDeploymentFactoriesRequest) (*QueryDeploymentFactoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentFactories not implemented")
+}
+func (*UnimplementedQueryServer) StateDiff(ctx context.Context, req *QueryStateDiffRequest) (*QueryStateDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateDiff not implemented")
+}
+func (*UnimplementedQueryServer) TouchedAccounts(ctx context.Context, req *QueryTouchedAccountsRequest) (*QueryTouchedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchedAccounts not implemented")
+}
+func (*UnimplementedQueryServer) ContractStats(ctx context.Context, req *QueryContractStatsRequest) (*QueryContractStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStats not implemented")
 }
 
-func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CosmosAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCosmosAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CosmosAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CosmosAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CosmosAccount(ctx, req.(*QueryCosmosAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ValidatorAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/ValidatorAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccount(ctx, req.(*QueryValidatorAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Balance(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Storage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Storage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Storage(ctx, req.(*QueryStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Code(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Code",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Code(ctx, req.(*QueryCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/EthCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthCall(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateGas(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceTxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TraceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceTx(ctx, req.(*QueryTraceTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EthCallRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceBlock(ctx, req.(*QueryTraceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x20
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
 	}
-	if m.GasCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
 	}
-	if len(m.Args) > 0 {
-		i -= len(m.Args)
-		copy(dAtA[i:], m.Args)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DeploymentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeploymentPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeploymentPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/DeploymentPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeploymentPolicy(ctx, req.(*QueryDeploymentPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
-		i--
-		dAtA[i] = 0x8
+func _Query_DeployerPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeployerPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DeployerPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/DeployerPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeployerPermission(ctx, req.(*QueryDeployerPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DeploymentFactories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeploymentFactoriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DeploymentFactories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/DeploymentFactories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeploymentFactories(ctx, req.(*QueryDeploymentFactoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/StateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StateDiff(ctx, req.(*QueryStateDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
+func _Query_TouchedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTouchedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x48
+	if interceptor == nil {
+		return srv.(QueryServer).TouchedAccounts(ctx, in)
 	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x42
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TouchedAccounts",
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err4 != nil {
-		return 0, err4
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TouchedAccounts(ctx, req.(*QueryTouchedAccountsRequest))
 	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStats(ctx, in)
 	}
-	if len(m.Predecessors) > 0 {
-		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/ContractStats",
 	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStats(ctx, req.(*QueryContractStatsRequest))
 	}
-	if m.Msg != nil {
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.evm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
+		{
+			MethodName: "CosmosAccount",
+			Handler:    _Query_CosmosAccount_Handler,
+		},
+		{
+			MethodName: "ValidatorAccount",
+			Handler:    _Query_ValidatorAccount_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _Query_Balance_Handler,
+		},
+		{
+			MethodName: "Storage",
+			Handler:    _Query_Storage_Handler,
+		},
+		{
+			MethodName: "Code",
+			Handler:    _Query_Code_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EthCall",
+			Handler:    _Query_EthCall_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Query_EstimateGas_Handler,
+		},
+		{
+			MethodName: "TraceTx",
+			Handler:    _Query_TraceTx_Handler,
+		},
+		{
+			MethodName: "TraceBlock",
+			Handler:    _Query_TraceBlock_Handler,
+		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "DeploymentPolicy",
+			Handler:    _Query_DeploymentPolicy_Handler,
+		},
+		{
+			MethodName: "DeployerPermission",
+			Handler:    _Query_DeployerPermission_Handler,
+		},
+		{
+			MethodName: "DeploymentFactories",
+			Handler:    _Query_DeploymentFactories_Handler,
+		},
+		{
+			MethodName: "StateDiff",
+			Handler:    _Query_StateDiff_Handler,
+		},
+		{
+			MethodName: "TouchedAccounts",
+			Handler:    _Query_TouchedAccounts_Handler,
+		},
+		{
+			MethodName: "ContractStats",
+			Handler:    _Query_ContractStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ethermint/evm/v1/query.proto",
 }
 
-func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2663,27 +3232,27 @@ func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2693,83 +3262,39 @@ func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x18
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x12
 	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCosmosAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2779,27 +3304,27 @@ func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
-	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCosmosAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2809,20 +3334,37 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CosmosAddress) > 0 {
+		i -= len(m.CosmosAddress)
+		copy(dAtA[i:], m.CosmosAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2832,447 +3374,3295 @@ func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryValidatorAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
-	}
-	return n
+func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EthCallRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Args)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GasCap != 0 {
-		n += 1 + sovQuery(uint64(m.GasCap))
-	}
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Predecessors) > 0 {
-		for _, e := range m.Predecessors {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EthCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Predecessors) > 0 {
+		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeploymentPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeploymentPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeploymentPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeploymentPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeploymentPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeploymentPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeploymentPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployerPermissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployerPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployerPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployerPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployerPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployerPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FactoryDeployer) > 0 {
+		i -= len(m.FactoryDeployer)
+		copy(dAtA[i:], m.FactoryDeployer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FactoryDeployer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeploymentFactoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeploymentFactoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeploymentFactoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeploymentFactoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeploymentFactoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeploymentFactoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Factories) > 0 {
+		for iNdEx := len(m.Factories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Factories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStateDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStateDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStateDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Diff.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTouchedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTouchedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTouchedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTouchedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTouchedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTouchedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountStateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountStateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountStateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CodeHashAfter) > 0 {
+		i -= len(m.CodeHashAfter)
+		copy(dAtA[i:], m.CodeHashAfter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHashAfter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CodeHashBefore) > 0 {
+		i -= len(m.CodeHashBefore)
+		copy(dAtA[i:], m.CodeHashBefore)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHashBefore)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NonceAfter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NonceAfter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NonceBefore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NonceBefore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BalanceAfter) > 0 {
+		i -= len(m.BalanceAfter)
+		copy(dAtA[i:], m.BalanceAfter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BalanceAfter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BalanceBefore) > 0 {
+		i -= len(m.BalanceBefore)
+		copy(dAtA[i:], m.BalanceBefore)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BalanceBefore)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Before) > 0 {
+		i -= len(m.Before)
+		copy(dAtA[i:], m.Before)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Before)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EthCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *EstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryTraceTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Predecessors) > 0 {
+		for _, e := range m.Predecessors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeploymentPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeploymentPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeploymentPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeployerPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployerPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.FactoryDeployer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeploymentFactoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeploymentFactoriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Factories) > 0 {
+		for _, e := range m.Factories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStateDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryStateDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Diff.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTouchedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTouchedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountStateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BalanceBefore)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BalanceAfter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NonceBefore != 0 {
+		n += 1 + sovQuery(uint64(m.NonceBefore))
+	}
+	if m.NonceAfter != 0 {
+		n += 1 + sovQuery(uint64(m.NonceAfter))
+	}
+	l = len(m.CodeHashBefore)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHashAfter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StorageDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Before)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryContractStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3295,15 +6685,15 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,7 +6721,43 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3354,7 +6780,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3377,17 +6803,17 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3397,29 +6823,31 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3429,43 +6857,78 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3487,7 +6950,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3510,17 +6973,17 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3530,23 +6993,24 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3569,7 +7033,7 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3592,17 +7056,17 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3612,29 +7076,31 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			m.Sequence = 0
+			m.GasCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3644,16 +7110,50 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.GasCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.AccountNumber = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3663,7 +7163,7 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3689,7 +7189,7 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3712,17 +7212,17 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			var stringLen uint64
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3732,24 +7232,11 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3771,7 +7258,7 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3794,17 +7281,17 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3814,29 +7301,33 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3846,16 +7337,33 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.AccountNumber = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3865,64 +7373,48 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3950,8 +7442,113 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3973,7 +7570,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3996,17 +7593,17 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4016,23 +7613,25 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4055,7 +7654,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4078,17 +7677,17 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4098,29 +7697,31 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4130,77 +7731,50 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4228,63 +7802,46 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4294,24 +7851,64 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4333,7 +7930,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4356,15 +7953,15 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4391,9 +7988,9 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4417,7 +8014,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4440,17 +8037,17 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4460,27 +8057,29 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4507,13 +8106,85 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4535,7 +8206,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4558,17 +8229,17 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4578,31 +8249,131 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4612,25 +8383,25 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4655,7 +8426,7 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeploymentPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4678,10 +8449,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeploymentPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeploymentPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4705,7 +8476,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeploymentPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4728,15 +8499,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeploymentPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeploymentPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4763,7 +8534,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeploymentPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4788,7 +8559,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeployerPermissionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4811,17 +8582,17 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeployerPermissionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeployerPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4831,31 +8602,79 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployerPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployerPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployerPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
 			}
-			m.GasCap = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4865,16 +8684,17 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Allowed = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDeployer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4884,45 +8704,24 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
-			}
+			m.FactoryDeployer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4944,7 +8743,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeploymentFactoriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4967,17 +8766,17 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeploymentFactoriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeploymentFactoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Gas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4987,11 +8786,28 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5013,7 +8829,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeploymentFactoriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5036,15 +8852,15 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeploymentFactoriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeploymentFactoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5071,16 +8887,14 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Factories = append(m.Factories, DeploymentFactory{})
+			if err := m.Factories[len(m.Factories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5107,18 +8921,68 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5128,31 +8992,29 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			m.BlockNumber = 0
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5162,16 +9024,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
+				m.FromHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			var stringLen uint64
+			m.ToHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5181,27 +9043,64 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ToHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5228,15 +9127,65 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Diff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTouchedAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTouchedAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTouchedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5246,31 +9195,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.FromHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			m.ChainId = 0
+			m.ToHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5280,16 +9214,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.ToHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.BlockMaxGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5299,11 +9233,28 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5325,7 +9276,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTouchedAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5348,17 +9299,17 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTouchedAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTouchedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5368,24 +9319,58 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5409,7 +9394,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountStateDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5432,17 +9417,17 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountStateDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountStateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5452,31 +9437,61 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.BalanceBefore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAfter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5486,33 +9501,48 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
+			m.BalanceAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBefore", wireType)
 			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NonceBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceAfter", wireType)
 			}
-			m.BlockNumber = 0
+			m.NonceAfter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5522,14 +9552,14 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
+				m.NonceAfter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHashBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5557,13 +9587,13 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.CodeHashBefore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHashAfter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5573,30 +9603,29 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CodeHashAfter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5606,64 +9635,26 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			m.Storage = append(m.Storage, StorageDiff{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5685,7 +9676,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *StorageDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5708,17 +9699,17 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5728,25 +9719,87 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Before = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.After = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5769,7 +9822,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5792,12 +9845,63 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5819,7 +9923,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5842,17 +9946,17 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5862,28 +9966,64 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, ContractStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,569 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/evmos/evmos/v16/types"
+)
+
+const (
+	// SetCodeTxType is the type of the EIP-7702 set code transactions.
+	SetCodeTxType = 0x04
+
+	// SetCodeAuthorizationBaseGas is the cost of the authorizations of the
+	// authorities that already exist. The difference with the cost of the new
+	// accounts charged in the intrinsic gas is refunded.
+	SetCodeAuthorizationBaseGas uint64 = 12500
+
+	// setCodeAuthorizationMagic is the prefix of the signed payload of the
+	// set code authorizations.
+	setCodeAuthorizationMagic = 0x05
+)
+
+// DelegationPrefix is the prefix of the delegation designators set as the code
+// of the accounts by the EIP-7702 authorizations.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// AddressToDelegation returns the delegation designator of the given address.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// ParseDelegation returns the address the code delegates to, if the code is a
+// delegation designator.
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(code, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// setCodeTxRLP is the RLP encoding of the set code transactions.
+type setCodeTxRLP struct {
+	ChainID           *big.Int
+	Nonce             uint64
+	GasTipCap         *big.Int
+	GasFeeCap         *big.Int
+	Gas               uint64
+	To                common.Address
+	Value             *big.Int
+	Data              []byte
+	AccessList        ethtypes.AccessList
+	AuthorizationList []setCodeAuthorizationRLP
+	V, R, S           *big.Int
+}
+
+// setCodeAuthorizationRLP is the RLP encoding of the set code authorizations.
+type setCodeAuthorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint8
+	R, S    *big.Int
+}
+
+// NewSetCodeTxFromBinary decodes the canonical encoding of a set code transaction.
+func NewSetCodeTxFromBinary(b []byte) (*SetCodeTx, error) {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+
+	var dec setCodeTxRLP
+	if err := rlp.DecodeBytes(b[1:], &dec); err != nil {
+		return nil, err
+	}
+
+	tx := &SetCodeTx{
+		Nonce:    dec.Nonce,
+		GasLimit: dec.Gas,
+		To:       dec.To.Hex(),
+		Data:     dec.Data,
+		Accesses: NewAccessList(&dec.AccessList),
+	}
+
+	for _, value := range []struct {
+		field **sdkmath.Int
+		value *big.Int
+	}{
+		{&tx.GasTipCap, dec.GasTipCap},
+		{&tx.GasFeeCap, dec.GasFeeCap},
+		{&tx.Amount, dec.Value},
+	} {
+		valueInt, err := types.SafeNewIntFromBigInt(value.value)
+		if err != nil {
+			return nil, err
+		}
+		*value.field = &valueInt
+	}
+
+	tx.Authorizations = make([]SetCodeAuthorization, len(dec.AuthorizationList))
+	for i, auth := range dec.AuthorizationList {
+		chainID, err := types.SafeNewIntFromBigInt(auth.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		tx.Authorizations[i] = SetCodeAuthorization{
+			ChainID: chainID,
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+		}
+		tx.Authorizations[i].SetSignatureValues(new(big.Int).SetUint64(uint64(auth.V)), auth.R, auth.S)
+	}
+
+	tx.SetSignatureValues(dec.ChainID, dec.V, dec.R, dec.S)
+	return tx, nil
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	authorizations := make([]SetCodeAuthorization, len(tx.Authorizations))
+	for i, auth := range tx.Authorizations {
+		authorizations[i] = SetCodeAuthorization{
+			ChainID: auth.ChainID,
+			Address: auth.Address,
+			Nonce:   auth.Nonce,
+			V:       common.CopyBytes(auth.V),
+			R:       common.CopyBytes(auth.R),
+			S:       common.CopyBytes(auth.S),
+		}
+	}
+
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: authorizations,
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns the fields of the transaction as a DynamicFeeTx, as
+// go-ethereum doesn't support the set code transactions.
+//
+// NOTE: the hash and the signature of the returned transaction are not the ones
+// of the set code transaction. Use Hash and Sender instead.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	dynamicFeeTx := DynamicFeeTx{
+		ChainID:   tx.ChainID,
+		GasTipCap: tx.GasTipCap,
+		GasFeeCap: tx.GasFeeCap,
+		GasLimit:  tx.GasLimit,
+		To:        tx.To,
+		Amount:    tx.Amount,
+	}
+	if err := dynamicFeeTx.Validate(); err != nil {
+		return err
+	}
+
+	if tx.To == "" {
+		return errorsmod.Wrap(ErrInvalidSetCodeTx, "set code transactions cannot create contracts")
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(ErrInvalidSetCodeTx, "authorization list cannot be empty")
+	}
+
+	for i, auth := range tx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "authorization %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GasLimit)
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// rlpFields returns the RLP encoding of the transaction fields.
+func (tx *SetCodeTx) rlpFields() setCodeTxRLP {
+	authorizations := make([]setCodeAuthorizationRLP, len(tx.Authorizations))
+	for i, auth := range tx.Authorizations {
+		authorizations[i] = auth.rlpFields()
+	}
+
+	v, r, s := tx.GetRawSignatureValues()
+	return setCodeTxRLP{
+		ChainID:           bigOrZero(tx.GetChainID()),
+		Nonce:             tx.Nonce,
+		GasTipCap:         bigOrZero(tx.GetGasTipCap()),
+		GasFeeCap:         bigOrZero(tx.GetGasFeeCap()),
+		Gas:               tx.GasLimit,
+		To:                common.HexToAddress(tx.To),
+		Value:             bigOrZero(tx.GetValue()),
+		Data:              tx.Data,
+		AccessList:        tx.GetAccessList(),
+		AuthorizationList: authorizations,
+		V:                 bigOrZero(v),
+		R:                 bigOrZero(r),
+		S:                 bigOrZero(s),
+	}
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(tx.rlpFields())
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{SetCodeTxType}, enc...), nil
+}
+
+// Hash returns the transaction hash.
+func (tx *SetCodeTx) Hash() common.Hash {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// SigHash returns the hash signed by the sender of the transaction.
+func (tx *SetCodeTx) SigHash() common.Hash {
+	fields := tx.rlpFields()
+	return prefixedRLPHash(SetCodeTxType, []interface{}{
+		fields.ChainID,
+		fields.Nonce,
+		fields.GasTipCap,
+		fields.GasFeeCap,
+		fields.Gas,
+		fields.To,
+		fields.Value,
+		fields.Data,
+		fields.AccessList,
+		fields.AuthorizationList,
+	})
+}
+
+// Sender returns the address that signed the transaction on the given chain.
+func (tx *SetCodeTx) Sender(chainID *big.Int) (common.Address, error) {
+	if txChainID := tx.GetChainID(); txChainID == nil || txChainID.Cmp(chainID) != 0 {
+		return common.Address{}, fmt.Errorf("%w: have %s want %s", ethtypes.ErrInvalidChainId, txChainID, chainID)
+	}
+
+	v, r, s := tx.GetRawSignatureValues()
+	return recoverSigner(tx.SigHash(), v, r, s)
+}
+
+// AsMessage returns the core message of the transaction sent by the given address.
+func (tx *SetCodeTx) AsMessage(from common.Address, baseFee *big.Int) SetCodeMessage {
+	gasPrice := tx.GetGasFeeCap()
+	if baseFee != nil {
+		gasPrice = tx.EffectiveGasPrice(baseFee)
+	}
+
+	return SetCodeMessage{
+		Message: ethtypes.NewMessage(
+			from,
+			tx.GetTo(),
+			tx.Nonce,
+			bigOrZero(tx.GetValue()),
+			tx.GasLimit,
+			gasPrice,
+			tx.GetGasFeeCap(),
+			tx.GetGasTipCap(),
+			tx.GetData(),
+			tx.GetAccessList(),
+			false,
+		),
+		AuthorizationList: tx.Authorizations,
+	}
+}
+
+// SetCodeMessage is the core message of a set code transaction, which carries
+// its authorization list.
+type SetCodeMessage struct {
+	ethtypes.Message
+	AuthorizationList []SetCodeAuthorization
+}
+
+// Validate performs a stateless validation of the authorization fields.
+func (auth SetCodeAuthorization) Validate() error {
+	if auth.ChainID.IsNil() || auth.ChainID.IsNegative() || !types.IsValidInt256(auth.ChainID.BigInt()) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidChainID, "invalid authorization chain ID %s", auth.ChainID)
+	}
+
+	if err := types.ValidateAddress(auth.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid authorization address")
+	}
+
+	// the nonce of the authority is incremented when the authorization is applied
+	if auth.Nonce == math.MaxUint64 {
+		return errorsmod.Wrapf(ErrInvalidSetCodeTx, "authorization nonce overflow %d", auth.Nonce)
+	}
+
+	return nil
+}
+
+// GetAddress returns the address of the delegated code.
+func (auth SetCodeAuthorization) GetAddress() common.Address {
+	return common.HexToAddress(auth.Address)
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the authorization.
+// The return values should not be modified by the caller.
+func (auth SetCodeAuthorization) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(auth.V, auth.R, auth.S)
+}
+
+// SetSignatureValues sets the signature values to the authorization.
+func (auth *SetCodeAuthorization) SetSignatureValues(v, r, s *big.Int) {
+	if v != nil {
+		auth.V = v.Bytes()
+	}
+	if r != nil {
+		auth.R = r.Bytes()
+	}
+	if s != nil {
+		auth.S = s.Bytes()
+	}
+}
+
+// rlpFields returns the RLP encoding of the authorization fields.
+func (auth SetCodeAuthorization) rlpFields() setCodeAuthorizationRLP {
+	v, r, s := auth.GetRawSignatureValues()
+	enc := setCodeAuthorizationRLP{
+		ChainID: bigOrZero(auth.ChainID.BigInt()),
+		Address: auth.GetAddress(),
+		Nonce:   auth.Nonce,
+		R:       bigOrZero(r),
+		S:       bigOrZero(s),
+	}
+	if v != nil && v.IsUint64() && v.Uint64() <= math.MaxUint8 {
+		enc.V = uint8(v.Uint64())
+	}
+	return enc
+}
+
+// SigHash returns the hash signed by the authority.
+func (auth SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRLPHash(setCodeAuthorizationMagic, []interface{}{
+		bigOrZero(auth.ChainID.BigInt()),
+		auth.GetAddress(),
+		auth.Nonce,
+	})
+}
+
+// Authority returns the address that signed the authorization.
+func (auth SetCodeAuthorization) Authority() (common.Address, error) {
+	v, r, s := auth.GetRawSignatureValues()
+	return recoverSigner(auth.SigHash(), v, r, s)
+}
+
+// prefixedRLPHash returns the hash of the type prefixed RLP encoding of the value.
+func prefixedRLPHash(prefix byte, value interface{}) common.Hash {
+	enc, err := rlp.EncodeToBytes(value)
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash([]byte{prefix}, enc)
+}
+
+// recoverSigner returns the address that signed the hash with the given y
+// parity and signature values.
+func recoverSigner(hash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	v = bigOrZero(v)
+	if r == nil || s == nil || !v.IsUint64() || v.Uint64() > 1 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	yParity := byte(v.Uint64())
+	if !crypto.ValidateSignatureValues(yParity, r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[crypto.RecoveryIDOffset] = yParity
+
+	pub, err := crypto.Ecrecover(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errors.New("invalid public key")
+	}
+
+	return common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]), nil
+}
+
+// decodeSignature returns the y parity and signature values of a 65 bytes
+// [R || S || V] signature.
+func decodeSignature(sig []byte) (v, r, s *big.Int, err error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, nil, nil, fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+	r = new(big.Int).SetBytes(sig[:32])
+	s = new(big.Int).SetBytes(sig[32:64])
+	v = new(big.Int).SetBytes([]byte{sig[crypto.RecoveryIDOffset]})
+	return v, r, s, nil
+}
+
+func bigOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return value
+}
@@ -0,0 +1,207 @@
+package types_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func (suite *TxDataTestSuite) TestSetCodeTxValidate() {
+	validAuth := types.SetCodeAuthorization{
+		ChainID: suite.sdkInt,
+		Address: suite.hexAddr,
+		Nonce:   1,
+	}
+
+	testCases := []struct {
+		name     string
+		tx       types.SetCodeTx
+		expError bool
+	}{
+		{
+			"valid",
+			types.SetCodeTx{
+				ChainID:        &suite.sdkInt,
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				To:             suite.hexAddr,
+				Authorizations: []types.SetCodeAuthorization{validAuth},
+			},
+			false,
+		},
+		{
+			"invalid - empty to",
+			types.SetCodeTx{
+				ChainID:        &suite.sdkInt,
+				GasTipCap:      &suite.sdkInt,
+				GasFeeCap:      &suite.sdkInt,
+				Amount:         &suite.sdkInt,
+				Authorizations: []types.SetCodeAuthorization{validAuth},
+			},
+			true,
+		},
+		{
+			"invalid - empty authorization list",
+			types.SetCodeTx{
+				ChainID:   &suite.sdkInt,
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+			},
+			true,
+		},
+		{
+			"invalid - negative authorization chain ID",
+			types.SetCodeTx{
+				ChainID:   &suite.sdkInt,
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				Authorizations: []types.SetCodeAuthorization{
+					{ChainID: suite.sdkMinusOneInt, Address: suite.hexAddr},
+				},
+			},
+			true,
+		},
+		{
+			"invalid - authorization address",
+			types.SetCodeTx{
+				ChainID:   &suite.sdkInt,
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				Authorizations: []types.SetCodeAuthorization{
+					{ChainID: suite.sdkInt, Address: suite.invalidAddr},
+				},
+			},
+			true,
+		},
+		{
+			"invalid - authorization nonce overflow",
+			types.SetCodeTx{
+				ChainID:   &suite.sdkInt,
+				GasTipCap: &suite.sdkInt,
+				GasFeeCap: &suite.sdkInt,
+				Amount:    &suite.sdkInt,
+				To:        suite.hexAddr,
+				Authorizations: []types.SetCodeAuthorization{
+					{ChainID: suite.sdkInt, Address: suite.hexAddr, Nonce: ^uint64(0)},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.tx.Validate()
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+	}
+}
+
+func (suite *TxDataTestSuite) TestParseDelegation() {
+	code := types.AddressToDelegation(suite.addr)
+	suite.Require().Len(code, 23)
+
+	addr, ok := types.ParseDelegation(code)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.addr, addr)
+
+	_, ok = types.ParseDelegation(code[:22])
+	suite.Require().False(ok)
+
+	_, ok = types.ParseDelegation(append([]byte{0xef, 0x01, 0x01}, suite.addr.Bytes()...))
+	suite.Require().False(ok)
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTx_SetCodeTx() {
+	authority, authorityKey := utiltx.NewAddrKey()
+	authorityECDSA, err := authorityKey.ToECDSA()
+	suite.Require().NoError(err)
+	chainID := big.NewInt(9000)
+
+	auth := types.SetCodeAuthorization{
+		ChainID: sdkmath.NewIntFromBigInt(chainID),
+		Address: suite.to.Hex(),
+		Nonce:   3,
+	}
+	sigHash := auth.SigHash()
+	sig, err := crypto.Sign(sigHash.Bytes(), authorityECDSA)
+	suite.Require().NoError(err)
+	auth.SetSignatureValues(new(big.Int).SetBytes(sig[64:]), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+
+	recovered, err := auth.Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(authority, recovered)
+
+	msg := types.NewTx(&types.EvmTxArgs{
+		ChainID:           chainID,
+		Nonce:             1,
+		To:                &suite.to,
+		GasLimit:          100000,
+		GasFeeCap:         suite.hundredBigInt,
+		GasTipCap:         big.NewInt(1),
+		Input:             []byte("test"),
+		Accesses:          &ethtypes.AccessList{},
+		AuthorizationList: []types.SetCodeAuthorization{auth},
+	})
+	msg.From = suite.from.Hex()
+	suite.Require().Equal(uint8(types.SetCodeTxType), msg.TxType())
+
+	err = msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
+	suite.Require().NoError(err)
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal(msg.TxHash().Hex(), msg.Hash)
+
+	sender, err := msg.GetSender(chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, sender)
+
+	_, err = msg.GetSender(big.NewInt(2))
+	suite.Require().Error(err)
+
+	txData, err := types.UnpackTxData(msg.Data)
+	suite.Require().NoError(err)
+	bz, err := txData.(*types.SetCodeTx).MarshalBinary()
+	suite.Require().NoError(err)
+	suite.Require().Equal(byte(types.SetCodeTxType), bz[0])
+
+	decoded := &types.MsgEthereumTx{}
+	suite.Require().NoError(decoded.UnmarshalBinary(bz))
+	suite.Require().Equal(msg.TxHash(), decoded.TxHash())
+	suite.Require().Equal(msg.Hash, decoded.Hash)
+
+	sender, err = decoded.GetSender(chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, sender)
+
+	txData, err = types.UnpackTxData(decoded.Data)
+	suite.Require().NoError(err)
+	setCodeTx, ok := txData.(*types.SetCodeTx)
+	suite.Require().True(ok)
+	suite.Require().Len(setCodeTx.Authorizations, 1)
+
+	recovered, err = setCodeTx.Authorizations[0].Authority()
+	suite.Require().NoError(err)
+	suite.Require().Equal(authority, recovered)
+
+	coreMsg := setCodeTx.AsMessage(sender, big.NewInt(10))
+	suite.Require().Equal(sender, coreMsg.From())
+	suite.Require().Equal(big.NewInt(11), coreMsg.GasPrice())
+	suite.Require().Equal(common.HexToAddress(setCodeTx.To), *coreMsg.To())
+	suite.Require().Len(coreMsg.AuthorizationList, 1)
+}
@@ -15,16 +15,17 @@ import (
 // EvmTxArgs encapsulates all possible params to create all EVM txs types.
 // This includes LegacyTx, DynamicFeeTx and AccessListTx
 type EvmTxArgs struct {
-	Nonce     uint64
-	GasLimit  uint64
-	Input     []byte
-	GasFeeCap *big.Int
-	GasPrice  *big.Int
-	ChainID   *big.Int
-	Amount    *big.Int
-	GasTipCap *big.Int
-	To        *common.Address
-	Accesses  *ethtypes.AccessList
+	Nonce             uint64
+	GasLimit          uint64
+	Input             []byte
+	GasFeeCap         *big.Int
+	GasPrice          *big.Int
+	ChainID           *big.Int
+	Amount            *big.Int
+	GasTipCap         *big.Int
+	To                *common.Address
+	Accesses          *ethtypes.AccessList
+	AuthorizationList []SetCodeAuthorization
 }
 
 // GetTxPriority returns the priority of a given Ethereum tx. It relies of the
@@ -258,6 +258,121 @@ func (m *DynamicFeeTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DynamicFeeTx proto.InternalMessageInfo
 
+// SetCodeTx is the data of EIP-7702 set code transactions.
+type SetCodeTx struct {
+	// chain_id of the destination EVM chain
+	ChainID *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=cosmossdk.io/math.Int" json:"chainID"`
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_tip_cap defines the max value for the gas tip
+	GasTipCap *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3,customtype=cosmossdk.io/math.Int" json:"gas_tip_cap,omitempty"`
+	// gas_fee_cap defines the max value for the gas fee
+	GasFeeCap *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3,customtype=cosmossdk.io/math.Int" json:"gas_fee_cap,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the hex formatted address of the recipient
+	To string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the the transaction amount.
+	Amount *cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// accesses is an array of access tuples
+	Accesses AccessList `protobuf:"bytes,9,rep,name=accesses,proto3,castrepeated=AccessList" json:"accessList"`
+	// authorizations is the list of the code delegations signed by the
+	// authorities
+	Authorizations []SetCodeAuthorization `protobuf:"bytes,10,rep,name=authorizations,proto3" json:"authorizationList"`
+	// v defines the signature value
+	V []byte `protobuf:"bytes,11,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,12,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,13,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeTx) Reset()         { *m = SetCodeTx{} }
+func (m *SetCodeTx) String() string { return proto.CompactTextString(m) }
+func (*SetCodeTx) ProtoMessage()    {}
+func (*SetCodeTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{4}
+}
+func (m *SetCodeTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeTx.Merge(m, src)
+}
+func (m *SetCodeTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeTx proto.InternalMessageInfo
+
+// SetCodeAuthorization is the authorization of an EIP-7702 set code transaction
+// to delegate the code of the signing account to the given address.
+type SetCodeAuthorization struct {
+	// chain_id of the EVM chain the authorization is valid on, or zero if it is
+	// valid on any chain
+	ChainID cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=cosmossdk.io/math.Int" json:"chainID"`
+	// address is the hex formatted address of the delegated code
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce is the nonce of the authority when the authorization is applied
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// v defines the signature value
+	V []byte `protobuf:"bytes,4,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the signature value
+	R []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value
+	S []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *SetCodeAuthorization) Reset()         { *m = SetCodeAuthorization{} }
+func (m *SetCodeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SetCodeAuthorization) ProtoMessage()    {}
+func (*SetCodeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{5}
+}
+func (m *SetCodeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCodeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCodeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCodeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCodeAuthorization.Merge(m, src)
+}
+func (m *SetCodeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCodeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCodeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCodeAuthorization proto.InternalMessageInfo
+
 // ExtensionOptionsEthereumTx is an extension option for ethereum transactions
 type ExtensionOptionsEthereumTx struct {
 }
@@ -266,7 +381,7 @@ func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthe
 func (m *ExtensionOptionsEthereumTx) String() string { return proto.CompactTextString(m) }
 func (*ExtensionOptionsEthereumTx) ProtoMessage()    {}
 func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{4}
+	return fileDescriptor_f75ac0a12d075f21, []int{6}
 }
 func (m *ExtensionOptionsEthereumTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +432,7 @@ func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
 func (m *MsgEthereumTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumTxResponse) ProtoMessage()    {}
 func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{5}
+	return fileDescriptor_f75ac0a12d075f21, []int{7}
 }
 func (m *MsgEthereumTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +474,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{6}
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +526,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{7}
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,6 +560,8 @@ func init() {
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
 	proto.RegisterType((*AccessListTx)(nil), "ethermint.evm.v1.AccessListTx")
 	proto.RegisterType((*DynamicFeeTx)(nil), "ethermint.evm.v1.DynamicFeeTx")
+	proto.RegisterType((*SetCodeTx)(nil), "ethermint.evm.v1.SetCodeTx")
+	proto.RegisterType((*SetCodeAuthorization)(nil), "ethermint.evm.v1.SetCodeAuthorization")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTx")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
@@ -454,68 +571,74 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x13, 0xe7, 0xd7, 0x24, 0x94, 0x95, 0xd5, 0x6a, 0x9d, 0x00, 0x71, 0x36, 0x48, 0x90,
-	0x45, 0xaa, 0xad, 0x16, 0xa9, 0xd2, 0xf6, 0x44, 0xb3, 0xed, 0xa2, 0x45, 0xad, 0x58, 0x99, 0xec,
-	0x05, 0x90, 0xa2, 0xa9, 0x3d, 0x9d, 0x8c, 0x88, 0x3d, 0x96, 0x67, 0x62, 0x25, 0x1c, 0xf7, 0xc4,
-	0x0d, 0x10, 0xff, 0x00, 0x07, 0x4e, 0x9c, 0x38, 0xec, 0x99, 0xf3, 0x8a, 0xd3, 0x0a, 0x2e, 0x88,
-	0x83, 0x41, 0x29, 0x12, 0x52, 0x8f, 0x9c, 0x39, 0xa0, 0x99, 0x71, 0x9a, 0x66, 0x43, 0x5a, 0x58,
-	0x89, 0xbd, 0xcd, 0xf3, 0xfb, 0xde, 0x8f, 0xf9, 0xbe, 0xf1, 0x7b, 0xa0, 0x8e, 0xf8, 0x00, 0xc5,
-	0x01, 0x09, 0xb9, 0x83, 0x92, 0xc0, 0x49, 0xb6, 0x1d, 0x3e, 0xb6, 0xa3, 0x98, 0x72, 0x6a, 0xdc,
-	0xb8, 0x70, 0xd9, 0x28, 0x09, 0xec, 0x64, 0xbb, 0x71, 0xd3, 0xa3, 0x2c, 0xa0, 0xcc, 0x09, 0x18,
-	0x16, 0xc8, 0x80, 0x61, 0x05, 0x6d, 0xd4, 0x95, 0xa3, 0x2f, 0x2d, 0x47, 0x19, 0x99, 0xab, 0xb1,
-	0x54, 0x40, 0x24, 0x53, 0xbe, 0x0d, 0x4c, 0x31, 0x55, 0x31, 0xe2, 0x94, 0x7d, 0x7d, 0x15, 0x53,
-	0x8a, 0x87, 0xc8, 0x81, 0x11, 0x71, 0x60, 0x18, 0x52, 0x0e, 0x39, 0xa1, 0xe1, 0x2c, 0x5f, 0x3d,
-	0xf3, 0x4a, 0xeb, 0x64, 0x74, 0xea, 0xc0, 0x70, 0xa2, 0x5c, 0xed, 0xcf, 0x35, 0xf0, 0xd2, 0x31,
-	0xc3, 0x87, 0xa2, 0x20, 0x1a, 0x05, 0xbd, 0xb1, 0xd1, 0x01, 0xba, 0x0f, 0x39, 0x34, 0xb5, 0x96,
-	0xd6, 0xa9, 0xee, 0x6c, 0xd8, 0x2a, 0xd6, 0x9e, 0xc5, 0xda, 0xfb, 0xe1, 0xc4, 0x95, 0x08, 0xa3,
-	0x0e, 0x74, 0x46, 0x3e, 0x45, 0x66, 0xae, 0xa5, 0x75, 0xb4, 0x6e, 0xe1, 0x3c, 0xb5, 0xb4, 0x2d,
-	0x57, 0x7e, 0x32, 0x2c, 0xa0, 0x0f, 0x20, 0x1b, 0x98, 0xf9, 0x96, 0xd6, 0xa9, 0x74, 0xab, 0x7f,
-	0xa6, 0x56, 0x29, 0x1e, 0x46, 0x7b, 0xed, 0xad, 0xb6, 0x2b, 0x1d, 0x86, 0x01, 0xf4, 0xd3, 0x98,
-	0x06, 0xa6, 0x2e, 0x00, 0xae, 0x3c, 0xef, 0xe9, 0x9f, 0x7d, 0x6d, 0xad, 0xb5, 0xbf, 0xcc, 0x81,
-	0xf2, 0x11, 0xc2, 0xd0, 0x9b, 0xf4, 0xc6, 0xc6, 0x06, 0x28, 0x84, 0x34, 0xf4, 0x90, 0xec, 0x46,
-	0x77, 0x95, 0x61, 0xec, 0x82, 0x0a, 0x86, 0x82, 0x39, 0xe2, 0xa9, 0xea, 0x95, 0x6e, 0xfd, 0x97,
-	0xd4, 0xda, 0x54, 0x24, 0x32, 0xff, 0x13, 0x9b, 0x50, 0x27, 0x80, 0x7c, 0x60, 0xdf, 0x0f, 0xb9,
-	0x5b, 0xc6, 0x90, 0x3d, 0x10, 0x50, 0xa3, 0x09, 0xf2, 0x18, 0x32, 0xd9, 0x94, 0xde, 0xad, 0x4d,
-	0x53, 0xab, 0xfc, 0x2e, 0x64, 0x47, 0x24, 0x20, 0xdc, 0x15, 0x0e, 0x63, 0x1d, 0xe4, 0x38, 0xcd,
-	0x5a, 0xca, 0x71, 0x6a, 0xdc, 0x01, 0x85, 0x04, 0x0e, 0x47, 0xc8, 0x2c, 0xc8, 0x1a, 0xaf, 0xaf,
-	0xac, 0x31, 0x4d, 0xad, 0xe2, 0x7e, 0x40, 0x47, 0x21, 0x77, 0x55, 0x84, 0xb8, 0x9f, 0x64, 0xb1,
-	0xd8, 0xd2, 0x3a, 0xb5, 0x8c, 0xaf, 0x1a, 0xd0, 0x12, 0xb3, 0x24, 0x3f, 0x68, 0x89, 0xb0, 0x62,
-	0xb3, 0xac, 0xac, 0x58, 0x58, 0xcc, 0xac, 0x28, 0x8b, 0xed, 0xad, 0x0b, 0x26, 0x7e, 0x78, 0xbc,
-	0x55, 0xec, 0x8d, 0x0f, 0x20, 0x87, 0xed, 0xef, 0xf3, 0xa0, 0xb6, 0xef, 0x79, 0x88, 0xb1, 0x23,
-	0xc2, 0x78, 0x6f, 0x6c, 0xbc, 0x07, 0xca, 0xde, 0x00, 0x92, 0xb0, 0x4f, 0x7c, 0x49, 0x4d, 0xa5,
-	0xeb, 0x5c, 0xd5, 0x5c, 0xe9, 0xae, 0x00, 0xdf, 0x3f, 0x38, 0x4f, 0xad, 0x92, 0xa7, 0x8e, 0x6e,
-	0x76, 0xf0, 0xe7, 0x1c, 0xe7, 0x56, 0x72, 0x9c, 0xff, 0xcf, 0x1c, 0xeb, 0x57, 0x73, 0x5c, 0x58,
-	0xe6, 0xb8, 0xf8, 0xdc, 0x1c, 0x97, 0x2e, 0x71, 0xfc, 0x11, 0x28, 0x43, 0x49, 0x14, 0x62, 0x66,
-	0xb9, 0x95, 0xef, 0x54, 0x77, 0x5e, 0xb3, 0x9f, 0xfd, 0x27, 0x6d, 0x45, 0x65, 0x6f, 0x14, 0x0d,
-	0x51, 0xb7, 0xf5, 0x24, 0xb5, 0xd6, 0xce, 0x53, 0x0b, 0xc0, 0x0b, 0x7e, 0xbf, 0xfd, 0xd5, 0x02,
-	0x73, 0xb6, 0xdd, 0x8b, 0x84, 0x4a, 0xc0, 0xca, 0x82, 0x80, 0x60, 0x41, 0xc0, 0xea, 0x2a, 0x01,
-	0xff, 0xca, 0x83, 0xda, 0xc1, 0x24, 0x84, 0x01, 0xf1, 0xee, 0x21, 0xf4, 0x42, 0x04, 0xbc, 0x03,
-	0xaa, 0x42, 0x40, 0x4e, 0xa2, 0xbe, 0x07, 0xa3, 0xeb, 0x25, 0x14, 0x72, 0xf7, 0x48, 0x74, 0x17,
-	0x46, 0xb3, 0xd0, 0x53, 0x84, 0x64, 0xa8, 0xfe, 0x6f, 0x42, 0xef, 0x21, 0x24, 0x42, 0x33, 0xf9,
-	0x0b, 0x57, 0xcb, 0x5f, 0x5c, 0x96, 0xbf, 0xf4, 0xdc, 0xf2, 0x97, 0x57, 0xc8, 0x5f, 0xf9, 0x5f,
-	0xe4, 0x07, 0x0b, 0xf2, 0x57, 0x17, 0xe4, 0xaf, 0xad, 0x92, 0xbf, 0x0d, 0x1a, 0x87, 0x63, 0x8e,
-	0x42, 0x46, 0x68, 0xf8, 0x7e, 0x24, 0x47, 0xf3, 0x7c, 0xe2, 0x66, 0x73, 0xef, 0x1b, 0x0d, 0x6c,
-	0x2e, 0x4c, 0x62, 0x17, 0xb1, 0x88, 0x86, 0x4c, 0x5e, 0x54, 0x0e, 0x53, 0x4d, 0xcd, 0x4a, 0x39,
-	0x3f, 0x6f, 0x03, 0x7d, 0x48, 0x31, 0x33, 0x73, 0xf2, 0x92, 0x9b, 0xcb, 0x97, 0x3c, 0xa2, 0xd8,
-	0x95, 0x10, 0xe3, 0x06, 0xc8, 0xc7, 0x88, 0xcb, 0x07, 0x50, 0x73, 0xc5, 0xd1, 0xa8, 0x83, 0x72,
-	0x12, 0xf4, 0x51, 0x1c, 0xd3, 0x38, 0x9b, 0x76, 0xa5, 0x24, 0x38, 0x14, 0xa6, 0x70, 0x09, 0xe9,
-	0x47, 0x0c, 0xf9, 0x4a, 0x44, 0xb7, 0x84, 0x21, 0x7b, 0xc8, 0x90, 0x3f, 0x1b, 0xcf, 0x1a, 0x78,
-	0xf9, 0x98, 0xe1, 0x87, 0x91, 0x0f, 0x39, 0x7a, 0x00, 0x63, 0x18, 0x30, 0x31, 0x2b, 0xe0, 0x88,
-	0x0f, 0x68, 0x4c, 0xf8, 0x24, 0x7b, 0xcd, 0xe6, 0x8f, 0x8f, 0xb7, 0x36, 0xb2, 0xa5, 0xb6, 0xef,
-	0xfb, 0x31, 0x62, 0xec, 0x03, 0x1e, 0x93, 0x10, 0xbb, 0x73, 0xa8, 0xb1, 0x0b, 0x8a, 0x91, 0xcc,
-	0x20, 0x5f, 0x6e, 0x75, 0xc7, 0x5c, 0xbe, 0x86, 0xaa, 0xd0, 0xd5, 0x85, 0x4c, 0x6e, 0x86, 0xde,
-	0x5b, 0x7f, 0xf4, 0xc7, 0x77, 0x6f, 0xcd, 0xf3, 0xb4, 0xeb, 0xe0, 0xe6, 0x33, 0x2d, 0xcd, 0xb8,
-	0xdb, 0x49, 0x35, 0x90, 0x3f, 0x66, 0xd8, 0x98, 0x00, 0x70, 0x69, 0xc7, 0x59, 0xcb, 0x85, 0x16,
-	0xa8, 0x6f, 0xbc, 0x79, 0x0d, 0x60, 0x96, 0xbf, 0x7d, 0xeb, 0xd1, 0x4f, 0xbf, 0x7f, 0x95, 0x7b,
-	0xa5, 0x5d, 0x17, 0x2b, 0x9a, 0xb2, 0x8b, 0x7d, 0x9d, 0x21, 0xfb, 0x7c, 0x6c, 0x7c, 0x0c, 0x6a,
-	0x0b, 0x6c, 0xdd, 0xfa, 0xc7, 0xdc, 0x97, 0x21, 0x8d, 0xdb, 0xd7, 0x42, 0x66, 0x0d, 0x74, 0xdf,
-	0x79, 0x32, 0x6d, 0x6a, 0x4f, 0xa7, 0x4d, 0xed, 0xb7, 0x69, 0x53, 0xfb, 0xe2, 0xac, 0xb9, 0xf6,
-	0xf4, 0xac, 0xb9, 0xf6, 0xf3, 0x59, 0x73, 0xed, 0xc3, 0x37, 0x30, 0xe1, 0x83, 0xd1, 0x89, 0xed,
-	0xd1, 0x60, 0xde, 0x1c, 0x65, 0x4e, 0xb2, 0xbd, 0xeb, 0x8c, 0x65, 0xa3, 0x7c, 0x12, 0x21, 0x76,
-	0x52, 0x94, 0xab, 0xfd, 0xed, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x90, 0x12, 0xd5, 0xd7,
-	0x08, 0x00, 0x00,
+	// 1067 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0xe3, 0xc4,
+	0x17, 0x8f, 0x13, 0xe7, 0xd7, 0x24, 0xdb, 0xef, 0x7e, 0xad, 0x54, 0xeb, 0x04, 0x88, 0xb3, 0x41,
+	0x5a, 0xb2, 0x48, 0xb5, 0xd5, 0xae, 0x54, 0x69, 0x7b, 0x22, 0x69, 0xbb, 0x68, 0x51, 0x0b, 0x2b,
+	0x6f, 0xf6, 0x02, 0x48, 0xd1, 0xd4, 0x9e, 0x3a, 0x16, 0xb1, 0xc7, 0xf2, 0x4c, 0xac, 0x64, 0x8f,
+	0x7b, 0xe2, 0x06, 0x88, 0x7f, 0x80, 0x03, 0x27, 0x4e, 0x1c, 0xf6, 0xcc, 0x11, 0xad, 0x38, 0xad,
+	0xe0, 0x82, 0x38, 0x04, 0x94, 0xae, 0x84, 0xd4, 0x23, 0x67, 0x0e, 0x68, 0x66, 0x9c, 0x26, 0x6e,
+	0x36, 0x2d, 0x54, 0x02, 0x09, 0x89, 0xdb, 0x3c, 0xbf, 0xcf, 0xfb, 0x31, 0x9f, 0xcf, 0xb3, 0xe6,
+	0x81, 0x2a, 0xa2, 0x7d, 0x14, 0x7a, 0xae, 0x4f, 0x0d, 0x14, 0x79, 0x46, 0xb4, 0x69, 0xd0, 0x91,
+	0x1e, 0x84, 0x98, 0x62, 0xe5, 0xfa, 0x99, 0x4b, 0x47, 0x91, 0xa7, 0x47, 0x9b, 0xb5, 0x1b, 0x16,
+	0x26, 0x1e, 0x26, 0x86, 0x47, 0x1c, 0x86, 0xf4, 0x88, 0x23, 0xa0, 0xb5, 0xaa, 0x70, 0xf4, 0xb8,
+	0x65, 0x08, 0x23, 0x76, 0xd5, 0x96, 0x0a, 0xb0, 0x64, 0xc2, 0x57, 0x71, 0xb0, 0x83, 0x45, 0x0c,
+	0x3b, 0xc5, 0x5f, 0x5f, 0x75, 0x30, 0x76, 0x06, 0xc8, 0x80, 0x81, 0x6b, 0x40, 0xdf, 0xc7, 0x14,
+	0x52, 0x17, 0xfb, 0xb3, 0x7c, 0xd5, 0xd8, 0xcb, 0xad, 0xa3, 0xe1, 0xb1, 0x01, 0xfd, 0xb1, 0x70,
+	0x35, 0x3f, 0x91, 0xc0, 0xb5, 0x43, 0xe2, 0xec, 0xb3, 0x82, 0x68, 0xe8, 0x75, 0x47, 0x4a, 0x0b,
+	0xc8, 0x36, 0xa4, 0x50, 0x95, 0x1a, 0x52, 0xab, 0xb4, 0x55, 0xd1, 0x45, 0xac, 0x3e, 0x8b, 0xd5,
+	0xdb, 0xfe, 0xd8, 0xe4, 0x08, 0xa5, 0x0a, 0x64, 0xe2, 0x3e, 0x46, 0x6a, 0xba, 0x21, 0xb5, 0xa4,
+	0x4e, 0xf6, 0x74, 0xa2, 0x49, 0x1b, 0x26, 0xff, 0xa4, 0x68, 0x40, 0xee, 0x43, 0xd2, 0x57, 0x33,
+	0x0d, 0xa9, 0x55, 0xec, 0x94, 0x7e, 0x9b, 0x68, 0xf9, 0x70, 0x10, 0xec, 0x34, 0x37, 0x9a, 0x26,
+	0x77, 0x28, 0x0a, 0x90, 0x8f, 0x43, 0xec, 0xa9, 0x32, 0x03, 0x98, 0xfc, 0xbc, 0x23, 0x7f, 0xfc,
+	0x85, 0x96, 0x6a, 0x7e, 0x96, 0x06, 0x85, 0x03, 0xe4, 0x40, 0x6b, 0xdc, 0x1d, 0x29, 0x15, 0x90,
+	0xf5, 0xb1, 0x6f, 0x21, 0xde, 0x8d, 0x6c, 0x0a, 0x43, 0xd9, 0x06, 0x45, 0x07, 0x32, 0xe6, 0x5c,
+	0x4b, 0x54, 0x2f, 0x76, 0xaa, 0x3f, 0x4d, 0xb4, 0x75, 0x41, 0x22, 0xb1, 0x3f, 0xd2, 0x5d, 0x6c,
+	0x78, 0x90, 0xf6, 0xf5, 0xfb, 0x3e, 0x35, 0x0b, 0x0e, 0x24, 0x0f, 0x18, 0x54, 0xa9, 0x83, 0x8c,
+	0x03, 0x09, 0x6f, 0x4a, 0xee, 0x94, 0xa7, 0x13, 0xad, 0xf0, 0x36, 0x24, 0x07, 0xae, 0xe7, 0x52,
+	0x93, 0x39, 0x94, 0x35, 0x90, 0xa6, 0x38, 0x6e, 0x29, 0x4d, 0xb1, 0x72, 0x17, 0x64, 0x23, 0x38,
+	0x18, 0x22, 0x35, 0xcb, 0x6b, 0xbc, 0xbe, 0xb2, 0xc6, 0x74, 0xa2, 0xe5, 0xda, 0x1e, 0x1e, 0xfa,
+	0xd4, 0x14, 0x11, 0xec, 0x7e, 0x9c, 0xc5, 0x5c, 0x43, 0x6a, 0x95, 0x63, 0xbe, 0xca, 0x40, 0x8a,
+	0xd4, 0x3c, 0xff, 0x20, 0x45, 0xcc, 0x0a, 0xd5, 0x82, 0xb0, 0x42, 0x66, 0x11, 0xb5, 0x28, 0x2c,
+	0xb2, 0xb3, 0xc6, 0x98, 0xf8, 0xee, 0xe9, 0x46, 0xae, 0x3b, 0xda, 0x83, 0x14, 0x36, 0xbf, 0xc9,
+	0x80, 0x72, 0xdb, 0xb2, 0x10, 0x21, 0x07, 0x2e, 0xa1, 0xdd, 0x91, 0xf2, 0x0e, 0x28, 0x58, 0x7d,
+	0xe8, 0xfa, 0x3d, 0xd7, 0xe6, 0xd4, 0x14, 0x3b, 0xc6, 0x45, 0xcd, 0xe5, 0x77, 0x19, 0xf8, 0xfe,
+	0xde, 0xe9, 0x44, 0xcb, 0x5b, 0xe2, 0x68, 0xc6, 0x07, 0x7b, 0xce, 0x71, 0x7a, 0x25, 0xc7, 0x99,
+	0xbf, 0xcc, 0xb1, 0x7c, 0x31, 0xc7, 0xd9, 0x65, 0x8e, 0x73, 0x57, 0xe6, 0x38, 0xbf, 0xc0, 0xf1,
+	0x07, 0xa0, 0x00, 0x39, 0x51, 0x88, 0xa8, 0x85, 0x46, 0xa6, 0x55, 0xda, 0x7a, 0x4d, 0x3f, 0xff,
+	0x4f, 0xea, 0x82, 0xca, 0xee, 0x30, 0x18, 0xa0, 0x4e, 0xe3, 0xd9, 0x44, 0x4b, 0x9d, 0x4e, 0x34,
+	0x00, 0xcf, 0xf8, 0xfd, 0xea, 0x67, 0x0d, 0xcc, 0xd9, 0x36, 0xcf, 0x12, 0x0a, 0x01, 0x8b, 0x09,
+	0x01, 0x41, 0x42, 0xc0, 0xd2, 0x2a, 0x01, 0x7f, 0xcf, 0x80, 0xf2, 0xde, 0xd8, 0x87, 0x9e, 0x6b,
+	0xdd, 0x43, 0xe8, 0x1f, 0x11, 0xf0, 0x2e, 0x28, 0x31, 0x01, 0xa9, 0x1b, 0xf4, 0x2c, 0x18, 0x5c,
+	0x2e, 0x21, 0x93, 0xbb, 0xeb, 0x06, 0xbb, 0x30, 0x98, 0x85, 0x1e, 0x23, 0xc4, 0x43, 0xe5, 0x3f,
+	0x13, 0x7a, 0x0f, 0x21, 0x16, 0x1a, 0xcb, 0x9f, 0xbd, 0x58, 0xfe, 0xdc, 0xb2, 0xfc, 0xf9, 0x2b,
+	0xcb, 0x5f, 0x58, 0x21, 0x7f, 0xf1, 0x6f, 0x91, 0x1f, 0x24, 0xe4, 0x2f, 0x25, 0xe4, 0x2f, 0xaf,
+	0x92, 0xff, 0x85, 0x0c, 0x8a, 0x0f, 0x11, 0xdd, 0xc5, 0xf6, 0x7f, 0xda, 0xff, 0x3b, 0xb5, 0x77,
+	0xc1, 0x1a, 0x1c, 0xd2, 0x3e, 0x0e, 0xdd, 0xc7, 0xe2, 0x69, 0x55, 0x01, 0x2f, 0x71, 0x6b, 0xb9,
+	0x44, 0x2c, 0x74, 0x7b, 0x11, 0xde, 0xa9, 0xc6, 0xb5, 0xfe, 0x9f, 0xc8, 0xc2, 0x8b, 0x9c, 0x4b,
+	0x2c, 0xc6, 0xac, 0x94, 0x18, 0xb3, 0x72, 0x62, 0xcc, 0xae, 0xad, 0x1a, 0xb3, 0x6f, 0x25, 0x50,
+	0x79, 0x59, 0x75, 0xe5, 0xdd, 0xa5, 0x89, 0xbb, 0xc3, 0xfa, 0xb9, 0xf2, 0xd4, 0xa9, 0x20, 0x0f,
+	0x6d, 0x3b, 0x44, 0x84, 0x88, 0xe7, 0xd7, 0x9c, 0x99, 0xf3, 0x79, 0xcc, 0x2c, 0xce, 0x23, 0xbf,
+	0x92, 0x9c, 0xb8, 0x52, 0x36, 0x71, 0xa5, 0xdc, 0xec, 0x4a, 0x62, 0x07, 0x68, 0x82, 0xda, 0xfe,
+	0x88, 0x22, 0x9f, 0xb8, 0xd8, 0x7f, 0x2f, 0xe0, 0xb4, 0xcc, 0x37, 0x94, 0x18, 0xf3, 0xa5, 0x04,
+	0xd6, 0x13, 0x9b, 0x8b, 0x89, 0x48, 0x80, 0x7d, 0xc2, 0x87, 0x83, 0x2f, 0x1f, 0x92, 0xd8, 0x2d,
+	0xd8, 0x59, 0xb9, 0x0d, 0xe4, 0x01, 0x76, 0x58, 0xbb, 0x4c, 0xb5, 0xf5, 0x65, 0xd5, 0x0e, 0xb0,
+	0x63, 0x72, 0x88, 0x72, 0x1d, 0x64, 0x42, 0x44, 0xf9, 0x05, 0xca, 0x26, 0x3b, 0x2a, 0x55, 0x50,
+	0x88, 0xbc, 0x1e, 0x0a, 0x43, 0x1c, 0xc6, 0xdb, 0x41, 0x3e, 0xf2, 0xf6, 0x99, 0xc9, 0x5c, 0xec,
+	0x77, 0x19, 0x12, 0x64, 0x8b, 0xc1, 0x37, 0xf3, 0x0e, 0x24, 0x8f, 0x08, 0xb2, 0x67, 0xeb, 0x8c,
+	0x04, 0xfe, 0x77, 0x48, 0x9c, 0x47, 0x81, 0x0d, 0x29, 0x7a, 0x00, 0x43, 0xe8, 0x11, 0xf6, 0xb6,
+	0xc6, 0x9a, 0xd3, 0x71, 0xac, 0x87, 0xfa, 0xfd, 0xd3, 0x8d, 0x4a, 0xbc, 0x04, 0xb6, 0x05, 0x97,
+	0x0f, 0x69, 0xe8, 0xfa, 0x8e, 0x39, 0x87, 0x2a, 0xdb, 0x20, 0x17, 0xf0, 0x0c, 0x9c, 0xf5, 0xd2,
+	0x96, 0xba, 0x7c, 0x0d, 0x51, 0xa1, 0x23, 0x33, 0x79, 0xcd, 0x18, 0xbd, 0xb3, 0xf6, 0xe4, 0xd7,
+	0xaf, 0xdf, 0x9c, 0xe7, 0x69, 0x56, 0xc1, 0x8d, 0x73, 0x2d, 0xcd, 0xb8, 0xdb, 0x9a, 0x48, 0x20,
+	0x73, 0x48, 0x1c, 0x65, 0x0c, 0xc0, 0xc2, 0x4e, 0xa8, 0x2d, 0x17, 0x4a, 0x50, 0x5f, 0x7b, 0xe3,
+	0x12, 0xc0, 0x2c, 0x7f, 0xf3, 0xe6, 0x93, 0x1f, 0x5e, 0x7c, 0x9e, 0x7e, 0xa5, 0x59, 0x65, 0x2b,
+	0x2d, 0x26, 0x67, 0xfb, 0x6d, 0x8c, 0xec, 0xd1, 0x91, 0xf2, 0x21, 0x28, 0x27, 0xd8, 0xba, 0xf9,
+	0xd2, 0xdc, 0x8b, 0x90, 0xda, 0xed, 0x4b, 0x21, 0xb3, 0x06, 0x3a, 0x6f, 0x3d, 0x9b, 0xd6, 0xa5,
+	0xe7, 0xd3, 0xba, 0xf4, 0xcb, 0xb4, 0x2e, 0x7d, 0x7a, 0x52, 0x4f, 0x3d, 0x3f, 0xa9, 0xa7, 0x7e,
+	0x3c, 0xa9, 0xa7, 0xde, 0xbf, 0xe5, 0xb8, 0xb4, 0x3f, 0x3c, 0xd2, 0x2d, 0xec, 0xcd, 0x9b, 0xc3,
+	0xc4, 0x88, 0x36, 0xb7, 0x8d, 0x11, 0x6f, 0x94, 0x8e, 0x03, 0x44, 0x8e, 0x72, 0x7c, 0x15, 0xbe,
+	0xf3, 0xc7, 0x00, 0xc3, 0xc6, 0x64, 0x5d, 0x07, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1035,30 +1158,7 @@ func (m *DynamicFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetCodeTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1068,39 +1168,41 @@ func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetCodeTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetCodeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x6a
 	}
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x62
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x5a
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1108,20 +1210,99 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasFeeCap != nil {
+		{
+			size := m.GasFeeCap.Size()
+			i -= size
+			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasTipCap != nil {
+		{
+			size := m.GasTipCap.Size()
+			i -= size
+			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *SetCodeAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1131,27 +1312,179 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetCodeAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetCodeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ChainID.Size()
+		i -= size
+		if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
@@ -1372,6 +1705,99 @@ func (m *DynamicFeeTx) Size() (n int) {
 	return n
 }
 
+func (m *SetCodeTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasTipCap != nil {
+		l = m.GasTipCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasFeeCap != nil {
+		l = m.GasFeeCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCodeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainID.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2762,6 +3188,711 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetCodeTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCodeTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCodeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, SetCodeAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCodeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCodeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCodeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetFeeTokenGasPriceCmd(),
+		GetFeeHistoryCmd(),
 	)
 	return cmd
 }
@@ -116,3 +120,79 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeTokenGasPriceCmd queries the gas price in a fee token
+func GetFeeTokenGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token-gas-price DENOM",
+		Short: "Get the base fee and minimum gas price in a fee token",
+		Long:  "Get the base fee and global minimum gas price converted to the given fee token denomination.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeTokenGasPriceRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.FeeTokenGasPrice(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeHistoryCmd queries the fee history of the most recent blocks
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history BLOCK_COUNT [LAST_BLOCK]",
+		Short: "Get the fee history of the most recent blocks",
+		Long:  "Get the base fee, gas usage and priority fee percentiles of up to BLOCK_COUNT blocks ending at LAST_BLOCK, or at the latest block if omitted.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockCount, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeeHistoryRequest{
+				BlockCount: blockCount,
+			}
+
+			if len(args) == 2 {
+				req.LastBlock, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
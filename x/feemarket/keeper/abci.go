@@ -37,7 +37,8 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and block gas used, and records the fee
+// history entry of the block.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
@@ -67,6 +68,8 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
+	k.SetBlockGasUsed(ctx, gasUsed.Uint64())
+	k.recordFeeHistory(ctx, updatedGasWanted, gasUsed.Uint64())
 
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
@@ -5,14 +5,19 @@ package keeper
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// The parent block gas is compared against the target gas of the parameters, if set, and the
+// resulting base fee is bounded by the max change rate and the min and max base fee parameters.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
@@ -41,32 +46,33 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return nil
 	}
 
+	// the block gas wanted (see EndBlock) is used by default. It's inflated by
+	// the gas limits declared by the senders, so the actual gas used can be
+	// selected through the UseGasUsed parameter.
 	parentGasUsed := k.GetBlockGasWanted(ctx)
+	if params.UseGasUsed {
+		parentGasUsed = k.GetBlockGasUsed(ctx)
+	}
 
-	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
+	gasLimit := uint64(math.MaxUint64)
 
 	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
 	if consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = big.NewInt(consParams.Block.MaxGas)
-	}
-
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetBig.IsUint64() {
-		return nil
+		gasLimit = uint64(consParams.Block.MaxGas)
 	}
 
-	parentGasTarget := parentGasTargetBig.Uint64()
+	parentGasTarget := params.EffectiveTargetGas(gasLimit)
+	parentGasTargetBig := new(big.Int).SetUint64(parentGasTarget)
 	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator))
 
-	// If the parent gasUsed is the same as the target, the baseFee remains
-	// unchanged.
-	if parentGasUsed == parentGasTarget {
-		return new(big.Int).Set(parentBaseFee)
-	}
+	var baseFee *big.Int
 
-	if parentGasUsed > parentGasTarget {
+	switch {
+	case parentGasUsed == parentGasTarget:
+		// If the parent gasUsed is the same as the target, the baseFee remains
+		// unchanged.
+		baseFee = new(big.Int).Set(parentBaseFee)
+	case parentGasUsed > parentGasTarget:
 		// If the parent block used more gas than its target, the baseFee should
 		// increase.
 		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - parentGasTarget)
@@ -77,18 +83,45 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 			common.Big1,
 		)
 
-		return x.Add(parentBaseFee, baseFeeDelta)
+		baseFee = x.Add(parentBaseFee, baseFeeDelta)
+	default:
+		// Otherwise if the parent block used less gas than its target, the baseFee
+		// should decrease.
+		gasUsedDelta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
+		x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+		y := x.Div(x, parentGasTargetBig)
+		baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
+
+		// Set global min gas price as lower bound of the base fee, transactions below
+		// the min gas price don't even reach the mempool.
+		minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
+		baseFee = math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+	}
+
+	return boundBaseFee(params, parentBaseFee, baseFee)
+}
+
+// boundBaseFee limits the change of the base fee with respect to the parent base
+// fee to the max change rate, and then bounds the result between the min and
+// max base fee. The bounds take precedence over the change rate so that they
+// apply on the next block after being updated by governance.
+func boundBaseFee(params types.Params, parentBaseFee, baseFee *big.Int) *big.Int {
+	if params.MaxChangeRate.IsPositive() {
+		// the base fee can always change by at least 1 so that it doesn't get
+		// stuck on low values
+		maxDelta := math.BigMax(
+			sdkmath.LegacyNewDecFromBigInt(parentBaseFee).Mul(params.MaxChangeRate).TruncateInt().BigInt(),
+			common.Big1,
+		)
+
+		upperBound := new(big.Int).Add(parentBaseFee, maxDelta)
+		lowerBound := new(big.Int).Sub(parentBaseFee, maxDelta)
+		baseFee = math.BigMax(math.BigMin(baseFee, upperBound), lowerBound)
+	}
+
+	if params.MaxBaseFee.IsPositive() {
+		baseFee = math.BigMin(baseFee, params.MaxBaseFee.BigInt())
 	}
 
-	// Otherwise if the parent block used less gas than its target, the baseFee
-	// should decrease.
-	gasUsedDelta := new(big.Int).SetUint64(parentGasTarget - parentGasUsed)
-	x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
-	y := x.Div(x, parentGasTargetBig)
-	baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
-
-	// Set global min gas price as lower bound of the base fee, transactions below
-	// the min gas price don't even reach the mempool.
-	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
-	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+	return math.BigMax(baseFee, params.MinBaseFee.BigInt())
 }
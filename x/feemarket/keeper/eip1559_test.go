@@ -6,6 +6,8 @@ import (
 
 	"cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestCalculateBaseFee() {
@@ -115,3 +117,227 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeWithBounds() {
+	testCases := []struct {
+		name            string
+		malleate        func(params *types.Params)
+		parentGasUsed   uint64
+		parentGasWanted uint64
+		expFee          *big.Int
+	}{
+		{
+			"use gas used - parent block used more gas than its target",
+			func(params *types.Params) {
+				params.UseGasUsed = true
+			},
+			100,
+			25,
+			big.NewInt(1125000000),
+		},
+		{
+			"use gas wanted - parent block wanted less gas than its target",
+			func(_ *types.Params) {},
+			100,
+			25,
+			big.NewInt(937500000),
+		},
+		{
+			"explicit target gas - parent block wanted more gas than its target",
+			func(params *types.Params) {
+				params.TargetGas = 25
+			},
+			0,
+			50,
+			big.NewInt(1125000000),
+		},
+		{
+			"min base fee - base fee increased to the lower bound",
+			func(params *types.Params) {
+				params.MinBaseFee = math.NewInt(1200000000)
+			},
+			0,
+			50,
+			big.NewInt(1200000000),
+		},
+		{
+			"max base fee - base fee capped to the upper bound",
+			func(params *types.Params) {
+				params.MaxBaseFee = math.NewInt(1050000000)
+			},
+			0,
+			100,
+			big.NewInt(1050000000),
+		},
+		{
+			"max change rate - increase capped",
+			func(params *types.Params) {
+				params.MaxChangeRate = math.LegacyNewDecWithPrec(5, 2)
+			},
+			0,
+			100,
+			big.NewInt(1050000000),
+		},
+		{
+			"max change rate - decrease capped",
+			func(params *types.Params) {
+				params.MaxChangeRate = math.LegacyNewDecWithPrec(5, 2)
+			},
+			0,
+			0,
+			big.NewInt(950000000),
+		},
+		{
+			"max change rate - change below the rate",
+			func(params *types.Params) {
+				params.MaxChangeRate = math.LegacyNewDecWithPrec(5, 1)
+			},
+			0,
+			100,
+			big.NewInt(1125000000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.BaseFee = math.NewInt(1000000000)
+			tc.malleate(&params)
+			suite.Require().NoError(params.Validate())
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+			suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, tc.parentGasUsed)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentGasWanted)
+
+			consParams := tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}}
+			suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+			fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+			suite.Require().Equal(tc.expFee, fee)
+		})
+	}
+}
+
+// TestBaseFeeConvergence simulates the base fee over consecutive blocks, where the
+// gas used by each block is given by the demand at the current base fee.
+func (suite *KeeperTestSuite) TestBaseFeeConvergence() {
+	const (
+		maxGas    = int64(30_000_000)
+		targetGas = uint64(10_000_000)
+		blocks    = 300
+	)
+
+	// demandCurve returns a gas demand that is inversely proportional to the base
+	// fee, so that the demand equals the target gas at a base fee of 1 gwei.
+	demandCurve := func(baseFee *big.Int) uint64 {
+		demand := new(big.Int).Quo(new(big.Int).Mul(big.NewInt(1e9), new(big.Int).SetUint64(targetGas)), baseFee)
+		if demand.Cmp(big.NewInt(maxGas)) > 0 {
+			return uint64(maxGas)
+		}
+		return demand.Uint64()
+	}
+
+	// constantLoad returns full blocks regardless of the base fee.
+	constantLoad := func(_ *big.Int) uint64 {
+		return uint64(maxGas)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(params *types.Params)
+		blockGas  func(baseFee *big.Int) uint64
+		initial   math.Int
+		expFee    math.Int
+		tolerance math.LegacyDec
+	}{
+		{
+			"demand curve - converges up to the equilibrium",
+			func(_ *types.Params) {},
+			demandCurve,
+			math.NewInt(1e8),
+			math.NewInt(1e9),
+			math.LegacyNewDecWithPrec(1, 2),
+		},
+		{
+			"demand curve - converges down to the equilibrium",
+			func(_ *types.Params) {},
+			demandCurve,
+			math.NewInt(1e11),
+			math.NewInt(1e9),
+			math.LegacyNewDecWithPrec(1, 2),
+		},
+		{
+			"demand curve with max change rate - converges to the equilibrium",
+			func(params *types.Params) {
+				params.MaxChangeRate = math.LegacyNewDecWithPrec(2, 2)
+			},
+			demandCurve,
+			math.NewInt(1e8),
+			math.NewInt(1e9),
+			math.LegacyNewDecWithPrec(1, 2),
+		},
+		{
+			"demand curve with min base fee above the equilibrium - converges to the min base fee",
+			func(params *types.Params) {
+				params.MinBaseFee = math.NewInt(2e9)
+			},
+			demandCurve,
+			math.NewInt(1e11),
+			math.NewInt(2e9),
+			math.LegacyZeroDec(),
+		},
+		{
+			"constant load - converges to the max base fee",
+			func(params *types.Params) {
+				params.MaxBaseFee = math.NewInt(5e10)
+			},
+			constantLoad,
+			math.NewInt(1e9),
+			math.NewInt(5e10),
+			math.LegacyZeroDec(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.UseGasUsed = true
+			params.TargetGas = targetGas
+			params.BaseFee = tc.initial
+			tc.malleate(&params)
+			suite.Require().NoError(params.Validate())
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			consParams := tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: maxGas, MaxBytes: 10}}
+			suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+			baseFee := tc.initial.BigInt()
+			for height := int64(1); height <= blocks; height++ {
+				suite.ctx = suite.ctx.WithBlockHeight(height)
+				suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, tc.blockGas(baseFee))
+
+				newBaseFee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+				suite.Require().NotNil(newBaseFee)
+
+				// the change between blocks must respect the max change rate
+				if params.MaxChangeRate.IsPositive() {
+					delta := new(big.Int).Abs(new(big.Int).Sub(newBaseFee, baseFee))
+					maxDelta := math.LegacyNewDecFromBigInt(baseFee).Mul(params.MaxChangeRate).TruncateInt()
+					suite.Require().True(math.NewIntFromBigInt(delta).LTE(maxDelta), "height %d", height)
+				}
+
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, newBaseFee)
+				baseFee = newBaseFee
+			}
+
+			diff := math.LegacyNewDecFromBigInt(baseFee).Sub(math.LegacyNewDecFromInt(tc.expFee)).Abs()
+			maxDiff := math.LegacyNewDecFromInt(tc.expFee).Mul(tc.tolerance)
+			suite.Require().True(diff.LTE(maxDiff), "expected base fee %s, got %s", tc.expFee, baseFee)
+		})
+	}
+}
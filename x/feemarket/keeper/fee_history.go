@@ -0,0 +1,127 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Fee History
+// Required by the eth_feeHistory and gas tip cap suggestions of the JSON-RPC.
+// ----------------------------------------------------------------------------
+
+// SetFeeHistoryEntry sets the fee history entry of a block to its ring buffer slot,
+// overwriting the entry of the block FeeHistorySize heights before.
+func (k Keeper) SetFeeHistoryEntry(ctx sdk.Context, entry types.FeeHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&entry)
+	store.Set(types.FeeHistoryKey(entry.Height), bz)
+}
+
+// GetFeeHistoryEntry returns the fee history entry of the given height and a
+// boolean indicating if it's still kept in the ring buffer.
+func (k Keeper) GetFeeHistoryEntry(ctx sdk.Context, height int64) (types.FeeHistoryEntry, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeHistoryKey(height))
+	if len(bz) == 0 {
+		return types.FeeHistoryEntry{}, false
+	}
+
+	var entry types.FeeHistoryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	if entry.Height != height {
+		return types.FeeHistoryEntry{}, false
+	}
+
+	return entry, true
+}
+
+// GetFeeHistory returns the fee history entries kept for the blockCount blocks
+// ending at lastBlock, in ascending height order.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, lastBlock int64, blockCount uint64) []types.FeeHistoryEntry {
+	firstBlock := lastBlock - int64(blockCount) + 1 //#nosec G701 -- block count is bounded by the fee history size
+	if firstBlock < 1 {
+		firstBlock = 1
+	}
+
+	entries := make([]types.FeeHistoryEntry, 0, lastBlock-firstBlock+1)
+	for height := firstBlock; height <= lastBlock; height++ {
+		if entry, found := k.GetFeeHistoryEntry(ctx, height); found {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// AddTransientPriorityFee adds the priority fee paid by an ethereum transaction
+// of the current block, together with the gas it used, to the transient store.
+func (k Keeper) AddTransientPriorityFee(ctx sdk.Context, gasUsed uint64, priorityFee *big.Int) {
+	store := ctx.TransientStore(k.transientKey)
+
+	count := uint64(0)
+	if bz := store.Get(types.KeyTransientPriorityFeeCount); len(bz) > 0 {
+		count = sdk.BigEndianToUint64(bz)
+	}
+
+	prefixStore := prefix.NewStore(store, types.KeyPrefixTransientPriorityFee)
+	prefixStore.Set(sdk.Uint64ToBigEndian(count), append(sdk.Uint64ToBigEndian(gasUsed), priorityFee.Bytes()...))
+	store.Set(types.KeyTransientPriorityFeeCount, sdk.Uint64ToBigEndian(count+1))
+}
+
+// GetTransientPriorityFees returns the priority fees paid by the ethereum
+// transactions of the current block.
+func (k Keeper) GetTransientPriorityFees(ctx sdk.Context) []types.TxPriorityFee {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPriorityFee)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var fees []types.TxPriorityFee
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		fees = append(fees, types.TxPriorityFee{
+			GasUsed:     sdk.BigEndianToUint64(bz[:8]),
+			PriorityFee: new(big.Int).SetBytes(bz[8:]),
+		})
+	}
+
+	return fees
+}
+
+// recordFeeHistory sets the fee history entry of the current block.
+// CONTRACT: this should be only called during EndBlock, after the block gas
+// wanted and used are set.
+func (k Keeper) recordFeeHistory(ctx sdk.Context, gasWanted, gasUsed uint64) {
+	entry := types.FeeHistoryEntry{
+		Height:      ctx.BlockHeight(),
+		BaseFee:     sdkmath.ZeroInt(),
+		NextBaseFee: sdkmath.ZeroInt(),
+		GasUsed:     gasUsed,
+		GasWanted:   gasWanted,
+		Rewards:     types.NewFeeHistoryRewards(k.GetTransientPriorityFees(ctx)),
+	}
+
+	if baseFee := k.GetBaseFee(ctx); baseFee != nil {
+		entry.BaseFee = sdkmath.NewIntFromBigInt(baseFee)
+	}
+
+	// the next base fee is calculated with the state of the current block, the
+	// same way as during the BeginBlock of the next block
+	if nextBaseFee := k.CalculateBaseFee(ctx.WithBlockHeight(ctx.BlockHeight() + 1)); nextBaseFee != nil {
+		entry.NextBaseFee = sdkmath.NewIntFromBigInt(nextBaseFee)
+	}
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > 0 {
+		entry.GasLimit = uint64(consParams.Block.MaxGas)
+	}
+
+	k.SetFeeHistoryEntry(ctx, entry)
+}
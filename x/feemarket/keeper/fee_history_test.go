@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// endBlock runs the fee market EndBlock at the given height with the given gas
+// used and priority fees.
+func (suite *KeeperTestSuite) endBlock(height int64, gasUsed uint64, priorityFees ...types.TxPriorityFee) sdk.Context {
+	meter := storetypes.NewGasMeter(uint64(1000000000))
+	meter.ConsumeGas(gasUsed, "test")
+
+	ctx := suite.ctx.WithBlockHeight(height).WithBlockGasMeter(meter)
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}})
+
+	// reset the transient store of the previous block
+	ctx = ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+	for _, fee := range priorityFees {
+		suite.app.FeeMarketKeeper.AddTransientPriorityFee(ctx, fee.GasUsed, fee.PriorityFee)
+	}
+	suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(ctx, gasUsed)
+
+	suite.app.FeeMarketKeeper.EndBlock(ctx, abci.RequestEndBlock{Height: height})
+
+	// only keep the persistent state
+	suite.app.FeeMarketKeeper.SetFeeHistoryEntry(suite.ctx, suite.mustGetFeeHistoryEntry(ctx, height))
+	return ctx
+}
+
+func (suite *KeeperTestSuite) mustGetFeeHistoryEntry(ctx sdk.Context, height int64) types.FeeHistoryEntry {
+	entry, found := suite.app.FeeMarketKeeper.GetFeeHistoryEntry(ctx, height)
+	suite.Require().True(found)
+	return entry
+}
+
+func (suite *KeeperTestSuite) TestEndBlockFeeHistory() {
+	suite.SetupTest()
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.BaseFee = sdkmath.NewInt(1000)
+	params.MinGasMultiplier = sdkmath.LegacyOneDec()
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	ctx := suite.endBlock(
+		10,
+		75,
+		types.TxPriorityFee{GasUsed: 50, PriorityFee: big.NewInt(2)},
+		types.TxPriorityFee{GasUsed: 25, PriorityFee: big.NewInt(1)},
+	)
+
+	entry := suite.mustGetFeeHistoryEntry(ctx, 10)
+	suite.Require().Equal(int64(10), entry.Height)
+	suite.Require().Equal(sdkmath.NewInt(1000), entry.BaseFee)
+	// the block used 75 gas with a target of 50 (ElasticityMultiplier = 2)
+	suite.Require().Equal(sdkmath.NewInt(1062), entry.NextBaseFee)
+	suite.Require().Equal(uint64(75), entry.GasUsed)
+	suite.Require().Equal(uint64(75), entry.GasWanted)
+	suite.Require().Equal(uint64(100), entry.GasLimit)
+	suite.Require().Equal(big.NewInt(1), entry.Reward(0))
+	suite.Require().Equal(big.NewInt(2), entry.Reward(50))
+
+	// block without ethereum transactions
+	ctx = suite.endBlock(11, 0)
+	entry = suite.mustGetFeeHistoryEntry(ctx, 11)
+	suite.Require().Empty(entry.Rewards)
+	suite.Require().Equal(big.NewInt(0), entry.Reward(50))
+
+	// the entry of the block FeeHistorySize heights later overwrites the slot
+	ctx = suite.endBlock(10+types.FeeHistorySize, 0)
+	_, found := suite.app.FeeMarketKeeper.GetFeeHistoryEntry(ctx, 10)
+	suite.Require().False(found)
+	suite.mustGetFeeHistoryEntry(ctx, 10+types.FeeHistorySize)
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeHistory() {
+	testCases := []struct {
+		name       string
+		req        *types.QueryFeeHistoryRequest
+		expPass    bool
+		expHeights []int64
+	}{
+		{
+			"fail - empty request",
+			nil,
+			false,
+			nil,
+		},
+		{
+			"fail - zero block count",
+			&types.QueryFeeHistoryRequest{},
+			false,
+			nil,
+		},
+		{
+			"fail - block count higher than the fee history size",
+			&types.QueryFeeHistoryRequest{BlockCount: types.FeeHistorySize + 1},
+			false,
+			nil,
+		},
+		{
+			"fail - last block higher than the current height",
+			&types.QueryFeeHistoryRequest{BlockCount: 1, LastBlock: 6},
+			false,
+			nil,
+		},
+		{
+			"pass - latest blocks",
+			&types.QueryFeeHistoryRequest{BlockCount: 2},
+			true,
+			[]int64{4, 5},
+		},
+		{
+			"pass - given last block",
+			&types.QueryFeeHistoryRequest{BlockCount: 2, LastBlock: 3},
+			true,
+			[]int64{2, 3},
+		},
+		{
+			"pass - missing blocks are skipped",
+			&types.QueryFeeHistoryRequest{BlockCount: 10},
+			true,
+			[]int64{2, 3, 4, 5},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			for height := int64(2); height <= 5; height++ {
+				suite.endBlock(height, 0)
+			}
+
+			ctx := suite.ctx.WithBlockHeight(5)
+			res, err := suite.app.FeeMarketKeeper.FeeHistory(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				heights := make([]int64, 0, len(res.Entries))
+				for _, entry := range res.Entries {
+					heights = append(heights, entry.Height)
+				}
+				suite.Require().Equal(tc.expHeights, heights)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
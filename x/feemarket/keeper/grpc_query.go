@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
@@ -52,3 +54,55 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// FeeTokenGasPrice implements the Query/FeeTokenGasPrice gRPC method
+func (k Keeper) FeeTokenGasPrice(c context.Context, req *types.QueryFeeTokenGasPriceRequest) (*types.QueryFeeTokenGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	feeToken, found := params.GetFeeToken(req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fee token %s is not registered", req.Denom)
+	}
+
+	res := &types.QueryFeeTokenGasPriceResponse{
+		MinGasPrice: feeToken.FromEvmDenom(params.MinGasPrice),
+	}
+
+	if baseFee := k.GetBaseFee(ctx); baseFee != nil {
+		aux := feeToken.FromEvmDenom(sdkmath.LegacyNewDecFromBigInt(baseFee))
+		res.BaseFee = &aux
+	}
+
+	return res, nil
+}
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.BlockCount == 0 || req.BlockCount > types.FeeHistorySize {
+		return nil, status.Errorf(codes.InvalidArgument, "block count must be between 1 and %d, got %d", types.FeeHistorySize, req.BlockCount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lastBlock := req.LastBlock
+	if lastBlock <= 0 {
+		lastBlock = ctx.BlockHeight()
+	}
+
+	if lastBlock > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "last block %d is higher than the current height %d", lastBlock, ctx.BlockHeight())
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		Entries: k.GetFeeHistory(ctx, lastBlock, req.BlockCount),
+	}, nil
+}
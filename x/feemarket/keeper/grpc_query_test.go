@@ -98,3 +98,54 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryFeeTokenGasPrice() {
+	denom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		denom    string
+		expPass  bool
+		expRes   *types.QueryFeeTokenGasPriceResponse
+	}{
+		{
+			"fail - fee token not registered",
+			func() {},
+			denom,
+			false,
+			nil,
+		},
+		{
+			"pass - base fee and min gas price converted",
+			func() {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.BaseFee = sdkmath.NewInt(1000)
+				params.MinGasPrice = sdkmath.LegacyNewDec(500)
+				params.FeeTokens = []types.FeeToken{types.NewFeeToken(denom, sdkmath.LegacyNewDecWithPrec(5, 1))}
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			denom,
+			true,
+			&types.QueryFeeTokenGasPriceResponse{
+				BaseFee:     func() *sdkmath.LegacyDec { baseFee := sdkmath.LegacyNewDec(500); return &baseFee }(),
+				MinGasPrice: sdkmath.LegacyNewDec(250),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.queryClient.FeeTokenGasPrice(suite.ctx.Context(), &types.QueryFeeTokenGasPriceRequest{Denom: tc.denom})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
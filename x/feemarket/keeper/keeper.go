@@ -77,6 +77,25 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetBlockGasUsed sets the block gas used to the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	gasBz := sdk.Uint64ToBigEndian(gas)
+	store.Set(types.KeyPrefixBlockGasUsed, gasBz)
+}
+
+// GetBlockGasUsed returns the last block gas used value from the store.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBlockGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
@@ -30,6 +30,18 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		params.MinGasMultiplier = math.LegacyZeroDec()
 	}
 
+	if params.MinBaseFee.IsNil() {
+		params.MinBaseFee = math.ZeroInt()
+	}
+
+	if params.MaxBaseFee.IsNil() {
+		params.MaxBaseFee = math.ZeroInt()
+	}
+
+	if params.MaxChangeRate.IsNil() {
+		params.MaxChangeRate = math.LegacyZeroDec()
+	}
+
 	return
 }
 
@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 4 to
+// version 5. Specifically, it sets the min base fee, max base fee and max change rate
+// parameters to their default values, which keep the previous base fee calculation.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.ParamsKey)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.MinBaseFee = types.DefaultMinBaseFee
+	params.MaxBaseFee = types.DefaultMaxBaseFee
+	params.MaxChangeRate = types.DefaultMaxChangeRate
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
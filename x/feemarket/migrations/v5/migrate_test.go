@@ -0,0 +1,51 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	v4types "github.com/evmos/evmos/v16/x/feemarket/migrations/v4/types"
+	v5 "github.com/evmos/evmos/v16/x/feemarket/migrations/v5"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	v4Params := v4types.DefaultParams()
+	v4Params.BaseFee = math.NewInt(1_000_000_000)
+
+	// Set the params in the store
+	paramsV4Bz := cdc.MustMarshal(&v4Params)
+	kvStore.Set(types.ParamsKey, paramsV4Bz)
+
+	err := v5.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	paramsBz := kvStore.Get(types.ParamsKey)
+	var params types.Params
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	require.Equal(t, v4Params.BaseFee, params.BaseFee)
+	require.Equal(t, v4Params.MinGasMultiplier, params.MinGasMultiplier)
+	require.False(t, params.UseGasUsed)
+	require.Zero(t, params.TargetGas)
+	require.Equal(t, types.DefaultMinBaseFee, params.MinBaseFee)
+	require.Equal(t, types.DefaultMaxBaseFee, params.MaxBaseFee)
+	require.Equal(t, types.DefaultMaxChangeRate, params.MaxChangeRate)
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,97 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// FeeHistorySize is the number of blocks kept in the fee history ring buffer
+	FeeHistorySize = 1024
+	// FeeHistoryRewardPercentileStep is the step between the percentiles of the
+	// priority fees kept for each block
+	FeeHistoryRewardPercentileStep = 5
+)
+
+// TxPriorityFee defines the priority fee paid by an ethereum transaction and the
+// gas it used.
+type TxPriorityFee struct {
+	GasUsed     uint64
+	PriorityFee *big.Int
+}
+
+// NewFeeHistoryRewards returns the priority fees at the percentiles 0, 5, ..., 100
+// of the gas used by the given transactions. It returns nil if there are no
+// transactions.
+func NewFeeHistoryRewards(txs []TxPriorityFee) []math.Int {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	sorted := make([]TxPriorityFee, len(txs))
+	copy(sorted, txs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PriorityFee.Cmp(sorted[j].PriorityFee) < 0
+	})
+
+	totalGasUsed := new(big.Int)
+	for _, tx := range sorted {
+		totalGasUsed.Add(totalGasUsed, new(big.Int).SetUint64(tx.GasUsed))
+	}
+
+	rewards := make([]math.Int, 0, 100/FeeHistoryRewardPercentileStep+1)
+
+	txIndex := 0
+	sumGasUsed := new(big.Int).SetUint64(sorted[0].GasUsed)
+	for p := 0; p <= 100; p += FeeHistoryRewardPercentileStep {
+		thresholdGasUsed := new(big.Int).Mul(totalGasUsed, big.NewInt(int64(p)))
+		thresholdGasUsed.Quo(thresholdGasUsed, big.NewInt(100))
+
+		for sumGasUsed.Cmp(thresholdGasUsed) < 0 && txIndex < len(sorted)-1 {
+			txIndex++
+			sumGasUsed.Add(sumGasUsed, new(big.Int).SetUint64(sorted[txIndex].GasUsed))
+		}
+
+		rewards = append(rewards, math.NewIntFromBigInt(sorted[txIndex].PriorityFee))
+	}
+
+	return rewards
+}
+
+// Reward returns the priority fee of the block at the given percentile, rounded
+// down to the closest percentile kept in the entry. It returns zero if the block
+// has no ethereum transactions.
+func (e FeeHistoryEntry) Reward(percentile float64) *big.Int {
+	if len(e.Rewards) == 0 {
+		return big.NewInt(0)
+	}
+
+	index := int(percentile / FeeHistoryRewardPercentileStep)
+	switch {
+	case index < 0:
+		index = 0
+	case index >= len(e.Rewards):
+		index = len(e.Rewards) - 1
+	}
+
+	return e.Rewards[index].BigInt()
+}
+
+// GasUsedRatio returns the ratio between the gas used by the block and its gas
+// limit, using the given default gas limit if the block gas is unlimited.
+func (e FeeHistoryEntry) GasUsedRatio(defaultGasLimit uint64) float64 {
+	gasLimit := e.GasLimit
+	if gasLimit == 0 {
+		gasLimit = defaultGasLimit
+	}
+
+	if gasLimit == 0 {
+		return 0
+	}
+
+	return float64(e.GasUsed) / float64(gasLimit)
+}
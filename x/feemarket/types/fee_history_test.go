@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeeHistoryTestSuite struct {
+	suite.Suite
+}
+
+func TestFeeHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeHistoryTestSuite))
+}
+
+func (suite *FeeHistoryTestSuite) TestNewFeeHistoryRewards() {
+	testCases := []struct {
+		name       string
+		txs        []TxPriorityFee
+		expRewards map[int]int64 // percentile -> reward
+	}{
+		{
+			"no transactions",
+			nil,
+			nil,
+		},
+		{
+			"single transaction",
+			[]TxPriorityFee{{GasUsed: 21000, PriorityFee: big.NewInt(3)}},
+			map[int]int64{0: 3, 50: 3, 100: 3},
+		},
+		{
+			"transactions weighted by gas used",
+			[]TxPriorityFee{
+				{GasUsed: 30, PriorityFee: big.NewInt(10)},
+				{GasUsed: 60, PriorityFee: big.NewInt(1)},
+				{GasUsed: 10, PriorityFee: big.NewInt(5)},
+			},
+			map[int]int64{0: 1, 60: 1, 65: 5, 70: 5, 75: 10, 100: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		rewards := NewFeeHistoryRewards(tc.txs)
+		if tc.expRewards == nil {
+			suite.Require().Nil(rewards, tc.name)
+			continue
+		}
+
+		suite.Require().Len(rewards, 100/FeeHistoryRewardPercentileStep+1, tc.name)
+		for percentile, expReward := range tc.expRewards {
+			suite.Require().Equal(math.NewInt(expReward), rewards[percentile/FeeHistoryRewardPercentileStep], "%s: percentile %d", tc.name, percentile)
+		}
+	}
+}
+
+func (suite *FeeHistoryTestSuite) TestFeeHistoryEntryReward() {
+	entry := FeeHistoryEntry{
+		Rewards: NewFeeHistoryRewards([]TxPriorityFee{
+			{GasUsed: 50, PriorityFee: big.NewInt(1)},
+			{GasUsed: 50, PriorityFee: big.NewInt(2)},
+		}),
+	}
+
+	suite.Require().Equal(big.NewInt(1), entry.Reward(0))
+	suite.Require().Equal(big.NewInt(1), entry.Reward(50))
+	suite.Require().Equal(big.NewInt(1), entry.Reward(54.9))
+	suite.Require().Equal(big.NewInt(2), entry.Reward(55))
+	suite.Require().Equal(big.NewInt(2), entry.Reward(100))
+	suite.Require().Equal(big.NewInt(1), entry.Reward(-1))
+	suite.Require().Equal(big.NewInt(2), entry.Reward(150))
+
+	suite.Require().Equal(big.NewInt(0), FeeHistoryEntry{}.Reward(50))
+}
+
+func (suite *FeeHistoryTestSuite) TestFeeHistoryEntryGasUsedRatio() {
+	suite.Require().Equal(0.25, FeeHistoryEntry{GasUsed: 25, GasLimit: 100}.GasUsedRatio(1000))
+	suite.Require().Equal(0.025, FeeHistoryEntry{GasUsed: 25}.GasUsedRatio(1000))
+	suite.Require().Equal(float64(0), FeeHistoryEntry{GasUsed: 25}.GasUsedRatio(0))
+}
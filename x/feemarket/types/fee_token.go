@@ -0,0 +1,63 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// NewFeeToken creates a new FeeToken instance
+func NewFeeToken(denom string, conversionRate math.LegacyDec) FeeToken {
+	return FeeToken{
+		Denom:          denom,
+		ConversionRate: conversionRate,
+	}
+}
+
+// Validate checks that the fee token has a valid denomination and a positive
+// conversion rate.
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+
+	if ft.ConversionRate.IsNil() || !ft.ConversionRate.IsPositive() {
+		return fmt.Errorf("conversion rate of fee token %s must be positive: %s", ft.Denom, ft.ConversionRate)
+	}
+
+	return nil
+}
+
+// FromEvmDenom converts an amount of the EVM denomination to the fee token.
+func (ft FeeToken) FromEvmDenom(amount math.LegacyDec) math.LegacyDec {
+	return amount.Mul(ft.ConversionRate)
+}
+
+// ToEvmDenom converts an amount of the fee token to the EVM denomination.
+func (ft FeeToken) ToEvmDenom(amount math.LegacyDec) math.LegacyDec {
+	return amount.Quo(ft.ConversionRate)
+}
+
+// FeeAmount returns the amount of the fee token paid for the given fee amount in
+// the EVM denomination, rounded up in favor of the fee collector.
+func (ft FeeToken) FeeAmount(amount math.Int) math.Int {
+	return ft.FromEvmDenom(math.LegacyNewDecFromInt(amount)).Ceil().TruncateInt()
+}
+
+// RefundAmount returns the amount of the fee token refunded for the given fee amount
+// in the EVM denomination, rounded down in favor of the fee collector.
+func (ft FeeToken) RefundAmount(amount math.Int) math.Int {
+	return ft.FromEvmDenom(math.LegacyNewDecFromInt(amount)).TruncateInt()
+}
+
+// Address returns the address that identifies the fee token in the access list
+// of the Ethereum transactions paying their fees with it. It is derived from the
+// hash of the denomination.
+func (ft FeeToken) Address() common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(ft.Denom)))
+}
@@ -43,6 +43,24 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_tokens defines the denominations, besides the EVM denomination, that are
+	// accepted to pay for the gas of cosmos and eth transactions
+	FeeTokens []FeeToken `protobuf:"bytes,9,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// use_gas_used defines if the base fee is calculated from the gas used by the
+	// parent block instead of its gas wanted
+	UseGasUsed bool `protobuf:"varint,10,opt,name=use_gas_used,json=useGasUsed,proto3" json:"use_gas_used,omitempty"`
+	// target_gas defines the gas per block targeted by the base fee calculation.
+	// If zero, the target is the block max gas divided by the elasticity multiplier.
+	TargetGas uint64 `protobuf:"varint,11,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+	// min_base_fee defines the lower bound of the base fee
+	MinBaseFee cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_base_fee"`
+	// max_base_fee defines the upper bound of the base fee. If zero, the base fee
+	// is unbounded.
+	MaxBaseFee cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_base_fee"`
+	// max_change_rate bounds the change of the base fee between blocks as a
+	// fraction of the parent base fee. If zero, the change is only bounded by the
+	// base fee change denominator.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +124,162 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetUseGasUsed() bool {
+	if m != nil {
+		return m.UseGasUsed
+	}
+	return false
+}
+
+func (m *Params) GetTargetGas() uint64 {
+	if m != nil {
+		return m.TargetGas
+	}
+	return 0
+}
+
+// FeeToken defines a denomination accepted to pay for gas and its conversion rate
+// to the EVM denomination.
+type FeeToken struct {
+	// denom is the denomination of the fee token (e.g. an IBC voucher)
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the amount of the fee token equivalent to one unit of the
+	// EVM denomination
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FeeHistoryEntry defines the fee data of a block kept in the fee history.
+type FeeHistoryEntry struct {
+	// height is the height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the base fee of the block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// next_base_fee is the base fee expected for the next block
+	NextBaseFee cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=next_base_fee,json=nextBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"next_base_fee"`
+	// gas_used is the gas used by the block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_wanted is the gas wanted by the block, as used by the base fee calculation
+	GasWanted uint64 `protobuf:"varint,5,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_limit is the max gas of the block. Zero means that the block gas is unlimited.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// rewards are the priority fees paid by the ethereum transactions of the block at
+	// the percentiles 0, 5, ..., 100 of their gas used. It's empty if the block has no
+	// ethereum transactions.
+	Rewards []cosmossdk_io_math.Int `protobuf:"bytes,7,rep,name=rewards,proto3,customtype=cosmossdk.io/math.Int" json:"rewards"`
+}
+
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+func (m *FeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*FeeToken)(nil), "ethermint.feemarket.v1.FeeToken")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "ethermint.feemarket.v1.FeeHistoryEntry")
 }
 
 func init() {
@@ -115,33 +287,50 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xad, 0xa5, 0x4d, 0x5d, 0x75, 0x81, 0x20, 0xda, 0x61, 0x56, 0xe6, 0x9a, 0x15, 0x86,
-	0x0f, 0xc3, 0x26, 0x14, 0xc6, 0x2e, 0xbb, 0x64, 0xa5, 0xdd, 0xc6, 0x06, 0x9d, 0x8f, 0xbb, 0x08,
-	0xd9, 0x7d, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x8a, 0x59, 0xbe, 0xc5, 0xbe, 0xc9, 0xbe, 0x46, 0x8e,
-	0x39, 0x8e, 0x1d, 0xc2, 0x48, 0xbe, 0xc8, 0x88, 0x9d, 0xd8, 0x81, 0x5d, 0x76, 0x11, 0xd2, 0xfb,
-	0xff, 0x7f, 0x7f, 0xde, 0x43, 0x0f, 0xbf, 0x02, 0x93, 0x41, 0x29, 0xb8, 0x34, 0xe1, 0x04, 0x40,
-	0xb0, 0x72, 0x0a, 0x26, 0xac, 0x46, 0xdd, 0x23, 0x28, 0x4a, 0x65, 0x14, 0x79, 0xd6, 0xfa, 0x82,
-	0x4e, 0xaa, 0x46, 0xcf, 0xcf, 0x53, 0x95, 0xaa, 0xda, 0x12, 0x6e, 0x6f, 0x8d, 0xfb, 0xe5, 0xcf,
-	0x1e, 0xee, 0x3f, 0xb0, 0x92, 0x09, 0x4d, 0x5c, 0x7c, 0x26, 0x15, 0x8d, 0x99, 0x06, 0x3a, 0x01,
-	0x70, 0x90, 0x87, 0x7c, 0x3b, 0x3a, 0x95, 0x6a, 0xcc, 0x34, 0xdc, 0x01, 0x90, 0x77, 0xf8, 0x72,
-	0x2f, 0xd2, 0x24, 0x63, 0x32, 0x05, 0xfa, 0x08, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xa5, 0xf3, 0xc4,
-	0x43, 0xfe, 0x20, 0x72, 0xe2, 0xc6, 0xfd, 0xbe, 0x36, 0xdc, 0x76, 0x3a, 0xb9, 0xc1, 0x17, 0x90,
-	0x33, 0x6d, 0x78, 0xc2, 0xcd, 0x9c, 0x8a, 0x59, 0x6e, 0x78, 0x91, 0x73, 0x28, 0x9d, 0x5e, 0x0d,
-	0x9e, 0x77, 0xe2, 0x97, 0x56, 0x23, 0xd7, 0x78, 0x00, 0x92, 0xc5, 0x39, 0xd0, 0x0c, 0x78, 0x9a,
-	0x19, 0xe7, 0xd8, 0x43, 0x7e, 0x2f, 0x7a, 0xda, 0x14, 0x3f, 0xd4, 0x35, 0xf2, 0x16, 0xdb, 0x6d,
-	0xd7, 0x7d, 0x0f, 0xf9, 0xa7, 0xe3, 0x17, 0x8b, 0xd5, 0x95, 0xf5, 0x7b, 0x75, 0x75, 0x91, 0x28,
-	0x2d, 0x94, 0xd6, 0x8f, 0xd3, 0x80, 0xab, 0x50, 0x30, 0x93, 0x05, 0x1f, 0xa5, 0x89, 0x4e, 0x76,
-	0x4d, 0x92, 0x7b, 0x3c, 0x10, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c, 0x01, 0xe7, 0xa4, 0xc6,
-	0xaf, 0x77, 0xf8, 0xe5, 0xbf, 0xf8, 0x67, 0x48, 0x59, 0x32, 0xbf, 0x85, 0x24, 0x3a, 0x13, 0x5c,
-	0xde, 0x33, 0xfd, 0xb0, 0xe5, 0xc8, 0x57, 0x4c, 0xf6, 0x41, 0x07, 0x93, 0xd9, 0xff, 0x9f, 0x36,
-	0x6c, 0xd2, 0xba, 0xd1, 0x3f, 0x1d, 0xd9, 0x47, 0xc3, 0xe3, 0x68, 0xc8, 0x25, 0x37, 0x9c, 0xe5,
-	0xed, 0xbf, 0x8c, 0xef, 0x16, 0x6b, 0x17, 0x2d, 0xd7, 0x2e, 0xfa, 0xb3, 0x76, 0xd1, 0x8f, 0x8d,
-	0x6b, 0x2d, 0x37, 0xae, 0xf5, 0x6b, 0xe3, 0x5a, 0xdf, 0x5e, 0xa7, 0xdc, 0x64, 0xb3, 0x38, 0x48,
-	0x94, 0x08, 0xa1, 0x12, 0x4a, 0xef, 0xce, 0x6a, 0xf4, 0x26, 0xfc, 0x7e, 0xb0, 0x34, 0x66, 0x5e,
-	0x80, 0x8e, 0xfb, 0xf5, 0x02, 0xdc, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xb6, 0x17, 0xf2,
-	0x58, 0x02, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0x26, 0x69, 0xfe, 0xbc, 0x24, 0x6d, 0x19, 0xda, 0xb2, 0x5a, 0x9a, 0x2e, 0x2d, 0x48,
+	0x0e, 0x92, 0x50, 0x0b, 0xea, 0x45, 0xc4, 0xd8, 0x26, 0x55, 0x2b, 0xd4, 0x45, 0x11, 0xbc, 0x2c,
+	0x93, 0xcd, 0xeb, 0x66, 0x68, 0x76, 0x26, 0xec, 0x4c, 0xd2, 0xe4, 0x0b, 0x78, 0xf6, 0xd3, 0xf8,
+	0x19, 0x7a, 0xec, 0x51, 0x3c, 0x14, 0x69, 0xbf, 0x88, 0xcc, 0x6c, 0x92, 0x2d, 0xd4, 0xc3, 0x7a,
+	0x09, 0x79, 0xef, 0xfd, 0x7e, 0xbf, 0x7d, 0xef, 0xcd, 0x6f, 0x06, 0x9e, 0xa0, 0x1a, 0x60, 0x14,
+	0x32, 0xae, 0x5a, 0xe7, 0x88, 0x21, 0x8d, 0x2e, 0x50, 0xb5, 0x26, 0x07, 0x49, 0xd0, 0x1c, 0x45,
+	0x42, 0x09, 0xb2, 0xb5, 0xc4, 0x35, 0x93, 0xd2, 0xe4, 0xe0, 0xf1, 0x46, 0x20, 0x02, 0x61, 0x20,
+	0x2d, 0xfd, 0x2f, 0x46, 0xef, 0x7d, 0x2f, 0x40, 0xe1, 0x8c, 0x46, 0x34, 0x94, 0xa4, 0x0e, 0x15,
+	0x2e, 0xbc, 0x1e, 0x95, 0xe8, 0x9d, 0x23, 0xda, 0x96, 0x63, 0x35, 0x4a, 0x6e, 0x99, 0x8b, 0x36,
+	0x95, 0xd8, 0x41, 0x24, 0xaf, 0x60, 0x7b, 0x51, 0xf4, 0xfc, 0x01, 0xe5, 0x01, 0x7a, 0x7d, 0xe4,
+	0x22, 0x64, 0x9c, 0x2a, 0x11, 0xd9, 0x59, 0xc7, 0x6a, 0xd4, 0x5c, 0xbb, 0x17, 0xa3, 0xdf, 0x1a,
+	0xc0, 0x51, 0x52, 0x27, 0x87, 0xb0, 0x89, 0x43, 0x2a, 0x15, 0xf3, 0x99, 0x9a, 0x79, 0xe1, 0x78,
+	0xa8, 0xd8, 0x68, 0xc8, 0x30, 0xb2, 0x73, 0x86, 0xb8, 0x91, 0x14, 0x3f, 0x2e, 0x6b, 0x64, 0x1f,
+	0x6a, 0xc8, 0x69, 0x6f, 0x88, 0xde, 0x00, 0x59, 0x30, 0x50, 0xf6, 0x8a, 0x63, 0x35, 0x72, 0x6e,
+	0x35, 0x4e, 0x9e, 0x98, 0x1c, 0x79, 0x09, 0xa5, 0x65, 0xd7, 0x05, 0xc7, 0x6a, 0x94, 0xdb, 0x3b,
+	0x57, 0x37, 0xbb, 0x99, 0xdf, 0x37, 0xbb, 0x9b, 0xbe, 0x90, 0xa1, 0x90, 0xb2, 0x7f, 0xd1, 0x64,
+	0xa2, 0x15, 0x52, 0x35, 0x68, 0xbe, 0xe3, 0xca, 0x2d, 0xce, 0x9b, 0x24, 0x5d, 0xa8, 0x85, 0x8c,
+	0x7b, 0x01, 0x95, 0xde, 0x28, 0x62, 0x3e, 0xda, 0x45, 0x43, 0xdf, 0x9f, 0xd3, 0xb7, 0x1f, 0xd2,
+	0x4f, 0x31, 0xa0, 0xfe, 0xec, 0x08, 0x7d, 0xb7, 0x12, 0x32, 0xde, 0xa5, 0xf2, 0x4c, 0xf3, 0xc8,
+	0x27, 0x20, 0x0b, 0xa1, 0x7b, 0x93, 0x95, 0xd2, 0xab, 0xad, 0xc7, 0x6a, 0xf7, 0x46, 0x3f, 0x06,
+	0xd0, 0x9b, 0x56, 0xe2, 0x02, 0xb9, 0xb4, 0xcb, 0x4e, 0xae, 0x51, 0x79, 0xe6, 0x34, 0xff, 0x7d,
+	0xb8, 0xcd, 0x0e, 0xe2, 0x67, 0x0d, 0x6c, 0xe7, 0xf5, 0xc7, 0xdc, 0xf2, 0xf9, 0x3c, 0x96, 0xc4,
+	0x81, 0xea, 0x58, 0xa2, 0xe9, 0x6c, 0x2c, 0xb1, 0x6f, 0x83, 0x39, 0x56, 0x18, 0x4b, 0xec, 0x52,
+	0xf9, 0x45, 0x62, 0x9f, 0xec, 0x00, 0x28, 0x1a, 0x05, 0xa8, 0x34, 0xc8, 0xae, 0x38, 0x56, 0x23,
+	0xef, 0x96, 0xe3, 0x4c, 0x97, 0x4a, 0xf2, 0x1a, 0xaa, 0x7a, 0xb4, 0xe5, 0x86, 0xab, 0x69, 0x36,
+	0x0c, 0x21, 0xe3, 0x0b, 0xdf, 0x68, 0x01, 0x3a, 0x4d, 0x04, 0x6a, 0xe9, 0x04, 0xe8, 0x74, 0x21,
+	0xf0, 0x01, 0xd6, 0xb4, 0xc0, 0xdc, 0x73, 0x11, 0x55, 0x68, 0xaf, 0xa6, 0xdf, 0x6c, 0x2d, 0xa4,
+	0xd3, 0xd8, 0x8d, 0x2e, 0x55, 0xf8, 0x3e, 0x5f, 0xca, 0xaf, 0xaf, 0xb8, 0xeb, 0x8c, 0x33, 0xc5,
+	0xe8, 0x70, 0xd9, 0xd5, 0x1e, 0x87, 0xd2, 0x62, 0x89, 0x64, 0x03, 0x56, 0x8c, 0xb3, 0xcd, 0x1d,
+	0x28, 0xbb, 0x71, 0x40, 0x4e, 0x61, 0xcd, 0x17, 0x7c, 0x82, 0x91, 0x64, 0x82, 0xc7, 0x6d, 0x64,
+	0xd3, 0xb7, 0xb1, 0x9a, 0x70, 0x75, 0x1f, 0x7b, 0x3f, 0xb3, 0xb0, 0xd6, 0x41, 0x3c, 0x61, 0x52,
+	0x89, 0x68, 0x76, 0xcc, 0x55, 0x34, 0x23, 0x5b, 0x50, 0x98, 0xdb, 0xdc, 0x32, 0x36, 0x2f, 0x0c,
+	0x1e, 0x1a, 0x3c, 0xfb, 0x5f, 0x06, 0x7f, 0x03, 0x35, 0x8e, 0x53, 0x95, 0x2c, 0x3f, 0x97, 0x86,
+	0x5e, 0xd1, 0x9c, 0xc5, 0xf6, 0x1f, 0x41, 0x69, 0x69, 0x9e, 0xbc, 0x31, 0x47, 0x31, 0x48, 0x9c,
+	0xa3, 0x4b, 0x97, 0x94, 0x2b, 0xec, 0x9b, 0xab, 0x99, 0x77, 0xcb, 0x01, 0x95, 0x5f, 0x4d, 0x82,
+	0x6c, 0x83, 0x0e, 0xbc, 0x21, 0x0b, 0x99, 0x32, 0x17, 0x33, 0xef, 0x6a, 0xa9, 0x53, 0x1d, 0x93,
+	0x17, 0x50, 0x8c, 0xf0, 0x92, 0x46, 0x7d, 0x69, 0x17, 0x9d, 0x5c, 0x8a, 0x91, 0xe6, 0xe8, 0x76,
+	0xe7, 0xea, 0xb6, 0x6e, 0x5d, 0xdf, 0xd6, 0xad, 0x3f, 0xb7, 0x75, 0xeb, 0xc7, 0x5d, 0x3d, 0x73,
+	0x7d, 0x57, 0xcf, 0xfc, 0xba, 0xab, 0x67, 0xbe, 0x3d, 0x0d, 0x98, 0x1a, 0x8c, 0x7b, 0x4d, 0x5f,
+	0x84, 0x2d, 0x9c, 0x84, 0x42, 0xce, 0x7f, 0x27, 0x07, 0xcf, 0x5b, 0xd3, 0x7b, 0x8f, 0xa6, 0x9a,
+	0x8d, 0x50, 0xf6, 0x0a, 0xe6, 0x01, 0x3c, 0xfc, 0x3b, 0x00, 0x07, 0x81, 0x3e, 0x53, 0x58, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -164,6 +353,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.TargetGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.UseGasUsed {
+		i--
+		if m.UseGasUsed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -222,6 +470,123 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.NextBaseFee.Size()
+		i -= size
+		if _, err := m.NextBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -257,6 +622,70 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.UseGasUsed {
+		n += 2
+	}
+	if m.TargetGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetGas))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.NextBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -474,6 +903,527 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseGasUsed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseGasUsed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+			}
+			m.TargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -22,18 +24,33 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockGasUsed
+	prefixFeeHistory
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientPriorityFee
+	prefixTransientPriorityFeeCount
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasUsed   = []byte{prefixBlockGasUsed}
+	KeyPrefixFeeHistory     = []byte{prefixFeeHistory}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientPriorityFee    = []byte{prefixTransientPriorityFee}
+	KeyTransientPriorityFeeCount     = []byte{prefixTransientPriorityFeeCount}
 )
+
+// FeeHistoryKey defines the key of the fee history slot in which the entry of the
+// given height is stored.
+func FeeHistoryKey(height int64) []byte {
+	slot := uint64(height) % FeeHistorySize //#nosec G701 -- block heights are not negative
+	return append(KeyPrefixFeeHistory, sdk.Uint64ToBigEndian(slot)...)
+}
@@ -19,6 +19,12 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultMinBaseFee is 0 (i.e only bounded by the min gas price)
+	DefaultMinBaseFee = math.ZeroInt()
+	// DefaultMaxBaseFee is 0 (i.e unbounded)
+	DefaultMaxBaseFee = math.ZeroInt()
+	// DefaultMaxChangeRate is 0 (i.e disabled)
+	DefaultMaxChangeRate = math.LegacyZeroDec()
 )
 
 // Parameter keys
@@ -69,6 +75,9 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		MaxChangeRate:            DefaultMaxChangeRate,
 	}
 }
 
@@ -82,6 +91,9 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		MaxChangeRate:            DefaultMaxChangeRate,
 	}
 }
 
@@ -103,9 +115,94 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBounds(p.MinBaseFee, p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxChangeRate(p.MaxChangeRate); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
+// GetFeeToken returns the fee token with the given denomination and a boolean
+// indicating if it was found.
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// validateFeeTokens checks that the fee tokens are valid and that their
+// denominations are unique.
+func validateFeeTokens(i interface{}) error {
+	feeTokens, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid fee token slice type: %T", i)
+	}
+
+	seenDenoms := make(map[string]struct{})
+	for _, feeToken := range feeTokens {
+		if _, ok := seenDenoms[feeToken.Denom]; ok {
+			return fmt.Errorf("duplicate fee token %s", feeToken.Denom)
+		}
+		seenDenoms[feeToken.Denom] = struct{}{}
+
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBaseFeeBounds checks that the base fee bounds are not negative and
+// that the max base fee, if set, is not lower than the min base fee.
+func validateBaseFeeBounds(minBaseFee, maxBaseFee math.Int) error {
+	if minBaseFee.IsNil() || maxBaseFee.IsNil() {
+		return fmt.Errorf("invalid base fee bounds: nil")
+	}
+
+	if minBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", minBaseFee)
+	}
+
+	if maxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative: %s", maxBaseFee)
+	}
+
+	if maxBaseFee.IsPositive() && maxBaseFee.LT(minBaseFee) {
+		return fmt.Errorf("max base fee %s cannot be lower than min base fee %s", maxBaseFee, minBaseFee)
+	}
+
+	return nil
+}
+
+func validateMaxChangeRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max change rate cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -118,6 +215,16 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// EffectiveTargetGas returns the gas per block targeted by the base fee calculation
+// given the block gas limit.
+func (p Params) EffectiveTargetGas(gasLimit uint64) uint64 {
+	if p.TargetGas > 0 {
+		return p.TargetGas
+	}
+	// CONTRACT: ElasticityMultiplier cannot be 0
+	return gasLimit / uint64(p.ElasticityMultiplier)
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(math.LegacyDec)
 
@@ -52,6 +52,131 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, math.LegacyNewDecWithPrec(-5, 1)),
 			true,
 		},
+		{
+			"valid: fee tokens",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               DefaultMinBaseFee,
+				MaxBaseFee:               DefaultMaxBaseFee,
+				MaxChangeRate:            DefaultMaxChangeRate,
+				FeeTokens:                []FeeToken{NewFeeToken("uusdc", math.LegacyNewDecWithPrec(5, 14))},
+			},
+			false,
+		},
+		{
+			"invalid: duplicate fee tokens",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               DefaultMinBaseFee,
+				MaxBaseFee:               DefaultMaxBaseFee,
+				MaxChangeRate:            DefaultMaxChangeRate,
+				FeeTokens: []FeeToken{
+					NewFeeToken("uusdc", math.LegacyNewDecWithPrec(5, 14)),
+					NewFeeToken("uusdc", math.LegacyOneDec()),
+				},
+			},
+			true,
+		},
+		{
+			"invalid: fee token conversion rate is zero",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               DefaultMinBaseFee,
+				MaxBaseFee:               DefaultMaxBaseFee,
+				MaxChangeRate:            DefaultMaxChangeRate,
+				FeeTokens:                []FeeToken{NewFeeToken("uusdc", math.LegacyZeroDec())},
+			},
+			true,
+		},
+		{
+			"invalid: fee token denom",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               DefaultMinBaseFee,
+				MaxBaseFee:               DefaultMaxBaseFee,
+				MaxChangeRate:            DefaultMaxChangeRate,
+				FeeTokens:                []FeeToken{NewFeeToken("1", math.LegacyOneDec())},
+			},
+			true,
+		},
+		{
+			"valid: base fee bounds and max change rate",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				UseGasUsed:               true,
+				TargetGas:                15_000_000,
+				MinBaseFee:               math.NewInt(100),
+				MaxBaseFee:               math.NewInt(10000),
+				MaxChangeRate:            math.LegacyNewDecWithPrec(5, 2),
+			},
+			false,
+		},
+		{
+			"invalid: min base fee is nil",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MaxBaseFee:               DefaultMaxBaseFee,
+				MaxChangeRate:            DefaultMaxChangeRate,
+			},
+			true,
+		},
+		{
+			"invalid: min base fee is negative",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               math.NewInt(-1),
+				MaxBaseFee:               DefaultMaxBaseFee,
+				MaxChangeRate:            DefaultMaxChangeRate,
+			},
+			true,
+		},
+		{
+			"invalid: max base fee lower than min base fee",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               math.NewInt(100),
+				MaxBaseFee:               math.NewInt(99),
+				MaxChangeRate:            DefaultMaxChangeRate,
+			},
+			true,
+		},
+		{
+			"invalid: max change rate is negative",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(1000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               DefaultMinBaseFee,
+				MaxBaseFee:               DefaultMaxBaseFee,
+				MaxChangeRate:            math.LegacyNewDecWithPrec(-1, 2),
+			},
+			true,
+		},
 		{
 			"invalid: min gas multiplier bigger than 1",
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
@@ -89,6 +214,19 @@ func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateMinGasMultiplier(math.LegacyNewDec(-5)))
 	suite.Require().Error(validateMinGasMultiplier(math.LegacyDec{}))
 	suite.Require().Error(validateMinGasMultiplier(""))
+	suite.Require().Error(validateMaxChangeRate(""))
+	suite.Require().Error(validateMaxChangeRate(math.LegacyDec{}))
+	suite.Require().NoError(validateMaxChangeRate(math.LegacyNewDecWithPrec(125, 3)))
+	suite.Require().Error(validateBaseFeeBounds(math.Int{}, math.ZeroInt()))
+	suite.Require().NoError(validateBaseFeeBounds(math.NewInt(10), math.ZeroInt()))
+}
+
+func (suite *ParamsTestSuite) TestEffectiveTargetGas() {
+	params := DefaultParams()
+	suite.Require().Equal(uint64(15_000_000), params.EffectiveTargetGas(30_000_000))
+
+	params.TargetGas = 10_000_000
+	suite.Require().Equal(uint64(10_000_000), params.EffectiveTargetGas(30_000_000))
 }
 
 func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
@@ -274,6 +274,197 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryFeeTokenGasPriceRequest defines the request type for querying the gas
+// price in a fee token.
+type QueryFeeTokenGasPriceRequest struct {
+	// denom is the denomination of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeeTokenGasPriceRequest) Reset()         { *m = QueryFeeTokenGasPriceRequest{} }
+func (m *QueryFeeTokenGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenGasPriceRequest) ProtoMessage()    {}
+func (*QueryFeeTokenGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryFeeTokenGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenGasPriceRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenGasPriceRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokenGasPriceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFeeTokenGasPriceResponse returns the gas price in a fee token.
+type QueryFeeTokenGasPriceResponse struct {
+	// base_fee is the EIP1559 base fee converted to the fee token
+	BaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee,omitempty"`
+	// min_gas_price is the global minimum gas price converted to the fee token
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *QueryFeeTokenGasPriceResponse) Reset()         { *m = QueryFeeTokenGasPriceResponse{} }
+func (m *QueryFeeTokenGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenGasPriceResponse) ProtoMessage()    {}
+func (*QueryFeeTokenGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryFeeTokenGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenGasPriceResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenGasPriceResponse proto.InternalMessageInfo
+
+// QueryFeeHistoryRequest defines the request type for querying the fee history.
+type QueryFeeHistoryRequest struct {
+	// block_count is the number of blocks to query, up to the fee history size
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// last_block is the height of the newest block to query. If zero, the latest
+	// block is used.
+	LastBlock int64 `protobuf:"varint,2,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetLastBlock() int64 {
+	if m != nil {
+		return m.LastBlock
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee history of the queried blocks.
+type QueryFeeHistoryResponse struct {
+	// entries are the fee history entries of the queried blocks that are kept in the
+	// store, in ascending height order
+	Entries []FeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetEntries() []FeeHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +472,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryFeeTokenGasPriceRequest)(nil), "ethermint.feemarket.v1.QueryFeeTokenGasPriceRequest")
+	proto.RegisterType((*QueryFeeTokenGasPriceResponse)(nil), "ethermint.feemarket.v1.QueryFeeTokenGasPriceResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryFeeHistoryResponse")
 }
 
 func init() {
@@ -288,35 +483,50 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x12, 0x48, 0xcb, 0x72, 0x41, 0x4b, 0x52, 0x81, 0x15, 0x36, 0xc8, 0x02, 0xc4, 0x47,
-	0xd9, 0x55, 0x0a, 0xe2, 0xc4, 0xc9, 0x87, 0x22, 0x24, 0x0e, 0x60, 0x6e, 0x5c, 0xaa, 0x75, 0x98,
-	0x3a, 0x56, 0x6a, 0xaf, 0xeb, 0xdd, 0x58, 0xf4, 0x8a, 0xc4, 0x85, 0x03, 0x42, 0xe2, 0x77, 0xf0,
-	0x3f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0xa8, 0x50, 0xc2, 0x0f, 0x41, 0xde, 0x5d, 0x07, 0x0c, 0x09,
-	0xcd, 0xc5, 0x1a, 0x8d, 0xdf, 0xbc, 0xf7, 0xe6, 0x8d, 0x16, 0xfb, 0xa0, 0xc7, 0x50, 0xa4, 0x49,
-	0xa6, 0xf9, 0x3e, 0x40, 0x2a, 0x8a, 0x09, 0x68, 0x5e, 0x0e, 0xf9, 0xe1, 0x14, 0x8a, 0x23, 0x96,
-	0x17, 0x52, 0x4b, 0xb2, 0xb5, 0xc0, 0xb0, 0x05, 0x86, 0x95, 0x43, 0xef, 0xf6, 0x8a, 0xd9, 0xdf,
-	0x20, 0x33, 0xef, 0x75, 0x63, 0x19, 0x4b, 0x53, 0xf2, 0xaa, 0x72, 0xdd, 0x7e, 0x2c, 0x65, 0x7c,
-	0x00, 0x5c, 0xe4, 0x09, 0x17, 0x59, 0x26, 0xb5, 0xd0, 0x89, 0xcc, 0x94, 0xfd, 0xeb, 0x77, 0x31,
-	0x79, 0x59, 0x59, 0x78, 0x21, 0x0a, 0x91, 0xaa, 0x10, 0x0e, 0xa7, 0xa0, 0xb4, 0xff, 0x0a, 0x5f,
-	0x69, 0x74, 0x55, 0x2e, 0x33, 0x05, 0xe4, 0x09, 0xee, 0xe4, 0xa6, 0x73, 0x15, 0xdd, 0x40, 0x77,
-	0x2e, 0xed, 0x50, 0xb6, 0xdc, 0x31, 0xb3, 0x73, 0xc1, 0xf9, 0xe3, 0xd3, 0x41, 0x2b, 0x74, 0x33,
-	0x7e, 0xcf, 0x91, 0x06, 0x42, 0xc1, 0x2e, 0x40, 0xad, 0xf5, 0x1c, 0x77, 0x9b, 0x6d, 0x27, 0xf6,
-	0x08, 0x6f, 0x46, 0x42, 0xc1, 0xde, 0x3e, 0x80, 0x91, 0xbb, 0x18, 0x5c, 0xfb, 0x7e, 0x3a, 0xe8,
-	0x8d, 0xa4, 0x4a, 0xa5, 0x52, 0x6f, 0x26, 0x2c, 0x91, 0x3c, 0x15, 0x7a, 0xcc, 0x9e, 0x65, 0x3a,
-	0xdc, 0x88, 0xec, 0xb4, 0xbf, 0x55, 0xb3, 0x1d, 0xc8, 0xd1, 0xe4, 0xa9, 0x58, 0x6c, 0x74, 0x17,
-	0xf7, 0xfe, 0xea, 0x3b, 0x99, 0xcb, 0xb8, 0x1d, 0x0b, 0xbb, 0x50, 0x3b, 0xac, 0xca, 0x9d, 0x2f,
-	0x6d, 0x7c, 0xc1, 0x60, 0xc9, 0x7b, 0x84, 0x3b, 0x76, 0x15, 0x72, 0x6f, 0xd5, 0xaa, 0xff, 0xa6,
-	0xe7, 0xdd, 0x5f, 0x0b, 0x6b, 0xf5, 0x7d, 0xff, 0xdd, 0xd7, 0x9f, 0x9f, 0xcf, 0xf5, 0x89, 0xc7,
-	0xa1, 0x4c, 0xa5, 0x6a, 0x5e, 0xd8, 0x26, 0x47, 0x3e, 0x20, 0xbc, 0xe1, 0xe2, 0x21, 0xff, 0x27,
-	0x6f, 0x66, 0xeb, 0x6d, 0xaf, 0x07, 0x76, 0x56, 0x6e, 0x1a, 0x2b, 0x94, 0xf4, 0x97, 0x59, 0xa9,
-	0x6f, 0x41, 0x3e, 0x22, 0xbc, 0x59, 0xa7, 0x48, 0xce, 0x10, 0x68, 0x1e, 0xc1, 0x7b, 0xb0, 0x26,
-	0xda, 0xf9, 0xb9, 0x65, 0xfc, 0x0c, 0xc8, 0xf5, 0xa5, 0x7e, 0x2a, 0xf4, 0x5e, 0x2c, 0x54, 0xb0,
-	0x7b, 0x3c, 0xa3, 0xe8, 0x64, 0x46, 0xd1, 0x8f, 0x19, 0x45, 0x9f, 0xe6, 0xb4, 0x75, 0x32, 0xa7,
-	0xad, 0x6f, 0x73, 0xda, 0x7a, 0xbd, 0x1d, 0x27, 0x7a, 0x3c, 0x8d, 0xd8, 0x48, 0xa6, 0x8e, 0xc2,
-	0x7e, 0xcb, 0xe1, 0x63, 0xfe, 0xf6, 0x0f, 0x3a, 0x7d, 0x94, 0x83, 0x8a, 0x3a, 0xe6, 0x45, 0x3c,
-	0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xd3, 0xfa, 0x92, 0xab, 0x03, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xa6, 0x4d, 0xdb, 0x8d, 0x7e, 0x52, 0xb5, 0xbf, 0xb4, 0x14, 0x93, 0xda, 0x60,
+	0xfe, 0xb4, 0x40, 0xb1, 0x95, 0x52, 0x40, 0x42, 0x9c, 0x02, 0x34, 0x20, 0xf5, 0x50, 0x0c, 0x07,
+	0xc4, 0x25, 0xda, 0xb8, 0x53, 0xc7, 0x4a, 0xed, 0x4d, 0xbd, 0x9b, 0x88, 0x08, 0x71, 0x00, 0x89,
+	0x0b, 0x07, 0x84, 0x84, 0xc4, 0x13, 0xf0, 0x10, 0x3c, 0x42, 0x8f, 0x95, 0xb8, 0xa0, 0x1e, 0x2a,
+	0xd4, 0xf2, 0x20, 0xc8, 0xeb, 0x75, 0x42, 0x9a, 0xb8, 0x09, 0x97, 0x68, 0x33, 0x99, 0xef, 0xcc,
+	0x67, 0x46, 0xdf, 0x51, 0x90, 0x01, 0xbc, 0x0e, 0xa1, 0xef, 0x05, 0xdc, 0xda, 0x01, 0xf0, 0x49,
+	0xd8, 0x00, 0x6e, 0xb5, 0x4b, 0xd6, 0x5e, 0x0b, 0xc2, 0x8e, 0xd9, 0x0c, 0x29, 0xa7, 0x78, 0xa1,
+	0x9b, 0x63, 0x76, 0x73, 0xcc, 0x76, 0x49, 0xbd, 0x96, 0xa2, 0xed, 0x25, 0x09, 0xbd, 0x5a, 0x70,
+	0xa9, 0x4b, 0xc5, 0xd3, 0x8a, 0x5e, 0x32, 0x5a, 0x74, 0x29, 0x75, 0x77, 0xc1, 0x22, 0x4d, 0xcf,
+	0x22, 0x41, 0x40, 0x39, 0xe1, 0x1e, 0x0d, 0x58, 0xfc, 0xab, 0x51, 0x40, 0xf8, 0x59, 0x84, 0xb0,
+	0x45, 0x42, 0xe2, 0x33, 0x1b, 0xf6, 0x5a, 0xc0, 0xb8, 0xf1, 0x1c, 0xfd, 0xdf, 0x17, 0x65, 0x4d,
+	0x1a, 0x30, 0xc0, 0x0f, 0x50, 0xae, 0x29, 0x22, 0x8b, 0xca, 0x45, 0x65, 0x25, 0xbf, 0xa6, 0x99,
+	0xc3, 0x89, 0xcd, 0x58, 0x57, 0x9e, 0xdc, 0x3f, 0xd2, 0x33, 0xb6, 0xd4, 0x18, 0xf3, 0xb2, 0x68,
+	0x99, 0x30, 0xd8, 0x00, 0x48, 0x7a, 0x6d, 0xa2, 0x42, 0x7f, 0x58, 0x36, 0x5b, 0x47, 0x33, 0x35,
+	0xc2, 0xa0, 0xba, 0x03, 0x20, 0xda, 0xcd, 0x96, 0xcf, 0x1f, 0x1e, 0xe9, 0xf3, 0x0e, 0x65, 0x3e,
+	0x65, 0x6c, 0xbb, 0x61, 0x7a, 0xd4, 0xf2, 0x09, 0xaf, 0x9b, 0x4f, 0x03, 0x6e, 0x4f, 0xd7, 0x62,
+	0xb5, 0xb1, 0x90, 0x54, 0xdb, 0xa5, 0x4e, 0xa3, 0x42, 0xba, 0x13, 0x5d, 0x47, 0xf3, 0xa7, 0xe2,
+	0xb2, 0xcd, 0x1c, 0xca, 0xba, 0x24, 0x1e, 0x28, 0x6b, 0x47, 0x4f, 0x63, 0x1d, 0x15, 0x45, 0xea,
+	0x06, 0xc0, 0x0b, 0xda, 0x80, 0xa0, 0x42, 0xd8, 0x56, 0xe8, 0x39, 0x09, 0x30, 0x2e, 0xa0, 0xa9,
+	0x6d, 0x08, 0xa8, 0x1f, 0x53, 0xd9, 0xf1, 0x17, 0xe3, 0x9b, 0x82, 0x96, 0x52, 0x64, 0xb2, 0xd3,
+	0xfd, 0x81, 0x81, 0xf4, 0xc3, 0x23, 0xfd, 0xc2, 0xe0, 0x40, 0x9b, 0xe0, 0x12, 0xa7, 0xf3, 0x08,
+	0x9c, 0xee, 0x58, 0xb8, 0x82, 0xfe, 0xf3, 0xbd, 0xa0, 0xea, 0x12, 0x56, 0x6d, 0x46, 0x45, 0x17,
+	0x27, 0x44, 0x81, 0xcb, 0xd1, 0x82, 0x47, 0x15, 0xc9, 0xfb, 0x5e, 0x17, 0xc6, 0x78, 0x89, 0x16,
+	0x12, 0xca, 0x27, 0x1e, 0xe3, 0x34, 0xec, 0x24, 0x63, 0xe9, 0x28, 0x5f, 0x8b, 0x96, 0x53, 0x75,
+	0x68, 0x2b, 0xe0, 0x82, 0x70, 0xd2, 0x46, 0x22, 0xf4, 0x30, 0x8a, 0xe0, 0x25, 0x84, 0x76, 0x09,
+	0xe3, 0x55, 0x11, 0x12, 0x00, 0x59, 0x7b, 0x36, 0x8a, 0x88, 0x9d, 0x1a, 0x35, 0x74, 0x6e, 0xa0,
+	0xb2, 0x9c, 0xbc, 0x82, 0xa6, 0x21, 0xe0, 0xa1, 0x07, 0xd1, 0x9e, 0xb3, 0x2b, 0xf9, 0xb5, 0xe5,
+	0x34, 0xe3, 0xf4, 0xc4, 0x8f, 0x03, 0x1e, 0x76, 0xa4, 0x83, 0x12, 0xf5, 0xda, 0xbb, 0x1c, 0x9a,
+	0x12, 0x4d, 0xf0, 0x07, 0x05, 0xe5, 0x62, 0x97, 0xe1, 0x1b, 0x69, 0xc5, 0x06, 0x8d, 0xad, 0xde,
+	0x1c, 0x2b, 0x37, 0xc6, 0x36, 0x8c, 0xf7, 0x3f, 0x7e, 0x7f, 0x99, 0x28, 0x62, 0xd5, 0x82, 0xb6,
+	0x4f, 0x59, 0xff, 0xf1, 0xc5, 0xa6, 0xc6, 0x1f, 0x15, 0x34, 0x2d, 0x9d, 0x8b, 0xcf, 0x2e, 0xde,
+	0x6f, 0x7b, 0x75, 0x75, 0xbc, 0x64, 0x89, 0x72, 0x45, 0xa0, 0x68, 0xb8, 0x38, 0x0c, 0x25, 0x71,
+	0x15, 0xfe, 0xa4, 0xa0, 0x99, 0xc4, 0xe0, 0x78, 0x44, 0x83, 0xfe, 0xfb, 0x50, 0x6f, 0x8d, 0x99,
+	0x2d, 0x79, 0xae, 0x0a, 0x1e, 0x1d, 0x2f, 0x0d, 0xe5, 0x11, 0x36, 0x72, 0x09, 0xc3, 0xdf, 0x15,
+	0x34, 0x77, 0xfa, 0x1e, 0xf0, 0xfa, 0x99, 0xad, 0x52, 0xae, 0x4e, 0xbd, 0xf3, 0x8f, 0x2a, 0x09,
+	0x7a, 0x4f, 0x80, 0x96, 0xb0, 0x35, 0x0c, 0x74, 0x07, 0xa0, 0xca, 0x23, 0x59, 0xef, 0xb0, 0xac,
+	0x37, 0xe2, 0x9c, 0xdf, 0xe2, 0xaf, 0x0a, 0x42, 0x3d, 0x37, 0x62, 0x73, 0x54, 0xfb, 0xfe, 0x6b,
+	0x52, 0xad, 0xb1, 0xf3, 0x25, 0xe8, 0xb2, 0x00, 0xbd, 0x84, 0xf5, 0x34, 0xd0, 0x7a, 0x2c, 0x28,
+	0x6f, 0xec, 0x1f, 0x6b, 0xca, 0xc1, 0xb1, 0xa6, 0xfc, 0x3a, 0xd6, 0x94, 0xcf, 0x27, 0x5a, 0xe6,
+	0xe0, 0x44, 0xcb, 0xfc, 0x3c, 0xd1, 0x32, 0xaf, 0x56, 0x5d, 0x8f, 0xd7, 0x5b, 0x35, 0xd3, 0xa1,
+	0xbe, 0x2c, 0x12, 0x7f, 0xb6, 0x4b, 0x77, 0xad, 0xd7, 0x7f, 0x15, 0xe4, 0x9d, 0x26, 0xb0, 0x5a,
+	0x4e, 0xfc, 0x01, 0xdc, 0xfe, 0x33, 0x00, 0x1d, 0x25, 0x7f, 0x07, 0x9a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +547,11 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeTokenGasPrice queries the base fee and the minimum gas price in the given
+	// fee token denomination.
+	FeeTokenGasPrice(ctx context.Context, in *QueryFeeTokenGasPriceRequest, opts ...grpc.CallOption) (*QueryFeeTokenGasPriceResponse, error)
+	// FeeHistory queries the fee history of the most recent blocks.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +589,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeTokenGasPrice(ctx context.Context, in *QueryFeeTokenGasPriceRequest, opts ...grpc.CallOption) (*QueryFeeTokenGasPriceResponse, error) {
+	out := new(QueryFeeTokenGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeTokenGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +615,11 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeTokenGasPrice queries the base fee and the minimum gas price in the given
+	// fee token denomination.
+	FeeTokenGasPrice(context.Context, *QueryFeeTokenGasPriceRequest) (*QueryFeeTokenGasPriceResponse, error)
+	// FeeHistory queries the fee history of the most recent blocks.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +635,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) FeeTokenGasPrice(ctx context.Context, req *QueryFeeTokenGasPriceRequest) (*QueryFeeTokenGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +700,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeTokenGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeTokenGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenGasPrice(ctx, req.(*QueryFeeTokenGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +752,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeTokenGasPrice",
+			Handler:    _Query_FeeTokenGasPrice_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -642,80 +930,283 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeeTokenGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryFeeTokenGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeTokenGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeTokenGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeTokenGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlockGasRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlockGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
+	if m.LastBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlock))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if m.LastBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlock))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1110,6 +1601,380 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeTokenGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlock", wireType)
+			}
+			m.LastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, FeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
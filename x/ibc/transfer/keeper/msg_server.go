@@ -26,6 +26,8 @@ var _ types.MsgServer = Keeper{}
 // registered through governance.
 // If user doesn't have enough balance of coin, it will attempt to convert
 // ERC20 tokens to the coin denomination, and continue with a regular transfer.
+// The token pairs served by the ERC20 precompile are never converted, since
+// their ERC20 balances are the coin balances.
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -70,6 +72,11 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	// update the msg denom to the token pair denom
 	msg.Token.Denom = pair.Denom
 
+	if k.erc20Keeper.IsPrecompilePair(ctx, pair) {
+		// no-op: the ERC20 precompile balances are the bank balances
+		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	}
+
 	// if the user has enough balance of the Cosmos representation, then we don't need to Convert
 	balance := k.bankKeeper.GetBalance(ctx, sender, pair.Denom)
 	if balance.Amount.GTE(msg.Token.Amount) {
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	erc20precompile "github.com/evmos/evmos/v16/precompiles/erc20"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
 	"github.com/evmos/evmos/v16/x/ibc/transfer/keeper"
 	"github.com/stretchr/testify/mock"
@@ -217,6 +219,32 @@ func (suite *KeeperTestSuite) TestTransfer() {
 			},
 			true,
 		},
+		{
+			"pass - precompile token pair - no conversion",
+			func() *types.MsgTransfer {
+				pair := erc20types.NewTokenPair(utiltx.GenerateAddress(), "test", erc20types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, pair.GetERC20Contract(), pair.GetID())
+
+				precompile, err := erc20precompile.NewPrecompile(pair, suite.app.BankKeeper, suite.app.AuthzKeeper, suite.app.TransferKeeper)
+				suite.Require().NoError(err)
+				err = suite.app.EvmKeeper.AddEVMExtensions(suite.ctx, precompile)
+				suite.Require().NoError(err)
+
+				senderAcc := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, math.NewInt(10)))
+				err = suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, senderAcc, coins)
+				suite.Require().NoError(err)
+				suite.Commit()
+
+				transferMsg := types.NewMsgTransfer("transfer", "channel-0", sdk.NewCoin("erc20/"+pair.Erc20Address, math.NewInt(10)), senderAcc.String(), "", timeoutHeight, 0, "")
+				return transferMsg
+			},
+			true,
+		},
 		{
 			"error - fail conversion - no balance in erc20",
 			func() *types.MsgTransfer {
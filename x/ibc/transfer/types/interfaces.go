@@ -35,5 +35,6 @@ type ERC20Keeper interface {
 	IsERC20Registered(ctx sdk.Context, contractAddr common.Address) bool
 	GetTokenPairID(ctx sdk.Context, token string) []byte
 	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	IsPrecompilePair(ctx sdk.Context, pair erc20types.TokenPair) bool
 	ConvertERC20(ctx context.Context, msg *erc20types.MsgConvertERC20) (*erc20types.MsgConvertERC20Response, error)
 }